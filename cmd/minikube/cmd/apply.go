@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/libminikube"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/plan"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var savedPlan string
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Creates or changes a cluster to match its cluster file",
+	Long: `Creates a cluster, or changes it to match its cluster file, with the changes that minikube plan shows.
+The attributes that cannot be changed in place, such as the driver or the memory, replace the cluster.
+With --plan, the changes are only made if they are still the ones of the saved plan. The failures exit with the same codes as minikube start.`,
+	Example: `minikube apply -f cluster.yaml
+minikube apply -f cluster.yaml --plan plan.json -o json`,
+	Run: func(_ *cobra.Command, _ []string) {
+		validateOutputFormat()
+		s, cc, p := computePlan()
+		if savedPlan != "" {
+			saved, err := plan.ReadPlan(savedPlan)
+			if err != nil {
+				exit.Message(reason.Usage, "Invalid plan: {{.error}}", out.V{"error": err})
+			}
+			if !plan.Equal(saved, p) {
+				exit.Message(reason.StalePlan, "The cluster or its file changed since the plan {{.plan}}", out.V{"plan": savedPlan})
+			}
+		}
+
+		events := make(chan libminikube.Event)
+		go func() {
+			for e := range events {
+				klog.Infof("%s: %s", e.Cluster, e.Message)
+			}
+		}()
+		// the JSON output is the plan alone
+		var progress func(plan.Change)
+		if outputFormat == "text" {
+			switch p.Action {
+			case plan.Create:
+				out.Step(style.Provisioning, "Creating cluster {{.name}} ...", out.V{"name": s.Name})
+			case plan.Replace:
+				out.Step(style.Provisioning, "Replacing cluster {{.name}} ...", out.V{"name": s.Name})
+			}
+			progress = func(c plan.Change) {
+				out.Step(style.Waiting, "Changing {{.attribute}} of {{.name}} to {{.value}} ...", out.V{"attribute": c.Attribute, "name": s.Name, "value": c.After})
+			}
+		}
+		err := plan.Apply(libminikube.New(libminikube.Options{Events: events}), s, cc, p, progress)
+		close(events)
+
+		var e *libminikube.Error
+		if errors.As(err, &e) {
+			exit.Message(reason.Kind{ID: e.ID, ExitCode: e.ExitCode, Advice: e.Advice}, e.Message)
+		}
+		if err != nil {
+			exit.Error(reason.InternalApply, "Unable to apply the cluster file", err)
+		}
+		if outputFormat == "json" {
+			printPlan(p)
+			return
+		}
+		if p.Action == plan.NoOp {
+			out.Step(style.Check, "{{.name}} matches its cluster file", out.V{"name": s.Name})
+			return
+		}
+		out.Step(style.Ready, "Applied the {{.count}} changes of {{.name}}", out.V{"count": len(p.Changes), "name": s.Name})
+	},
+}
+
+func init() {
+	applyCmd.Flags().StringVarP(&clusterFile, "file", "f", "", "The cluster file, eg: cluster.yaml")
+	applyCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+	applyCmd.Flags().StringVar(&savedPlan, "plan", "", "A plan saved by minikube plan -o json, which the changes must still match")
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// backgroundImagesCmd is the process started by 'minikube start --background-images'
+var backgroundImagesCmd = &cobra.Command{
+	Use:    "background-images",
+	Short:  "Loads the cached images and pulls the addon images of a started cluster",
+	Long:   "Loads the cached images and pulls the addon images of a started cluster. Started by 'minikube start --background-images', its progress is shown by 'minikube status --detailed'.",
+	Hidden: true,
+	Run: func(_ *cobra.Command, _ []string) {
+		cname := ClusterFlagValue()
+		cc, err := config.Load(cname)
+		if err != nil {
+			exit.Error(reason.HostConfigLoad, "Error getting cluster config", err)
+		}
+		if err := node.LoadBackgroundImages(cc); err != nil {
+			exit.Error(reason.GuestImageLoad, "Failed to load images", err)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(backgroundImagesCmd)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/perf"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// benchProfile is the profile of 'minikube bench', which it deletes before and after each run
+const benchProfile = "minikube-bench"
+
+var (
+	benchRuns         int
+	benchScenarios    []string
+	benchImage        string
+	benchBaseline     string
+	benchSaveBaseline string
+	benchThreshold    float64
+)
+
+// benchCmd represents the bench command
+var benchCmd = &cobra.Command{
+	Use:   "bench [flags] [-- start flags]",
+	Short: "Times minikube scenarios, and compares them against a baseline",
+	Long: `Times the start, image-load, node-add and stop scenarios over several runs, on a dedicated "minikube-bench" profile that is deleted before and after each run.
+Reports the mean wall time and the CPU time of the minikube process of each scenario, and compares them against a baseline saved by a previous run. The arguments after -- are passed to 'minikube start', eg: to compare drivers.`,
+	Example: `minikube bench --save-baseline=docker.json -- --driver=docker
+minikube bench --baseline=docker.json -- --driver=docker`,
+	Run: func(_ *cobra.Command, args []string) {
+		if outputFormat != "text" && outputFormat != "json" {
+			exit.Message(reason.Usage, "invalid output format: {{.output}}. Valid values: 'text', 'json'", out.V{"output": outputFormat})
+		}
+		if benchRuns < 1 {
+			exit.Message(reason.Usage, "The --runs flag must be at least 1")
+		}
+
+		var baseline perf.Baseline
+		if benchBaseline != "" {
+			b, err := perf.ReadBaseline(benchBaseline)
+			if err != nil {
+				exit.Error(reason.Usage, "Unable to read the baseline", err)
+			}
+			baseline = b
+		}
+
+		o := perf.BenchOptions{
+			Binary:    os.Args[0],
+			Profile:   benchProfile,
+			StartArgs: args,
+			Scenarios: benchScenarios,
+			Runs:      benchRuns,
+			Image:     benchImage,
+		}
+		// the JSON output is the results alone
+		if outputFormat == "text" {
+			o.Progress = func(scenario string, run int) {
+				out.Step(style.Waiting, "Timing {{.scenario}} ({{.run}}/{{.runs}}) ...", out.V{"scenario": scenario, "run": run, "runs": benchRuns})
+			}
+		}
+		results, err := perf.Bench(context.Background(), o)
+		if err != nil {
+			exit.Error(reason.InternalBench, "Unable to run the benchmark", err)
+		}
+
+		if benchSaveBaseline != "" {
+			if err := perf.WriteBaseline(benchSaveBaseline, perf.NewBaseline(results)); err != nil {
+				exit.Error(reason.InternalBench, "Unable to save the baseline", err)
+			}
+		}
+
+		regressions := perf.Regressions(results, baseline, benchThreshold/100)
+		if outputFormat == "json" {
+			printBenchJSON(results, regressions)
+		} else {
+			printBenchTable(results, baseline)
+		}
+		if len(regressions) > 0 {
+			var names []string
+			for _, r := range regressions {
+				names = append(names, r.Scenario)
+			}
+			exit.Message(reason.BenchRegression, "{{.scenarios}} took more than {{.threshold}}% longer than the baseline", out.V{"scenarios": strings.Join(names, ", "), "threshold": benchThreshold})
+		}
+	},
+}
+
+func printBenchTable(results []perf.BenchResult, baseline perf.Baseline) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Scenario", "Runs", "Wall (s)", "CPU (s)", "Baseline (s)", "Change"})
+	table.SetAutoFormatHeaders(false)
+	table.SetBorders(tablewriter.Border{Left: true, Top: true, Right: true, Bottom: true})
+	table.SetCenterSeparator("|")
+	for _, r := range results {
+		base, change := "", ""
+		if b, ok := baseline[r.Scenario]; ok && b > 0 {
+			base = fmt.Sprintf("%.1f", b)
+			change = fmt.Sprintf("%+.0f%%", (r.MeanWall()-b)/b*100)
+		}
+		table.Append([]string{r.Scenario, fmt.Sprint(len(r.Wall)), fmt.Sprintf("%.1f", r.MeanWall()), fmt.Sprintf("%.1f", r.MeanCPU()), base, change})
+	}
+	table.Render()
+}
+
+func printBenchJSON(results []perf.BenchResult, regressions []perf.Regression) {
+	b, err := json.Marshal(map[string]interface{}{"results": results, "regressions": regressions})
+	if err != nil {
+		exit.Error(reason.InternalJSONMarshal, "json encoding failure", err)
+	}
+	os.Stdout.Write(b)
+}
+
+func init() {
+	benchCmd.Flags().IntVar(&benchRuns, "runs", 3, "Number of times each scenario is timed")
+	benchCmd.Flags().StringSliceVar(&benchScenarios, "scenarios", perf.BenchScenarios, "Scenarios to time. Options include: [start,image-load,node-add,stop]")
+	benchCmd.Flags().StringVar(&benchImage, "image", "gcr.io/k8s-minikube/busybox:latest", "Image loaded by the image-load scenario")
+	benchCmd.Flags().StringVar(&benchBaseline, "baseline", "", "Baseline file to compare the results against, saved by --save-baseline")
+	benchCmd.Flags().StringVar(&benchSaveBaseline, "save-baseline", "", "File to save the results to, as a baseline for later runs")
+	benchCmd.Flags().Float64Var(&benchThreshold, "threshold", 20, "Percentage by which a scenario may be slower than its baseline before it counts as a regression")
+	benchCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+	RootCmd.AddCommand(benchCmd)
+}
@@ -17,11 +17,14 @@ limitations under the License.
 package cmd
 
 import (
+	"slices"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
 	cmdConfig "k8s.io/minikube/cmd/minikube/cmd/config"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/detect"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/image"
 	"k8s.io/minikube/pkg/minikube/machine"
@@ -35,6 +38,11 @@ const cacheImageConfigKey = "cache"
 
 const allFlag = "all"
 
+var (
+	cachePlatforms    []string
+	cacheAllPlatforms bool
+)
+
 // cacheCmd represents the cache command
 var cacheCmd = &cobra.Command{
 	Use:   "cache",
@@ -49,6 +57,12 @@ var addCacheCmd = &cobra.Command{
 	Long:  "Add an image to local cache.",
 	Run: func(_ *cobra.Command, args []string) {
 		out.WarningT("\"minikube cache\" will be deprecated in upcoming versions, please switch to \"minikube image load\"")
+		// Cache the variants of the other platforms, for the nodes of their arch
+		for arch, images := range cachePlatformImages(args) {
+			if err := image.SaveArchToDir(images, detect.ArchImageCacheDir(arch), arch, false); err != nil {
+				exit.Error(reason.InternalCacheLoad, "Failed to cache images", err)
+			}
+		}
 		// Cache and load images into docker daemon
 		if err := machine.CacheAndLoadImages(args, cacheAddProfiles(), false); err != nil {
 			exit.Error(reason.InternalCacheLoad, "Failed to cache and load images", err)
@@ -62,6 +76,35 @@ var addCacheCmd = &cobra.Command{
 
 func addCacheCmdFlags() {
 	addCacheCmd.Flags().Bool(allFlag, false, "Add image to cache for all running minikube clusters")
+	addCacheCmd.Flags().StringSliceVar(&cachePlatforms, "platform", nil, "Also cache the variants of these platforms, for the nodes of their architecture, eg: linux/arm64")
+	addCacheCmd.Flags().BoolVar(&cacheAllPlatforms, "all-platforms", false, "Also cache the variants of all the linux platforms of the image in its registry")
+}
+
+// cachePlatformImages returns the images to cache for each arch of --platform, or of the variants of the images with --all-platforms
+func cachePlatformImages(images []string) map[string][]string {
+	archImages := map[string][]string{}
+	for _, p := range cachePlatforms {
+		arch, err := image.ParsePlatform(p)
+		if err != nil {
+			exit.Message(reason.Usage, "Invalid --platform {{.platform}}: {{.err}}", out.V{"platform": p, "err": err})
+		}
+		archImages[arch] = images
+	}
+	if cacheAllPlatforms {
+		for _, img := range images {
+			archs, err := image.RemoteArchs(img)
+			if err != nil {
+				out.WarningT("Unable to list the platforms of {{.image}}: {{.err}}", out.V{"image": img, "err": err})
+				continue
+			}
+			for _, arch := range archs {
+				if !slices.Contains(archImages[arch], img) {
+					archImages[arch] = append(archImages[arch], img)
+				}
+			}
+		}
+	}
+	return archImages
 }
 
 func cacheAddProfiles() []*config.Profile {
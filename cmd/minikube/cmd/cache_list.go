@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	cmdConfig "k8s.io/minikube/cmd/minikube/cmd/config"
 	"k8s.io/minikube/pkg/minikube/exit"
+	img "k8s.io/minikube/pkg/minikube/image"
 	"k8s.io/minikube/pkg/minikube/reason"
 )
 
@@ -33,6 +34,8 @@ var cacheListFormat string
 // CacheListTemplate represents the cache list template
 type CacheListTemplate struct {
 	CacheImage string
+	// Platforms are the platforms that the image is cached for, eg: linux/amd64
+	Platforms []string
 }
 
 // listCacheCmd represents the cache list command
@@ -65,7 +68,10 @@ func cacheList(images []string) error {
 		if err != nil {
 			return err
 		}
-		listTmplt := CacheListTemplate{image}
+		listTmplt := CacheListTemplate{CacheImage: image}
+		for _, arch := range img.CachedArchs(image) {
+			listTmplt.Platforms = append(listTmplt.Platforms, "linux/"+arch)
+		}
 		if err := tmpl.Execute(os.Stdout, listTmplt); err != nil {
 			return err
 		}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/libminikube"
+	"k8s.io/minikube/pkg/minikube/capi"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	capiContext  string
+	capiInterval time.Duration
+)
+
+// capiCmd represents the set of Cluster API subcommands
+var capiCmd = &cobra.Command{
+	Use:   "capi",
+	Short: "Runs a Cluster API infrastructure provider that creates minikube clusters",
+	Long:  "Operations of the Cluster API infrastructure provider, which creates and scales minikube clusters on this host for the Cluster API objects of a management cluster",
+	Run: func(_ *cobra.Command, _ []string) {
+		exit.Message(reason.Usage, "Usage: minikube capi [crds|run]")
+	},
+}
+
+var capiCRDsCmd = &cobra.Command{
+	Use:     "crds",
+	Short:   "Prints the CustomResourceDefinitions of the Cluster API provider",
+	Long:    "Prints the CustomResourceDefinitions of MinikubeCluster, MinikubeMachine and MinikubeMachineTemplate, to be applied to the management cluster",
+	Example: "minikube capi crds | kubectl apply -f -",
+	Run: func(_ *cobra.Command, _ []string) {
+		fmt.Print(capi.CRDs)
+	},
+}
+
+var capiRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Runs the Cluster API provider until interrupted",
+	Long: `Reconciles the MinikubeCluster and MinikubeMachine objects of the management cluster into minikube clusters and nodes on this host, until interrupted.
+Each MinikubeCluster creates the minikube cluster of the same name, and its MinikubeMachines add its nodes.`,
+	Example: "minikube capi run --context capi-mgmt",
+	Run: func(_ *cobra.Command, _ []string) {
+		cfg, err := kapi.ClientConfig(capiContext)
+		if err != nil {
+			exit.Error(reason.HostCAPIProvider, "Unable to get the config of the management cluster", err)
+		}
+		client, err := dynamic.NewForConfig(cfg)
+		if err != nil {
+			exit.Error(reason.HostCAPIProvider, "Unable to create a client of the management cluster", err)
+		}
+		core, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			exit.Error(reason.HostCAPIProvider, "Unable to create a client of the management cluster", err)
+		}
+
+		events := make(chan libminikube.Event)
+		go func() {
+			for e := range events {
+				klog.Infof("%s: %s", e.Cluster, e.Message)
+			}
+		}()
+		p := capi.NewProvider(client, core, libminikube.New(libminikube.Options{Events: events}))
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+		out.Step(style.Running, "Running the Cluster API provider for {{.host}}. Press Ctrl-C to stop.", out.V{"host": cfg.Host})
+		p.Run(ctx, capiInterval)
+	},
+}
+
+func init() {
+	capiRunCmd.Flags().StringVar(&capiContext, "context", "", "The kubectl context of the management cluster, defaults to the current context")
+	capiRunCmd.Flags().DurationVar(&capiInterval, "interval", 10*time.Second, "How often the objects of the management cluster are reconciled")
+	capiCmd.AddCommand(capiCRDsCmd)
+	capiCmd.AddCommand(capiRunCmd)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var certsRewrapCmd = &cobra.Command{
+	Use:   "rewrap-secrets",
+	Short: "Encrypt every secret of the cluster again with the current encryption provider",
+	Long:  "Rewrites every secret of a cluster started with --encrypt-secrets, so that the secrets written before encryption was enabled, or before the provider changed, are encrypted with the current provider.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube certs rewrap-secrets")
+		}
+		cname := ClusterFlagValue()
+		co := mustload.Healthy(cname)
+		if co.Config.EncryptSecrets == "" {
+			exit.Message(reason.Usage, "The secrets of \"{{.name}}\" are not encrypted, to encrypt them run: minikube start -p {{.name}} --encrypt-secrets", out.V{"name": cname})
+		}
+		if err := bootstrapper.RewrapSecrets(co.CP.Runner, *co.Config); err != nil {
+			exit.Error(reason.GuestRewrapSecrets, "Unable to rewrap the secrets", err)
+		}
+		out.Step(style.Ready, "Encrypted the secrets of \"{{.name}}\" with {{.provider}}", out.V{"name": cname, "provider": co.Config.EncryptSecrets})
+	},
+}
+
+func init() {
+	certsCmd.AddCommand(certsRewrapCmd)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var certsRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Renew the certificates of the cluster",
+	Long:  "Renews the certificates that minikube signs for the cluster, and the ones kubeadm manages on each control-plane node, restarts the control-plane components to use them, and updates the kubeconfig. The CAs are kept.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube certs rotate")
+		}
+		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+
+		co := mustload.Healthy(cname)
+		if err := node.RotateCerts(co.API, co.Config); err != nil {
+			exit.Error(reason.GuestCert, "Unable to rotate the certificates", err)
+		}
+		out.Step(style.Ready, "Rotated the certificates of \"{{.name}}\"", out.V{"name": cname})
+		printCertsTable(*co.Config, clusterCertsExpiry(co.API, *co.Config))
+	},
+}
+
+func init() {
+	addLockTimeoutFlag(certsRotateCmd)
+	certsCmd.AddCommand(certsRotateCmd)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/state"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+var certsStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show when the certificates of the cluster expire",
+	Long:  "Shows when the certificates of the cluster expire: the CAs, client and apiserver certs kept on the host, and the kubeadm, kubelet and docker daemon certs of each running node.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube certs status")
+		}
+		if outputFormat != "text" && outputFormat != "json" {
+			exit.Message(reason.Usage, "invalid output format: {{.output}}. Valid values: 'text', 'json'", out.V{"output": outputFormat})
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		certs := clusterCertsExpiry(api, *cc)
+
+		if outputFormat == "json" {
+			b, err := json.Marshal(certs)
+			if err != nil {
+				exit.Error(reason.InternalJSONMarshal, "json encoding failure", err)
+			}
+			os.Stdout.Write(b)
+		} else {
+			printCertsTable(*cc, certs)
+		}
+
+		expiring := 0
+		for _, c := range certs {
+			if c.ExpiresWithin(bootstrapper.ExpiryWarning) {
+				expiring++
+			}
+		}
+		if expiring > 0 && outputFormat == "text" {
+			out.WarningT("{{.count}} certificates of the cluster expire within 30 days. To renew them, run: 'minikube certs rotate -p {{.profile}}'", out.V{"count": expiring, "profile": cc.Name})
+		}
+	},
+}
+
+// clusterCertsExpiry returns the expiry of the certificates of cc kept on the host, and of the ones of each running node
+func clusterCertsExpiry(api libmachine.API, cc config.ClusterConfig) []bootstrapper.CertExpiry {
+	certs := bootstrapper.HostCertsExpiry(cc)
+	for _, n := range cc.Nodes {
+		m := config.MachineName(cc, n)
+		if st, err := machine.Status(api, m); err != nil || st != state.Running.String() {
+			klog.Infof("skipping %s, which is not running: %v", m, err)
+			continue
+		}
+		h, err := machine.LoadHost(api, m)
+		if err != nil {
+			exit.Error(reason.GuestLoadHost, "Error getting host", err)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			exit.Error(reason.InternalCommandRunner, "Failed to get command runner", err)
+		}
+		guest, err := bootstrapper.GuestCertsExpiry(r, n)
+		if err != nil {
+			exit.Error(reason.GuestCert, "Unable to read the certificates of a node", err)
+		}
+		certs = append(certs, guest...)
+	}
+	return certs
+}
+
+// certsDaysLeft returns the days until the first of certs expires, negative once it has
+func certsDaysLeft(certs []bootstrapper.CertExpiry, now time.Time) int {
+	if len(certs) == 0 {
+		return 0
+	}
+	first := certs[0].NotAfter
+	for _, c := range certs[1:] {
+		if c.NotAfter.Before(first) {
+			first = c.NotAfter
+		}
+	}
+	return daysLeft(first, now)
+}
+
+// daysLeft returns the whole days from now until t, rounded down so that it is negative once t has passed
+func daysLeft(t, now time.Time) int {
+	return int(math.Floor(t.Sub(now).Hours() / 24))
+}
+
+func printCertsTable(cc config.ClusterConfig, certs []bootstrapper.CertExpiry) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Node", "Certificate", "Expires", "Days Left"})
+	table.SetAutoFormatHeaders(false)
+	table.SetBorders(tablewriter.Border{Left: true, Top: true, Right: true, Bottom: true})
+	table.SetCenterSeparator("|")
+	for _, c := range certs {
+		node := "host"
+		if c.Node != "" {
+			node = config.MachineName(cc, config.Node{Name: c.Node})
+		}
+		table.Append([]string{node, c.Path, c.NotAfter.Local().Format(time.RFC1123), fmt.Sprint(daysLeft(c.NotAfter, time.Now()))})
+	}
+	table.Render()
+}
+
+func init() {
+	certsStatusCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+	certsCmd.AddCommand(certsStatusCmd)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/host"
+	"github.com/docker/machine/libmachine/state"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/sysinit"
+)
+
+// reconfigure is the part of a node that has to be reconfigured for a setting to take effect
+type reconfigure int
+
+const (
+	// reconfigureEngine provisions the container engine again, which restarts it
+	reconfigureEngine reconfigure = iota
+	// reconfigureKubelet regenerates the kubelet config and restarts the kubelet
+	reconfigureKubelet
+	// reconfigureRegistries provisions the container engine again, and renders the registries into the config of containerd and cri-o
+	reconfigureRegistries
+)
+
+// applyFn updates a cluster config with the value of a setting
+type applyFn func(cc *config.ClusterConfig, val string) (reconfigure, error)
+
+// splitList splits a comma separated config value
+func splitList(val string) []string {
+	var l []string
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+	return l
+}
+
+func applyInsecureRegistry(cc *config.ClusterConfig, val string) (reconfigure, error) {
+	cc.InsecureRegistry = splitList(val)
+	return reconfigureRegistries, nil
+}
+
+func applyRegistryMirror(cc *config.ClusterConfig, val string) (reconfigure, error) {
+	cc.RegistryMirror = splitList(val)
+	return reconfigureRegistries, nil
+}
+
+func applyDockerEnv(cc *config.ClusterConfig, val string) (reconfigure, error) {
+	cc.DockerEnv = config.SplitDockerEnv(val)
+	return reconfigureEngine, nil
+}
+
+// applyExtraConfig replaces the kubelet options of the cluster, options of other components need a restart of the control plane
+func applyExtraConfig(cc *config.ClusterConfig, val string) (reconfigure, error) {
+	var es config.ExtraOptionSlice
+	for _, e := range config.SplitExtraOptions(val) {
+		if err := es.Set(e); err != nil {
+			return 0, err
+		}
+	}
+	for _, e := range es {
+		if e.Component != "kubelet" {
+			return 0, fmt.Errorf("%s options can not be applied to a running cluster, only kubelet options can", e.Component)
+		}
+	}
+	opts := config.ExtraOptionSlice{}
+	for _, e := range cc.KubernetesConfig.ExtraOptions {
+		if !es.Exists(e.String()) {
+			opts = append(opts, e)
+		}
+	}
+	cc.KubernetesConfig.ExtraOptions = append(opts, es...)
+	return reconfigureKubelet, nil
+}
+
+// Apply applies a setting to every running node of a profile and saves the updated cluster config.
+// It returns the names of the nodes that were updated.
+func Apply(profile, name, val string) ([]string, error) {
+	s, err := findSetting(name)
+	if err != nil {
+		return nil, err
+	}
+	if s.apply == nil {
+		return nil, fmt.Errorf("%q can not be applied to a running cluster, run 'minikube start' for it to take effect", name)
+	}
+
+	cc, err := config.Load(profile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load profile %q", profile)
+	}
+	what, err := s.apply(cc, val)
+	if err != nil {
+		return nil, err
+	}
+
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "api client")
+	}
+	defer api.Close()
+
+	var updated []string
+	for _, n := range cc.Nodes {
+		machineName := config.MachineName(*cc, n)
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			return updated, errors.Wrapf(err, "load host %q", machineName)
+		}
+		if st, err := h.Driver.GetState(); err != nil || st != state.Running {
+			klog.Infof("skipping %q, which is not running: %v", machineName, err)
+			continue
+		}
+		if err := applyToNode(api, h, *cc, n, what); err != nil {
+			return updated, errors.Wrapf(err, "apply %s to %q", name, machineName)
+		}
+		updated = append(updated, machineName)
+	}
+	return updated, config.SaveProfile(cc.Name, cc)
+}
+
+func applyToNode(api libmachine.API, h *host.Host, cc config.ClusterConfig, n config.Node, what reconfigure) error {
+	switch what {
+	case reconfigureEngine:
+		return machine.ProvisionEngine(api, h, cc)
+	case reconfigureRegistries:
+		return machine.RefreshRuntimeConfig(api, h, cc)
+	}
+
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return errors.Wrap(err, "command runner")
+	}
+	cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r, Socket: cc.KubernetesConfig.CRISocket})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
+	bs, err := cluster.Bootstrapper(api, viper.GetString(Bootstrapper), cc, r)
+	if err != nil {
+		return errors.Wrap(err, "bootstrapper")
+	}
+	if err := bs.UpdateNode(cc, n, cr); err != nil {
+		return errors.Wrap(err, "update node")
+	}
+	return sysinit.New(r).Restart("kubelet")
+}
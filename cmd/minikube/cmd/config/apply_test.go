@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestApplyExtraConfig(t *testing.T) {
+	cc := &config.ClusterConfig{KubernetesConfig: config.KubernetesConfig{ExtraOptions: config.ExtraOptionSlice{
+		{Component: "kubelet", Key: "max-pods", Value: "110"},
+		{Component: "apiserver", Key: "v", Value: "2"},
+	}}}
+
+	what, err := applyExtraConfig(cc, "kubelet.max-pods=50, kubelet.eviction-hard=memory.available<5%,nodefs.available<10%")
+	if err != nil {
+		t.Fatalf("applyExtraConfig() error: %v", err)
+	}
+	if what != reconfigureKubelet {
+		t.Errorf("applyExtraConfig() = %v, want reconfigureKubelet", what)
+	}
+	want := config.ExtraOptionSlice{
+		{Component: "apiserver", Key: "v", Value: "2"},
+		{Component: "kubelet", Key: "max-pods", Value: "50"},
+		{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<5%,nodefs.available<10%"},
+	}
+	if !reflect.DeepEqual(cc.KubernetesConfig.ExtraOptions, want) {
+		t.Errorf("ExtraOptions = %v, want %v", cc.KubernetesConfig.ExtraOptions, want)
+	}
+
+	if _, err := applyExtraConfig(cc, "apiserver.v=5"); err == nil {
+		t.Errorf("applyExtraConfig() of an apiserver option returned no error")
+	}
+}
+
+func TestApplyEngineSettings(t *testing.T) {
+	cc := &config.ClusterConfig{}
+	tests := []struct {
+		apply applyFn
+		val   string
+		field *[]string
+		want  reconfigure
+		value []string
+	}{
+		{applyInsecureRegistry, "a, b,", &cc.InsecureRegistry, reconfigureRegistries, []string{"a", "b"}},
+		{applyRegistryMirror, "a, b,", &cc.RegistryMirror, reconfigureRegistries, []string{"a", "b"}},
+		{applyDockerEnv, "FOO=1, NO_PROXY=a,b,", &cc.DockerEnv, reconfigureEngine, []string{"FOO=1", "NO_PROXY=a,b"}},
+	}
+	for _, tc := range tests {
+		what, err := tc.apply(cc, tc.val)
+		if err != nil {
+			t.Fatalf("apply error: %v", err)
+		}
+		if what != tc.want {
+			t.Errorf("apply = %v, want %v", what, tc.want)
+		}
+		if !reflect.DeepEqual(*tc.field, tc.value) {
+			t.Errorf("apply(%q) = %q, want %q", tc.val, *tc.field, tc.value)
+		}
+	}
+
+	if _, err := Apply("minikube", "cpus", "4"); err == nil {
+		t.Errorf("Apply() of cpus returned no error")
+	}
+}
@@ -39,6 +39,8 @@ type Setting struct {
 	validDefaults func() []string
 	validations   []setFn
 	callbacks     []setFn
+	// apply updates a running cluster with the new value, see `minikube config set --apply`
+	apply applyFn
 }
 
 // These are all the settings that are configurable
@@ -105,6 +107,7 @@ var settings = []Setting{
 		name:          "kubernetes-version",
 		set:           SetString,
 		validDefaults: supportedKubernetesVersions,
+		validations:   []setFn{IsValidKubernetesVersion},
 	},
 	{
 		name:        "iso-url",
@@ -112,44 +115,70 @@ var settings = []Setting{
 		validations: []setFn{IsValidURL, IsURLExists},
 	},
 	{
-		name: config.WantUpdateNotification,
-		set:  SetBool,
+		name:        config.WantUpdateNotification,
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
-		name: config.WantBetaUpdateNotification,
-		set:  SetBool,
+		name:        config.WantBetaUpdateNotification,
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
-		name: config.ReminderWaitPeriodInHours,
-		set:  SetInt,
+		name:        config.ReminderWaitPeriodInHours,
+		set:         SetInt,
+		validations: []setFn{IsPositive},
 	},
 	{
-		name: config.WantNoneDriverWarning,
-		set:  SetBool,
+		name:        config.WantNoneDriverWarning,
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
-		name: config.WantVirtualBoxDriverWarning,
-		set:  SetBool,
+		name:        config.WantVirtualBoxDriverWarning,
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
-		name: config.ProfileName,
-		set:  SetString,
+		name:        config.ProfileName,
+		set:         SetString,
+		validations: []setFn{IsValidProfileName},
 	},
 	{
-		name: Bootstrapper,
-		set:  SetString,
+		name:        Bootstrapper,
+		set:         SetString,
+		validations: []setFn{IsValidBootstrapper},
 	},
 	{
-		name: "insecure-registry",
-		set:  SetString,
+		name:  "insecure-registry",
+		set:   SetString,
+		apply: applyInsecureRegistry,
+	},
+	{
+		name:  "registry-mirror",
+		set:   SetString,
+		apply: applyRegistryMirror,
+	},
+	{
+		name:        "docker-env",
+		set:         SetString,
+		validations: []setFn{IsValidDockerEnv},
+		apply:       applyDockerEnv,
+	},
+	{
+		name:        "extra-config",
+		set:         SetString,
+		validations: []setFn{IsValidExtraConfig},
+		apply:       applyExtraConfig,
 	},
 	{
 		name: "hyperv-virtual-switch",
 		set:  SetString,
 	},
 	{
-		name: "disable-driver-mounts",
-		set:  SetBool,
+		name:        "disable-driver-mounts",
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
 		name:   "cache",
@@ -157,20 +186,49 @@ var settings = []Setting{
 		setMap: SetMap,
 	},
 	{
-		name: config.EmbedCerts,
-		set:  SetBool,
+		name:        config.EmbedCerts,
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
-		name: "native-ssh",
-		set:  SetBool,
+		name:        config.KeepContext,
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
-		name: config.Rootless,
-		set:  SetBool,
+		name:        "native-ssh",
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
 	},
 	{
-		name: config.MaxAuditEntries,
-		set:  SetInt,
+		name:        config.Rootless,
+		set:         SetBool,
+		validations: []setFn{IsValidBool},
+	},
+	{
+		name:        config.MaxAuditEntries,
+		set:         SetInt,
+		validations: []setFn{IsNonNegative},
+	},
+	{
+		name:        config.WarmNodes,
+		set:         SetInt,
+		validations: []setFn{IsNonNegative},
+	},
+	{
+		name:        config.ProvisionAttempts,
+		set:         SetInt,
+		validations: []setFn{IsPositive},
+	},
+	{
+		name:        config.ProvisionBackoff,
+		set:         SetString,
+		validations: []setFn{IsValidDuration},
+	},
+	{
+		name:        config.ProvisionRetryPhases,
+		set:         SetString,
+		validations: []setFn{IsValidRetryPhases},
 	},
 }
 
@@ -47,6 +47,7 @@ var addonsConfigureCmd = &cobra.Command{
 		}
 
 		profile := ClusterFlagValue()
+		defer mustload.LockProfile(profile, lockTimeout).Release()
 
 		addon := args[0]
 		// allows for additional prompting of information when enabling addons
@@ -285,5 +286,6 @@ var addonsConfigureCmd = &cobra.Command{
 }
 
 func init() {
+	addLockTimeoutFlag(addonsConfigureCmd)
 	AddonsCmd.AddCommand(addonsConfigureCmd)
 }
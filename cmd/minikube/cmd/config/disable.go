@@ -35,6 +35,7 @@ var addonsDisableCmd = &cobra.Command{
 		if len(args) != 1 {
 			exit.Message(reason.Usage, "usage: minikube addons disable ADDON_NAME")
 		}
+		defer mustload.LockProfile(ClusterFlagValue(), lockTimeout).Release()
 		_, cc := mustload.Partial(ClusterFlagValue())
 		err := addons.VerifyNotPaused(ClusterFlagValue(), false)
 		if err != nil {
@@ -59,5 +60,6 @@ var addonsDisableCmd = &cobra.Command{
 }
 
 func init() {
+	addLockTimeoutFlag(addonsDisableCmd)
 	AddonsCmd.AddCommand(addonsDisableCmd)
 }
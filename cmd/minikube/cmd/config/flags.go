@@ -17,11 +17,21 @@ limitations under the License.
 package config
 
 import (
+	"time"
+
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"k8s.io/minikube/pkg/minikube/config"
 )
 
+var lockTimeout time.Duration
+
 // ClusterFlagValue returns the current cluster name based on flags
 func ClusterFlagValue() string {
 	return viper.GetString(config.ProfileName)
 }
+
+// addLockTimeoutFlag adds the --lock-timeout flag to a command that changes a profile
+func addLockTimeoutFlag(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&lockTimeout, "lock-timeout", 10*time.Minute, "How long to wait for other minikube commands changing the same profile to finish. 0 fails immediately.")
+}
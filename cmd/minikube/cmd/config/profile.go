@@ -84,11 +84,11 @@ var ProfileCmd = &cobra.Command{
 			out.ErrT(style.Sad, `Error loading profile config: {{.error}}`, out.V{"error": err})
 		}
 		if err == nil {
-			if cc.KeepContext {
+			if config.KeepsContext(*cc) {
 				out.SuccessT("Skipped switching kubectl context for {{.profile_name}} because --keep-context was set.", out.V{"profile_name": profile})
 				out.SuccessT("To connect to this cluster, use: kubectl --context={{.profile_name}}", out.V{"profile_name": profile})
 			} else {
-				err := kubeconfig.SetCurrentContext(profile, kubeconfig.PathFromEnv())
+				err := kubeconfig.SetCurrentContext(profile, kubeconfig.PathForProfile(profile, cc.KubeconfigMode))
 				if err != nil {
 					out.ErrT(style.Sad, `Error while setting kubectl current context :  {{.error}}`, out.V{"error": err})
 				}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"github.com/docker/machine/libmachine/state"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/archive"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	exportOutput       string
+	exportIncludeDisks bool
+	exportDefinition   bool
+)
+
+var profileExportCmd = &cobra.Command{
+	Use:   "export NAME",
+	Short: "Exports a profile to an archive",
+	Long: `Packages the config, certificates and cached artifacts of a profile into a single archive, which can be restored on another host with 'minikube profile import'.
+The machine disks are only included with --include-disks, and only for VM drivers.
+With --definition, only the settings, nodes and addons of the cluster are exported, as YAML, without the paths on the host, the certificates and the state of its machines, so that the team can create an equivalent cluster with 'minikube profile import' and 'minikube start'.`,
+	Example: `minikube profile export minikube -o minikube.tar.zst
+minikube profile export minikube --definition -o cluster.yaml`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "usage: minikube profile export NAME -o FILE")
+		}
+		name := args[0]
+		cc, err := config.Load(name)
+		if err != nil {
+			if config.IsNotExist(err) {
+				exit.Message(reason.Usage, `Profile "{{.name}}" not found`, out.V{"name": name})
+			}
+			exit.Error(reason.HostConfigLoad, "Unable to load config", err)
+		}
+
+		if exportDefinition {
+			dest := exportOutput
+			if dest == "" {
+				dest = name + ".yaml"
+			}
+			if err := archive.ExportDefinition(cc, dest); err != nil {
+				exit.Error(reason.HostProfileExport, "Failed to export profile", err)
+			}
+			out.Styled(style.Check, `Exported the definition of profile "{{.name}}" to {{.path}}`, out.V{"name": name, "path": dest})
+			return
+		}
+
+		if exportIncludeDisks {
+			if driver.IsKIC(cc.Driver) {
+				out.WarningT("The {{.driver}} driver keeps its disks in the container runtime, they will not be exported", out.V{"driver": cc.Driver})
+			} else {
+				warnIfRunning(cc)
+			}
+		}
+
+		dest := exportOutput
+		if dest == "" {
+			dest = name + ".tar.zst"
+		}
+		m, err := archive.Export(cc, dest, exportIncludeDisks && !driver.IsKIC(cc.Driver))
+		if err != nil {
+			exit.Error(reason.HostProfileExport, "Failed to export profile", err)
+		}
+		out.Styled(style.Check, `Exported profile "{{.name}}" to {{.path}}`, out.V{"name": m.Profile, "path": dest})
+	},
+}
+
+// warnIfRunning warns that copying the disks of a running machine may produce an inconsistent image
+func warnIfRunning(cc *config.ClusterConfig) {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		klog.Warningf("failed to get machine api client: %v", err)
+		return
+	}
+	defer api.Close()
+	for _, n := range cc.Nodes {
+		st, err := machine.Status(api, config.MachineName(*cc, n))
+		if err != nil {
+			klog.Warningf("failed to get status of %s: %v", n.Name, err)
+			continue
+		}
+		if st == state.Running.String() {
+			out.WarningT(`Profile "{{.name}}" is running, its disks may be exported in an inconsistent state. Run "minikube stop -p {{.name}}" first.`, out.V{"name": cc.Name})
+			return
+		}
+	}
+}
+
+func init() {
+	profileExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "The file to write. Defaults to NAME.tar.zst, or NAME.yaml with --definition")
+	profileExportCmd.Flags().BoolVar(&exportIncludeDisks, "include-disks", false, "If true, also exports the machine disks of VM drivers. Stop the profile first.")
+	profileExportCmd.Flags().BoolVar(&exportDefinition, "definition", false, "If true, exports a YAML definition of the cluster, without paths on the host, certificates or machine state, rather than an archive")
+	profileExportCmd.MarkFlagsMutuallyExclusive("definition", "include-disks")
+	ProfileCmd.AddCommand(profileExportCmd)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/archive"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var profileImportCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "Imports a profile from an archive or a definition",
+	Long:  "Restores a profile written by 'minikube profile export', or creates the profile of a definition written by 'minikube profile export --definition', eg: cluster.yaml, for 'minikube start' to create its machines. The profile must not exist yet.",
+	Example: `minikube profile import minikube.tar.zst
+minikube profile import cluster.yaml`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "usage: minikube profile import FILE")
+		}
+		if ext := filepath.Ext(args[0]); ext == ".yaml" || ext == ".yml" {
+			importDefinition(args[0])
+			return
+		}
+		m, err := archive.Import(args[0])
+		if err != nil {
+			if errors.Is(err, archive.ErrProfileExists) {
+				exit.Message(reason.HostProfileImport, `Profile "{{.name}}" already exists. To replace it, run "minikube delete -p {{.name}}" first.`, out.V{"name": m.Profile})
+			}
+			exit.Error(reason.HostProfileImport, "Failed to import profile", err)
+		}
+		out.Styled(style.Check, `Imported profile "{{.name}}" exported by minikube {{.version}}`, out.V{"name": m.Profile, "version": m.MinikubeVersion})
+		if !m.IncludesDisks {
+			out.Styled(style.Tip, `To create its machine, run: "minikube start -p {{.name}}"`, out.V{"name": m.Profile})
+		}
+	},
+}
+
+// importDefinition creates the profile of a cluster definition
+func importDefinition(src string) {
+	cc, err := archive.ImportDefinition(src)
+	if err != nil {
+		if errors.Is(err, archive.ErrProfileExists) {
+			exit.Message(reason.HostProfileImport, `Profile "{{.name}}" already exists. To replace it, run "minikube delete -p {{.name}}" first.`, out.V{"name": cc.Name})
+		}
+		exit.Error(reason.HostProfileImport, "Failed to import profile", err)
+	}
+	out.Styled(style.Check, `Imported profile "{{.name}}" with {{.count}} node(s)`, out.V{"name": cc.Name, "count": len(cc.Nodes)})
+	out.Styled(style.Tip, `To create its machines, run: "minikube start -p {{.name}}"`, out.V{"name": cc.Name})
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileImportCmd)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var profileRenameCmd = &cobra.Command{
+	Use:   "rename OLD NEW",
+	Short: "Renames a profile",
+	Long: `Renames a stopped profile along with its machines, kubeconfig context and certificates.
+Kubernetes node names follow the machine names, so the nodes are registered under their new names on the next start.
+The containers of the docker and podman drivers are created again by the next start, on copies of their volumes.
+VM drivers are not supported, as VM names are owned by the hypervisor.`,
+	Example: "minikube profile rename minikube dev",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 2 {
+			exit.Message(reason.Usage, "usage: minikube profile rename OLD NEW")
+		}
+		oldName, newName := args[0], args[1]
+		if !config.ProfileNameValid(newName) {
+			exit.Message(reason.Usage, "Profile name '{{.profilename}}' is not valid. Only alphanumeric and dashes '-' are permitted. Minimum 2 characters, starting with alphanumeric.", out.V{"profilename": newName})
+		}
+		if config.ProfileNameInReservedKeywords(newName) {
+			exit.Message(reason.InternalReservedProfile, `Profile name "{{.profilename}}" is a reserved keyword`, out.V{"profilename": newName})
+		}
+		if config.ProfileExists(newName) {
+			exit.Message(reason.Usage, `Profile "{{.name}}" already exists`, out.V{"name": newName})
+		}
+
+		for _, name := range []string{oldName, newName} {
+			r, err := config.LockProfile(name, 0)
+			if err != nil {
+				if errors.Is(err, config.ErrProfileLocked) {
+					exit.Message(reason.HostProfileLocked, `Profile "{{.name}}" is being changed by another minikube process`, out.V{"name": name})
+				}
+				exit.Error(reason.HostProfileLocked, "Unable to lock profile", err)
+			}
+			defer r.Release()
+		}
+
+		cc, err := config.Load(oldName)
+		if err != nil {
+			if config.IsNotExist(err) {
+				exit.Message(reason.Usage, `Profile "{{.name}}" not found`, out.V{"name": oldName})
+			}
+			exit.Error(reason.HostConfigLoad, "Unable to load config", err)
+		}
+
+		api, err := machine.NewAPIClient()
+		if err != nil {
+			exit.Error(reason.NewAPIClient, "Failed to get machine client", err)
+		}
+		defer api.Close()
+		if err := machine.CanRename(api, *cc); err != nil {
+			exit.Message(reason.Usage, `Unable to rename profile "{{.name}}": {{.error}}`, out.V{"name": oldName, "error": err})
+		}
+
+		if driver.IsKIC(cc.Driver) {
+			out.Styled(style.Copying, "Copying the {{.driver}} volumes of {{.name}}, this may take a while ...", out.V{"driver": cc.Driver, "name": oldName})
+		}
+		if err := machine.RenameProfile(cc, newName); err != nil {
+			exit.Error(reason.HostProfileRename, "Failed to rename profile", err)
+		}
+		if err := kubeconfig.RenameContext(oldName, newName, kubeconfig.PathForProfile(newName, cc.KubeconfigMode)); err != nil {
+			out.WarningT("Unable to rename the kubectl context of {{.name}}: {{.error}}", out.V{"name": oldName, "error": err})
+		}
+		if active, err := Get(config.ProfileName); err == nil && active == oldName {
+			if err := Set(config.ProfileName, newName); err != nil {
+				klog.Warningf("failed to set the active profile to %s: %v", newName, err)
+			}
+		}
+		out.Styled(style.Check, `Renamed profile "{{.old}}" to "{{.new}}"`, out.V{"old": oldName, "new": newName})
+		out.Styled(style.Tip, `To start it, run: "minikube start -p {{.name}}"`, out.V{"name": newName})
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileRenameCmd)
+}
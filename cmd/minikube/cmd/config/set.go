@@ -17,20 +17,29 @@ limitations under the License.
 package config
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
 )
 
+var applySetting bool
+
 var configSetCmd = &cobra.Command{
 	Use:   "set PROPERTY_NAME PROPERTY_VALUE",
 	Short: "Sets an individual value in a minikube config file",
 	Long: `Sets the PROPERTY_NAME config value to PROPERTY_VALUE
-	These values can be overwritten by flags or environment variables at runtime.`,
+	These values can be overwritten by flags or environment variables at runtime.
+	With --apply, settings that do not require recreating the cluster (insecure-registry, registry-mirror, docker-env and kubelet extra-config)
+	are also applied to the running nodes of the current profile.`,
 	Run: func(_ *cobra.Command, args []string) {
 		if len(args) < 2 {
 			exit.Message(reason.Usage, "not enough arguments ({{.ArgCount}}).\nusage: minikube config set PROPERTY_NAME PROPERTY_VALUE", out.V{"ArgCount": len(args)})
@@ -42,10 +51,25 @@ var configSetCmd = &cobra.Command{
 		if err != nil {
 			exit.Error(reason.InternalConfigSet, "Set failed", err)
 		}
+		if !applySetting {
+			return
+		}
+		defer mustload.LockProfile(ClusterFlagValue(), lockTimeout).Release()
+		nodes, err := Apply(ClusterFlagValue(), args[0], args[1])
+		if err != nil {
+			exit.Error(reason.InternalConfigSet, "Apply failed", err)
+		}
+		if len(nodes) == 0 {
+			out.WarningT("No running nodes were found, {{.name}} will take effect on the next start", out.V{"name": args[0]})
+			return
+		}
+		out.Styled(style.Check, "Applied {{.name}} to: {{.nodes}}", out.V{"name": args[0], "nodes": strings.Join(nodes, ", ")})
 	},
 }
 
 func init() {
+	configSetCmd.Flags().BoolVar(&applySetting, "apply", false, "If true, also apply the setting to the running nodes of the current profile, if it does not require recreating them")
+	addLockTimeoutFlag(configSetCmd)
 	ConfigCmd.AddCommand(configSetCmd)
 }
 
@@ -70,6 +94,9 @@ func Set(name string, value string) error {
 	if err != nil {
 		return errors.Wrapf(err, "set")
 	}
+	if errs := checkCompatibility(cc, name); len(errs) > 0 {
+		return errors.Wrapf(fmt.Errorf("%v", errs), "check compatibility for %q with value of %q", name, value)
+	}
 
 	// Run any callbacks for this property
 	err = run(name, value, s.callbacks)
@@ -79,3 +79,33 @@ func createTestConfig(t *testing.T) {
 		t.Fatalf("error creating temporary profiles directory: %+v", err)
 	}
 }
+
+func TestSetIncompatible(t *testing.T) {
+	createTestConfig(t)
+	if err := Set("driver", "ssh"); err != nil {
+		t.Fatalf("Set returned error for valid property value: %+v", err)
+	}
+	err := Set("memory", "no-limit")
+	if err == nil {
+		t.Fatalf("Set did not return error for a value incompatible with the driver")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	createTestConfig(t)
+	cfg := `{"driver": "ssh", "memory": "no-limit", "cpus": 0, "unknown": true}`
+	if err := os.WriteFile(localpath.ConfigFile(), []byte(cfg), 0644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if err := os.WriteFile(localpath.DefaultsFile(), []byte("v: -1\nports: [80]\n"), 0644); err != nil {
+		t.Fatalf("write defaults: %v", err)
+	}
+	errs, err := Validate("minikube")
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	// cpus, unknown, v from the defaults file and memory=no-limit with the ssh driver
+	if len(errs) != 4 {
+		t.Errorf("Validate returned %d problems, want 4: %v", len(errs), errs)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validates the values in the minikube config file",
+	Long: `Validates every value in the minikube config file, together with the settings the profile inherits from the defaults file,
+and reports all problems at once instead of failing later inside minikube start.`,
+	Run: func(_ *cobra.Command, _ []string) {
+		errs, err := Validate(ClusterFlagValue())
+		if err != nil {
+			exit.Error(reason.HostConfigLoad, "Unable to load config", err)
+		}
+		if len(errs) == 0 {
+			out.Styled(style.Check, "The minikube config is valid")
+			return
+		}
+		for _, e := range errs {
+			out.Styled(style.Failure, "{{.error}}", out.V{"error": e})
+		}
+		exit.Message(reason.HostConfigInvalid, "The minikube config has {{.count}} problem(s)", out.V{"count": len(errs)})
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configValidateCmd)
+}
+
+// Validate checks the config file and the defaults inherited by profile against the settings schema.
+// It returns one error per problem found.
+func Validate(profile string) ([]error, error) {
+	cfg, err := config.ReadConfig(localpath.ConfigFile())
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := config.ReadDefaults(localpath.DefaultsFile())
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	// the config file takes precedence over the defaults file, so validate the merged result
+	merged := defaults.ForProfile(profile)
+	for _, k := range sortedKeys(merged) {
+		if _, inConfig := cfg[k]; inConfig {
+			continue
+		}
+		// the defaults file may contain any start flag, only the ones with a schema can be checked
+		if _, err := findSetting(k); err != nil {
+			continue
+		}
+		if err := validateValue(k, merged[k]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", localpath.DefaultsFile(), err))
+		}
+	}
+	for _, k := range sortedKeys(cfg) {
+		if err := validateValue(k, cfg[k]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", localpath.ConfigFile(), err))
+		}
+		merged[k] = cfg[k]
+	}
+	return append(errs, checkCompatibility(merged, "")...), nil
+}
+
+// validateValue checks a single value against its setting's type and validations
+func validateValue(name string, val interface{}) error {
+	s, err := findSetting(name)
+	if err != nil {
+		return err
+	}
+	// maps are only ever written by dedicated commands such as `minikube cache add`
+	if m, ok := val.(map[string]interface{}); ok {
+		if s.setMap == nil {
+			return fmt.Errorf("%q must not be a map", name)
+		}
+		return s.setMap(config.MinikubeConfig{}, name, m)
+	}
+	str := fmt.Sprint(val)
+	if err := run(name, str, s.validations); err != nil {
+		return fmt.Errorf("%q: %v", name, err)
+	}
+	if err := s.set(config.MinikubeConfig{}, name, str); err != nil {
+		return fmt.Errorf("%q: %v", name, err)
+	}
+	return nil
+}
+
+func sortedKeys(m config.MinikubeConfig) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
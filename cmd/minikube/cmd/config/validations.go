@@ -23,12 +23,24 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/blang/semver/v4"
 	units "github.com/docker/go-units"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/out"
+	pkgutil "k8s.io/minikube/pkg/util"
+	"k8s.io/minikube/pkg/version"
+)
+
+const (
+	// minimumMemory mirrors the usable minimum enforced by minikube start
+	minimumMemory = 1800
+	// minimumCPUs mirrors the minimum enforced by minikube start
+	minimumCPUs = 2
 )
 
 // IsValidDriver checks if a driver is supported
@@ -59,11 +71,18 @@ func IsValidCPUs(name, cpus string) error {
 	if cpus == constants.MaxResources || cpus == constants.NoLimit {
 		return nil
 	}
-	return IsPositive(name, cpus)
+	if err := IsPositive(name, cpus); err != nil {
+		return err
+	}
+	// minikube start accepts fewer with --force, eg: for the none driver on a single CPU machine
+	if i, _ := strconv.Atoi(cpus); i < minimumCPUs {
+		out.WarningT("{{.name}} is less than the minimum of {{.minimum}}, minikube start will refuse it unless --force is given", out.V{"name": name, "minimum": minimumCPUs})
+	}
+	return nil
 }
 
 // IsValidMemory checks if a string is a valid memory size
-func IsValidMemory(_, memsize string) error {
+func IsValidMemory(name, memsize string) error {
 	if memsize == constants.MaxResources || memsize == constants.NoLimit {
 		return nil
 	}
@@ -71,6 +90,10 @@ func IsValidMemory(_, memsize string) error {
 	if err != nil {
 		return fmt.Errorf("invalid memory size: %v", err)
 	}
+	// minikube start accepts less with --force
+	if mb, err := pkgutil.CalculateSizeInMB(memsize); err == nil && mb < minimumMemory {
+		out.WarningT("{{.name}} of {{.size}}MB is less than the usable minimum of {{.minimum}}MB, minikube start will refuse it unless --force is given", out.V{"name": name, "size": mb, "minimum": minimumMemory})
+	}
 	return nil
 }
 
@@ -129,6 +152,22 @@ func IsPositive(name, val string) error {
 	return nil
 }
 
+// IsValidDuration checks if a string parses as a duration, eg: 10s
+func IsValidDuration(name, val string) error {
+	if _, err := time.ParseDuration(val); err != nil {
+		return fmt.Errorf("%s:%v", name, err)
+	}
+	return nil
+}
+
+// IsValidRetryPhases checks if a string is a comma separated list of config.RetryPhases
+func IsValidRetryPhases(name, val string) error {
+	if _, err := config.ParseRetryPhases(val); err != nil {
+		return fmt.Errorf("%s:%v", name, err)
+	}
+	return nil
+}
+
 // IsValidCIDR checks if a string parses as a CIDR
 func IsValidCIDR(_, cidr string) error {
 	_, _, err := net.ParseCIDR(cidr)
@@ -155,3 +194,154 @@ func IsValidRuntime(_, runtime string) error {
 	}
 	return nil
 }
+
+// IsNonNegative checks if an integer is zero or greater
+func IsNonNegative(name, val string) error {
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		return fmt.Errorf("%s:%v", name, err)
+	}
+	if i < 0 {
+		return fmt.Errorf("%s must be >= 0", name)
+	}
+	return nil
+}
+
+// IsValidBool checks if a string parses as a boolean
+func IsValidBool(name, val string) error {
+	if _, err := strconv.ParseBool(val); err != nil {
+		return fmt.Errorf("%s must be true or false", name)
+	}
+	return nil
+}
+
+// IsValidDockerEnv checks if a string is a comma separated list of KEY=VALUE pairs
+func IsValidDockerEnv(name, val string) error {
+	for _, e := range config.SplitDockerEnv(val) {
+		if k, _, ok := strings.Cut(e, "="); !ok || k == "" {
+			return fmt.Errorf("%s %q must be formatted as KEY=VALUE", name, e)
+		}
+	}
+	return nil
+}
+
+// IsValidExtraConfig checks if a string is a comma separated list of component.key=value options
+func IsValidExtraConfig(name, val string) error {
+	var es config.ExtraOptionSlice
+	for _, e := range config.SplitExtraOptions(val) {
+		if err := es.Set(e); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// IsValidBootstrapper checks if a string is a supported cluster bootstrapper
+func IsValidBootstrapper(name, val string) error {
+	if val != "kubeadm" {
+		return fmt.Errorf("%s %q is not supported, valid options are: kubeadm", name, val)
+	}
+	return nil
+}
+
+// IsValidProfileName checks if a string may be used as a profile name
+func IsValidProfileName(_, name string) error {
+	if !config.ProfileNameValid(name) {
+		return fmt.Errorf("profile name %q is not valid: only alphanumeric and dashes '-' are permitted, minimum 2 characters, starting with alphanumeric", name)
+	}
+	if config.ProfileNameInReservedKeywords(name) {
+		return fmt.Errorf("profile name %q is a reserved keyword", name)
+	}
+	return nil
+}
+
+// IsValidKubernetesVersion checks if a string is a Kubernetes version that minikube can start
+func IsValidKubernetesVersion(_, ver string) error {
+	switch strings.ToLower(ver) {
+	case "stable", "latest", "newest", constants.NoKubernetesVersion:
+		return nil
+	}
+	v, err := semver.ParseTolerant(ver)
+	if err != nil {
+		return fmt.Errorf("invalid Kubernetes version %q: %v", ver, err)
+	}
+	oldest := semver.MustParse(strings.TrimPrefix(constants.OldestKubernetesVersion, version.VersionPrefix))
+	if v.LT(oldest) {
+		return fmt.Errorf("Kubernetes version %s is older than the oldest supported version %s", ver, constants.OldestKubernetesVersion)
+	}
+	return nil
+}
+
+// compatibilityRule checks that a combination of settings can be used together
+type compatibilityRule struct {
+	keys  []string
+	check func(m config.MinikubeConfig) error
+}
+
+// compatibilityRules are checked whenever one of their keys is set, and by `minikube config validate`
+var compatibilityRules = []compatibilityRule{
+	{
+		keys:  []string{"driver", "vm-driver", "memory"},
+		check: requiresKICDriverFor("memory", constants.NoLimit),
+	},
+	{
+		keys:  []string{"driver", "vm-driver", "cpus"},
+		check: requiresKICDriverFor("cpus", constants.NoLimit),
+	},
+	{
+		keys: []string{"driver", "vm-driver", config.Rootless},
+		check: func(m config.MinikubeConfig) error {
+			drv := configuredDriver(m)
+			if b, ok := m[config.Rootless].(bool); ok && b && drv != "" && !driver.IsKIC(drv) {
+				return fmt.Errorf("%s is only supported by the docker and podman drivers, not %q", config.Rootless, drv)
+			}
+			return nil
+		},
+	},
+	{
+		keys: []string{"driver", "vm-driver", "hyperv-virtual-switch"},
+		check: func(m config.MinikubeConfig) error {
+			drv := configuredDriver(m)
+			if _, ok := m["hyperv-virtual-switch"]; ok && drv != "" && drv != driver.HyperV {
+				return fmt.Errorf("hyperv-virtual-switch is only used by the hyperv driver, not %q", drv)
+			}
+			return nil
+		},
+	},
+}
+
+// requiresKICDriverFor returns a rule that only allows value for key with the docker and podman drivers
+func requiresKICDriverFor(key, value string) func(config.MinikubeConfig) error {
+	return func(m config.MinikubeConfig) error {
+		drv := configuredDriver(m)
+		if fmt.Sprint(m[key]) == value && drv != "" && !driver.IsKIC(drv) {
+			return fmt.Errorf("%s=%s is only supported by the docker and podman drivers, not %q", key, value, drv)
+		}
+		return nil
+	}
+}
+
+// configuredDriver returns the driver set in the config, if any
+func configuredDriver(m config.MinikubeConfig) string {
+	if d, ok := m["driver"]; ok {
+		return fmt.Sprint(d)
+	}
+	if d, ok := m["vm-driver"]; ok {
+		return fmt.Sprint(d)
+	}
+	return ""
+}
+
+// checkCompatibility runs the compatibility rules involving key, or every rule if key is empty
+func checkCompatibility(m config.MinikubeConfig, key string) []error {
+	var errs []error
+	for _, r := range compatibilityRules {
+		if key != "" && !containsString(r.keys, key) {
+			continue
+		}
+		if err := r.check(m); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
@@ -19,6 +19,8 @@ package config
 import (
 	"os"
 	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
 )
 
 type validationTest struct {
@@ -151,6 +153,7 @@ func TestIsValidCPUs(t *testing.T) {
 	tests := []validationTest{
 		{"2", false},
 		{"16", false},
+		{"1", false},
 		{"max", false},
 		{"no-limit", false},
 		{"abc", true},
@@ -165,6 +168,7 @@ func TestIsValidMemory(t *testing.T) {
 	tests := []validationTest{
 		{"4000mb", false},
 		{"8gb", false},
+		{"1024mb", false},
 		{"max", false},
 		{"no-limit", false},
 		{"-4000", true},
@@ -174,3 +178,77 @@ func TestIsValidMemory(t *testing.T) {
 
 	runValidations(t, tests, "memory", IsValidMemory)
 }
+
+func TestIsValidKubernetesVersion(t *testing.T) {
+	tests := []validationTest{
+		{"v1.30.0", false},
+		{"1.30", false},
+		{"stable", false},
+		{"latest", false},
+		{"v1.0.0", true},
+		{"abc", true},
+		{"", true},
+	}
+
+	runValidations(t, tests, "kubernetes-version", IsValidKubernetesVersion)
+}
+
+func TestIsValidProfileName(t *testing.T) {
+	tests := []validationTest{
+		{"minikube", false},
+		{"p2", false},
+		{"-p", true},
+		{"start", true},
+	}
+
+	runValidations(t, tests, "profile", IsValidProfileName)
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.MinikubeConfig
+		key     string
+		wantErr bool
+	}{
+		{"no-limit docker", config.MinikubeConfig{"driver": "docker", "memory": "no-limit"}, "memory", false},
+		{"no-limit kvm2", config.MinikubeConfig{"driver": "kvm2", "memory": "no-limit"}, "memory", true},
+		{"no-limit without driver", config.MinikubeConfig{"cpus": "no-limit"}, "cpus", false},
+		{"rootless virtualbox", config.MinikubeConfig{"vm-driver": "virtualbox", config.Rootless: true}, "vm-driver", true},
+		{"hyperv switch qemu", config.MinikubeConfig{"driver": "qemu2", "hyperv-virtual-switch": "x"}, "driver", true},
+		{"unrelated key", config.MinikubeConfig{"driver": "kvm2", "memory": "no-limit"}, "v", false},
+		{"all rules", config.MinikubeConfig{"driver": "kvm2", "memory": "no-limit"}, "", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := checkCompatibility(tc.cfg, tc.key)
+			if (len(errs) > 0) != tc.wantErr {
+				t.Errorf("checkCompatibility(%v, %q) = %v, wantErr %t", tc.cfg, tc.key, errs, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsValidDockerEnv(t *testing.T) {
+	tests := []validationTest{
+		{"HTTP_PROXY=http://proxy:3128", false},
+		{"HTTP_PROXY=http://proxy:3128,NO_PROXY=localhost", false},
+		{"NO_PROXY=localhost,10.0.0.0/8", false},
+		{"HTTP_PROXY", true},
+		{"=value", true},
+	}
+
+	runValidations(t, tests, "docker-env", IsValidDockerEnv)
+}
+
+func TestIsValidExtraConfig(t *testing.T) {
+	tests := []validationTest{
+		{"kubelet.max-pods=50", false},
+		{"kubelet.max-pods=50,apiserver.v=2", false},
+		{"kubelet.eviction-hard=memory.available<5%,nodefs.available<10%", false},
+		{"kubelet", true},
+		{"kubelet.max-pods", true},
+	}
+
+	runValidations(t, tests, "extra-config", IsValidExtraConfig)
+}
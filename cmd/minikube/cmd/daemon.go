@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/daemon"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var daemonSocket string
+
+// daemonCmd represents the daemon command
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Serves an API that manages clusters, for GUIs and IDE plugins",
+	Long: `Serves a REST API on a local socket, which only the user can connect to, that creates, starts, stops, deletes and watches clusters and their nodes, and runs their tunnels and mounts.
+The clients authenticate with the bearer token written to ~/.minikube/daemon/token, which changes every time the daemon starts.`,
+	Example: `minikube daemon
+curl --unix-socket ~/.minikube/daemon/daemon.sock -H "Authorization: Bearer $(cat ~/.minikube/daemon/token)" http://minikube/v1/clusters`,
+	Run: func(_ *cobra.Command, _ []string) {
+		binary, err := os.Executable()
+		if err != nil {
+			exit.Error(reason.HostDaemon, "Unable to find the minikube binary", err)
+		}
+		token, err := daemon.WriteToken(localpath.DaemonToken())
+		if err != nil {
+			exit.Error(reason.HostDaemon, "Unable to write the token of the daemon", err)
+		}
+
+		socket := daemonSocket
+		if socket == "" {
+			socket = localpath.DaemonSocket()
+		}
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+		out.Step(style.Running, "Serving the minikube API on {{.socket}}. Press Ctrl-C to stop.", out.V{"socket": socket})
+		if err := daemon.Serve(ctx, socket, daemon.NewServer(token, binary)); err != nil {
+			exit.Error(reason.HostDaemon, "Unable to serve the minikube API", err)
+		}
+	},
+}
+
+func init() {
+	daemonCmd.Flags().StringVar(&daemonSocket, "socket", "", "Path of the unix socket that the API is served on, defaults to ~/.minikube/daemon/daemon.sock")
+}
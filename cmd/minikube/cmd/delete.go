@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -115,6 +116,7 @@ func init() {
 	deleteCmd.Flags().BoolVar(&deleteAll, "all", false, "Set flag to delete all profiles")
 	deleteCmd.Flags().BoolVar(&purge, "purge", false, "Set this flag to delete the '.minikube' folder from your user directory.")
 	deleteCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+	addLockTimeoutFlag(deleteCmd)
 
 	if err := viper.BindPFlags(deleteCmd.Flags()); err != nil {
 		exit.Error(reason.InternalBindFlags, "unable to bind flags", err)
@@ -233,6 +235,11 @@ func runDelete(_ *cobra.Command, args []string) {
 	defer cancel()
 
 	if deleteAll {
+		// lock every profile before removing the containers, which another minikube may be starting or stopping
+		for _, p := range profilesToDelete {
+			defer mustLockProfile(p.Name).Release()
+		}
+
 		deleteContainersAndVolumes(delCtx, oci.Docker)
 		deleteContainersAndVolumes(delCtx, oci.Podman)
 
@@ -250,6 +257,8 @@ func runDelete(_ *cobra.Command, args []string) {
 		}
 
 		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+
 		profile, err := config.LoadProfile(cname)
 		orphan := false
 
@@ -339,7 +348,7 @@ func deleteProfile(ctx context.Context, profile *config.Profile) error {
 				klog.Warningf("failed to unpause %s : %v", profile.Name, err)
 			}
 			out.Styled(style.DeletingHost, `Deleting "{{.profile_name}}" in {{.driver_name}} ...`, out.V{"profile_name": profile.Name, "driver_name": profile.Config.Driver})
-			for _, n := range profile.Config.Nodes {
+			for _, n := range slices.Concat(profile.Config.Nodes, profile.Config.WarmNodes) {
 				machineName := config.MachineName(*profile.Config, n)
 				delete.PossibleLeftOvers(ctx, machineName, profile.Config.Driver)
 			}
@@ -428,7 +437,7 @@ func deleteHosts(api libmachine.API, cc *config.ClusterConfig) {
 	register.Reg.SetStep(register.Deleting)
 
 	if cc != nil {
-		for _, n := range cc.Nodes {
+		for _, n := range slices.Concat(cc.Nodes, cc.WarmNodes) {
 			machineName := config.MachineName(*cc, n)
 			if err := machine.DeleteHost(api, machineName); err != nil {
 				switch errors.Cause(err).(type) {
@@ -583,7 +592,7 @@ func deleteProfileDirectory(profile string) {
 
 func deleteMachineDirectories(cc *config.ClusterConfig) {
 	if cc != nil {
-		for _, n := range cc.Nodes {
+		for _, n := range slices.Concat(cc.Nodes, cc.WarmNodes) {
 			machineName := config.MachineName(*cc, n)
 			deleteProfileDirectory(machineName)
 		}
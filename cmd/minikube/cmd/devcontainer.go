@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/devcontainer"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+var devcontainerFile string
+
+// devcontainerCmd represents the devcontainer command
+var devcontainerCmd = &cobra.Command{
+	Use:   "devcontainer",
+	Short: "Exposes the cluster to a dev container, eg: of VS Code",
+	Long: `Prints the settings of devcontainer.json that expose the cluster to a dev container, so that kubectl, and docker with the docker container runtime, target the cluster from inside the container.
+The dev container joins the network of the nodes with the docker and podman drivers, and mounts a kubeconfig whose server is the address of the cluster in that network. With --write, the settings are merged into a devcontainer.json.`,
+	Example: `minikube devcontainer
+minikube devcontainer --write .devcontainer/devcontainer.json`,
+	Run: func(_ *cobra.Command, _ []string) {
+		cname := ClusterFlagValue()
+		co := mustload.Running(cname)
+		cc := co.Config
+		if driver.BareMetal(cc.Driver) {
+			exit.Message(reason.EnvDriverConflict, "The '{{.driver}}' driver runs the cluster on the host, whose kubeconfig a dev container can mount as it is", out.V{"driver": cc.Driver})
+		}
+
+		// the address of the API server in the network of the nodes, rather than the one forwarded to the host
+		server := "https://" + net.JoinHostPort(co.CP.Node.IP, strconv.Itoa(co.CP.Node.Port))
+		if config.IsHA(*cc) {
+			server = "https://" + net.JoinHostPort(cc.KubernetesConfig.APIServerHAVIP, strconv.Itoa(cc.APIServerPort))
+		}
+		kc, err := kubeconfig.Standalone(cname, kubeconfig.PathForProfile(cname, cc.KubeconfigMode), server)
+		if err != nil {
+			exit.Error(reason.HostKubeconfigUpdate, "Unable to generate the kubeconfig of the dev container", err)
+		}
+		o := devcontainer.Options{Kubeconfig: localpath.DevcontainerKubeconfig(cname)}
+		if err := lock.WriteFile(o.Kubeconfig, kc, 0o600); err != nil {
+			exit.Error(reason.HostKubeconfigUpdate, "Unable to write the kubeconfig of the dev container", err)
+		}
+
+		if driver.IsKIC(cc.Driver) {
+			o.Network = cc.Network
+			if o.Network == "" {
+				o.Network = cname
+			}
+		}
+		if cc.KubernetesConfig.ContainerRuntime == constants.Docker {
+			o.DockerHost = dockerURL(co.CP.Node.IP, constants.DockerDaemonPort)
+			o.CertsDir = localpath.MakeMiniPath("certs")
+		} else {
+			out.WarningT("Only kubectl targets the cluster, as the {{.runtime}} container runtime is not exposed to dev containers", out.V{"runtime": cc.KubernetesConfig.ContainerRuntime})
+		}
+		c := devcontainer.Generate(o)
+
+		if devcontainerFile != "" {
+			if err := devcontainer.Merge(devcontainerFile, c); err != nil {
+				exit.Error(reason.HostDevcontainer, "Unable to merge the settings into the devcontainer.json", err)
+			}
+			out.Step(style.Ready, "Exposed {{.name}} to the dev container of {{.file}}. Rebuild the container to apply the changes.", out.V{"name": cname, "file": devcontainerFile})
+			return
+		}
+		b, err := json.MarshalIndent(c, "", "  ")
+		if err != nil {
+			exit.Error(reason.InternalJSONMarshal, "json encoding failure", err)
+		}
+		os.Stdout.Write(append(b, '\n'))
+	},
+}
+
+func init() {
+	devcontainerCmd.Flags().StringVar(&devcontainerFile, "write", "", "A devcontainer.json to merge the settings into, which is created if it does not exist")
+}
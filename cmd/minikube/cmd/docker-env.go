@@ -25,9 +25,12 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	apiWait "k8s.io/apimachinery/pkg/util/wait"
@@ -41,6 +44,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/docker"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/localpath"
@@ -49,6 +53,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/shell"
 	"k8s.io/minikube/pkg/minikube/sshagent"
+	"k8s.io/minikube/pkg/minikube/style"
 	"k8s.io/minikube/pkg/minikube/sysinit"
 	pkgnetwork "k8s.io/minikube/pkg/network"
 	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
@@ -127,6 +132,8 @@ var (
 	sshHost              bool
 	sshAdd               bool
 	dockerUnset          bool
+	dockerRemote         string
+	dockerRemotePort     int
 	defaultNoProxyGetter NoProxyGetter
 )
 
@@ -295,6 +302,10 @@ docker-cli install instructions: https://minikube.sigs.k8s.io/docs/tutorials/doc
 			exit.SetShell(true)
 		}
 
+		if dockerRemote != "" && sshHost {
+			exit.Message(reason.Usage, "The --remote flag cannot be used with --ssh-host")
+		}
+
 		cname := ClusterFlagValue()
 
 		co := mustload.Running(cname)
@@ -313,6 +324,10 @@ docker-cli install instructions: https://minikube.sigs.k8s.io/docs/tutorials/doc
 		if err := dockerEnvSupported(cr, driverName); err != nil {
 			exit.Message(reason.Usage, err.Error())
 		}
+		// nerdctld is only reached over SSH
+		if dockerRemote != "" && cr != constants.Docker {
+			exit.Message(reason.Usage, "The --remote flag requires the docker container runtime")
+		}
 
 		// for the sake of docker-env command, start nerdctl and nerdctld
 		if cr == constants.Containerd {
@@ -346,14 +361,9 @@ docker-cli install instructions: https://minikube.sigs.k8s.io/docs/tutorials/doc
 		}
 
 		d := co.CP.Host.Driver
-		port := constants.DockerDaemonPort
-		if driver.NeedsPortForward(driverName) {
-			port, err = oci.ForwardedPort(driverName, cname, port)
-			if err != nil {
-				exit.Message(reason.DrvPortForward, "Error getting port binding for '{{.driver_name}} driver: {{.error}}", out.V{"driver_name": driverName, "error": err})
-			}
-		} else if driver.IsQEMU(driverName) && pkgnetwork.IsBuiltinQEMU(co.Config.Network) {
-			port = d.(*qemu.Driver).EnginePort
+		port, err := dockerDaemonPort(co)
+		if err != nil {
+			exit.Message(reason.DrvPortForward, "Error getting port binding for '{{.driver_name}} driver: {{.error}}", out.V{"driver_name": driverName, "error": err})
 		}
 
 		hostname, err := d.GetSSHHostname()
@@ -400,6 +410,11 @@ docker-cli install instructions: https://minikube.sigs.k8s.io/docs/tutorials/doc
 			}
 		}
 
+		if dockerRemote != "" {
+			serveDockerRemote(ec)
+			return
+		}
+
 		if err := dockerSetScript(ec, os.Stdout); err != nil {
 			exit.Error(reason.InternalDockerScript, "Error generating set output", err)
 		}
@@ -433,6 +448,37 @@ docker-cli install instructions: https://minikube.sigs.k8s.io/docs/tutorials/doc
 	},
 }
 
+// serveDockerRemote prints the docker-env of the other machines, which connect to the Docker daemon of the cluster
+// through a proxy on the --remote interface of the host, and serves it until interrupted
+func serveDockerRemote(ec DockerEnvConfig) {
+	o := docker.RemoteOptions{
+		Host:      dockerRemote,
+		Port:      dockerRemotePort,
+		Upstream:  net.JoinHostPort(ec.hostIP, strconv.Itoa(ec.port)),
+		CertsDir:  ec.certsDir,
+		RemoteDir: filepath.Join(localpath.Profile(ec.profile), "docker-remote"),
+	}
+	if err := docker.GenerateRemoteCerts(o); err != nil {
+		exit.Error(reason.IfDockerRemote, "Unable to generate the certificates of the remote clients", err)
+	}
+
+	remote := ec
+	remote.hostIP = o.Host
+	remote.port = o.Port
+	remote.certsDir = o.ClientCertsDir()
+	if err := dockerSetScript(remote, os.Stdout); err != nil {
+		exit.Error(reason.InternalDockerScript, "Error generating set output", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	out.ErrT(style.Notice, "Copy {{.dir}} to the other machines, and set DOCKER_CERT_PATH to where you copied it", out.V{"dir": o.ClientCertsDir()})
+	out.ErrT(style.Running, "Serving the Docker daemon of {{.profile}} on {{.address}}. Press Ctrl-C to stop.", out.V{"profile": ec.profile, "address": net.JoinHostPort(o.Host, strconv.Itoa(o.Port))})
+	if err := docker.ServeRemote(ctx, o); err != nil {
+		exit.Error(reason.IfDockerRemote, "Unable to serve the Docker daemon", err)
+	}
+}
+
 // DockerEnvConfig encapsulates all external inputs into shell generation for Docker
 type DockerEnvConfig struct {
 	shell.EnvConfig
@@ -451,6 +497,18 @@ type DockerEnvConfig struct {
 	sshAgentPID int
 }
 
+// dockerDaemonPort returns the port of the docker daemon of the control plane, as reached from the host
+func dockerDaemonPort(co mustload.ClusterController) (int, error) {
+	driverName := co.CP.Host.DriverName
+	if driver.NeedsPortForward(driverName) {
+		return oci.ForwardedPort(driverName, co.Config.Name, constants.DockerDaemonPort)
+	}
+	if driver.IsQEMU(driverName) && pkgnetwork.IsBuiltinQEMU(co.Config.Network) {
+		return co.CP.Host.Driver.(*qemu.Driver).EnginePort, nil
+	}
+	return constants.DockerDaemonPort, nil
+}
+
 // dockerSetScript writes out a shell-compatible 'docker-env' script
 func dockerSetScript(ec DockerEnvConfig, w io.Writer) error {
 	var dockerSetEnvTmpl string
@@ -695,4 +753,6 @@ func init() {
 	dockerEnvCmd.Flags().StringVar(&shell.ForceShell, "shell", "", "Force environment to be configured for a specified shell: [fish, cmd, powershell, tcsh, bash, zsh], default is auto-detect")
 	dockerEnvCmd.Flags().StringVarP(&outputFormat, "output", "o", "", "One of 'text', 'yaml' or 'json'.")
 	dockerEnvCmd.Flags().BoolVarP(&dockerUnset, "unset", "u", false, "Unset variables instead of setting them")
+	dockerEnvCmd.Flags().StringVar(&dockerRemote, "remote", "", "Address of a host interface, eg: 192.168.1.10, on which the Docker daemon is exposed over TLS, with client cert auth, to other machines such as a CI agent. Prints their environment, and serves until interrupted.")
+	dockerEnvCmd.Flags().IntVar(&dockerRemotePort, "remote-port", constants.DockerDaemonPort, "Port on which the Docker daemon is exposed with --remote")
 }
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/doctor"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/util"
+)
+
+var (
+	doctorDriver   string
+	doctorMemory   string
+	doctorDiskSize string
+	doctorNodes    int
+)
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Checks that the host can run a cluster, and how to fix it",
+	Long: `Checks that the host can run the nodes of a cluster, before minikube start or minikube node add: the driver, hardware virtualization, the memory and disk space for the requested nodes, and the network interfaces of VPNs.
+The driver, memory, disk size and number of nodes default to the ones of the profile, if it exists, or else to the defaults of minikube start. The command exits with 30 if a check fails.`,
+	Example: `minikube doctor
+minikube doctor --driver=kvm2 --memory=4g --nodes=3 -o json`,
+	Run: func(_ *cobra.Command, _ []string) {
+		validateOutputFormat()
+		req := doctorRequest()
+		r := doctor.Run(req, doctor.Inspect(req))
+		printDoctorReport(r)
+		if r.Failed() {
+			exit.Code(reason.ExHostError)
+		}
+	},
+}
+
+// doctorRequest returns the nodes to check the host for, from the flags, then the profile, then the defaults of minikube start
+func doctorRequest() doctor.Request {
+	req := doctor.Request{Driver: doctorDriver, Nodes: doctorNodes}
+	cc, err := config.Load(ClusterFlagValue())
+	if err != nil && !config.IsNotExist(err) {
+		exit.Error(reason.HostConfigLoad, "Unable to load the cluster", err)
+	}
+	if cc != nil {
+		if req.Driver == "" {
+			req.Driver = cc.Driver
+		}
+		req.Memory, req.DiskSize = cc.Memory, cc.DiskSize
+		if req.Nodes == 0 {
+			req.Nodes = len(cc.Nodes)
+		}
+	}
+	if req.Driver == "" {
+		ds, _, _ := driver.Suggest(driver.Choices(false))
+		if ds.Name == "" {
+			exit.Message(reason.DrvNotDetected, "No possible driver was detected. Try specifying --driver")
+		}
+		req.Driver = ds.Name
+	}
+	if req.Nodes == 0 {
+		req.Nodes = 1
+	}
+	if doctorMemory != "" {
+		req.Memory = parseDoctorSize(doctorMemory, "--memory")
+	}
+	if req.Memory == 0 {
+		sysLimit, containerLimit, err := memoryLimits(req.Driver)
+		if err != nil {
+			klog.Warningf("Unable to query memory limits: %v", err)
+			sysLimit, containerLimit = 0, 0
+		}
+		req.Memory = suggestMemoryAllocation(sysLimit, containerLimit, req.Nodes)
+	}
+	if doctorDiskSize != "" {
+		req.DiskSize = parseDoctorSize(doctorDiskSize, "--disk-size")
+	}
+	if req.DiskSize == 0 {
+		req.DiskSize = parseDoctorSize(defaultDiskSize, "--disk-size")
+	}
+	return req
+}
+
+// parseDoctorSize returns the size in MB of s, eg: 4g
+func parseDoctorSize(s, flag string) int {
+	mb, err := util.CalculateSizeInMB(s)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid {{.flag}}: {{.error}}", out.V{"flag": flag, "error": err})
+	}
+	return mb
+}
+
+// printDoctorReport prints r as JSON, or one line per check, followed by its fix
+func printDoctorReport(r doctor.Report) {
+	if outputFormat == "json" {
+		b, err := json.Marshal(r)
+		if err != nil {
+			exit.Error(reason.InternalJSONMarshal, "json encoding failure", err)
+		}
+		os.Stdout.Write(append(b, '\n'))
+		return
+	}
+	styles := map[doctor.Status]style.Enum{doctor.OK: style.Success, doctor.Warning: style.Warning, doctor.Failure: style.Failure}
+	for _, c := range r.Checks {
+		out.Styled(styles[c.Status], "{{.name}}: {{.message}}", out.V{"name": c.Name, "message": c.Message})
+		if c.Fix != "" {
+			out.Styled(style.Tip, "  {{.fix}}", out.V{"fix": c.Fix})
+		}
+		if c.Doc != "" {
+			out.Styled(style.Documentation, "  {{.url}}", out.V{"url": c.Doc})
+		}
+	}
+}
+
+func init() {
+	doctorCmd.Flags().StringVarP(&doctorDriver, "driver", "d", "", "The driver to check the host for. Defaults to the one of the profile, or else the one that minikube start would choose")
+	doctorCmd.Flags().StringVar(&doctorMemory, "memory", "", "The memory of each node (format: <number>[<unit>], where unit = b, k, m or g). Defaults to the one of the profile, or else the one that minikube start would choose")
+	doctorCmd.Flags().StringVar(&doctorDiskSize, "disk-size", "", "The disk size of each node (format: <number>[<unit>], where unit = b, k, m or g). Defaults to the one of the profile, or else "+defaultDiskSize)
+	doctorCmd.Flags().IntVarP(&doctorNodes, "nodes", "n", 0, "The number of nodes. Defaults to the ones of the profile, or else 1")
+	doctorCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+}
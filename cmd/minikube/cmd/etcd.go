@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// etcdSnapshotExt is the extension of the snapshots in the profile directory
+const etcdSnapshotExt = ".db"
+
+// etcdCmd represents the set of etcd subcommands
+var etcdCmd = &cobra.Command{
+	Use:   "etcd",
+	Short: "Back up the etcd of the cluster, or restore it",
+	Long:  "Operations on the etcd of a cluster. Snapshots are kept in the etcd-snapshots directory of the profile, unless another file is given.",
+	Run: func(_ *cobra.Command, _ []string) {
+		exit.Message(reason.Usage, "Usage: minikube etcd [backup|restore]")
+	},
+}
+
+// latestEtcdSnapshot returns the latest snapshot in the profile directory of cname, empty if there are none
+func latestEtcdSnapshot(cname string) (string, error) {
+	entries, err := os.ReadDir(localpath.EtcdSnapshots(cname))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), etcdSnapshotExt) {
+			names = append(names, e.Name())
+		}
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+	// named after the time they were taken
+	sort.Strings(names)
+	return filepath.Join(localpath.EtcdSnapshots(cname), names[len(names)-1]), nil
+}
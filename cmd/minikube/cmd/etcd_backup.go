@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var etcdBackupOutput string
+
+var etcdBackupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Save a snapshot of the etcd of the cluster",
+	Long:  "Saves a snapshot of the etcd of the cluster, taken with etcdctl on the primary control-plane node. It has the data of all the members of a cluster with several control-plane nodes.",
+	Example: `minikube etcd backup
+minikube etcd backup --output snap.db`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube etcd backup [--output FILE]")
+		}
+		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+
+		co := mustload.Healthy(cname)
+		if config.IsExternalEtcd(*co.Config) {
+			exit.Message(reason.Usage, "The etcd of \"{{.name}}\" is external, back it up with the tools of its cluster", out.V{"name": cname})
+		}
+		dest := etcdBackupOutput
+		if dest == "" {
+			dest = filepath.Join(localpath.EtcdSnapshots(cname), time.Now().Format("20060102-150405")+etcdSnapshotExt)
+		}
+		if err := node.EtcdBackup(co.API, co.Config, dest); err != nil {
+			exit.Error(reason.GuestEtcdBackup, "Unable to back up etcd", err)
+		}
+		out.Step(style.Ready, "Saved the etcd snapshot of \"{{.name}}\" to {{.path}}", out.V{"name": cname, "path": dest})
+	},
+}
+
+func init() {
+	etcdBackupCmd.Flags().StringVarP(&etcdBackupOutput, "output", "o", "", "The file to save the snapshot to. Defaults to a new file in the etcd-snapshots directory of the profile")
+	addLockTimeoutFlag(etcdBackupCmd)
+	etcdCmd.AddCommand(etcdBackupCmd)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var etcdRestoreCmd = &cobra.Command{
+	Use:   "restore [FILE]",
+	Short: "Restore the etcd of the cluster from a snapshot",
+	Long: `Restores the etcd of the cluster from a snapshot, by default the latest one in the etcd-snapshots directory of the profile.
+Every control-plane node restores its member from the snapshot, then etcd and the API server are restarted on all of them. The data that is replaced is kept in the etcd data directory of each node, as member.bak.`,
+	Example: `minikube etcd restore
+minikube etcd restore snap.db`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) > 1 {
+			exit.Message(reason.Usage, "Usage: minikube etcd restore [FILE]")
+		}
+		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+
+		src := ""
+		if len(args) == 1 {
+			src = args[0]
+		} else {
+			latest, err := latestEtcdSnapshot(cname)
+			if err != nil {
+				exit.Error(reason.HostPathStat, "Unable to list the etcd snapshots", err)
+			}
+			if latest == "" {
+				exit.Message(reason.Usage, "No etcd snapshot of \"{{.name}}\" to restore, take one with: minikube etcd backup", out.V{"name": cname})
+			}
+			src = latest
+		}
+		if _, err := os.Stat(src); err != nil {
+			exit.Error(reason.HostPathStat, "Unable to read the snapshot", err)
+		}
+
+		co := mustload.Healthy(cname)
+		if config.IsExternalEtcd(*co.Config) {
+			exit.Message(reason.Usage, "The etcd of \"{{.name}}\" is external, restore it with the tools of its cluster", out.V{"name": cname})
+		}
+		if err := node.EtcdRestore(co.API, co.Config, src); err != nil {
+			exit.Error(reason.GuestEtcdRestore, "Unable to restore etcd", err)
+		}
+		out.Step(style.Ready, "Restored the etcd of \"{{.name}}\" from {{.path}}", out.V{"name": cname, "path": src})
+	},
+}
+
+func init() {
+	addLockTimeoutFlag(etcdRestoreCmd)
+	etcdCmd.AddCommand(etcdRestoreCmd)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestLatestEtcdSnapshot(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+
+	got, err := latestEtcdSnapshot("p1")
+	if err != nil || got != "" {
+		t.Fatalf("latestEtcdSnapshot() without snapshots = %q, %v, want none", got, err)
+	}
+
+	dir := localpath.EtcdSnapshots("p1")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"20260101-120000.db", "20260301-080000.db", "20260201-230000.db", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err = latestEtcdSnapshot("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "20260301-080000.db"); got != want {
+		t.Errorf("latestEtcdSnapshot() = %q, want %q", got, want)
+	}
+}
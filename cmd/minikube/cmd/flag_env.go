@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/klog/v2"
+)
+
+// bindsFlagEnv returns whether every flag of cmd may be set through a MINIKUBE_* environment variable.
+// This is limited to start and node commands: generic flags of other commands, such as `delete --all`, are too dangerous to pick up from the environment.
+func bindsFlagEnv(cmd *cobra.Command) bool {
+	return cmd == startCmd || (cmd.HasParent() && cmd.Parent() == nodeCmd)
+}
+
+// flagEnvName returns the environment variable that sets a flag, eg: MINIKUBE_REGISTRY_MIRROR for --registry-mirror
+func flagEnvName(name string) string {
+	return minikubeEnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// applyFlagEnv sets every flag not passed on the command line from its MINIKUBE_* environment variable.
+// Such flags are marked as changed, so the precedence is: flag > environment > config file.
+func applyFlagEnv(flags *pflag.FlagSet) error {
+	var errs []string
+	flags.VisitAll(func(f *pflag.Flag) {
+		if f.Changed {
+			return
+		}
+		env := flagEnvName(f.Name)
+		val, ok := os.LookupEnv(env)
+		if !ok {
+			return
+		}
+		klog.Infof("setting --%s from $%s", f.Name, env)
+		if err := flags.Set(f.Name, val); err != nil {
+			errs = append(errs, fmt.Sprintf("$%s: %v", env, err))
+		}
+	})
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestFlagEnvName(t *testing.T) {
+	tests := map[string]string{
+		"memory":          "MINIKUBE_MEMORY",
+		"registry-mirror": "MINIKUBE_REGISTRY_MIRROR",
+		"cni":             "MINIKUBE_CNI",
+	}
+	for name, want := range tests {
+		if got := flagEnvName(name); got != want {
+			t.Errorf("flagEnvName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestApplyFlagEnv(t *testing.T) {
+	newFlags := func() *pflag.FlagSet {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.String("memory", "", "")
+		fs.Int("nodes", 1, "")
+		fs.StringSlice("registry-mirror", nil, "")
+		return fs
+	}
+
+	t.Setenv("MINIKUBE_MEMORY", "4g")
+	t.Setenv("MINIKUBE_NODES", "3")
+	t.Setenv("MINIKUBE_REGISTRY_MIRROR", "https://a.example,https://b.example")
+
+	t.Run("env", func(t *testing.T) {
+		fs := newFlags()
+		if err := applyFlagEnv(fs); err != nil {
+			t.Fatalf("applyFlagEnv() error: %v", err)
+		}
+		if v, _ := fs.GetString("memory"); v != "4g" {
+			t.Errorf("memory = %q, want 4g", v)
+		}
+		if v, _ := fs.GetInt("nodes"); v != 3 {
+			t.Errorf("nodes = %d, want 3", v)
+		}
+		want := []string{"https://a.example", "https://b.example"}
+		if v, _ := fs.GetStringSlice("registry-mirror"); !reflect.DeepEqual(v, want) {
+			t.Errorf("registry-mirror = %v, want %v", v, want)
+		}
+		if !fs.Changed("memory") {
+			t.Errorf("memory set from the environment should be marked as changed")
+		}
+	})
+
+	t.Run("flag wins", func(t *testing.T) {
+		fs := newFlags()
+		if err := fs.Parse([]string{"--memory=8g"}); err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+		if err := applyFlagEnv(fs); err != nil {
+			t.Fatalf("applyFlagEnv() error: %v", err)
+		}
+		if v, _ := fs.GetString("memory"); v != "8g" {
+			t.Errorf("memory = %q, want the command line value 8g", v)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("MINIKUBE_NODES", "three")
+		if err := applyFlagEnv(newFlags()); err == nil {
+			t.Errorf("applyFlagEnv() with MINIKUBE_NODES=three returned no error")
+		}
+	})
+}
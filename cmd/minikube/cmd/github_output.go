@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/ghactions"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// writeGitHubOutput writes the outputs of a cluster, and the environment variables that target it,
+// for the subsequent steps of a GitHub Actions workflow: what minikube docker-env and kubectl would otherwise be parsed for
+func writeGitHubOutput(cname string, kcs *kubeconfig.Settings) {
+	co := mustload.Running(cname)
+	cc := co.Config
+
+	var ips []string
+	for _, n := range cc.Nodes {
+		ips = append(ips, n.IP)
+	}
+	outputs := map[string]string{
+		"cluster":  cname,
+		"node-ips": strings.Join(ips, ","),
+	}
+	env := map[string]string{}
+	// kcs is nil with --no-kubernetes
+	if kcs != nil {
+		path := kubeconfig.PathForProfile(cname, cc.KubeconfigMode)
+		outputs["kubeconfig"] = path
+		outputs["api-server"] = kcs.ClusterServerAddress
+		env[constants.KubeconfigEnvVar] = path
+	}
+
+	// the same variables as minikube docker-env, which only supports single-node clusters
+	if cc.KubernetesConfig.ContainerRuntime == constants.Docker && len(cc.Nodes) == 1 && !driver.BareMetal(cc.Driver) {
+		port, err := dockerDaemonPort(co)
+		if err != nil {
+			exit.Message(reason.DrvPortForward, "Error getting port binding for '{{.driver_name}} driver: {{.error}}", out.V{"driver_name": cc.Driver, "error": err})
+		}
+		ec := DockerEnvConfig{
+			profile:  cname,
+			driver:   cc.Driver,
+			hostIP:   co.CP.IP.String(),
+			port:     port,
+			certsDir: localpath.MakeMiniPath("certs"),
+		}
+		for k, v := range dockerEnvVars(ec) {
+			if v != "" {
+				env[k] = v
+			}
+		}
+		outputs["docker-host"] = env[constants.DockerHostEnv]
+	}
+
+	if err := ghactions.WriteOutputs(outputs); err != nil {
+		exit.Error(reason.HostGitHubOutput, "Unable to write the outputs of the GitHub Actions step", err)
+	}
+	if err := ghactions.WriteEnv(env); err != nil {
+		exit.Error(reason.HostGitHubOutput, "Unable to write the environment of the GitHub Actions step", err)
+	}
+}
@@ -84,6 +84,10 @@ var loadImageCmd = &cobra.Command{
 		if len(args) == 0 {
 			exit.Message(reason.Usage, "Please provide an image in your local daemon to load into minikube via <minikube image load IMAGE_NAME>")
 		}
+		if outputFormat != "text" && outputFormat != "json" {
+			exit.Message(reason.Usage, "invalid output format: {{.output}}. Valid values: 'text', 'json'", out.V{"output": outputFormat})
+		}
+		out.SetJSON(outputFormat == "json")
 		// Cache and load images into container runtime
 		profile, err := config.LoadProfile(viper.GetString(config.ProfileName))
 		if err != nil {
@@ -133,6 +137,8 @@ var loadImageCmd = &cobra.Command{
 			args = []string{tmp}
 		}
 
+		// the images are loaded into all the nodes at once, with the progress of each
+		machine.ShowLoadProgress(true)
 		if imgDaemon || imgRemote {
 			image.UseDaemon(imgDaemon)
 			image.UseRemote(imgRemote)
@@ -390,6 +396,7 @@ func init() {
 	loadImageCmd.Flags().BoolVar(&imgDaemon, "daemon", false, "Cache image from docker daemon")
 	loadImageCmd.Flags().BoolVar(&imgRemote, "remote", false, "Cache image from remote registry")
 	loadImageCmd.Flags().BoolVar(&overwrite, "overwrite", true, "Overwrite image even if same image:tag name exists")
+	loadImageCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
 	imageCmd.AddCommand(loadImageCmd)
 	imageCmd.AddCommand(removeImageCmd)
 	imageCmd.AddCommand(pullImageCmd)
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+// kubeconfigCmd represents the kubeconfig command
+var kubeconfigCmd = &cobra.Command{
+	Use:   "kubeconfig",
+	Short: "Prints the path of the kubeconfig holding the context of a cluster",
+	Long: `Prints the path of the kubeconfig holding the kubectl context of a cluster.
+For clusters started with --kubeconfig-mode=separate this is a file of their own, otherwise it is the kubeconfig from $KUBECONFIG or ~/.kube/config.`,
+	Example: `export KUBECONFIG=$(minikube -p dev kubeconfig)`,
+	Run: func(_ *cobra.Command, _ []string) {
+		_, cc := mustload.Partial(ClusterFlagValue())
+		out.Ln(kubeconfig.PathForProfile(cc.Name, cc.KubeconfigMode))
+	},
+}
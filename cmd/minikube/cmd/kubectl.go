@@ -29,6 +29,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/detect"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
@@ -58,9 +59,13 @@ host. Please be aware that when using --ssh all paths will apply to the remote m
 
 		version := constants.DefaultKubernetesVersion
 		binaryMirror := ""
+		profileKubeconfig := ""
 		if err == nil {
 			version = cc.KubernetesConfig.KubernetesVersion
 			binaryMirror = cc.BinaryMirror
+			if cc.KubeconfigMode == kubeconfig.ModeSeparate {
+				profileKubeconfig = kubeconfig.PathForProfile(cc.Name, cc.KubeconfigMode)
+			}
 		}
 
 		cname := ClusterFlagValue()
@@ -97,25 +102,7 @@ host. Please be aware that when using --ssh all paths will apply to the remote m
 			os.Exit(1)
 		}
 
-		if len(args) > 0 {
-			insertIndex := 0
-			if args[0] == cobra.ShellCompRequestCmd || args[0] == cobra.ShellCompNoDescRequestCmd {
-				// Insert right after __complete to allow code completion from the correct cluster.
-				insertIndex = 1
-			} else {
-				// Add cluster argument before first flag, but after all commands.
-				// This improves error message of kubectl in case the command is wrong.
-				insertIndex = len(args)
-				for i, arg := range args {
-					if strings.HasPrefix(arg, "-") {
-						insertIndex = i
-						break
-					}
-				}
-			}
-			clusterArg := "--cluster=" + cname
-			args = append(append(append([]string{}, args[:insertIndex]...), clusterArg), args[insertIndex:]...)
-		}
+		args = pinToProfile(args, cname, profileKubeconfig)
 
 		c, err := KubectlCommand(version, binaryMirror, args...)
 		if err != nil {
@@ -151,6 +138,51 @@ func kubeconfigPath() string {
 	return "/etc/kubernetes/admin.conf"
 }
 
+// pinToProfile adds --context and, if set, --kubeconfig to the kubectl args, so that kubectl talks to the cluster of the profile
+// whatever the current context is. Flags passed by the user take precedence.
+func pinToProfile(args []string, profile string, kubeconfigPath string) []string {
+	if len(args) == 0 {
+		return args
+	}
+	insertIndex := 0
+	if args[0] == cobra.ShellCompRequestCmd || args[0] == cobra.ShellCompNoDescRequestCmd {
+		// Insert right after __complete to allow code completion from the correct cluster.
+		insertIndex = 1
+	} else {
+		// Add cluster argument before first flag, but after all commands.
+		// This improves error message of kubectl in case the command is wrong.
+		insertIndex = len(args)
+		for i, arg := range args {
+			if strings.HasPrefix(arg, "-") {
+				insertIndex = i
+				break
+			}
+		}
+	}
+
+	pinned := []string{}
+	if !hasKubectlFlag(args, "--context") && !hasKubectlFlag(args, "--cluster") {
+		pinned = append(pinned, "--context="+profile)
+	}
+	if kubeconfigPath != "" && !hasKubectlFlag(args, "--kubeconfig") {
+		pinned = append(pinned, "--kubeconfig="+kubeconfigPath)
+	}
+	return append(append(append([]string{}, args[:insertIndex]...), pinned...), args[insertIndex:]...)
+}
+
+// hasKubectlFlag returns whether flag is passed to kubectl itself, rather than to a command run by it after "--"
+func hasKubectlFlag(args []string, flag string) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			return false
+		}
+		if arg == flag || strings.HasPrefix(arg, flag+"=") {
+			return true
+		}
+	}
+	return false
+}
+
 // KubectlCommand will return kubectl command with a version matching the cluster
 func KubectlCommand(version, binaryURL string, args ...string) (*exec.Cmd, error) {
 	if version == "" {
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/translate"
+)
+
+// kubectlPluginName is the name of the minikube binary installed as a kubectl plugin, eg: by krew
+const kubectlPluginName = "kubectl-minikube"
+
+// kubectlPluginCommands are the commands of 'kubectl minikube', for the users who live in kubectl
+var kubectlPluginCommands = []*cobra.Command{nodeCmd, imageCmd, serviceCmd, tunnelCmd}
+
+// setupKubectlPlugin turns the root command into 'kubectl minikube', which targets the profile of the current kubectl context
+func setupKubectlPlugin(args []string) []string {
+	if RootCmd.Annotations == nil {
+		RootCmd.Annotations = map[string]string{}
+	}
+	RootCmd.Annotations[cobra.CommandDisplayNameAnnotation] = "kubectl minikube"
+	RootCmd.Long = "Manages the nodes, images, services and tunnel of the minikube cluster of the current kubectl context."
+	for _, c := range RootCmd.Commands() {
+		if !slices.Contains(kubectlPluginCommands, c) && c != optionsCmd && c != completionCmd && c.Name() != "help" {
+			RootCmd.RemoveCommand(c)
+		}
+	}
+	templates.ActsAsRootCommand(RootCmd, []string{"options"}, templates.CommandGroups{
+		{Message: translate.T("Commands:"), Commands: kubectlPluginCommands},
+	}...)
+
+	current, err := kubeconfig.GetCurrentContext()
+	if err != nil {
+		klog.Warningf("current context: %v", err)
+	}
+	return kubectlPluginArgs(args, current)
+}
+
+// kubectlPluginArgs returns the minikube arguments of the arguments of 'kubectl minikube': the --context of kubectl,
+// or else the current context if it is a minikube profile, selects the profile
+func kubectlPluginArgs(args []string, current string) []string {
+	var out []string
+	profile := ""
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "--":
+			out = append(out, args[i:]...)
+			i = len(args)
+		case a == "--context" && i+1 < len(args):
+			out = append(out, "--"+config.ProfileName, args[i+1])
+			profile = args[i+1]
+			i++
+		case strings.HasPrefix(a, "--context="):
+			profile = strings.TrimPrefix(a, "--context=")
+			out = append(out, "--"+config.ProfileName+"="+profile)
+		default:
+			if a == "-p" || a == "--"+config.ProfileName || strings.HasPrefix(a, "-p=") || strings.HasPrefix(a, "--"+config.ProfileName+"=") {
+				profile = a
+			}
+			out = append(out, a)
+		}
+	}
+	if profile == "" && current != "" && config.ProfileExists(current) {
+		out = append([]string{"--" + config.ProfileName + "=" + current}, out...)
+	}
+	return out
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestKubectlPluginArgs(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	if err := os.MkdirAll(localpath.Profile("dev"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(localpath.Profile("dev"), "config.json"), []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		description string
+		args        []string
+		current     string
+		want        []string
+	}{
+		{"current context", []string{"node", "add"}, "dev", []string{"--profile=dev", "node", "add"}},
+		{"not a minikube context", []string{"node", "add"}, "gke", []string{"node", "add"}},
+		{"kubectl context", []string{"node", "drain", "dev-m02", "--context", "other"}, "dev", []string{"node", "drain", "dev-m02", "--profile", "other"}},
+		{"kubectl context with =", []string{"--context=other", "tunnel"}, "dev", []string{"--profile=other", "tunnel"}},
+		{"profile", []string{"-p", "other", "service", "list"}, "dev", []string{"-p", "other", "service", "list"}},
+		{"after --", []string{"image", "load", "--", "--context"}, "dev", []string{"--profile=dev", "image", "load", "--", "--context"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := kubectlPluginArgs(tc.args, tc.current); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("kubectlPluginArgs(%v) = %v, want %v", tc.args, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPinToProfile(t *testing.T) {
+	tests := []struct {
+		description string
+		args        []string
+		kubeconfig  string
+		want        []string
+	}{
+		{"no args", nil, "", nil},
+		{"command", []string{"get", "pods", "-A"}, "", []string{"get", "pods", "--context=p1", "-A"}},
+		{"separate kubeconfig", []string{"get", "pods"}, "/p1/kubeconfig", []string{"get", "pods", "--context=p1", "--kubeconfig=/p1/kubeconfig"}},
+		{"completion", []string{"__complete", "get", ""}, "", []string{"__complete", "--context=p1", "get", ""}},
+		{"user context", []string{"get", "pods", "--context=other"}, "", []string{"get", "pods", "--context=other"}},
+		{"user cluster", []string{"get", "pods", "--cluster", "other"}, "", []string{"get", "pods", "--cluster", "other"}},
+		{"context after --", []string{"exec", "pod", "--", "sh", "--context"}, "", []string{"exec", "pod", "--context=p1", "--", "sh", "--context"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got := pinToProfile(tc.args, "p1", tc.kubeconfig)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("pinToProfile(%v) = %v, want %v", tc.args, got, tc.want)
+			}
+		})
+	}
+}
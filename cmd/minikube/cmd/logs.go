@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"io"
 	"os"
 
 	"github.com/docker/machine/libmachine/state"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/logs"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/style"
@@ -53,8 +55,12 @@ var (
 	fileOutput string
 	// auditLogs only shows the audit logs
 	auditLogs bool
+	// auditK8sLogs only shows the audit logs of the API server
+	auditK8sLogs bool
 	// lastStartOnly shows logs from last start
 	lastStartOnly bool
+	// perfLogs only shows the command timing report
+	perfLogs bool
 )
 
 // logsCmd represents the logs command
@@ -85,6 +91,13 @@ var logsCmd = &cobra.Command{
 			}
 			return
 		}
+		if perfLogs {
+			err := logs.OutputPerf()
+			if err != nil {
+				klog.Errorf("failed to output command timings: %v", err)
+			}
+			return
+		}
 		if auditLogs {
 			err := logs.OutputAudit(numberOfLines)
 			if err != nil {
@@ -92,6 +105,10 @@ var logsCmd = &cobra.Command{
 			}
 			return
 		}
+		if auditK8sLogs {
+			outputAuditK8s(logOutput)
+			return
+		}
 		logs.OutputOffline(numberOfLines, logOutput)
 
 		if shouldSilentFail() {
@@ -128,6 +145,45 @@ var logsCmd = &cobra.Command{
 	},
 }
 
+// outputAuditK8s prints the audit log of the API server of the --node, or of every control-plane node
+func outputAuditK8s(logOutput io.Writer) {
+	cname := ClusterFlagValue()
+	co := mustload.Running(cname)
+	if co.Config.AuditPolicy == "" {
+		exit.Message(reason.Usage, "The API server of \"{{.name}}\" does not audit requests, to audit them run: minikube start -p {{.name}} --audit-policy=policy.yaml", out.V{"name": cname})
+	}
+
+	nodes := config.ControlPlanes(*co.Config)
+	if nodeName != "" {
+		n, _, err := node.Retrieve(*co.Config, nodeName)
+		if err != nil {
+			exit.Message(reason.GuestNodeRetrieve, "Node {{.nodeName}} does not exist.", out.V{"nodeName": nodeName})
+		}
+		if !n.ControlPlane {
+			exit.Message(reason.Usage, "Node {{.nodeName}} is not a control-plane node, the audit log is written to those", out.V{"nodeName": nodeName})
+		}
+		nodes = []config.Node{*n}
+	}
+	if followLogs && len(nodes) > 1 {
+		exit.Message(reason.Usage, "The audit logs of several control-plane nodes cannot be followed at once, choose one with --node")
+	}
+
+	for _, n := range nodes {
+		m := config.MachineName(*co.Config, n)
+		h, err := machine.LoadHost(co.API, m)
+		if err != nil {
+			exit.Error(reason.GuestLoadHost, "Error getting host", err)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			exit.Error(reason.InternalCommandRunner, "Failed to get command runner", err)
+		}
+		if err := logs.OutputAuditK8s(r, m, numberOfLines, followLogs, logOutput); err != nil {
+			exit.Error(reason.GuestAuditLog, "Unable to read the audit log", err)
+		}
+	}
+}
+
 // shouldSilentFail returns true if the user specifies the --file flag and the host isn't running
 // This is to prevent outputting the message 'The control plane node must be running for this command' which confuses
 // many users while gathering logs to report their issue as the message makes them think the log file wasn't generated
@@ -159,5 +215,7 @@ func init() {
 	logsCmd.Flags().StringVar(&nodeName, "node", "", "The node to get logs from. Defaults to the primary control plane.")
 	logsCmd.Flags().StringVar(&fileOutput, "file", "", "If present, writes to the provided file instead of stdout.")
 	logsCmd.Flags().BoolVar(&auditLogs, "audit", false, "Show only the audit logs")
+	logsCmd.Flags().BoolVar(&auditK8sLogs, "audit-k8s", false, "Show only the audit logs of the API server of the cluster, started with --audit-policy")
 	logsCmd.Flags().BoolVar(&lastStartOnly, "last-start-only", false, "Show only the last start logs.")
+	logsCmd.Flags().BoolVar(&perfLogs, "perf", false, "Show only a report of how long provisioning commands took, aggregated by command")
 }
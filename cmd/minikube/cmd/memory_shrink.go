@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// memoryShrinkCmd is the process started by 'minikube start --memory-auto-shrink'
+var memoryShrinkCmd = &cobra.Command{
+	Use:    "memory-shrink",
+	Short:  "Shrinks the memory of the guests of an idle cluster",
+	Long:   "Shrinks the memory of the guests of a cluster that has been idle for the --memory-auto-shrink duration, and restores it on activity. Started by 'minikube start --memory-auto-shrink', it exits once the cluster is stopped or deleted.",
+	Hidden: true,
+	Run: func(_ *cobra.Command, _ []string) {
+		if err := node.ShrinkMemory(ClusterFlagValue()); err != nil {
+			exit.Error(reason.GuestMemoryShrink, "Failed to shrink memory", err)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(memoryShrinkCmd)
+}
@@ -17,8 +17,17 @@ limitations under the License.
 package cmd
 
 import (
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
 	"k8s.io/minikube/pkg/minikube/reason"
 )
 
@@ -28,6 +37,50 @@ var nodeCmd = &cobra.Command{
 	Short: "Add, remove, or list additional nodes",
 	Long:  "Operations on nodes",
 	Run: func(_ *cobra.Command, _ []string) {
-		exit.Message(reason.Usage, "Usage: minikube node [add|start|stop|delete|list]")
+		exit.Message(reason.Usage, "Usage: minikube node [add|start|stop|delete|list|trust|exec|status|refresh-runtime-config]")
 	},
 }
+
+// setNodeOutput prints the output of a node subcommand as JSON events with --output json, as minikube start does,
+// and starts its steps with first
+func setNodeOutput(first register.RegStep) {
+	if outputFormat != "text" && outputFormat != "json" {
+		exit.Message(reason.Usage, "invalid output format: {{.output}}. Valid values: 'text', 'json'", out.V{"output": outputFormat})
+	}
+	out.SetJSON(outputFormat == "json")
+	register.SetEventLogPath(localpath.EventLog(ClusterFlagValue()))
+	register.Reg.SetStep(first)
+}
+
+// addNodeOutputFlag adds the --output flag of setNodeOutput to c
+func addNodeOutputFlag(c *cobra.Command) {
+	c.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+}
+
+// addProvisionRetryFlags adds the flags of the retry policy of node.Add to c, which the config settings of the same names default them
+func addProvisionRetryFlags(c *cobra.Command) {
+	c.Flags().Int(config.ProvisionAttempts, 1, "Number of times a node is tried to be added, when it fails in one of --provision-retry-phases. 1 never tries again.")
+	c.Flags().Duration(config.ProvisionBackoff, 10*time.Second, "How long to wait before a node is tried to be added again, doubled after each attempt")
+	c.Flags().String(config.ProvisionRetryPhases, strings.Join(config.RetryPhases, ","), "Comma separated phases of adding a node whose failures are tried again: download (its Kubernetes binaries), create (its machine) and join (the cluster). The machine is reused, rather than created again, after a download or join failure.")
+}
+
+// bindProvisionRetryFlags binds the flags of addProvisionRetryFlags of c to viper, which node.Add reads them from,
+// for the commands whose flags are not all bound, and validates them
+func bindProvisionRetryFlags(c *cobra.Command) {
+	for _, f := range []string{config.ProvisionAttempts, config.ProvisionBackoff, config.ProvisionRetryPhases} {
+		if err := viper.BindPFlag(f, c.Flags().Lookup(f)); err != nil {
+			exit.Error(reason.InternalBindFlags, "unable to bind flags", err)
+		}
+	}
+	validateProvisionRetryFlags()
+}
+
+// validateProvisionRetryFlags exits if the flags of addProvisionRetryFlags are invalid
+func validateProvisionRetryFlags() {
+	if viper.GetInt(config.ProvisionAttempts) < 1 {
+		exit.Message(reason.Usage, "--{{.flag}} must be at least 1, not {{.value}}", out.V{"flag": config.ProvisionAttempts, "value": viper.GetInt(config.ProvisionAttempts)})
+	}
+	if _, err := config.ParseRetryPhases(viper.GetString(config.ProvisionRetryPhases)); err != nil {
+		exit.Message(reason.Usage, "Invalid --{{.flag}}: {{.error}}", out.V{"flag": config.ProvisionRetryPhases, "error": err})
+	}
+}
@@ -17,11 +17,24 @@ limitations under the License.
 package cmd
 
 import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/drivers/kic/oci"
 
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
 	"k8s.io/minikube/pkg/minikube/cni"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/detect"
+	"k8s.io/minikube/pkg/minikube/download"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/mustload"
@@ -30,12 +43,24 @@ import (
 	"k8s.io/minikube/pkg/minikube/out/register"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/util"
 )
 
 var (
 	cpNode              bool
 	workerNode          bool
 	deleteNodeOnFailure bool
+	nodeExtraOptions    config.ExtraOptionSlice
+	kubeadmPatchesDir   string
+	nodeCount           int
+	nodeMemory          string
+	nodeCPUs            int
+	nodeDiskSize        string
+	nodeTopology        string
+	nodeLabels          map[string]string
+	nodeTaints          []string
+	nodeArch            string
+	nodeForce           bool
 )
 
 var nodeAddCmd = &cobra.Command{
@@ -43,6 +68,10 @@ var nodeAddCmd = &cobra.Command{
 	Short: "Adds a node to the given cluster.",
 	Long:  "Adds a node to the given cluster config, and starts it.",
 	Run: func(cmd *cobra.Command, _ []string) {
+		setNodeOutput(register.InitialSetup)
+		bindProvisionRetryFlags(cmd)
+		defer mustLockProfile(ClusterFlagValue()).Release()
+
 		co := mustload.Healthy(ClusterFlagValue())
 		cc := co.Config
 
@@ -50,10 +79,19 @@ var nodeAddCmd = &cobra.Command{
 			out.FailureT("none driver does not support multi-node clusters")
 		}
 
+		if nodeTopology != "" {
+			addTopologyNodes(cmd, cc, nodeTopology)
+			return
+		}
+
 		if cpNode && !config.IsHA(*cc) {
 			out.FailureT("Adding a control-plane node to a non-HA (non-multi-control plane) cluster is not currently supported. Please first delete the cluster and use 'minikube start --ha' to create new one.")
 		}
 
+		if nodeCount < 1 {
+			exit.Message(reason.Usage, "--count must be at least 1, not {{.count}}", out.V{"count": nodeCount})
+		}
+
 		roles := []string{}
 		if workerNode {
 			roles = append(roles, "worker")
@@ -62,20 +100,72 @@ var nodeAddCmd = &cobra.Command{
 			roles = append(roles, "control-plane")
 		}
 
-		// calculate appropriate new node name with id following the last existing one
-		lastID, err := node.ID(cc.Nodes[len(cc.Nodes)-1].Name)
+		arch, err := validateNodeArch(cc.Driver, nodeArch)
 		if err != nil {
-			lastID = len(cc.Nodes)
-			out.ErrLn("determining last node index (will assume %d): %v", lastID, err)
+			exit.Message(reason.Usage, "Invalid --arch: {{.error}}", out.V{"error": err})
+		}
+		if arch != "" {
+			if _, err := download.ISO(download.ISOURLs(arch), false); err != nil {
+				exit.Error(reason.GuestNodeAdd, "Failed to cache the ISO", err)
+			}
 		}
-		name := node.Name(lastID + 1)
 
-		out.Step(style.Happy, "Adding node {{.name}} to cluster {{.cluster}} as {{.roles}}", out.V{"name": name, "cluster": cc.Name, "roles": roles})
+		// new node names with ids following the last existing one, warm nodes included.
+		// Workers take over the warm nodes first, which only have to join the cluster, unless they are of another arch.
+		names := node.NextNames(cc, nodeCount, !cpNode && arch == "")
+		name := strings.Join(names, ", ")
+		// before the memory of the cluster is lowered for its first worker, which the existing nodes were not created with
+		allocMemory, allocCPUs := nodesResources(*cc, cc.Nodes, names)
+
+		if nodeCount == 1 {
+			out.Step(style.Happy, "Adding node {{.name}} to cluster {{.cluster}} as {{.roles}}", out.V{"name": name, "cluster": cc.Name, "roles": roles})
+		} else {
+			out.Step(style.Happy, "Adding {{.count}} nodes {{.names}} to cluster {{.cluster}} as {{.roles}}", out.V{"count": nodeCount, "names": name, "cluster": cc.Name, "roles": roles})
+		}
 		n := config.Node{
-			Name:              name,
 			Worker:            workerNode,
 			ControlPlane:      cpNode,
 			KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+			ExtraOptions:      nodeExtraOptions,
+			Arch:              arch,
+		}
+		for _, o := range nodeExtraOptions {
+			if o.Component != bsutil.Kubelet {
+				exit.Message(reason.Usage, "Only kubelet options can be set for a single node, not {{.option}}", out.V{"option": o.String()})
+			}
+		}
+		if nodeMemory != "" {
+			mem, err := util.CalculateSizeInMB(nodeMemory)
+			if err != nil {
+				exit.Message(reason.Usage, "Invalid memory size {{.memory}}: {{.error}}", out.V{"memory": nodeMemory, "error": err})
+			}
+			validateRequestedMemorySize(mem, cc.Driver)
+			n.Memory = mem
+		}
+		if nodeCPUs < 0 {
+			exit.Message(reason.Usage, "--cpus cannot be negative, not {{.cpus}}", out.V{"cpus": nodeCPUs})
+		}
+		n.CPUs = nodeCPUs
+		if nodeDiskSize != "" {
+			if err := validateDiskSize(nodeDiskSize); err != nil {
+				exit.Message(reason.Usage, "{{.error}}", out.V{"error": err})
+			}
+			n.DiskSize, _ = util.CalculateSizeInMB(nodeDiskSize)
+		}
+		if err := config.ValidateLabels(nodeLabels); err != nil {
+			exit.Message(reason.Usage, "Invalid --node-labels: {{.error}}", out.V{"error": err})
+		}
+		if err := config.ValidateTaints(nodeTaints); err != nil {
+			exit.Message(reason.Usage, "Invalid --node-taints: {{.error}}", out.V{"error": err})
+		}
+		n.Labels = nodeLabels
+		n.Taints = nodeTaints
+		if kubeadmPatchesDir != "" {
+			patches, err := readKubeadmPatches(kubeadmPatchesDir, cc.KubernetesConfig.KubernetesVersion)
+			if err != nil {
+				exit.Message(reason.Usage, "Invalid kubeadm patches: {{.error}}", out.V{"error": err})
+			}
+			n.KubeadmPatches = patches
 		}
 
 		// Make sure to decrease the default amount of memory we use per VM if this is the first worker node
@@ -89,11 +179,33 @@ var nodeAddCmd = &cobra.Command{
 			}
 		}
 
-		register.Reg.SetStep(register.InitialSetup)
-		if err := node.Add(cc, n, deleteNodeOnFailure); err != nil {
-			_, err := maybeDeleteAndRetry(cmd, *cc, n, nil, err)
-			if err != nil {
-				exit.Error(reason.GuestNodeAdd, "failed to add node", err)
+		var ns []config.Node
+		for _, name := range names {
+			n.Name = name
+			ns = append(ns, n)
+		}
+		addMemory, addCPUs := nodesResources(*cc, ns, nil)
+		validateNodeCapacity(*cc, allocMemory+addMemory, allocCPUs+addCPUs)
+
+		switch {
+		case nodeCount == 1:
+			if err := node.Add(cc, ns[0], deleteNodeOnFailure); err != nil {
+				_, err := maybeDeleteAndRetry(cmd, *cc, ns[0], nil, err)
+				if err != nil {
+					exit.Error(reason.GuestNodeAdd, "failed to add node", err)
+				}
+			}
+		case cpNode:
+			// the control-plane nodes join one after the other, as etcd members do
+			for _, n := range ns {
+				out.Ln("")
+				if err := node.Add(cc, n, deleteNodeOnFailure); err != nil {
+					exit.Error(reason.GuestNodeAdd, "failed to add node", err)
+				}
+			}
+		default:
+			if err := addNodesInParallel(cc, ns, deleteNodeOnFailure); err != nil {
+				exit.Error(reason.GuestNodeAdd, "failed to add nodes", err)
 			}
 		}
 
@@ -101,14 +213,207 @@ var nodeAddCmd = &cobra.Command{
 			exit.Error(reason.HostSaveProfile, "failed to save config", err)
 		}
 
+		register.Reg.SetStep(register.Done)
 		out.Step(style.Ready, "Successfully added {{.name}} to {{.cluster}}!", out.V{"name": name, "cluster": cc.Name})
+
+		if viper.GetInt(config.WarmNodes) > 0 {
+			if err := node.StartWarmNodes(cc); err != nil {
+				out.WarningT("Unable to warm nodes: {{.error}}", out.V{"error": err})
+			}
+		}
 	},
 }
 
 func init() {
 	nodeAddCmd.Flags().BoolVar(&cpNode, "control-plane", false, "If set, added node will become a control-plane. Defaults to false. Currently only supported for existing HA (multi-control plane) clusters.")
 	nodeAddCmd.Flags().BoolVar(&workerNode, "worker", true, "If set, added node will be available as worker. Defaults to true.")
+	nodeAddCmd.Flags().IntVar(&nodeCount, "count", 1, "Number of nodes to add. The workers join the cluster concurrently, the control-plane nodes one after the other.")
+	nodeAddCmd.Flags().StringVar(&nodeMemory, "memory", "", "Amount of RAM of the added node, instead of the cluster's (format: <number>[<unit>], where unit = b, k, m or g), eg: 8g")
+	nodeAddCmd.Flags().IntVar(&nodeCPUs, "cpus", 0, "Number of CPUs of the added node, instead of the cluster's")
+	nodeAddCmd.Flags().StringVar(&nodeDiskSize, "disk-size", "", "Disk size of the added node, instead of the cluster's (format: <number>[<unit>], where unit = b, k, m or g). Ignored by the docker and podman drivers, whose nodes use the storage of the host.")
+	nodeAddCmd.Flags().StringToStringVar(&nodeLabels, "node-labels", nil, "Labels of the added node, in addition to the minikube ones, eg: accelerator=gpu,tier=batch. The node registers with them.")
+	nodeAddCmd.Flags().StringSliceVar(&nodeTaints, "node-taints", nil, "Taints of the added node, in the syntax of kubectl taint, eg: dedicated=gpu:NoSchedule. The node registers with them, so no pod is scheduled on it before.")
+	nodeAddCmd.Flags().StringVar(&nodeTopology, "topology", "", "A topology file of the nodes to add, with their roles, resources, labels and taints, instead of --count, --control-plane, --worker, --memory, --cpus, --disk-size, --node-labels and --node-taints")
+	nodeAddCmd.Flags().StringVar(&nodeArch, "arch", "", "CPU architecture of the added node, eg: arm64, instead of the host's. Only supported by the qemu2 driver, which emulates it. The nodes of the ssh driver have the arch of their machine.")
+	nodeAddCmd.Flags().BoolVar(&nodeForce, "force", false, "Add the nodes even if they need more memory than the host has, along with the existing nodes of the cluster")
 	nodeAddCmd.Flags().BoolVar(&deleteNodeOnFailure, "delete-on-failure", false, "If set, delete the current cluster if start fails and try again. Defaults to false.")
 
+	nodeAddCmd.Flags().Var(&nodeExtraOptions, "extra-config", "A set of key=value pairs that configure the kubelet of the added node only, on top of the cluster's extra-config. The key should be '.' separated, and the first part must be kubelet, eg: kubelet.eviction-hard=memory.available<5%")
+	nodeAddCmd.Flags().StringVar(&kubeadmPatchesDir, "kubeadm-patches", "", "A directory of kubeadm patches applied when the added node joins the cluster, eg: kubeletconfiguration+merge.yaml to change its cgroup driver. Files follow the kubeadm naming convention: target[suffix][+patchtype].extension")
+
+	addNodeOutputFlag(nodeAddCmd)
+	addLockTimeoutFlag(nodeAddCmd)
+	addProvisionRetryFlags(nodeAddCmd)
+
 	nodeCmd.AddCommand(nodeAddCmd)
 }
+
+// validateNodeArch returns the arch of a node added to a cluster of the driver drv with --arch, empty for the one of the host
+func validateNodeArch(drv, arch string) (string, error) {
+	if arch == "" || arch == detect.EffectiveArch() {
+		return "", nil
+	}
+	if arch != "amd64" && arch != "arm64" {
+		return "", errors.Errorf("%q is not supported, only amd64 and arm64 are", arch)
+	}
+	if !driver.IsQEMU(drv) {
+		return "", errors.Errorf("the %s driver does not support nodes of another arch than the host, only the qemu2 driver does", drv)
+	}
+	return arch, nil
+}
+
+// nodesResources returns the memory in MB and the CPUs of the nodes ns of cc, but the ones named in skip,
+// eg: the warm nodes that the added nodes take over
+func nodesResources(cc config.ClusterConfig, ns []config.Node, skip []string) (int, int) {
+	memory, cpus := 0, 0
+	for _, n := range ns {
+		if slices.Contains(skip, n.Name) {
+			continue
+		}
+		r := config.NodeResources(cc, n)
+		memory += r.Memory
+		cpus += r.CPUs
+	}
+	return memory, cpus
+}
+
+// validateNodeCapacity exits, unless --force, if the nodes of cc would have the given memory in MB, more than the host,
+// or the docker or podman daemon, has. CPUs are shared between the nodes, so over-committing them only warns.
+func validateNodeCapacity(cc config.ClusterConfig, memory, cpus int) {
+	// the nodes of the ssh driver are other machines
+	if driver.IsSSH(cc.Driver) {
+		return
+	}
+	sysLimit, containerLimit, err := memoryLimits(cc.Driver)
+	if err != nil {
+		klog.Warningf("Unable to query memory limits: %v", err)
+		return
+	}
+	kind, limit, of := reason.RsrcInsufficientSysMemory, sysLimit, "the host"
+	if containerLimit > 0 {
+		kind, limit, of = reason.RsrcInsufficientContainerMemory, containerLimit, driver.FullName(cc.Driver)
+	}
+	kind.Advice = "Add nodes with less memory, eg: 'minikube node add --memory=2200mb', or remove nodes with 'minikube node delete'"
+	v := out.V{"cluster": cc.Name, "memory": memory, "limit": limit, "of": of}
+	switch {
+	case memory <= limit:
+	case cc.MemoryAutoShrink != 0 && (driver.IsKVM(cc.Driver) || driver.IsHyperV(cc.Driver)):
+		// the guests give their memory back to the host when idle, eg: with the dynamic memory of Hyper-V
+		out.WarnReason(kind, "The nodes of {{.cluster}} would have {{.memory}}MB of memory, more than the {{.limit}}MB of {{.of}}, which only the memory of their idle guests being shrunk leaves room for", v)
+	case nodeForce:
+		out.Error(kind, "The nodes of {{.cluster}} would have {{.memory}}MB of memory, more than the {{.limit}}MB of {{.of}}", v)
+	default:
+		exit.Message(kind, "The nodes of {{.cluster}} would have {{.memory}}MB of memory, more than the {{.limit}}MB of {{.of}}. Use --force to add them anyway", v)
+	}
+
+	available, err := cpu.Counts(true)
+	if driver.IsKIC(cc.Driver) {
+		var si oci.SysInfo
+		si, err = oci.CachedDaemonInfo(cc.Driver)
+		available = si.CPUs
+	}
+	if err != nil {
+		klog.Warningf("Unable to get CPU info: %v", err)
+		return
+	}
+	if cpus > available {
+		out.WarningT("The nodes of {{.cluster}} would have {{.cpus}} CPUs, more than the {{.available}} of {{.of}}, which slows them down", out.V{"cluster": cc.Name, "cpus": cpus, "available": available, "of": of})
+	}
+}
+
+// addTopologyNodes adds the nodes of the topology file at path to cc: the control-plane ones one after the other,
+// then the workers concurrently
+func addTopologyNodes(cmd *cobra.Command, cc *config.ClusterConfig, path string) {
+	for _, f := range []string{"count", "control-plane", "worker", "memory", "cpus", "disk-size", "node-labels", "node-taints", "arch"} {
+		if cmd.Flags().Changed(f) {
+			exit.Message(reason.Usage, "--topology cannot be combined with --{{.flag}}, the topology file has the nodes to add", out.V{"flag": f})
+		}
+	}
+	t, err := config.LoadTopology(path)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid topology file {{.path}}: {{.error}}", out.V{"path": path, "error": err})
+	}
+	if t.ControlPlanes() > 0 && !config.IsHA(*cc) {
+		exit.Message(reason.Usage, "Adding control-plane nodes to a non-HA (non-multi-control plane) cluster is not currently supported. Please first delete the cluster and use 'minikube start --topology' to create new one.")
+	}
+
+	ns := t.Expand()
+	// the warm nodes are not taken over, as their resources may not be the ones of the topology
+	names := node.NextNames(cc, len(ns), false)
+	var workers []config.Node
+	for i := range ns {
+		ns[i].Name = names[i]
+		ns[i].KubernetesVersion = cc.KubernetesConfig.KubernetesVersion
+		ns[i].ExtraOptions = nodeExtraOptions
+		if ns[i].Memory != 0 {
+			validateRequestedMemorySize(ns[i].Memory, cc.Driver)
+		}
+		if !ns[i].ControlPlane {
+			workers = append(workers, ns[i])
+		}
+	}
+	for _, o := range nodeExtraOptions {
+		if o.Component != bsutil.Kubelet {
+			exit.Message(reason.Usage, "Only kubelet options can be set for a single node, not {{.option}}", out.V{"option": o.String()})
+		}
+	}
+	name := strings.Join(names, ", ")
+	out.Step(style.Happy, "Adding {{.count}} nodes {{.names}} of {{.topology}} to cluster {{.cluster}}", out.V{"count": len(ns), "names": name, "topology": path, "cluster": cc.Name})
+
+	allocMemory, allocCPUs := nodesResources(*cc, cc.Nodes, nil)
+	addMemory, addCPUs := nodesResources(*cc, ns, nil)
+	validateNodeCapacity(*cc, allocMemory+addMemory, allocCPUs+addCPUs)
+
+	if len(cc.Nodes) == 1 && (!cc.MultiNodeRequested || cni.IsDisabled(*cc)) {
+		warnAboutMultiNodeCNI()
+	}
+
+	// the control-plane nodes join one after the other, as etcd members do
+	for _, n := range ns[:len(ns)-len(workers)] {
+		out.Ln("")
+		if err := node.Add(cc, n, deleteNodeOnFailure); err != nil {
+			exit.Error(reason.GuestNodeAdd, "failed to add node", err)
+		}
+	}
+	if len(workers) > 0 {
+		if err := addNodesInParallel(cc, workers, deleteNodeOnFailure); err != nil {
+			exit.Error(reason.GuestNodeAdd, "failed to add nodes", err)
+		}
+	}
+
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		exit.Error(reason.HostSaveProfile, "failed to save config", err)
+	}
+	register.Reg.SetStep(register.Done)
+	out.Step(style.Ready, "Successfully added {{.name}} to {{.cluster}}!", out.V{"name": name, "cluster": cc.Name})
+}
+
+// readKubeadmPatches reads the kubeadm patches in dir, keyed by file name
+func readKubeadmPatches(dir string, kubernetesVersion string) (map[string]string, error) {
+	version, err := util.ParseKubernetesVersion(kubernetesVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Kubernetes version")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	patches := map[string]string{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if err := bsutil.ValidateKubeadmPatch(e.Name(), version); err != nil {
+			return nil, err
+		}
+		b, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		patches[e.Name()] = string(b)
+	}
+	if len(patches) == 0 {
+		return nil, errors.Errorf("no kubeadm patches found in %s", dir)
+	}
+	return patches, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/detect"
+)
+
+func TestValidateNodeArch(t *testing.T) {
+	other := "arm64"
+	if detect.EffectiveArch() == "arm64" {
+		other = "amd64"
+	}
+	tests := []struct {
+		description string
+		driver      string
+		arch        string
+		want        string
+		err         bool
+	}{
+		{"unset", "docker", "", "", false},
+		{"arch of the host", "docker", detect.EffectiveArch(), "", false},
+		{"qemu2", "qemu2", other, other, false},
+		{"unsupported driver", "docker", other, "", true},
+		{"unsupported arch", "qemu2", "s390x", "", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := validateNodeArch(tc.driver, tc.arch)
+			if (err != nil) != tc.err {
+				t.Fatalf("validateNodeArch(%q, %q) = %v", tc.driver, tc.arch, err)
+			}
+			if got != tc.want {
+				t.Errorf("validateNodeArch(%q, %q) = %q, want %q", tc.driver, tc.arch, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNodesResources(t *testing.T) {
+	cc := config.ClusterConfig{Memory: 2200, CPUs: 2}
+	ns := []config.Node{{Name: ""}, {Name: "m02", Memory: 4000, CPUs: 4}, {Name: "m03"}}
+	tests := []struct {
+		description string
+		skip        []string
+		memory      int
+		cpus        int
+	}{
+		{"all", nil, 8400, 8},
+		{"warm node taken over", []string{"m03"}, 6200, 6},
+		{"none", []string{"", "m02", "m03"}, 0, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			memory, cpus := nodesResources(cc, ns, tc.skip)
+			if memory != tc.memory || cpus != tc.cpus {
+				t.Errorf("nodesResources(%v) = %d, %d, want %d, %d", tc.skip, memory, cpus, tc.memory, tc.cpus)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/docker/machine/libmachine"
 	"github.com/spf13/cobra"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/delete"
@@ -28,10 +29,14 @@ import (
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/style"
 )
 
+// nodeQuorumForce stops or deletes a control-plane node even if it would leave etcd without quorum
+var nodeQuorumForce bool
+
 var nodeDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Deletes a node from a cluster.",
@@ -40,9 +45,12 @@ var nodeDeleteCmd = &cobra.Command{
 		if len(args) == 0 {
 			exit.Message(reason.Usage, "Usage: minikube node delete [name]")
 		}
+		setNodeOutput(register.DeletingNode)
 		name := args[0]
+		defer mustLockProfile(ClusterFlagValue()).Release()
 
 		co := mustload.Healthy(ClusterFlagValue())
+		checkNodeQuorum(co.API, *co.Config, name, true)
 		out.Step(style.DeletingHost, "Deleting node {{.name}} from cluster {{.cluster}}", out.V{"name": name, "cluster": co.Config.Name})
 
 		n, err := node.Delete(*co.Config, name)
@@ -57,10 +65,31 @@ var nodeDeleteCmd = &cobra.Command{
 			delete.PossibleLeftOvers(ctx, machineName, co.Config.Driver)
 		}
 
+		register.Reg.SetStep(register.Done)
 		out.Step(style.Deleted, "Node {{.name}} was successfully deleted.", out.V{"name": name})
 	},
 }
 
+// checkNodeQuorum exits, unless --force, if stopping the node name of cc, or deleting it, would leave etcd without quorum
+func checkNodeQuorum(api libmachine.API, cc config.ClusterConfig, name string, deleting bool) {
+	n, _, err := node.Retrieve(cc, name)
+	if err != nil {
+		exit.Error(reason.GuestNodeRetrieve, "retrieving node", err)
+	}
+	err = node.CheckQuorum(api, cc, *n, deleting)
+	if err == nil {
+		return
+	}
+	if nodeQuorumForce {
+		out.WarningT("Ignoring that {{.error}}", out.V{"error": err})
+		return
+	}
+	exit.Message(reason.GuestNodeQuorum, "Refusing to stop or delete {{.name}}, as {{.error}}. Use --force to do it anyway", out.V{"name": name, "error": err})
+}
+
 func init() {
+	nodeDeleteCmd.Flags().BoolVar(&nodeQuorumForce, "force", false, "Delete a control-plane node even if it would leave etcd without quorum")
+	addNodeOutputFlag(nodeDeleteCmd)
+	addLockTimeoutFlag(nodeDeleteCmd)
 	nodeCmd.AddCommand(nodeDeleteCmd)
 }
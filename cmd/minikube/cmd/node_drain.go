@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var nodeDrainCmd = &cobra.Command{
+	Use:   "drain",
+	Short: "Drains a node in a cluster.",
+	Long:  "Cordons a node in a cluster, and evicts its pods, eg: before it is stopped. The node is scheduled again once it is uncordoned with 'kubectl uncordon'.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.Message(reason.Usage, "Usage: minikube node drain [name]")
+		}
+		name := args[0]
+
+		co := mustload.Healthy(ClusterFlagValue())
+		out.Step(style.Waiting, "Draining node {{.name}} of cluster {{.cluster}}", out.V{"name": name, "cluster": co.Config.Name})
+		if err := node.Drain(*co.Config, name); err != nil {
+			exit.Error(reason.GuestNodeDrain, "draining node", err)
+		}
+		out.Step(style.Success, "Node {{.name}} was successfully drained.", out.V{"name": name})
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeDrainCmd)
+}
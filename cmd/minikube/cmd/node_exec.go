@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+var nodeExecAll bool
+
+var nodeExecCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Runs a command on one or all nodes",
+	Long: `Runs a command on a node, by default the primary control plane, or on all nodes concurrently with --all.
+With --all, each line of output is labeled with the name of its node. Exits with a non-zero code if the command fails on any node.`,
+	Example: "minikube node exec --all -- sudo crictl images",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.Message(reason.Usage, "Usage: minikube node exec [--all|--node NAME] -- COMMAND")
+		}
+		if nodeExecAll && nodeName != "" {
+			exit.Message(reason.Usage, "--all and --node cannot be combined")
+		}
+		co := mustload.Running(ClusterFlagValue())
+		if driver.BareMetal(co.Config.Driver) {
+			exit.Message(reason.Usage, "'none' driver does not support 'minikube node exec' command")
+		}
+
+		ns := []config.Node{*co.CP.Node}
+		if nodeExecAll {
+			ns = co.Config.Nodes
+		} else if nodeName != "" {
+			n, _, err := node.Retrieve(*co.Config, nodeName)
+			if err != nil {
+				exit.Message(reason.GuestNodeRetrieve, "Node {{.nodeName}} does not exist.", out.V{"nodeName": nodeName})
+			}
+			ns = []config.Node{*n}
+		}
+
+		code := execOnNodes(co, ns, strings.Join(args, " "))
+		os.Exit(code)
+	},
+}
+
+// execOnNodes runs the shell command c on the nodes ns concurrently, and returns the exit code of minikube node exec:
+// the one of the command on a single node, or 1 if it failed on any of several nodes
+func execOnNodes(co mustload.ClusterController, ns []config.Node, c string) int {
+	// the hosts are loaded one after the other, as the API is not safe for concurrent use
+	runners := make([]command.Runner, len(ns))
+	errs := make([]error, len(ns))
+	for i, n := range ns {
+		h, err := machine.LoadHost(co.API, config.MachineName(*co.Config, n))
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		runners[i], errs[i] = machine.CommandRunner(h)
+	}
+
+	var mu sync.Mutex
+	codes := make([]int, len(ns))
+	var wg sync.WaitGroup
+	for i, n := range ns {
+		name := config.MachineName(*co.Config, n)
+		if errs[i] != nil {
+			out.FailureT("Unable to run the command on node {{.node}}: {{.error}}", out.V{"node": name, "error": errs[i]})
+			codes[i] = 1
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cmd := exec.Command("/bin/bash", "-c", c)
+			cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+			stdout := &labelWriter{mu: &mu, w: os.Stdout, label: "[" + name + "] "}
+			stderr := &labelWriter{mu: &mu, w: os.Stderr, label: "[" + name + "] "}
+			if len(ns) > 1 {
+				cmd.Stdout, cmd.Stderr = stdout, stderr
+			}
+			rr, err := runners[i].RunCmd(cmd)
+			stdout.Flush()
+			stderr.Flush()
+			if err == nil {
+				return
+			}
+			codes[i] = 1
+			if rr != nil && rr.ExitCode > 0 {
+				codes[i] = rr.ExitCode
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			out.FailureT("The command failed on node {{.node}} with exit code {{.code}}", out.V{"node": name, "code": codes[i]})
+		}()
+	}
+	wg.Wait()
+
+	if len(ns) == 1 {
+		return codes[0]
+	}
+	for _, code := range codes {
+		if code != 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// labelWriter writes whole lines to w, prefixed with label, so that the lines of concurrent writers sharing mu do not interleave
+type labelWriter struct {
+	mu    *sync.Mutex
+	w     io.Writer
+	label string
+	buf   []byte
+}
+
+func (l *labelWriter) Write(p []byte) (int, error) {
+	l.buf = append(l.buf, p...)
+	for {
+		i := bytes.IndexByte(l.buf, '\n')
+		if i == -1 {
+			return len(p), nil
+		}
+		l.mu.Lock()
+		_, err := fmt.Fprintf(l.w, "%s%s", l.label, l.buf[:i+1])
+		l.mu.Unlock()
+		l.buf = l.buf[i+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+}
+
+// Flush writes the last line, which has no newline, if any
+func (l *labelWriter) Flush() {
+	if len(l.buf) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.w, "%s%s\n", l.label, l.buf)
+	l.buf = nil
+}
+
+func init() {
+	nodeExecCmd.Flags().BoolVar(&nodeExecAll, "all", false, "If set, runs the command on all nodes concurrently")
+	nodeExecCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to run the command on. Defaults to the primary control plane.")
+	nodeCmd.AddCommand(nodeExecCmd)
+}
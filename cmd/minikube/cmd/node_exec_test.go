@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestLabelWriter(t *testing.T) {
+	var mu sync.Mutex
+	var b bytes.Buffer
+	m01 := &labelWriter{mu: &mu, w: &b, label: "[m01] "}
+	m02 := &labelWriter{mu: &mu, w: &b, label: "[m02] "}
+	fmt.Fprint(m01, "a")
+	fmt.Fprint(m02, "x\ny")
+	fmt.Fprint(m01, "b\nc\n")
+	m01.Flush()
+	m02.Flush()
+	want := "[m02] x\n[m01] ab\n[m01] c\n[m02] y\n"
+	if got := b.String(); got != want {
+		t.Errorf("labelWriter wrote %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var nodeRefreshRuntimeConfigCmd = &cobra.Command{
+	Use:   "refresh-runtime-config",
+	Short: "Applies the registry settings of the profile to the container runtime of the running nodes",
+	Long: `Renders the insecure registries and registry mirrors of the profile into the configuration of the container runtime of each running node, or only of the node it is given, eg: after they were changed with 'minikube config set --apply' while some nodes were stopped.
+Docker and cri-o are restarted for them to take effect.`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) > 1 {
+			exit.Message(reason.Usage, "Usage: minikube node refresh-runtime-config [name]")
+		}
+		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+		api, cc := mustload.Partial(cname)
+
+		nodes := cc.Nodes
+		if len(args) == 1 {
+			n, _, err := node.Retrieve(*cc, args[0])
+			if err != nil {
+				exit.Error(reason.GuestNodeRetrieve, "retrieving node", err)
+			}
+			nodes = []config.Node{*n}
+		}
+
+		refreshed := 0
+		for _, n := range nodes {
+			machineName := config.MachineName(*cc, n)
+			if !machine.IsRunning(api, machineName) {
+				out.Step(style.Notice, "Skipping {{.name}}, which is not running", out.V{"name": machineName})
+				continue
+			}
+			h, err := machine.LoadHost(api, machineName)
+			if err != nil {
+				exit.Error(reason.GuestLoadHost, "Error getting host", err)
+			}
+			out.Step(style.Provisioning, "Refreshing the container runtime config of {{.name}} ...", out.V{"name": machineName})
+			if err := machine.RefreshRuntimeConfig(api, h, *cc); err != nil {
+				exit.Error(reason.RuntimeEnable, "Unable to refresh the container runtime config", err)
+			}
+			refreshed++
+		}
+		if refreshed == 0 {
+			out.WarningT("No running nodes were found, the registry settings will take effect on the next start")
+			return
+		}
+		out.Step(style.Ready, "Refreshed the container runtime config of {{.count}} nodes", out.V{"count": refreshed})
+	},
+}
+
+func init() {
+	addLockTimeoutFlag(nodeRefreshRuntimeConfigCmd)
+	nodeCmd.AddCommand(nodeRefreshRuntimeConfigCmd)
+}
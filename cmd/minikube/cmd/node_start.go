@@ -40,6 +40,8 @@ var nodeStartCmd = &cobra.Command{
 		if len(args) == 0 {
 			exit.Message(reason.Usage, "Usage: minikube node start [name]")
 		}
+		setNodeOutput(register.InitialSetup)
+		defer mustLockProfile(ClusterFlagValue()).Release()
 
 		api, cc := mustload.Partial(ClusterFlagValue())
 		name := args[0]
@@ -55,7 +57,6 @@ var nodeStartCmd = &cobra.Command{
 			os.Exit(0)
 		}
 
-		register.Reg.SetStep(register.InitialSetup)
 		r, p, m, h, err := node.Provision(cc, n, viper.GetBool(deleteOnFailure))
 		if err != nil {
 			exit.Error(reason.GuestNodeProvision, "provisioning host for node", err)
@@ -77,11 +78,14 @@ var nodeStartCmd = &cobra.Command{
 				exit.Error(reason.GuestNodeStart, "failed to start node", err)
 			}
 		}
+		register.Reg.SetStep(register.Done)
 		out.Step(style.Happy, "Successfully started node {{.name}}!", out.V{"name": machineName})
 	},
 }
 
 func init() {
 	nodeStartCmd.Flags().Bool(deleteOnFailure, false, "If set, delete the current cluster if start fails and try again. Defaults to false.")
+	addNodeOutputFlag(nodeStartCmd)
+	addLockTimeoutFlag(nodeStartCmd)
 	nodeCmd.AddCommand(nodeStartCmd)
 }
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/state"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// diskPressurePercent is the usage of /var from which a node is shown under disk pressure, as the kubelet evicts pods below 10% available by default
+const diskPressurePercent = 90
+
+var nodeStatusWatch time.Duration
+
+var nodeStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Shows the health of the nodes",
+	Long: `Shows the health of each node of the cluster, or of the given one: whether its host is running and reachable over SSH,
+the state of its kubelet, API server and container runtime, and the usage of its disk.
+With --watch, the table is shown again at every interval until interrupted, like kubectl get nodes -w, with the state of the machines that kubectl cannot see.`,
+	Example: "minikube node status --watch 5s",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) > 1 {
+			exit.Message(reason.Usage, "Usage: minikube node status [name]")
+		}
+		cname := ClusterFlagValue()
+		api, cc := mustload.Partial(cname)
+
+		interval := nodeStatusWatch
+		if !cmd.Flags().Changed("watch") || interval < 0 {
+			interval = 0
+		}
+		redraw := interval > 0 && term.IsTerminal(int(os.Stdout.Fd()))
+		for {
+			var hs []nodeHealth
+			for _, n := range cc.Nodes {
+				if len(args) == 1 && n.Name != args[0] && config.MachineName(*cc, n) != args[0] {
+					continue
+				}
+				hs = append(hs, nodeHealthOf(api, *cc, n))
+			}
+			if len(args) == 1 && len(hs) == 0 {
+				exit.Message(reason.GuestNodeRetrieve, "Node {{.nodeName}} does not exist.", out.V{"nodeName": args[0]})
+			}
+
+			if redraw {
+				fmt.Print("\033[H\033[2J")
+			}
+			if interval > 0 {
+				fmt.Printf("Every %s: %s\n\n", interval, time.Now().Format(time.TimeOnly))
+			}
+			if err := nodeHealthTable(hs, os.Stdout); err != nil {
+				exit.Error(reason.InternalStatusText, "status text failure", err)
+			}
+			if interval == 0 {
+				var sts []*Status
+				for _, h := range hs {
+					sts = append(sts, h.Status)
+				}
+				os.Exit(exitCode(sts))
+			}
+
+			time.Sleep(interval)
+			if !redraw {
+				fmt.Println()
+			}
+			// nodes may have been added or deleted in the meantime
+			if c, err := config.Load(cname); err == nil {
+				cc = c
+			}
+		}
+	},
+}
+
+// nodeHealth is the health of a node, as shown by minikube node status
+type nodeHealth struct {
+	*Status
+	// SSH is Reachable or Unreachable for a running host, or the state of the host
+	SSH string
+	// Disk is the usage of /var, with DiskPressure from diskPressurePercent
+	Disk string
+}
+
+// nodeHealthOf returns the health of the node n of cc
+func nodeHealthOf(api libmachine.API, cc config.ClusterConfig, n config.Node) nodeHealth {
+	st, err := nodeStatus(api, cc, n)
+	if err != nil {
+		klog.Errorf("status error: %v", err)
+	}
+	nodeDetails(api, cc, n, st)
+	h := nodeHealth{Status: st, SSH: st.Host}
+	if st.Host != state.Running.String() && st.Host != codeNames[InsufficientStorage] {
+		return h
+	}
+
+	name := config.MachineName(cc, n)
+	host, err := machine.LoadHost(api, name)
+	if err != nil {
+		klog.Errorf("load host %s: %v", name, err)
+		return h
+	}
+	h.SSH = Irrelevant
+	if !driver.BareMetal(cc.Driver) {
+		h.SSH = "Reachable"
+		if _, err := host.RunSSHCommand("true"); err != nil {
+			klog.Errorf("ssh %s: %v", name, err)
+			h.SSH = "Unreachable"
+		}
+	}
+	r, err := machine.CommandRunner(host)
+	if err != nil {
+		klog.Errorf("command runner %s: %v", name, err)
+		return h
+	}
+	if p, err := machine.DiskUsed(r, "/var"); err != nil {
+		klog.Errorf("disk usage of %s: %v", name, err)
+	} else {
+		h.Disk = fmt.Sprintf("%d%%", p)
+		if p >= diskPressurePercent {
+			h.Disk += " DiskPressure"
+		}
+	}
+	return h
+}
+
+// nodeHealthTable writes the health of the nodes as a table, one node per row
+func nodeHealthTable(hs []nodeHealth, w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tHOST\tSSH\tKUBELET\tAPISERVER\tRUNTIME\tDISK")
+	for _, h := range hs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", h.Name, h.Host, h.SSH, h.Kubelet, h.APIServer, orNone(h.Runtime), orNone(h.Disk))
+	}
+	return tw.Flush()
+}
+
+func init() {
+	nodeStatusCmd.Flags().DurationVarP(&nodeStatusWatch, "watch", "w", 2*time.Second, "Shows the health of the nodes again at every interval, until interrupted")
+	nodeStatusCmd.Flags().Lookup("watch").NoOptDefVal = "2s"
+	nodeCmd.AddCommand(nodeStatusCmd)
+}
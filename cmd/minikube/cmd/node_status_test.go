@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNodeHealthTable(t *testing.T) {
+	hs := []nodeHealth{
+		{Status: &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Runtime: "Running"}, SSH: "Reachable", Disk: "93% DiskPressure"},
+		{Status: &Status{Name: "minikube-m02", Host: "Stopped", Kubelet: "Stopped", APIServer: "Stopped", Runtime: "Stopped"}, SSH: "Stopped"},
+	}
+	want := `NAME          HOST     SSH        KUBELET  APISERVER  RUNTIME  DISK
+minikube      Running  Reachable  Running  Running    Running  93% DiskPressure
+minikube-m02  Stopped  Stopped    Stopped  Stopped    Stopped  <none>
+`
+	var b bytes.Buffer
+	if err := nodeHealthTable(hs, &b); err != nil {
+		t.Fatalf("nodeHealthTable() error: %v", err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("nodeHealthTable() = \n%s, want:\n%s", got, want)
+	}
+}
@@ -22,10 +22,10 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/exit"
-	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/style"
 )
@@ -38,6 +38,8 @@ var nodeStopCmd = &cobra.Command{
 		if len(args) == 0 {
 			exit.Message(reason.Usage, "Usage: minikube node stop [name]")
 		}
+		setNodeOutput(register.Stopping)
+		defer mustLockProfile(ClusterFlagValue()).Release()
 
 		name := args[0]
 		api, cc := mustload.Partial(ClusterFlagValue())
@@ -47,17 +49,22 @@ var nodeStopCmd = &cobra.Command{
 			exit.Error(reason.GuestNodeRetrieve, "retrieving node", err)
 		}
 
+		checkNodeQuorum(api, *cc, name, false)
 		machineName := config.MachineName(*cc, *n)
 
-		err = machine.StopHost(api, machineName)
+		err = node.Stop(api, *cc, *n)
 		if err != nil {
 			out.ErrT(style.Fatal, "Failed to stop node {{.name}}: {{.error}}", out.V{"name": name, "error": err})
 			os.Exit(reason.ExHostError)
 		}
+		register.Reg.SetStep(register.Done)
 		out.Step(style.Stopped, "Successfully stopped node {{.name}}", out.V{"name": machineName})
 	},
 }
 
 func init() {
+	nodeStopCmd.Flags().BoolVar(&nodeQuorumForce, "force", false, "Stop a control-plane node even if it would leave etcd without quorum")
+	addNodeOutputFlag(nodeStopCmd)
+	addLockTimeoutFlag(nodeStopCmd)
 	nodeCmd.AddCommand(nodeStopCmd)
 }
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/sshutil"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var resetHostKey bool
+
+var nodeTrustCmd = &cobra.Command{
+	Use:   "trust",
+	Short: "Shows or resets the SSH host key that minikube trusts for a node",
+	Long: `Shows the SSH host key that minikube recorded when it last created or booted the guest of a node, and verifies on every connection.
+If the guest legitimately presents another key, eg: after it was rebuilt outside of minikube, --reset forgets the recorded key and trusts the one it presents now.`,
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube node trust [--reset] [name]")
+		}
+
+		name := args[0]
+		cname := ClusterFlagValue()
+		if resetHostKey {
+			defer mustLockProfile(cname).Release()
+		}
+		api, cc := mustload.Partial(cname)
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.Error(reason.GuestNodeRetrieve, "retrieving node", err)
+		}
+		machineName := config.MachineName(*cc, *n)
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			exit.Error(reason.GuestLoadHost, "Error getting host", err)
+		}
+		if driver.BareMetal(h.DriverName) {
+			exit.Message(reason.Usage, "The {{.driver}} driver does not connect to its nodes over SSH", out.V{"driver": h.DriverName})
+		}
+
+		if resetHostKey {
+			if err := sshutil.ResetHostKey(machineName); err != nil {
+				exit.Error(reason.GuestHostKey, "Unable to forget the host key", err)
+			}
+			if machine.IsRunning(api, machineName) {
+				// the key the guest presents is recorded on connect
+				c, err := sshutil.NewSSHClient(h.Driver)
+				if err != nil {
+					exit.Error(reason.GuestHostKey, "Unable to record the host key", err)
+				}
+				c.Close()
+			}
+		}
+
+		key, err := sshutil.TrustedHostKey(machineName)
+		if err != nil {
+			exit.Error(reason.GuestHostKey, "Unable to read the host key", err)
+		}
+		if key == nil {
+			out.Step(style.Notice, "No host key is trusted for {{.name}} yet, the one it presents on the next connection will be", out.V{"name": machineName})
+			return
+		}
+		out.Step(style.Ready, "{{.name}} is trusted with host key {{.type}} {{.fingerprint}}", out.V{"name": machineName, "type": key.Type(), "fingerprint": ssh.FingerprintSHA256(key)})
+	},
+}
+
+func init() {
+	nodeTrustCmd.Flags().BoolVar(&resetHostKey, "reset", false, "Forget the recorded host key, and trust the one that the node presents now")
+	addLockTimeoutFlag(nodeTrustCmd)
+	nodeCmd.AddCommand(nodeTrustCmd)
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/cni"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/util"
+)
+
+var (
+	poolSize     int
+	poolNewSize  int
+	poolOS       string
+	poolMemory   string
+	poolCPUs     int
+	poolDiskSize string
+	poolLabels   map[string]string
+	poolTaints   []string
+)
+
+// nodepoolCmd represents the set of node pool subcommands
+var nodepoolCmd = &cobra.Command{
+	Use:   "nodepool",
+	Short: "Create, scale, delete or list node pools",
+	Long:  "Operations on node pools, which are groups of identical worker nodes that are created, scaled and deleted together",
+	Run: func(_ *cobra.Command, _ []string) {
+		exit.Message(reason.Usage, "Usage: minikube nodepool [create|scale|delete|list]")
+	},
+}
+
+var nodepoolCreateCmd = &cobra.Command{
+	Use:     "create",
+	Short:   "Creates a node pool",
+	Long:    "Creates a node pool, and adds its nodes to the cluster. If any of them fails to be added, the pool is deleted along with the nodes that were.",
+	Example: "minikube nodepool create gpu --size 2 --memory 8g --labels accelerator=gpu --taints dedicated=gpu:NoSchedule",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube nodepool create NAME")
+		}
+		defer mustLockProfile(ClusterFlagValue()).Release()
+
+		cc := mustload.Healthy(ClusterFlagValue()).Config
+		if driver.BareMetal(cc.Driver) {
+			exit.Message(reason.DrvUnsupportedMulti, "The none driver is not compatible with multi-node clusters.")
+		}
+
+		p := config.NodePool{Name: args[0], OS: poolOS, CPUs: poolCPUs, Labels: poolLabels, Taints: poolTaints}
+		if poolMemory != "" {
+			mem, err := util.CalculateSizeInMB(poolMemory)
+			if err != nil {
+				exit.Message(reason.Usage, "Invalid memory size {{.memory}}: {{.error}}", out.V{"memory": poolMemory, "error": err})
+			}
+			validateRequestedMemorySize(mem, cc.Driver)
+			p.Memory = mem
+		}
+		if poolDiskSize != "" {
+			if err := validateDiskSize(poolDiskSize); err != nil {
+				exit.Message(reason.Usage, "{{.error}}", out.V{"error": err})
+			}
+			p.DiskSize, _ = util.CalculateSizeInMB(poolDiskSize)
+		}
+		if err := p.Validate(); err != nil {
+			exit.Message(reason.Usage, "Invalid node pool: {{.error}}", out.V{"error": err})
+		}
+		if poolSize < 0 {
+			exit.Message(reason.Usage, "--size cannot be negative, not {{.size}}", out.V{"size": poolSize})
+		}
+		if config.FindNodePool(*cc, p.Name) != nil {
+			exit.Message(reason.Usage, "Node pool {{.pool}} already exists in cluster {{.cluster}}, use 'minikube nodepool scale' to change its size", out.V{"pool": p.Name, "cluster": cc.Name})
+		}
+
+		out.Step(style.Happy, "Creating node pool {{.pool}} of {{.size}} nodes in cluster {{.cluster}}", out.V{"pool": p.Name, "size": poolSize, "cluster": cc.Name})
+		cc.NodePools = append(cc.NodePools, p)
+		if err := config.SaveProfile(cc.Name, cc); err != nil {
+			exit.Error(reason.HostSaveProfile, "failed to save config", err)
+		}
+		if err := addPoolNodes(cc, p, poolSize); err != nil {
+			if rerr := removeNodePool(cc.Name, p.Name); rerr != nil {
+				klog.Warningf("removing node pool %s: %v", p.Name, rerr)
+			}
+			exit.Error(reason.GuestNodeAdd, "failed to create node pool", err)
+		}
+		out.Step(style.Ready, "Node pool {{.pool}} was successfully created", out.V{"pool": p.Name})
+	},
+}
+
+var nodepoolScaleCmd = &cobra.Command{
+	Use:     "scale",
+	Short:   "Changes the number of nodes of a node pool",
+	Long:    "Adds nodes to a node pool, or deletes its last ones. If any of the nodes fails to be added, the ones that were are deleted.",
+	Example: "minikube nodepool scale gpu --size 3",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 || !cmd.Flags().Changed("size") {
+			exit.Message(reason.Usage, "Usage: minikube nodepool scale NAME --size N")
+		}
+		defer mustLockProfile(ClusterFlagValue()).Release()
+
+		cc := mustload.Healthy(ClusterFlagValue()).Config
+		p := mustFindNodePool(cc, args[0])
+		if poolNewSize < 0 {
+			exit.Message(reason.Usage, "--size cannot be negative, not {{.size}}", out.V{"size": poolNewSize})
+		}
+
+		ns := config.NodePoolNodes(*cc, p.Name)
+		switch {
+		case poolNewSize > len(ns):
+			out.Step(style.Happy, "Scaling node pool {{.pool}} up from {{.from}} to {{.to}} nodes", out.V{"pool": p.Name, "from": len(ns), "to": poolNewSize})
+			if err := addPoolNodes(cc, *p, poolNewSize-len(ns)); err != nil {
+				exit.Error(reason.GuestNodeAdd, "failed to scale node pool", err)
+			}
+		case poolNewSize < len(ns):
+			out.Step(style.DeletingHost, "Scaling node pool {{.pool}} down from {{.from}} to {{.to}} nodes", out.V{"pool": p.Name, "from": len(ns), "to": poolNewSize})
+			if err := deletePoolNodes(cc.Name, ns[poolNewSize:], false); err != nil {
+				exit.Error(reason.GuestNodeDelete, "failed to scale node pool", err)
+			}
+		default:
+			out.Step(style.Ready, "Node pool {{.pool}} already has {{.size}} nodes", out.V{"pool": p.Name, "size": poolNewSize})
+			return
+		}
+		out.Step(style.Ready, "Node pool {{.pool}} was successfully scaled to {{.size}} nodes", out.V{"pool": p.Name, "size": poolNewSize})
+	},
+}
+
+var nodepoolDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Deletes a node pool and its nodes",
+	Long:  "Deletes the nodes of a node pool, then the pool. If any of the nodes fails to be deleted, the pool is kept with the remaining ones, and the command can be run again.",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.Message(reason.Usage, "Usage: minikube nodepool delete NAME")
+		}
+		defer mustLockProfile(ClusterFlagValue()).Release()
+
+		cc := mustload.Healthy(ClusterFlagValue()).Config
+		p := mustFindNodePool(cc, args[0])
+
+		out.Step(style.DeletingHost, "Deleting node pool {{.pool}} from cluster {{.cluster}}", out.V{"pool": p.Name, "cluster": cc.Name})
+		if err := deletePoolNodes(cc.Name, config.NodePoolNodes(*cc, p.Name), false); err != nil {
+			exit.Error(reason.GuestNodeDelete, "failed to delete node pool", err)
+		}
+		if err := removeNodePool(cc.Name, p.Name); err != nil {
+			exit.Error(reason.HostSaveProfile, "failed to save config", err)
+		}
+		out.Step(style.Deleted, "Node pool {{.pool}} was successfully deleted.", out.V{"pool": p.Name})
+	},
+}
+
+var nodepoolListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists node pools",
+	Long:  "Lists the node pools of the cluster, with the resources of their nodes",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.Message(reason.Usage, "Usage: minikube nodepool list")
+		}
+		_, cc := mustload.Partial(ClusterFlagValue())
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tSIZE\tOS\tCPUS\tMEMORY\tDISK\tLABELS\tTAINTS")
+		for _, p := range cc.NodePools {
+			r := config.NodeResources(*cc, p.Node())
+			var labels []string
+			for k, v := range p.Labels {
+				labels = append(labels, k+"="+v)
+			}
+			sort.Strings(labels)
+			fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%dMB\t%dMB\t%s\t%s\n", p.Name, len(config.NodePoolNodes(*cc, p.Name)), p.OS, r.CPUs, r.Memory, r.DiskSize, strings.Join(labels, ","), strings.Join(p.Taints, ","))
+		}
+		w.Flush()
+	},
+}
+
+// mustFindNodePool returns the node pool name of cc, or exits if there is none
+func mustFindNodePool(cc *config.ClusterConfig, name string) *config.NodePool {
+	p := config.FindNodePool(*cc, name)
+	if p == nil {
+		exit.Message(reason.Usage, "Node pool {{.pool}} does not exist in cluster {{.cluster}}", out.V{"pool": name, "cluster": cc.Name})
+	}
+	return p
+}
+
+// addPoolNodes adds count nodes of the pool p to cc, all of them or none: if any fails to be added, the ones that were are deleted
+func addPoolNodes(cc *config.ClusterConfig, p config.NodePool, count int) error {
+	if count == 0 {
+		return nil
+	}
+	var ns []config.Node
+	// the warm nodes are not taken over, as their resources may not be the ones of the pool
+	for _, name := range node.NextNames(cc, count, false) {
+		n := p.Node()
+		n.Name = name
+		n.KubernetesVersion = cc.KubernetesConfig.KubernetesVersion
+		ns = append(ns, n)
+	}
+	if len(cc.Nodes) == 1 && (!cc.MultiNodeRequested || cni.IsDisabled(*cc)) {
+		warnAboutMultiNodeCNI()
+	}
+
+	register.Reg.SetStep(register.InitialSetup)
+	var err error
+	if count == 1 {
+		out.Ln("")
+		err = node.Add(cc, ns[0], false)
+	} else {
+		err = addNodesInParallel(cc, ns, false)
+	}
+	if err == nil {
+		return config.SaveProfile(cc.Name, cc)
+	}
+
+	out.WarningT("Deleting the nodes added to node pool {{.pool}}, as not all of them could be", out.V{"pool": p.Name})
+	if derr := deletePoolNodes(cc.Name, ns, true); derr != nil {
+		out.WarningT("Unable to delete the nodes: {{.error}}", out.V{"error": derr})
+	}
+	return err
+}
+
+// deletePoolNodes deletes the nodes ns of the cluster cname, the last ones first.
+// With force, the nodes that cannot be removed from Kubernetes, eg: as they failed to join the cluster, have their hosts deleted anyway.
+func deletePoolNodes(cname string, ns []config.Node, force bool) error {
+	for i := len(ns) - 1; i >= 0; i-- {
+		name := ns[i].Name
+		// node.Delete saves the config without the node, so it is loaded again for each one
+		cc, err := config.Load(cname)
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		if _, _, err := node.Retrieve(*cc, name); err != nil {
+			klog.Infof("node %s was deleted already: %v", name, err)
+			continue
+		}
+		out.Step(style.DeletingHost, "Deleting node {{.name}}", out.V{"name": config.MachineName(*cc, ns[i])})
+		if _, err := node.Delete(*cc, name); err != nil {
+			if !force {
+				return errors.Wrapf(err, "delete node %s", name)
+			}
+			klog.Warningf("deleting node %s: %v, deleting its host only", name, err)
+			if cc, err = config.Load(cname); err != nil {
+				return errors.Wrap(err, "load cluster")
+			}
+			if err := node.Remove(cc, name); err != nil {
+				return errors.Wrapf(err, "remove node %s", name)
+			}
+		}
+	}
+	return nil
+}
+
+// removeNodePool removes the node pool name from the config of the cluster cname
+func removeNodePool(cname, name string) error {
+	cc, err := config.Load(cname)
+	if err != nil {
+		return errors.Wrap(err, "load cluster")
+	}
+	var pools []config.NodePool
+	for _, p := range cc.NodePools {
+		if p.Name != name {
+			pools = append(pools, p)
+		}
+	}
+	cc.NodePools = pools
+	return config.SaveProfile(cname, cc)
+}
+
+func init() {
+	nodepoolCreateCmd.Flags().IntVar(&poolSize, "size", 1, "Number of nodes of the pool")
+	nodepoolCreateCmd.Flags().StringVar(&poolOS, "os", "linux", "Operating system of the nodes of the pool. Only linux is supported.")
+	nodepoolCreateCmd.Flags().StringVar(&poolMemory, "memory", "", "Amount of RAM of the nodes of the pool, instead of the cluster's (format: <number>[<unit>], where unit = b, k, m or g), eg: 8g")
+	nodepoolCreateCmd.Flags().IntVar(&poolCPUs, "cpus", 0, "Number of CPUs of the nodes of the pool, instead of the cluster's")
+	nodepoolCreateCmd.Flags().StringVar(&poolDiskSize, "disk-size", "", "Disk size of the nodes of the pool, instead of the cluster's (format: <number>[<unit>], where unit = b, k, m or g). Ignored by the docker and podman drivers, whose nodes use the storage of the host.")
+	nodepoolCreateCmd.Flags().StringToStringVar(&poolLabels, "labels", nil, "Labels of the nodes of the pool, in addition to "+config.NodePoolLabel+"=NAME, eg: accelerator=gpu,tier=batch")
+	nodepoolCreateCmd.Flags().StringSliceVar(&poolTaints, "taints", nil, "Taints of the nodes of the pool, in the syntax of kubectl taint, eg: dedicated=gpu:NoSchedule")
+	nodepoolScaleCmd.Flags().IntVar(&poolNewSize, "size", 0, "Number of nodes of the pool, the last ones are deleted to scale it down")
+
+	for _, c := range []*cobra.Command{nodepoolCreateCmd, nodepoolScaleCmd, nodepoolDeleteCmd} {
+		addLockTimeoutFlag(c)
+		nodepoolCmd.AddCommand(c)
+	}
+	nodepoolCmd.AddCommand(nodepoolListCmd)
+}
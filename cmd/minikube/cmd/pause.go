@@ -95,6 +95,7 @@ func runPause(_ *cobra.Command, _ []string) {
 		ids = append(ids, uids...)
 	}
 
+	invalidatePromptCache(co.Config.Name)
 	register.Reg.SetStep(register.Done)
 	if namespaces == nil {
 		out.Step(style.Unpause, "Paused {{.count}} containers", out.V{"count": len(ids)})
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/plan"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+var (
+	clusterFile      string
+	detailedExitCode bool
+)
+
+// planCmd represents the plan command
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Shows the changes that minikube apply would make to a cluster",
+	Long: `Compares a cluster with its cluster file, and shows the changes that minikube apply would make to it, in a deterministic order.
+The JSON output is a stable interface for infrastructure as code tools. With --detailed-exitcode, the command exits with 8 if there are changes.`,
+	Example: `minikube plan -f cluster.yaml
+minikube plan -f cluster.yaml -o json > plan.json`,
+	Run: func(_ *cobra.Command, _ []string) {
+		validateOutputFormat()
+		_, _, p := computePlan()
+		printPlan(p)
+		if detailedExitCode && p.Action != plan.NoOp {
+			exit.Code(reason.ExPlanChanges)
+		}
+	},
+}
+
+func validateOutputFormat() {
+	if outputFormat != "text" && outputFormat != "json" {
+		exit.Message(reason.Usage, "invalid output format: {{.output}}. Valid values: 'text', 'json'", out.V{"output": outputFormat})
+	}
+}
+
+// computePlan reads the cluster file, and returns the plan that brings its cluster, nil if it does not exist, to its state
+func computePlan() (plan.Spec, *config.ClusterConfig, plan.Plan) {
+	if clusterFile == "" {
+		exit.Message(reason.Usage, "The --file flag is required")
+	}
+	s, err := plan.ReadSpec(clusterFile)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid cluster file: {{.error}}", out.V{"error": err})
+	}
+	cc, err := config.Load(s.Name)
+	if config.IsNotExist(err) {
+		return s, nil, plan.Compute(s, nil)
+	}
+	if err != nil {
+		exit.Error(reason.HostConfigLoad, "Unable to load the cluster", err)
+	}
+	return s, cc, plan.Compute(s, cc)
+}
+
+// printPlan prints p as JSON, or one line per change, like: ~ nodes: 1 -> 3
+func printPlan(p plan.Plan) {
+	if outputFormat == "json" {
+		b, err := json.Marshal(p)
+		if err != nil {
+			exit.Error(reason.InternalJSONMarshal, "json encoding failure", err)
+		}
+		os.Stdout.Write(append(b, '\n'))
+		return
+	}
+	if p.Action == plan.NoOp {
+		out.Ln("%s: no changes", p.Cluster)
+		return
+	}
+	out.Ln("%s: %s", p.Cluster, p.Action)
+	symbols := map[plan.Action]string{plan.Create: "+", plan.Update: "~", plan.Replace: "-/+"}
+	for _, c := range p.Changes {
+		if c.Action == plan.Create {
+			out.Ln("  %s %s: %v", symbols[c.Action], c.Attribute, c.After)
+			continue
+		}
+		out.Ln("  %s %s: %v -> %v", symbols[c.Action], c.Attribute, c.Before, c.After)
+	}
+}
+
+func init() {
+	planCmd.Flags().StringVarP(&clusterFile, "file", "f", "", "The cluster file, eg: cluster.yaml")
+	planCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+	planCmd.Flags().BoolVar(&detailedExitCode, "detailed-exitcode", false, fmt.Sprintf("Exit with %d if there are changes, rather than 0", reason.ExPlanChanges))
+}
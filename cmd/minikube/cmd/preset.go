@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// builtinPresets are the flag bundles available to `minikube start --preset`.
+// Presets of the same name in the defaults file take precedence.
+var builtinPresets = map[string]config.MinikubeConfig{
+	// unattended runs: never prompt, wait for every component and retry once from scratch
+	"ci": {
+		interactive:     false,
+		waitComponents:  "all",
+		deleteOnFailure: true,
+		autoUpdate:      false,
+	},
+	// NVIDIA GPUs passed through to the cluster
+	"gpu": {
+		"driver":         "docker",
+		containerRuntime: "docker",
+		gpus:             "all",
+	},
+}
+
+// ciFlags are the start flags of --ci, for unattended runs that start fast: no prompts, the preloaded images only,
+// waiting for what the workloads of the tests need rather than for every component, and for less time
+var ciFlags = config.MinikubeConfig{
+	interactive:    false,
+	autoUpdate:     false,
+	preload:        true,
+	cacheImages:    false,
+	waitComponents: strings.Join([]string{kverify.APIServerWaitKey, kverify.SystemPodsWaitKey, kverify.DefaultSAWaitKey}, ","),
+	waitTimeout:    "3m",
+	eventLog:       "minikube-events.json",
+}
+
+// applyCI sets every flag of --ci that was not set on the command line, through the environment or by a preset
+func applyCI(flags *pflag.FlagSet) error {
+	return setPresetFlags(flags, "--ci", ciFlags)
+}
+
+// presetNames returns the names of all known presets, sorted
+func presetNames(user map[string]config.MinikubeConfig) []string {
+	var names []string
+	for n := range builtinPresets {
+		names = append(names, n)
+	}
+	for n := range user {
+		if _, ok := builtinPresets[n]; !ok {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyPreset sets every flag of a preset that was not set on the command line or through the environment
+// The preset is looked up in the defaults file first, then in the built-in presets.
+func applyPreset(flags *pflag.FlagSet, name string) error {
+	if name == "" {
+		return nil
+	}
+	d, err := config.ReadDefaults(localpath.DefaultsFile())
+	if err != nil {
+		return err
+	}
+	p, ok := d.Presets[name]
+	if !ok {
+		p, ok = builtinPresets[name]
+	}
+	if !ok {
+		return fmt.Errorf("unknown preset %q, valid presets are: %s", name, strings.Join(presetNames(d.Presets), ", "))
+	}
+	return setPresetFlags(flags, name, p)
+}
+
+// setPresetFlags validates and applies the flags of a preset
+func setPresetFlags(flags *pflag.FlagSet, name string, p config.MinikubeConfig) error {
+	var keys []string
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, k := range keys {
+		f := flags.Lookup(k)
+		if f == nil {
+			errs = append(errs, fmt.Sprintf("preset %q: unknown flag --%s", name, k))
+			continue
+		}
+		if f.Changed {
+			continue
+		}
+		val := presetValue(p[k])
+		klog.Infof("setting --%s=%s from preset %q", k, val, name)
+		if err := flags.Set(k, val); err != nil {
+			errs = append(errs, fmt.Sprintf("preset %q: --%s: %v", name, k, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// presetValue returns the flag value of a preset entry, lists from the defaults file become comma separated values
+func presetValue(v interface{}) string {
+	if l, ok := v.([]interface{}); ok {
+		var s []string
+		for _, e := range l {
+			s = append(s, fmt.Sprint(e))
+		}
+		return strings.Join(s, ",")
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestApplyPreset(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv(localpath.MinikubeHome, home)
+	defaults := `presets:
+  big:
+    cpus: 8
+    addons: [ingress, metrics-server]
+  broken:
+    no-such-flag: true
+`
+	if err := os.MkdirAll(localpath.MiniPath(), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(localpath.MiniPath(), "defaults.yaml"), []byte(defaults), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	newFlags := func() *pflag.FlagSet {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.String("cpus", "2", "")
+		fs.StringSlice("addons", nil, "")
+		fs.Bool(interactive, true, "")
+		fs.StringSlice(waitComponents, nil, "")
+		fs.Bool(deleteOnFailure, false, "")
+		fs.Bool(autoUpdate, true, "")
+		return fs
+	}
+
+	t.Run("user", func(t *testing.T) {
+		fs := newFlags()
+		if err := fs.Parse([]string{"--cpus=4"}); err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+		if err := applyPreset(fs, "big"); err != nil {
+			t.Fatalf("applyPreset() error: %v", err)
+		}
+		if v, _ := fs.GetString("cpus"); v != "4" {
+			t.Errorf("cpus = %q, want the command line value 4", v)
+		}
+		want := []string{"ingress", "metrics-server"}
+		if v, _ := fs.GetStringSlice("addons"); !reflect.DeepEqual(v, want) {
+			t.Errorf("addons = %v, want %v", v, want)
+		}
+	})
+
+	t.Run("builtin", func(t *testing.T) {
+		fs := newFlags()
+		if err := applyPreset(fs, "ci"); err != nil {
+			t.Fatalf("applyPreset() error: %v", err)
+		}
+		if v, _ := fs.GetBool(interactive); v {
+			t.Errorf("interactive = true, want false")
+		}
+		if v, _ := fs.GetStringSlice(waitComponents); !reflect.DeepEqual(v, []string{"all"}) {
+			t.Errorf("wait = %v, want [all]", v)
+		}
+	})
+
+	for _, name := range []string{"broken", "missing"} {
+		if err := applyPreset(newFlags(), name); err == nil {
+			t.Errorf("applyPreset(%q) returned no error", name)
+		}
+	}
+}
+
+func TestCIFlags(t *testing.T) {
+	for k := range ciFlags {
+		if startCmd.Flags().Lookup(k) == nil {
+			t.Errorf("--ci sets the unknown flag --%s", k)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/juju/mutex/v2"
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/mustload"
+)
+
+const lockTimeoutFlag = "lock-timeout"
+
+var lockTimeout time.Duration
+
+// addLockTimeoutFlag adds the --lock-timeout flag to a command that changes a profile
+func addLockTimeoutFlag(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&lockTimeout, lockTimeoutFlag, 10*time.Minute, "How long to wait for other minikube commands changing the same profile to finish. 0 fails immediately.")
+}
+
+// mustLockProfile acquires the lock of a profile, waiting up to --lock-timeout for other minikube processes changing it.
+// The lock is released when the process exits, or by calling Release.
+func mustLockProfile(name string) mutex.Releaser {
+	return mustload.LockProfile(name, lockTimeout)
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"github.com/docker/machine/libmachine/state"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+const defaultPromptFormat = `{{.Profile}}:{{.Status}}`
+
+var (
+	promptFormat   string
+	promptCacheTTL time.Duration
+)
+
+// PromptState holds the compact cluster state rendered by `minikube prompt`
+type PromptState struct {
+	Profile           string
+	Status            string
+	Nodes             int
+	KubernetesVersion string
+	Updated           time.Time
+}
+
+// promptCmd represents the prompt command
+var promptCmd = &cobra.Command{
+	Use:   "prompt",
+	Short: "Prints the active profile and a compact status for shell prompts",
+	Long: `Prints the active profile and a compact status string suitable for embedding in PS1 or starship prompt segments.
+
+The status is cached per profile so that calling this command on every prompt stays fast. Nothing is printed if the profile does not exist.`,
+	Example: `  PS1='$(minikube prompt 2>/dev/null) \$ '`,
+	Run: func(_ *cobra.Command, _ []string) {
+		tmpl, err := template.New("prompt").Parse(promptFormat)
+		if err != nil {
+			exit.Error(reason.Usage, "invalid --format template", err)
+		}
+
+		ps, err := promptStatus(ClusterFlagValue(), promptCacheTTL)
+		if err != nil {
+			klog.Warningf("prompt status: %v", err)
+		}
+		if ps == nil {
+			return
+		}
+
+		if err := tmpl.Execute(os.Stdout, ps); err != nil {
+			exit.Error(reason.InternalStatusText, "prompt text failure", err)
+		}
+	},
+}
+
+// promptStatus returns the prompt state for a profile, served from cache when it is younger than ttl.
+// A nil state with a nil error means the profile does not exist.
+func promptStatus(profile string, ttl time.Duration) (*PromptState, error) {
+	if ps, err := readPromptCache(profile); err == nil && time.Since(ps.Updated) < ttl {
+		return ps, nil
+	}
+
+	cc, err := config.Load(profile)
+	if err != nil {
+		if config.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "load")
+	}
+
+	ps := &PromptState{
+		Profile:           profile,
+		Status:            Nonexistent,
+		Nodes:             len(cc.Nodes),
+		KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+		Updated:           time.Now(),
+	}
+
+	cp, err := config.ControlPlane(*cc)
+	if err != nil {
+		return ps, errors.Wrap(err, "control plane")
+	}
+
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return ps, errors.Wrap(err, "api client")
+	}
+	defer api.Close()
+
+	hs, err := machine.Status(api, config.MachineName(*cc, cp))
+	if err != nil {
+		return ps, errors.Wrap(err, "host")
+	}
+	if hs != state.None.String() {
+		ps.Status = hs
+	}
+
+	if err := writePromptCache(ps); err != nil {
+		klog.Warningf("unable to write prompt cache: %v", err)
+	}
+	return ps, nil
+}
+
+// updatePromptCache refreshes the prompt cache from a freshly computed cluster status
+func updatePromptCache(cc *config.ClusterConfig, statuses []*Status) {
+	if len(statuses) == 0 {
+		return
+	}
+	st := statuses[0].Host
+	if st == codeNames[InsufficientStorage] {
+		st = state.Running.String()
+	}
+	ps := &PromptState{
+		Profile:           cc.Name,
+		Status:            st,
+		Nodes:             len(cc.Nodes),
+		KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+		Updated:           time.Now(),
+	}
+	if err := writePromptCache(ps); err != nil {
+		klog.Warningf("unable to write prompt cache: %v", err)
+	}
+}
+
+// invalidatePromptCache removes the prompt cache of a profile whose state changed, so that the next prompt queries it
+func invalidatePromptCache(profile string) {
+	if err := os.Remove(localpath.PromptCache(profile)); err != nil && !os.IsNotExist(err) {
+		klog.Warningf("unable to remove prompt cache: %v", err)
+	}
+}
+
+func readPromptCache(profile string) (*PromptState, error) {
+	f, err := os.Open(localpath.PromptCache(profile))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decodePromptState(f)
+}
+
+func decodePromptState(r io.Reader) (*PromptState, error) {
+	ps := &PromptState{}
+	if err := json.NewDecoder(r).Decode(ps); err != nil {
+		return nil, errors.Wrap(err, "decode")
+	}
+	return ps, nil
+}
+
+func writePromptCache(ps *PromptState) error {
+	path := localpath.PromptCache(ps.Profile)
+	if err := os.MkdirAll(filepath.Dir(path), localpath.Perm(0755)); err != nil {
+		return err
+	}
+	b, err := json.Marshal(ps)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, localpath.Perm(0644))
+}
+
+func init() {
+	promptCmd.Flags().StringVarP(&promptFormat, "format", "f", defaultPromptFormat,
+		`Go template format string for the prompt output. Available fields: .Profile, .Status, .Nodes, .KubernetesVersion`)
+	promptCmd.Flags().DurationVar(&promptCacheTTL, "cache-ttl", 30*time.Second, "How long a cached status is reused before the host state is queried again.")
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestPromptStatus(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+
+	t.Run("missing", func(t *testing.T) {
+		ps, err := promptStatus("missing", time.Minute)
+		if err != nil {
+			t.Fatalf("promptStatus() error: %v", err)
+		}
+		if ps != nil {
+			t.Errorf("promptStatus() = %+v, want nil", ps)
+		}
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		want := &PromptState{Profile: "cached", Status: "Running", Nodes: 2, Updated: time.Now()}
+		if err := writePromptCache(want); err != nil {
+			t.Fatalf("writePromptCache() error: %v", err)
+		}
+		got, err := promptStatus("cached", time.Minute)
+		if err != nil {
+			t.Fatalf("promptStatus() error: %v", err)
+		}
+		if got == nil || got.Status != want.Status || got.Nodes != want.Nodes {
+			t.Errorf("promptStatus() = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		stale := &PromptState{Profile: "expired", Status: "Running", Updated: time.Now().Add(-time.Hour)}
+		if err := writePromptCache(stale); err != nil {
+			t.Fatalf("writePromptCache() error: %v", err)
+		}
+		// the cache is stale and no cluster config exists, so nothing should be reported
+		got, err := promptStatus("expired", time.Minute)
+		if err != nil {
+			t.Fatalf("promptStatus() error: %v", err)
+		}
+		if got != nil {
+			t.Errorf("promptStatus() = %+v, want nil", got)
+		}
+	})
+	t.Run("invalidated", func(t *testing.T) {
+		if err := writePromptCache(&PromptState{Profile: "stopped", Status: "Running", Updated: time.Now()}); err != nil {
+			t.Fatalf("writePromptCache() error: %v", err)
+		}
+		invalidatePromptCache("stopped")
+		// the profile does not exist, so only a cache would report it
+		got, err := promptStatus("stopped", time.Minute)
+		if err != nil {
+			t.Fatalf("promptStatus() error: %v", err)
+		}
+		if got != nil {
+			t.Errorf("promptStatus() = %+v, want nil", got)
+		}
+		// nothing to remove
+		invalidatePromptCache("stopped")
+	})
+}
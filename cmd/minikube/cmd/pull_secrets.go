@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// pullSecretsCmd is the process started by 'minikube start --pull-secrets-provider'
+var pullSecretsCmd = &cobra.Command{
+	Use:    "pull-secrets",
+	Short:  "Refreshes the imagePullSecrets of a cluster with short-lived registry tokens",
+	Long:   "Mints short-lived tokens of the --pull-secrets-registry from the cloud credentials of the host, and writes them to the imagePullSecrets of the --pull-secrets-namespaces. Started by 'minikube start --pull-secrets-provider', it exits once the cluster is stopped or deleted.",
+	Hidden: true,
+	Run: func(_ *cobra.Command, _ []string) {
+		if err := node.RefreshPullSecrets(ClusterFlagValue()); err != nil {
+			exit.Error(reason.GuestPullSecrets, "Failed to refresh the pull secrets", err)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(pullSecretsCmd)
+}
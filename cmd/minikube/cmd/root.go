@@ -32,6 +32,7 @@ import (
 	configCmd "k8s.io/minikube/cmd/minikube/cmd/config"
 	"k8s.io/minikube/pkg/drivers/kic/oci"
 	"k8s.io/minikube/pkg/minikube/audit"
+	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/detect"
@@ -63,12 +64,31 @@ var RootCmd = &cobra.Command{
 	Use:   "minikube",
 	Short: "minikube quickly sets up a local Kubernetes cluster",
 	Long:  `minikube provisions and manages local Kubernetes clusters optimized for development workflows.`,
-	PersistentPreRun: func(_ *cobra.Command, _ []string) {
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
 		for _, path := range dirs {
 			if err := os.MkdirAll(path, 0777); err != nil {
 				exit.Error(reason.HostHomeMkdir, "Error creating minikube directory", err)
 			}
 		}
+		if bindsFlagEnv(cmd) {
+			if err := applyFlagEnv(cmd.Flags()); err != nil {
+				exit.Message(reason.Usage, "Invalid environment variable: {{.error}}", out.V{"error": err})
+			}
+		}
+		if cmd == startCmd {
+			if err := applyPreset(cmd.Flags(), viper.GetString(presetFlag)); err != nil {
+				exit.Message(reason.Usage, "Invalid preset: {{.error}}", out.V{"error": err})
+			}
+			if viper.GetBool(config.CI) {
+				if err := applyCI(cmd.Flags()); err != nil {
+					exit.Message(reason.Usage, "Invalid --ci flags: {{.error}}", out.V{"error": err})
+				}
+			}
+		}
+		if viper.GetBool(config.CI) {
+			out.SetPlain(true)
+			viper.Set(config.WantUpdateNotification, false)
+		}
 		userName := viper.GetString(config.UserFlag)
 		if !validateUsername(userName) {
 			out.WarningT("User name '{{.username}}' is not valid", out.V{"username": userName})
@@ -90,12 +110,19 @@ var RootCmd = &cobra.Command{
 		if err := audit.LogCommandEnd(auditID); err != nil {
 			klog.Warningf("failed to log command end to audit: %v", err)
 		}
+		command.FlushTimings()
 	},
 }
 
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
+	// the timings of the commands are written once, as minikube exits
+	exit.SetExitFunc(func(code int) {
+		command.FlushTimings()
+		os.Exit(code)
+	})
+
 	// Check whether this is a windows binary (.exe) running inisde WSL.
 	if runtime.GOOS == "windows" && detect.IsMicrosoftWSL() {
 		var found = false
@@ -140,6 +167,10 @@ func Execute() {
 		}
 	}
 
+	if callingCmd == kubectlPluginName {
+		os.Args = append([]string{os.Args[0]}, setupKubectlPlugin(os.Args[1:])...)
+	}
+
 	applyToAllCommands(RootCmd, func(c *cobra.Command) {
 		c.Short = translate.T(c.Short)
 		c.Long = translate.T(c.Long)
@@ -225,6 +256,7 @@ func init() {
 	RootCmd.PersistentFlags().String(config.UserFlag, "", "Specifies the user executing the operation. Useful for auditing operations executed by 3rd party tools. Defaults to the operating system username.")
 	RootCmd.PersistentFlags().Bool(config.SkipAuditFlag, false, "Skip recording the current command in the audit logs.")
 	RootCmd.PersistentFlags().Bool(config.Rootless, false, "Force to use rootless driver (docker and podman driver only)")
+	RootCmd.PersistentFlags().Bool(config.CI, false, "Optimize for CI pipelines: plain output without emojis, spinners or progress bars, and no update checks. 'minikube start' also never prompts, uses the preloaded images only, waits for fewer components for less time, and writes its JSON events to minikube-events.json.")
 
 	translate.DetermineLocale()
 
@@ -257,6 +289,12 @@ func init() {
 				configCmd.ConfigCmd,
 				configCmd.ProfileCmd,
 				updateContextCmd,
+				kubeconfigCmd,
+				certsCmd,
+				etcdCmd,
+				upgradeCmd,
+				promptCmd,
+				rootlessCmd,
 			},
 		},
 		{
@@ -273,7 +311,14 @@ func init() {
 				sshCmd,
 				kubectlCmd,
 				nodeCmd,
+				nodepoolCmd,
 				cpCmd,
+				daemonCmd,
+				devcontainerCmd,
+				capiCmd,
+				planCmd,
+				applyCmd,
+				uiCmd,
 			},
 		},
 		{
@@ -283,6 +328,7 @@ func init() {
 				sshHostCmd,
 				ipCmd,
 				logsCmd,
+				doctorCmd,
 				updateCheckCmd,
 				versionCmd,
 				optionsCmd,
@@ -315,6 +361,25 @@ func initConfig() {
 		}
 	}
 	setupViper()
+	applyDefaultsFile()
+}
+
+// applyDefaultsFile registers the settings of the global defaults file as viper defaults,
+// so that flags, environment variables and `minikube config set` keys all take precedence over them.
+// Start only reads unchanged flags for new profiles, so existing profiles are unaffected.
+func applyDefaultsFile() {
+	path := localpath.DefaultsFile()
+	d, err := config.ReadDefaults(path)
+	if err != nil {
+		out.WarningT("Unable to read {{.path}}: {{.error}}", out.V{"path": path, "error": err})
+		return
+	}
+	for k, v := range d.ForProfile(viper.GetString(config.ProfileName)) {
+		if startCmd.Flags().Lookup(k) == nil && RootCmd.PersistentFlags().Lookup(k) == nil {
+			klog.Warningf("%s: %q is not a start flag, it may be ignored", path, k)
+		}
+		viper.SetDefault(k, v)
+	}
 }
 
 func setupViper() {
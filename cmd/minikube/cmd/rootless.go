@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/docker/machine/libmachine/mcnerror"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"k8s.io/klog/v2"
+
+	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/delete"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/rootless"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+var (
+	rootlessDryRun bool
+	rootlessForce  bool
+)
+
+// rootlessCmd represents the rootless command
+var rootlessCmd = &cobra.Command{
+	Use:   "rootless",
+	Short: "Prepares the host and profiles for the rootless docker and podman drivers",
+	Long:  "Prepares the host and profiles for the rootless docker and podman drivers, see https://minikube.sigs.k8s.io/docs/drivers/docker/",
+	Run: func(cmd *cobra.Command, _ []string) {
+		_ = cmd.Help()
+	},
+}
+
+var rootlessSetupCmd = &cobra.Command{
+	Use:   "setup",
+	Short: "Checks and configures the host prerequisites of the rootless drivers",
+	Long: `Checks the host prerequisites of the rootless docker and podman drivers: subordinate uid and gid ranges, user namespaces,
+cgroup v2 delegation, user mode networking and the kernel version.
+Prerequisites that can be met automatically are configured with sudo, the others are reported with advice.`,
+	Run: func(_ *cobra.Command, _ []string) {
+		if runtime.GOOS != "linux" {
+			exit.Message(reason.Usage, "Rootless drivers are only supported on Linux")
+		}
+		h, err := rootless.NewHost()
+		if err != nil {
+			exit.Error(reason.HostCurrentUser, "Unable to get current user", err)
+		}
+
+		manual := 0
+		for _, c := range h.Checks() {
+			if c.Err == nil {
+				out.Styled(style.Check, "{{.name}}: OK", out.V{"name": c.Name})
+				continue
+			}
+			out.Styled(style.Failure, "{{.name}}: {{.error}}", out.V{"name": c.Name, "error": c.Err})
+			for _, fix := range c.Fix {
+				if rootlessDryRun {
+					out.Styled(style.Command, "sudo sh -c {{.fix}}", out.V{"fix": shellQuote(fix)})
+					continue
+				}
+				if err := runAsRoot(fix); err != nil {
+					exit.Error(reason.HostRootlessSetup, "Failed to configure "+c.Name, err)
+				}
+			}
+			if c.Advice != "" {
+				out.Styled(style.Tip, c.Advice)
+			}
+			if len(c.Fix) == 0 {
+				manual++
+			}
+		}
+		if manual > 0 {
+			exit.Message(reason.HostRootlessSetup, "{{.count}} prerequisite(s) of the rootless drivers must be met manually", out.V{"count": manual})
+		}
+	},
+}
+
+// runAsRoot runs a shell command with sudo, which may prompt for a password
+func runAsRoot(command string) error {
+	out.Styled(style.Command, "sudo sh -c {{.fix}}", out.V{"fix": shellQuote(command)})
+	c := exec.Command("sudo", "sh", "-c", command)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	return c.Run()
+}
+
+// shellQuote quotes s for a POSIX shell
+func shellQuote(s string) string {
+	q := "'"
+	for _, r := range s {
+		if r == '\'' {
+			q += `'\''`
+			continue
+		}
+		q += string(r)
+	}
+	return q + "'"
+}
+
+var rootlessMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Converts a profile of a rootful docker or podman driver for a rootless one",
+	Long: `Converts a profile of a rootful docker or podman driver for a rootless one: its container runtime is switched from docker to containerd,
+the KubeletInUserNamespace feature gate is enabled and its machines are deleted, so that the next start creates them with the rootless driver.
+The workloads of the cluster are lost, so the migration must be confirmed, or --force given when not run in a terminal.
+Profiles of other drivers can not be migrated.`,
+	Run: func(_ *cobra.Command, _ []string) {
+		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+		api, cc := mustload.Partial(cname)
+		defer api.Close()
+
+		if !driver.IsKIC(cc.Driver) {
+			exit.Message(reason.Usage, `Profile "{{.name}}" uses the {{.driver}} driver, only docker and podman driver profiles can be migrated`, out.V{"name": cname, "driver": cc.Driver})
+		}
+		si, err := oci.CachedDaemonInfo(cc.Driver)
+		if err != nil {
+			exit.Message(reason.Usage, "Ensure your {{.driver_name}} is running and is healthy.", out.V{"driver_name": driver.FullName(cc.Driver)})
+		}
+		// the machines belong to the rootful daemon, which must still be the current one to delete them
+		if si.Rootless {
+			exit.Message(reason.Usage, `The current {{.driver_name}} is already rootless. Switch back to the rootful one running "{{.name}}" first, eg: 'docker context use default'`, out.V{"driver_name": driver.FullName(cc.Driver), "name": cname})
+		}
+
+		var machines []string
+		for _, n := range cc.Nodes {
+			machines = append(machines, config.MachineName(*cc, n))
+		}
+		if rootlessDryRun {
+			if cc.KubernetesConfig.ContainerRuntime == constants.Docker {
+				out.Styled(style.Notice, "The container runtime would be switched from docker to containerd")
+			}
+			out.Styled(style.Notice, "The KubeletInUserNamespace feature gate would be enabled")
+			out.Styled(style.Notice, `The machines of "{{.name}}" would be deleted: {{.machines}}`, out.V{"name": cname, "machines": strings.Join(machines, ", ")})
+			return
+		}
+		if !rootlessForce {
+			if !term.IsTerminal(int(os.Stdin.Fd())) {
+				exit.Message(reason.Usage, `Migrating "{{.name}}" deletes its machines and workloads, pass --force to confirm`, out.V{"name": cname})
+			}
+			if !cmdcfg.AskForYesNoConfirmation(fmt.Sprintf("Migrating %q deletes its machines and workloads, continue?", cname), []string{"yes", "y"}, []string{"no", "n"}) {
+				out.Styled(style.Notice, `Profile "{{.name}}" was not migrated`, out.V{"name": cname})
+				return
+			}
+		}
+
+		migrateToRootless(cc)
+		out.WarningT(`Deleting the machines of "{{.name}}", its workloads will be lost`, out.V{"name": cname})
+		for _, m := range machines {
+			delete.PossibleLeftOvers(context.Background(), m, cc.Driver)
+			if err := machine.DeleteHost(api, m); err != nil {
+				var notExist mcnerror.ErrHostDoesNotExist
+				if !errors.As(err, &notExist) {
+					exit.Error(reason.HostRootlessMigrate, "Failed to delete machine", err)
+				}
+				klog.Infof("%s does not exist: %v", m, err)
+			}
+		}
+		if err := config.SaveProfile(cname, cc); err != nil {
+			exit.Error(reason.HostSaveProfile, "failed to save config", err)
+		}
+
+		out.Styled(style.Check, `Profile "{{.name}}" is ready for the rootless {{.driver_name}} driver`, out.V{"name": cname, "driver_name": driver.FullName(cc.Driver)})
+		if driver.IsDocker(cc.Driver) {
+			out.Styled(style.Tip, `Switch to the rootless Docker and start the cluster: "docker context use rootless && minikube start -p {{.name}}"`, out.V{"name": cname})
+		} else {
+			out.Styled(style.Tip, `Require the rootless Podman and start the cluster: "minikube config set rootless true && minikube start -p {{.name}}"`, out.V{"name": cname})
+		}
+	},
+}
+
+// migrateToRootless changes the settings of cc that the rootless drivers do not support
+func migrateToRootless(cc *config.ClusterConfig) {
+	if cc.KubernetesConfig.ContainerRuntime == constants.Docker {
+		out.Styled(style.Notice, "Switching the container runtime from docker to containerd, as recommended for rootless drivers")
+		cc.KubernetesConfig.ContainerRuntime = constants.Containerd
+		cc.KubernetesConfig.CRISocket = ""
+		for i := range cc.Nodes {
+			cc.Nodes[i].ContainerRuntime = constants.Containerd
+		}
+	}
+	// see https://kubernetes.io/docs/tasks/administer-cluster/kubelet-in-userns/
+	cc.KubernetesConfig.FeatureGates = addFeatureGate(cc.KubernetesConfig.FeatureGates, "KubeletInUserNamespace=true")
+}
+
+func init() {
+	rootlessSetupCmd.Flags().BoolVar(&rootlessDryRun, "dry-run", false, "Only print the commands that would configure the host")
+	rootlessMigrateCmd.Flags().BoolVar(&rootlessDryRun, "dry-run", false, "Only print the changes that would be made to the profile")
+	rootlessMigrateCmd.Flags().BoolVar(&rootlessForce, "force", false, "Delete the machines of the profile without asking for confirmation")
+	addLockTimeoutFlag(rootlessMigrateCmd)
+	rootlessCmd.AddCommand(rootlessSetupCmd)
+	rootlessCmd.AddCommand(rootlessMigrateCmd)
+}
@@ -26,11 +26,14 @@ import (
 	"os"
 	"os/exec"
 	"os/user"
+	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Delta456/box-cli-maker/v2"
@@ -54,8 +57,11 @@ import (
 	"k8s.io/klog/v2"
 	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
 	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
+	kubevip "k8s.io/minikube/pkg/minikube/cluster/ha/kube-vip"
+	"k8s.io/minikube/pkg/minikube/cni"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/cruntime"
@@ -64,6 +70,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/driver/auxdriver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/ghactions"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/machine"
@@ -98,6 +105,8 @@ var (
 	apiServerNames             []string
 	apiServerIPs               []net.IP
 	hostRe                     = regexp.MustCompile(`^[^-][\w\.-]+$`)
+	// topologyNodes are the nodes of the topology file of --topology, the control-plane ones first
+	topologyNodes []config.Node
 )
 
 func init() {
@@ -105,6 +114,8 @@ func init() {
 	initKubernetesFlags()
 	initDriverFlags()
 	initNetworkingFlags()
+	addLockTimeoutFlag(startCmd)
+	addProvisionRetryFlags(startCmd)
 	if err := viper.BindPFlags(startCmd.Flags()); err != nil {
 		exit.Error(reason.InternalBindFlags, "unable to bind flags", err)
 	}
@@ -153,8 +164,18 @@ func platform() string {
 // runStart handles the executes the flow of "minikube start"
 func runStart(cmd *cobra.Command, _ []string) {
 	register.SetEventLogPath(localpath.EventLog(ClusterFlagValue()))
+	if path := viper.GetString(eventLog); path != "" {
+		register.AddEventLogPath(path)
+	}
 	ctx := context.Background()
 	out.SetJSON(outputFormat == "json")
+	if viper.GetBool(githubOutput) {
+		if !ghactions.Enabled() {
+			exit.Message(reason.Usage, "--github-output requires the {{.output}} and {{.env}} files of a GitHub Actions step", out.V{"output": ghactions.OutputEnv, "env": ghactions.EnvEnv})
+		}
+		out.SetGitHubGroups(true)
+		defer out.EndGroup()
+	}
 	if err := pkgtrace.Initialize(viper.GetString(trace)); err != nil {
 		exit.Message(reason.Usage, "error initializing tracing: {{.Error}}", out.V{"Error": err.Error()})
 	}
@@ -181,11 +202,17 @@ func runStart(cmd *cobra.Command, _ []string) {
 	if len(registryMirror) == 0 {
 		registryMirror = viper.GetStringSlice("registry-mirror")
 	}
+	// docker-env may also be set with `minikube config set docker-env`, as a comma separated list
+	if len(config.DockerEnv) == 0 && viper.IsSet("docker-env") {
+		config.DockerEnv = config.SplitDockerEnv(viper.GetString("docker-env"))
+	}
 
 	if !config.ProfileNameValid(ClusterFlagValue()) {
 		out.WarningT("Profile name '{{.name}}' is not valid", out.V{"name": ClusterFlagValue()})
 		exit.Message(reason.Usage, "Only alphanumeric and dashes '-' are permitted. Minimum 2 characters, starting with alphanumeric.")
 	}
+	defer mustLockProfile(ClusterFlagValue()).Release()
+
 	existing, err := config.Load(ClusterFlagValue())
 	if err != nil && !config.IsNotExist(err) {
 		kind := reason.HostConfigLoad
@@ -195,12 +222,19 @@ func runStart(cmd *cobra.Command, _ []string) {
 		exit.Message(kind, "Unable to load config: {{.error}}", out.V{"error": err})
 	}
 
+	if viper.GetBool(resume) {
+		validateResume(existing)
+	}
 	if existing != nil {
 		upgradeExistingConfig(cmd, existing)
 	} else {
 		validateProfileName()
 	}
 
+	if path := viper.GetString(topology); path != "" {
+		useTopology(cmd, path, existing)
+	}
+
 	validateSpecifiedDriver(existing)
 	validateKubernetesVersion(existing)
 	validateContainerRuntime(existing)
@@ -281,15 +315,23 @@ func runStart(cmd *cobra.Command, _ []string) {
 		}
 	}
 
-	kubeconfig, err := startWithDriver(cmd, starter, existing)
+	kcs, err := startWithDriver(cmd, starter, existing)
+	invalidatePromptCache(starter.Cfg.Name)
 	if err != nil {
 		node.ExitIfFatal(err, useForce)
 		exit.Error(reason.GuestStart, "failed to start node", err)
 	}
 
-	if err := showKubectlInfo(kubeconfig, starter.Node.KubernetesVersion, starter.Node.ContainerRuntime, starter.Cfg.Name); err != nil {
+	if err := showKubectlInfo(kcs, starter.Node.KubernetesVersion, starter.Node.ContainerRuntime, starter.Cfg.Name); err != nil {
 		klog.Errorf("kubectl info: %v", err)
 	}
+	if starter.Cfg.KubeconfigMode == kubeconfig.ModeSeparate {
+		out.Styled(style.Tip, "This cluster has a kubeconfig of its own, to use it run: export KUBECONFIG={{.path}}", out.V{"path": localpath.Kubeconfig(starter.Cfg.Name)})
+	}
+	if viper.GetBool(githubOutput) {
+		out.EndGroup()
+		writeGitHubOutput(starter.Cfg.Name, kcs)
+	}
 }
 
 func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *config.ClusterConfig) (node.Starter, error) {
@@ -345,6 +387,21 @@ func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *
 		}
 	}
 
+	if cc.KubernetesConfig.DualStack {
+		validateDualStack(cc)
+	}
+
+	if config.IsExternalEtcd(cc) || cc.ExternalEtcd.CAFile != "" || cc.ExternalEtcd.CertFile != "" || cc.ExternalEtcd.KeyFile != "" {
+		validateExternalEtcd(cc)
+	}
+
+	k := cc.KubernetesConfig
+	if k.HAVIP != "" || k.HALBMode != "" || len(k.HABGPPeers) > 0 {
+		if err := validateHALoadBalancer(cc); err != nil {
+			exit.Message(reason.Usage, "Invalid HA load balancer: {{.error}}", out.V{"error": err})
+		}
+	}
+
 	if driver.IsVM(cc.Driver) && runtime.GOARCH == "arm64" && cc.KubernetesConfig.ContainerRuntime == "crio" {
 		exit.Message(reason.Unimplemented, "arm64 VM drivers do not currently support the crio container runtime. See https://github.com/kubernetes/minikube/issues/14146 for details.")
 	}
@@ -352,6 +409,7 @@ func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *
 	// This is about as far as we can go without overwriting config files
 	if viper.GetBool(dryRun) {
 		out.Step(style.DryRun, `dry-run validation complete!`)
+		printDryRunPlan(dryRunPlan(cc, n, existing))
 		os.Exit(0)
 	}
 
@@ -377,7 +435,17 @@ func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *
 		ssh.SetDefaultClient(ssh.External)
 	}
 
+	if existing != nil {
+		node.PlanUpgradeDelta(&cc, existing.KubernetesConfig.KubernetesVersion)
+	}
+
 	mRunner, preExists, mAPI, host, err := node.Provision(&cc, &n, viper.GetBool(deleteOnFailure))
+	// before the error is handled, so that a cluster that failed to start does not outlive its TTL either
+	if !cc.TTL.Expires.IsZero() && config.ProfileExists(cc.Name) {
+		if err := node.StartTTL(&cc, os.Args[0]); err != nil {
+			out.WarningT("Unable to delete the cluster once its TTL expires: {{.error}}", out.V{"error": err})
+		}
+	}
 	if err != nil {
 		return node.Starter{}, err
 	}
@@ -463,38 +531,13 @@ func startWithDriver(cmd *cobra.Command, starter node.Starter, existing *config.
 		}
 	}
 
-	// target total and number of control-plane nodes
-	numCPNodes := 1
-	numNodes := viper.GetInt(nodes)
-	if existing != nil {
-		numCPNodes = 0
-		for _, n := range existing.Nodes {
-			if n.ControlPlane {
-				numCPNodes++
-			}
-		}
-		numNodes = len(existing.Nodes)
-	} else if viper.GetBool(ha) {
-		numCPNodes = 3
-	}
-
 	// apart from starter, add any additional existing or new nodes
-	for i := 1; i < numNodes; i++ {
-		var n config.Node
-		if existing != nil {
-			n = existing.Nodes[i]
-		} else {
-			nodeName := node.Name(i + 1)
-			n = config.Node{
-				Name:              nodeName,
-				Port:              starter.Cfg.APIServerPort,
-				KubernetesVersion: starter.Cfg.KubernetesConfig.KubernetesVersion,
-				ContainerRuntime:  starter.Cfg.KubernetesConfig.ContainerRuntime,
-				Worker:            true,
-			}
-			if i < numCPNodes { // starter node is also counted as (primary) cp node
-				n.ControlPlane = true
-			}
+	var workers []config.Node
+	for _, n := range secondaryNodes(starter.Cfg, existing) {
+		// the control-plane nodes join one after the other, as etcd members do
+		if viper.GetBool(parallelNodes) && !n.ControlPlane {
+			workers = append(workers, n)
+			continue
 		}
 
 		out.Ln("") // extra newline for clarity on the command line
@@ -502,9 +545,40 @@ func startWithDriver(cmd *cobra.Command, starter node.Starter, existing *config.
 			return nil, errors.Wrap(err, "adding node")
 		}
 	}
+	if len(workers) > 0 {
+		if err := addNodesInParallel(starter.Cfg, workers, viper.GetBool(deleteOnFailure)); err != nil {
+			return nil, errors.Wrap(err, "adding nodes")
+		}
+	}
 
 	pause.RemovePausedFile(starter.Runner)
 
+	if viper.GetBool(backgroundImages) && starter.Cfg.KubernetesConfig.KubernetesVersion != constants.NoKubernetesVersion {
+		if err := node.StartBackgroundImages(starter.Cfg); err != nil {
+			out.WarningT("Unable to load images in the background: {{.error}}", out.V{"error": err})
+		}
+	}
+
+	// Hyper-V balloons the memory of the guests by itself, see the hyperv driver
+	if starter.Cfg.MemoryAutoShrink > 0 && driver.IsKVM(starter.Cfg.Driver) {
+		if err := node.StartMemoryShrink(starter.Cfg); err != nil {
+			out.WarningT("Unable to shrink memory when idle: {{.error}}", out.V{"error": err})
+		}
+	}
+
+	if starter.Cfg.PullSecrets.Provider != "" {
+		if err := node.StartPullSecrets(starter.Cfg); err != nil {
+			out.WarningT("Unable to refresh the pull secrets: {{.error}}", out.V{"error": err})
+		}
+	}
+
+	// also run without the setting, to delete the warm nodes left from when it was set
+	if viper.GetInt(config.WarmNodes) > 0 || len(starter.Cfg.WarmNodes) > 0 {
+		if err := node.StartWarmNodes(starter.Cfg); err != nil {
+			out.WarningT("Unable to warm nodes: {{.error}}", out.V{"error": err})
+		}
+	}
+
 	return kubeconfig, nil
 }
 
@@ -546,6 +620,34 @@ func displayEnviron(env []string) {
 	}
 }
 
+// addNodesInParallel adds the worker nodes ns concurrently. Their steps are silenced, as they would interleave,
+// and their aggregated progress is shown instead.
+func addNodesInParallel(cc *config.ClusterConfig, ns []config.Node, delOnFail bool) error {
+	var names []string
+	for _, n := range ns {
+		names = append(names, config.MachineName(*cc, n))
+	}
+	out.Ln("")
+	out.Step(style.ThumbsUp, "Starting {{.count}} worker nodes in parallel: {{.nodes}}", out.V{"count": len(ns), "nodes": strings.Join(names, ", ")})
+
+	var mu sync.Mutex
+	ready := 0
+	out.SetSilent(true)
+	err := node.AddParallel(cc, ns, delOnFail, func(n config.Node, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		name := config.MachineName(*cc, n)
+		if err != nil {
+			out.Progress(style.Failure, "Node {{.node}} failed to start: {{.error}}", out.V{"node": name, "error": err})
+			return
+		}
+		ready++
+		out.Progress(style.Ready, "Node {{.node}} is ready ({{.ready}}/{{.total}})", out.V{"node": name, "ready": ready, "total": len(ns)})
+	})
+	out.SetSilent(false)
+	return err
+}
+
 func showKubectlInfo(kcs *kubeconfig.Settings, k8sVersion, rtime, machineName string) error {
 	if k8sVersion == constants.NoKubernetesVersion {
 		register.Reg.SetStep(register.Done)
@@ -1276,6 +1378,10 @@ func validateFlags(cmd *cobra.Command, drvName string) { //nolint:gocyclo
 		validateListenAddress(viper.GetString(listenAddress))
 	}
 
+	if mode := viper.GetString(kubeconfigMode); mode != kubeconfig.ModeShared && mode != kubeconfig.ModeSeparate {
+		exit.Message(reason.Usage, "Invalid --kubeconfig-mode {{.mode}}, must be {{.shared}} or {{.separate}}", out.V{"mode": mode, "shared": kubeconfig.ModeShared, "separate": kubeconfig.ModeSeparate})
+	}
+
 	if cmd.Flags().Changed(imageRepository) {
 		viper.Set(imageRepository, validateImageRepository(viper.GetString(imageRepository)))
 	}
@@ -1348,6 +1454,12 @@ func validateFlags(cmd *cobra.Command, drvName string) { //nolint:gocyclo
 		)
 	}
 
+	for _, o := range config.ExtraOptions {
+		if o.Node != "" && o.Component != bsutil.Kubelet {
+			exit.Message(reason.Usage, "Only kubelet options can be set for a single node, not {{.option}}", out.V{"option": o.String()})
+		}
+	}
+
 	// check that kubeadm extra args contain only allowed parameters
 	for param := range config.ExtraOptions.AsMap().Get(bsutil.Kubeadm) {
 		if !config.ContainsParam(bsutil.KubeadmExtraArgsAllowed[bsutil.KubeadmCmdParam], param) &&
@@ -1362,7 +1474,20 @@ func validateFlags(cmd *cobra.Command, drvName string) { //nolint:gocyclo
 
 	validateBareMetal(drvName)
 	validateRegistryMirror()
+	validateSharedImageCache(drvName)
+	validateMemoryAutoShrink(drvName)
+	validateCertsDir()
+	validateOIDC()
+	validateEncryptSecrets()
+	validatePodSecurityLevel()
+	validateSecurityProfiles()
+	validateAuditPolicy()
+	validatePullSecrets()
+	validateRegistryAuth()
+	validateTTL()
 	validateInsecureRegistry()
+	validateKubeProxyMode()
+	validateProvisionRetryFlags()
 }
 
 // validatePorts validates that the --ports are not below 1024 for the host and not outside range
@@ -1592,6 +1717,288 @@ func validateRegistryMirror() {
 	}
 }
 
+// validateSharedImageCache validates that --shared-image-cache can be used with the driver and the other flags
+func validateSharedImageCache(drvName string) {
+	if !viper.GetBool(sharedImageCache) {
+		return
+	}
+	if !driver.IsKIC(drvName) {
+		exit.Message(reason.Usage, "The --shared-image-cache flag is only supported by the docker and podman drivers")
+	}
+	// dockerd refuses to start with registry mirrors set both by flags and by its daemon.json
+	if len(registryMirror) > 0 && viper.GetString(containerRuntime) == constants.Docker {
+		exit.Message(reason.Usage, "The --shared-image-cache flag can not be used with --registry-mirror and the docker container runtime")
+	}
+}
+
+// validateMemoryAutoShrink validates that --memory-auto-shrink can be used with the driver
+func validateMemoryAutoShrink(drvName string) {
+	d := viper.GetDuration(memoryAutoShrink)
+	if d == 0 {
+		return
+	}
+	if d < 0 {
+		exit.Message(reason.Usage, "The --memory-auto-shrink flag must be a positive duration")
+	}
+	if !driver.IsKVM(drvName) && !driver.IsHyperV(drvName) {
+		exit.Message(reason.Usage, "The --memory-auto-shrink flag is only supported by the kvm2 and hyperv drivers")
+	}
+}
+
+// validateTTL validates that --ttl is a positive duration
+func validateTTL() {
+	if viper.GetDuration(clusterTTL) < 0 {
+		exit.Message(reason.Usage, "The --ttl flag must be a positive duration")
+	}
+}
+
+// validateCertsDir validates that --certs-dir holds a CA, and an apiserver cert with its key if any
+func validateCertsDir() {
+	dir := viper.GetString(certsDir)
+	if dir == "" {
+		return
+	}
+	for _, f := range []string{"ca.crt", "ca.key"} {
+		if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+			exit.Message(reason.Usage, "The --certs-dir directory must contain {{.file}}: {{.error}}", out.V{"file": f, "error": err})
+		}
+	}
+	_, crtErr := os.Stat(filepath.Join(dir, "apiserver.crt"))
+	_, keyErr := os.Stat(filepath.Join(dir, "apiserver.key"))
+	if (crtErr == nil) != (keyErr == nil) {
+		exit.Message(reason.Usage, "The --certs-dir directory must contain both apiserver.crt and apiserver.key, or neither")
+	}
+}
+
+// validateOIDC validates that --oidc-issuer-url is an HTTPS URL, or the dex addon
+func validateOIDC() {
+	issuer := viper.GetString(oidcIssuerURL)
+	if issuer == "" {
+		return
+	}
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --oidc-issuer-url cannot be used with --no-kubernetes")
+	}
+	if viper.GetString(oidcClientID) == "" {
+		exit.Message(reason.Usage, "The --oidc-client-id is required with --oidc-issuer-url")
+	}
+	if issuer == constants.DexIssuer {
+		return
+	}
+	u, err := url.Parse(issuer)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		exit.Message(reason.Usage, "The --oidc-issuer-url must be an HTTPS URL, or 'dex': {{.url}}", out.V{"url": issuer})
+	}
+}
+
+// validateEncryptSecrets validates the provider of --encrypt-secrets
+func validateEncryptSecrets() {
+	provider := viper.GetString(encryptSecrets)
+	if provider == "" {
+		return
+	}
+	if provider != "aescbc" && provider != "kms" {
+		exit.Message(reason.Usage, "Invalid --encrypt-secrets {{.provider}}. Options include: [aescbc,kms]", out.V{"provider": provider})
+	}
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --encrypt-secrets flag cannot be used with --no-kubernetes")
+	}
+	if provider == "kms" {
+		out.Styled(style.Notice, "The API server encrypts secrets with a KMS v2 plugin, which must listen on {{.socket}} on each control-plane node", out.V{"socket": bootstrapper.KMSPluginSocket})
+	}
+}
+
+// validatePodSecurityLevel validates the level of --pod-security-level
+func validatePodSecurityLevel() {
+	level := viper.GetString(podSecurityLevel)
+	if level == "" {
+		return
+	}
+	if !slices.Contains(bootstrapper.PodSecurityLevels, level) {
+		exit.Message(reason.Usage, "Invalid --pod-security-level {{.level}}. Options include: [{{.levels}}]", out.V{"level": level, "levels": strings.Join(bootstrapper.PodSecurityLevels, ",")})
+	}
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --pod-security-level flag cannot be used with --no-kubernetes")
+	}
+}
+
+// validateDualStack validates that the driver, the CNI and the Kubernetes version of cc support --dual-stack
+func validateDualStack(cc config.ClusterConfig) {
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --dual-stack flag cannot be used with --no-kubernetes")
+	}
+	if !slices.Contains([]string{driver.Docker, driver.Podman, driver.KVM2}, cc.Driver) {
+		exit.Message(reason.Usage, "The --dual-stack flag is only supported by the docker, podman and kvm2 drivers, not {{.driver}}", out.V{"driver": cc.Driver})
+	}
+	if ip, _, err := net.ParseCIDR(cc.KubernetesConfig.ServiceCIDR); err != nil || ip.To4() == nil {
+		exit.Message(reason.Usage, "The --service-cluster-ip-range of dual-stack clusters must be an IPv4 CIDR, their IPv6 one is {{.cidr}}", out.V{"cidr": constants.DefaultServiceCIDRv6})
+	}
+	if err := cni.ValidateDualStack(cc); err != nil {
+		exit.Message(reason.Usage, "{{.err}}", out.V{"err": err})
+	}
+	// dual-stack is enabled by default since Kubernetes v1.21
+	if v, err := util.ParseKubernetesVersion(cc.KubernetesConfig.KubernetesVersion); err == nil && v.LT(semver.MustParse("1.21.0")) {
+		exit.Message(reason.Usage, "The --dual-stack flag requires Kubernetes v1.21 or later")
+	}
+}
+
+// validateResume exits if there is no existing cluster to resume the start of, and shows the nodes whose provisioning is resumed
+func validateResume(existing *config.ClusterConfig) {
+	if existing == nil {
+		exit.Message(reason.Usage, "There is no cluster {{.name}} to resume the start of, run 'minikube start' to create it", out.V{"name": ClusterFlagValue()})
+	}
+	var incomplete []string
+	for _, n := range existing.Nodes {
+		if !config.Bootstrapped(n, true) {
+			incomplete = append(incomplete, config.MachineName(*existing, n))
+		}
+	}
+	if len(incomplete) == 0 {
+		out.Styled(style.Tip, "The nodes of {{.name}} are all provisioned, so it is started as usual", out.V{"name": existing.Name})
+		return
+	}
+	out.Step(style.Waiting, "Resuming the start of {{.name}}, whose nodes {{.nodes}} are not provisioned yet", out.V{"name": existing.Name, "nodes": strings.Join(incomplete, ", ")})
+}
+
+// validateKubeProxyMode validates the mode of --kube-proxy-mode
+func validateKubeProxyMode() {
+	mode := viper.GetString(kubeProxyMode)
+	if mode == "" {
+		return
+	}
+	if mode == "kernelspace" {
+		exit.Message(reason.Usage, "The kernelspace mode of kube-proxy is the one of Windows nodes, which minikube does not support")
+	}
+	if !slices.Contains(bsutil.KubeProxyModes, mode) {
+		exit.Message(reason.Usage, "Invalid --kube-proxy-mode {{.mode}}. Options include: [{{.modes}}]", out.V{"mode": mode, "modes": strings.Join(bsutil.KubeProxyModes, ",")})
+	}
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --kube-proxy-mode flag cannot be used with --no-kubernetes")
+	}
+	if m := config.ExtraOptions.Get("mode", bsutil.Kubeproxy); m != "" && m != mode {
+		exit.Message(reason.Usage, "The --kube-proxy-mode {{.mode}} conflicts with --extra-config=kube-proxy.mode={{.extra}}", out.V{"mode": mode, "extra": m})
+	}
+}
+
+// validateSecurityProfiles validates --seccomp-default and --security-profiles-dir
+func validateSecurityProfiles() {
+	if viper.GetBool(noKubernetes) && (viper.GetBool(seccompDefault) || viper.GetString(securityProfilesDir) != "") {
+		exit.Message(reason.Usage, "The --seccomp-default and --security-profiles-dir flags cannot be used with --no-kubernetes")
+	}
+	dir := viper.GetString(securityProfilesDir)
+	if dir == "" {
+		return
+	}
+	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+		exit.Message(reason.Usage, "The --security-profiles-dir must be a directory: {{.dir}}", out.V{"dir": dir})
+	}
+}
+
+// validateAuditPolicy validates that --audit-policy is an audit policy
+func validateAuditPolicy() {
+	policy := viper.GetString(auditPolicy)
+	if policy == "" {
+		return
+	}
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --audit-policy flag cannot be used with --no-kubernetes")
+	}
+	if err := bootstrapper.ValidateAuditPolicy(policy); err != nil {
+		exit.Message(reason.Usage, "Invalid --audit-policy {{.policy}}: {{.error}}", out.V{"policy": policy, "error": err})
+	}
+}
+
+// validateRegistryAuth validates that each --registry-auth is a docker config.json file or registry=user:password
+func validateRegistryAuth() {
+	auths := viper.GetStringSlice(registryAuth)
+	if len(auths) > 0 && viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --registry-auth flag cannot be used with --no-kubernetes")
+	}
+	for _, v := range auths {
+		if _, err := os.Stat(v); err == nil {
+			continue
+		}
+		if _, _, _, err := bootstrapper.ParseRegistryAuth(v); err != nil {
+			exit.Message(reason.Usage, "Invalid --registry-auth: {{.error}}", out.V{"error": err})
+		}
+	}
+}
+
+// validateExternalEtcd validates the --external-etcd of cc, and its files
+func validateExternalEtcd(cc config.ClusterConfig) {
+	if !config.IsExternalEtcd(cc) {
+		exit.Message(reason.Usage, "The --external-etcd-ca-file, --external-etcd-cert-file and --external-etcd-key-file flags require --external-etcd")
+	}
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --external-etcd flag cannot be used with --no-kubernetes")
+	}
+	if err := bootstrapper.ValidateExternalEtcd(cc.ExternalEtcd); err != nil {
+		exit.Message(reason.Usage, "Invalid --external-etcd: {{.error}}", out.V{"error": err})
+	}
+}
+
+// validateHALoadBalancer validates the --ha-vip, --ha-lb-mode and --ha-bgp-peers of cc
+func validateHALoadBalancer(cc config.ClusterConfig) error {
+	k := cc.KubernetesConfig
+	if !config.IsHA(cc) {
+		return errors.New("the --ha-vip, --ha-lb-mode and --ha-bgp-peers flags require an HA (multi-control plane) cluster")
+	}
+	if vip := k.HAVIP; vip != "" {
+		if ip := net.ParseIP(vip); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("--ha-vip %s is not an IPv4 address", vip)
+		}
+		for _, n := range cc.Nodes {
+			if n.IP == vip {
+				return fmt.Errorf("--ha-vip %s is the IP of the node %s", vip, config.MachineName(cc, n))
+			}
+		}
+	}
+	mode := kubevip.Mode(cc)
+	if !slices.Contains(kubevip.Modes, mode) {
+		return fmt.Errorf("unknown --ha-lb-mode %s, options include: [%s]", mode, strings.Join(kubevip.Modes, ","))
+	}
+	if mode == kubevip.ModeHAProxy && !driver.IsKIC(cc.Driver) {
+		return fmt.Errorf("--ha-lb-mode=%s requires the docker or podman driver", mode)
+	}
+	if mode == kubevip.ModeBGP && len(k.HABGPPeers) == 0 {
+		return fmt.Errorf("--ha-lb-mode=%s requires --ha-bgp-peers", mode)
+	}
+	if mode != kubevip.ModeBGP && len(k.HABGPPeers) > 0 {
+		return fmt.Errorf("--ha-bgp-peers requires --ha-lb-mode=%s", kubevip.ModeBGP)
+	}
+	for _, p := range k.HABGPPeers {
+		if err := kubevip.ValidateBGPPeer(p); err != nil {
+			return errors.Wrap(err, "--ha-bgp-peers")
+		}
+	}
+	return nil
+}
+
+// validatePullSecrets validates that the CLI of --pull-secrets-provider mints tokens of --pull-secrets-registry
+func validatePullSecrets() {
+	provider := viper.GetString(pullSecretsProvider)
+	if provider == "" {
+		return
+	}
+	if viper.GetBool(noKubernetes) {
+		exit.Message(reason.Usage, "The --pull-secrets-provider flag cannot be used with --no-kubernetes")
+	}
+	registry := viper.GetString(pullSecretsRegistry)
+	if registry == "" {
+		exit.Message(reason.Usage, "The --pull-secrets-registry is required with --pull-secrets-provider")
+	}
+	if len(viper.GetStringSlice(pullSecretsNamespaces)) == 0 {
+		exit.Message(reason.Usage, "The --pull-secrets-namespaces must not be empty")
+	}
+	args, _, err := node.PullSecretsCommand(provider, registry)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid --pull-secrets-registry {{.registry}}: {{.error}}", out.V{"registry": registry, "error": err})
+	}
+	if _, err := exec.LookPath(args[0]); err != nil {
+		exit.Message(reason.Usage, "The {{.cli}} CLI is required on the host with --pull-secrets-provider={{.provider}}", out.V{"cli": args[0], "provider": provider})
+	}
+}
+
 // This function validates if the --image-repository
 // args match the format of registry.cn-hangzhou.aliyuncs.com/google_containers
 // also "<hostname>[:<port>]"
@@ -1678,6 +2085,75 @@ func validateInsecureRegistry() {
 	}
 }
 
+// secondaryNodes returns the nodes that are started after the primary control-plane node of cc: the other nodes of the
+// existing cluster, or the new ones of --nodes, --ha and --topology
+func secondaryNodes(cc *config.ClusterConfig, existing *config.ClusterConfig) []config.Node {
+	if existing != nil {
+		if len(existing.Nodes) < 2 {
+			return nil
+		}
+		var ns []config.Node
+		for _, n := range existing.Nodes[1:] {
+			ns = append(ns, withNodeExtraOptions(*cc, n))
+		}
+		return ns
+	}
+
+	// the starter node is also counted as the (primary) control-plane node
+	numCPNodes := 1
+	if viper.GetBool(ha) {
+		numCPNodes = 3
+	}
+	var ns []config.Node
+	for i := 1; i < viper.GetInt(nodes); i++ {
+		n := config.Node{
+			Name:              node.Name(i + 1),
+			Port:              cc.APIServerPort,
+			KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+			ContainerRuntime:  cc.KubernetesConfig.ContainerRuntime,
+			Worker:            true,
+			ControlPlane:      i < numCPNodes,
+		}
+		if i < len(topologyNodes) {
+			n = withTopology(n, topologyNodes[i])
+		}
+		ns = append(ns, withNodeExtraOptions(*cc, n))
+	}
+	return ns
+}
+
+// useTopology loads the topology file at path, whose nodes are created with a new cluster
+func useTopology(cmd *cobra.Command, path string, existing *config.ClusterConfig) {
+	if cmd.Flags().Changed(nodes) || cmd.Flags().Changed(ha) {
+		exit.Message(reason.Usage, "--topology cannot be combined with --nodes or --ha, the topology file has the nodes of the cluster")
+	}
+	if existing != nil {
+		out.WarningT("Ignoring --topology, as the cluster already exists. Use 'minikube node add --topology' to add nodes to it.")
+		return
+	}
+	t, err := config.LoadTopology(path)
+	if err == nil {
+		err = t.ValidateCluster()
+	}
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid topology file {{.path}}: {{.error}}", out.V{"path": path, "error": err})
+	}
+	topologyNodes = t.Expand()
+	viper.Set(nodes, len(topologyNodes))
+	viper.Set(ha, t.ControlPlanes() > 1)
+}
+
+// withTopology returns n with the role, resources, labels and taints of the node t of a topology file
+func withTopology(n, t config.Node) config.Node {
+	n.ControlPlane = t.ControlPlane
+	n.CPUs = t.CPUs
+	n.Memory = t.Memory
+	n.DiskSize = t.DiskSize
+	n.Labels = t.Labels
+	n.Taints = t.Taints
+	return n
+}
+
 // configureNodes creates primary control-plane node config on first cluster start or updates existing cluster nodes configs on restart.
 // It will return updated cluster config and primary control-plane node or any error occurred.
 func configureNodes(cc config.ClusterConfig, existing *config.ClusterConfig) (config.ClusterConfig, config.Node, error) {
@@ -1696,6 +2172,15 @@ func configureNodes(cc config.ClusterConfig, existing *config.ClusterConfig) (co
 			ControlPlane:      true,
 			Worker:            true,
 		}
+		if len(topologyNodes) > 0 {
+			pcp = withTopology(pcp, topologyNodes[0])
+		}
+		pcp = withNodeExtraOptions(cc, pcp)
+		names := []string{cc.Name}
+		for i := 2; i <= viper.GetInt(nodes); i++ {
+			names = append(names, node.Name(i), fmt.Sprintf("%s-%s", cc.Name, node.Name(i)))
+		}
+		validateNodeExtraOptions(names)
 		cc.Nodes = []config.Node{pcp}
 		return cc, pcp, nil
 	}
@@ -1703,12 +2188,15 @@ func configureNodes(cc config.ClusterConfig, existing *config.ClusterConfig) (co
 	// Make sure that existing nodes honor if KubernetesVersion gets specified on restart
 	// KubernetesVersion is the only attribute that the user can override in the Node object
 	nodes := []config.Node{}
+	var names []string
 	for _, n := range existing.Nodes {
 		n.KubernetesVersion = kv
 		n.ContainerRuntime = cr
-		nodes = append(nodes, n)
+		nodes = append(nodes, withNodeExtraOptions(*existing, n))
+		names = append(names, n.Name, config.MachineName(*existing, n))
 	}
 	cc.Nodes = nodes
+	validateNodeExtraOptions(names)
 
 	pcp, err := config.ControlPlane(*existing)
 	if err != nil {
@@ -1716,10 +2204,30 @@ func configureNodes(cc config.ClusterConfig, existing *config.ClusterConfig) (co
 	}
 	pcp.KubernetesVersion = kv
 	pcp.ContainerRuntime = cr
+	pcp = withNodeExtraOptions(*existing, pcp)
 
 	return cc, pcp, nil
 }
 
+// withNodeExtraOptions returns n with the --extra-config scoped to it with the node:NAME: prefix, where NAME is its node or machine name,
+// on top of the options it already has. They are saved with the node, so they also apply to its later starts.
+func withNodeExtraOptions(cc config.ClusterConfig, n config.Node) config.Node {
+	opts := config.ExtraOptions.ForNode(n.Name, config.MachineName(cc, n))
+	if len(opts) > 0 {
+		n.ExtraOptions = n.ExtraOptions.Merge(opts)
+	}
+	return n
+}
+
+// validateNodeExtraOptions exits if the --extra-config is scoped to a node that is not one of names
+func validateNodeExtraOptions(names []string) {
+	for _, name := range config.ExtraOptions.Nodes() {
+		if !slices.Contains(names, name) {
+			exit.Message(reason.Usage, "The --extra-config is scoped to the node {{.name}}, which the cluster does not have", out.V{"name": name})
+		}
+	}
+}
+
 // autoSetDriverOptions sets the options needed for specific driver automatically.
 func autoSetDriverOptions(cmd *cobra.Command, drvName string) (err error) {
 	err = nil
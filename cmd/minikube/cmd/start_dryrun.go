@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/addons"
+	"k8s.io/minikube/pkg/minikube/cni"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// startPlan is what minikube start would do, which --dry-run prints instead of doing it
+type startPlan struct {
+	Cluster string `json:"cluster" yaml:"cluster"`
+	// Action is create for a new cluster, or start for an existing one
+	Action            string `json:"action" yaml:"action"`
+	Driver            string `json:"driver" yaml:"driver"`
+	ISO               string `json:"iso,omitempty" yaml:"iso,omitempty"`
+	KicBaseImage      string `json:"kicBaseImage,omitempty" yaml:"kicBaseImage,omitempty"`
+	KubernetesVersion string `json:"kubernetesVersion" yaml:"kubernetesVersion"`
+	ContainerRuntime  string `json:"containerRuntime" yaml:"containerRuntime"`
+	CNI               string `json:"cni" yaml:"cni"`
+	// Nodes are in the order they are started, the primary control-plane node first
+	Nodes  []plannedNode `json:"nodes" yaml:"nodes"`
+	Addons []string      `json:"addons" yaml:"addons"`
+	// Steps are the actions that minikube start would take, in order
+	Steps []string `json:"steps" yaml:"steps"`
+}
+
+// plannedNode is a node of a startPlan. Memory and DiskSize are in MB.
+type plannedNode struct {
+	Name         string `json:"name" yaml:"name"`
+	ControlPlane bool   `json:"controlPlane" yaml:"controlPlane"`
+	Worker       bool   `json:"worker" yaml:"worker"`
+	CPUs         int    `json:"cpus" yaml:"cpus"`
+	Memory       int    `json:"memory" yaml:"memory"`
+	DiskSize     int    `json:"diskSize" yaml:"diskSize"`
+}
+
+// dryRunPlan returns what minikube start would do with cc, the config generated from the flags, and n, its primary
+// control-plane node, without changing the host
+func dryRunPlan(cc config.ClusterConfig, n config.Node, existing *config.ClusterConfig) startPlan {
+	p := startPlan{
+		Cluster:           cc.Name,
+		Action:            "create",
+		Driver:            cc.Driver,
+		KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+		ContainerRuntime:  cc.KubernetesConfig.ContainerRuntime,
+		CNI:               cniName(&cc),
+		Addons:            []string{},
+	}
+	if existing != nil {
+		p.Action = "start"
+	}
+	switch {
+	case driver.IsKIC(cc.Driver):
+		p.KicBaseImage = cc.KicBaseImage
+	case driver.IsVM(cc.Driver) && !driver.IsSSH(cc.Driver):
+		p.ISO = cc.MinikubeISO
+		if existing == nil {
+			// the first of them that can be downloaded is cached
+			if urls := viper.GetStringSlice(isoURL); len(urls) > 0 {
+				p.ISO = urls[0]
+			}
+		}
+	}
+
+	for _, cn := range append([]config.Node{n}, secondaryNodes(&cc, existing)...) {
+		r := config.NodeResources(cc, cn)
+		p.Nodes = append(p.Nodes, plannedNode{
+			Name:         config.MachineName(cc, cn),
+			ControlPlane: cn.ControlPlane,
+			Worker:       cn.Worker,
+			CPUs:         r.CPUs,
+			Memory:       r.Memory,
+			DiskSize:     r.DiskSize,
+		})
+	}
+
+	if viper.GetBool(installAddons) && cc.KubernetesConfig.KubernetesVersion != constants.NoKubernetesVersion {
+		existingAddons := map[string]bool{}
+		if existing != nil && existing.Addons != nil {
+			existingAddons = existing.Addons
+		}
+		addonList := viper.GetStringSlice(config.AddonListFlag)
+		if cc.OIDC.IssuerURL == constants.DexIssuer {
+			addonList = append(addonList, "dex")
+		}
+		for name, enable := range addons.ToEnable(&cc, existingAddons, addonList) {
+			if enable {
+				p.Addons = append(p.Addons, name)
+			}
+		}
+		sort.Strings(p.Addons)
+	}
+
+	p.Steps = dryRunSteps(p, existing != nil)
+	return p
+}
+
+// dryRunSteps returns the actions of p, in the order minikube start takes them
+func dryRunSteps(p startPlan, exists bool) []string {
+	var steps []string
+	switch {
+	case p.ISO != "" && !exists:
+		steps = append(steps, fmt.Sprintf("cache the ISO %s", p.ISO))
+	case p.KicBaseImage != "":
+		steps = append(steps, fmt.Sprintf("pull the base image %s", p.KicBaseImage))
+	}
+	for i, n := range p.Nodes {
+		if exists {
+			steps = append(steps, fmt.Sprintf("start the machine %s, unless it is running", n.Name))
+		} else {
+			steps = append(steps, fmt.Sprintf("create the %s machine %s with %d CPUs, %d MB of memory and %d MB of disk", p.Driver, n.Name, n.CPUs, n.Memory, n.DiskSize))
+		}
+		if p.KubernetesVersion == constants.NoKubernetesVersion {
+			continue
+		}
+		if i > 0 {
+			role := "a worker"
+			if n.ControlPlane {
+				role = "a control-plane node"
+			}
+			steps = append(steps, fmt.Sprintf("join %s to the cluster as %s", n.Name, role))
+			continue
+		}
+		steps = append(steps, fmt.Sprintf("bootstrap Kubernetes %s with %s on %s", p.KubernetesVersion, p.ContainerRuntime, n.Name))
+		if p.CNI != "disabled" {
+			steps = append(steps, fmt.Sprintf("deploy the %s CNI", p.CNI))
+		}
+		if len(p.Addons) > 0 {
+			steps = append(steps, fmt.Sprintf("enable the addons %s", strings.Join(p.Addons, ", ")))
+		}
+	}
+	return steps
+}
+
+// cniName returns the name of the CNI of cc, resolving the default one
+func cniName(cc *config.ClusterConfig) string {
+	switch c := cc.KubernetesConfig.CNI; c {
+	case "", "auto":
+	case "false":
+		return "disabled"
+	default:
+		return c
+	}
+	m, err := cni.New(cc)
+	if err != nil {
+		klog.Warningf("unable to choose the CNI: %v", err)
+		return "auto"
+	}
+	switch m.(type) {
+	case cni.Disabled:
+		return "disabled"
+	case cni.KindNet:
+		return "kindnet"
+	case cni.Bridge:
+		return "bridge"
+	}
+	return m.String()
+}
+
+// printDryRunPlan prints p as YAML, or as JSON with --output=json
+func printDryRunPlan(p startPlan) {
+	var b []byte
+	var err error
+	if outputFormat == "json" {
+		b, err = json.Marshal(p)
+		b = append(b, '\n')
+	} else {
+		b, err = yaml.Marshal(p)
+	}
+	if err != nil {
+		exit.Error(reason.InternalJSONMarshal, "Unable to encode the plan", err)
+	}
+	os.Stdout.Write(b)
+}
@@ -18,6 +18,8 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -30,8 +32,10 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/drivers/kic"
 	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	kubevip "k8s.io/minikube/pkg/minikube/cluster/ha/kube-vip"
 	"k8s.io/minikube/pkg/minikube/cni"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
@@ -40,6 +44,8 @@ import (
 	"k8s.io/minikube/pkg/minikube/download"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/proxy"
 	"k8s.io/minikube/pkg/minikube/reason"
@@ -75,6 +81,7 @@ const (
 	installAddons           = "install-addons"
 	defaultDiskSize         = "20000mb"
 	keepContext             = "keep-context"
+	kubeconfigMode          = "kubeconfig-mode"
 	createMount             = "mount"
 	featureGates            = "feature-gates"
 	apiServerName           = "apiserver-name"
@@ -116,9 +123,42 @@ const (
 	hostOnlyNicType         = "host-only-nic-type"
 	natNicType              = "nat-nic-type"
 	ha                      = "ha"
+	haVIP                   = "ha-vip"
+	haLBMode                = "ha-lb-mode"
+	haBGPPeers              = "ha-bgp-peers"
 	nodes                   = "nodes"
 	preload                 = "preload"
 	deleteOnFailure         = "delete-on-failure"
+	backgroundImages        = "background-images"
+	sharedImageCache        = "shared-image-cache"
+	memoryAutoShrink        = "memory-auto-shrink"
+	certsDir                = "certs-dir"
+	oidcIssuerURL           = "oidc-issuer-url"
+	oidcClientID            = "oidc-client-id"
+	oidcUsernameClaim       = "oidc-username-claim"
+	oidcGroupsClaim         = "oidc-groups-claim"
+	encryptSecrets          = "encrypt-secrets"
+	podSecurityLevel        = "pod-security-level"
+	seccompDefault          = "seccomp-default"
+	securityProfilesDir     = "security-profiles-dir"
+	auditPolicy             = "audit-policy"
+	externalEtcd            = "external-etcd"
+	externalEtcdCAFile      = "external-etcd-ca-file"
+	externalEtcdCertFile    = "external-etcd-cert-file"
+	externalEtcdKeyFile     = "external-etcd-key-file"
+	pullSecretsProvider     = "pull-secrets-provider"
+	pullSecretsRegistry     = "pull-secrets-registry"
+	pullSecretsNamespaces   = "pull-secrets-namespaces"
+	registryAuth            = "registry-auth"
+	clusterTTL              = "ttl"
+	eventLog                = "event-log"
+	githubOutput            = "github-output"
+	parallelNodes           = "parallel-nodes"
+	topology                = "topology"
+	kubeProxyMode           = "kube-proxy-mode"
+	kubeadmPatches          = "kubeadm-patches"
+	dualStack               = "dual-stack"
+	resume                  = "resume"
 	forceSystemd            = "force-systemd"
 	kicBaseImage            = "base-image"
 	ports                   = "ports"
@@ -144,6 +184,7 @@ const (
 	staticIP                = "static-ip"
 	gpus                    = "gpus"
 	autoPauseInterval       = "auto-pause-interval"
+	presetFlag              = "preset"
 )
 
 var (
@@ -159,7 +200,8 @@ func initMinikubeFlags() {
 	viper.AutomaticEnv()
 	startCmd.Flags().Bool(force, false, "Force minikube to perform possibly dangerous operations")
 	startCmd.Flags().Bool(interactive, true, "Allow user prompts for more information")
-	startCmd.Flags().Bool(dryRun, false, "dry-run mode. Validates configuration, but does not mutate system state")
+	startCmd.Flags().Bool(dryRun, false, "dry-run mode. Validates configuration, and prints what minikube start would do as YAML, or JSON with --output=json, but does not mutate system state")
+	startCmd.Flags().String(presetFlag, "", fmt.Sprintf("A named bundle of flags to start with, flags passed on the command line take precedence. Built-in presets: %s. More can be defined in the defaults file", strings.Join(presetNames(nil), ", ")))
 
 	startCmd.Flags().String(cpus, "2", fmt.Sprintf("Number of CPUs allocated to Kubernetes. Use %q to use the maximum number of CPUs. Use %q to not specify a limit (Docker/Podman only)", constants.MaxResources, constants.NoLimit))
 	startCmd.Flags().String(memory, "", fmt.Sprintf("Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g). Use %q to use the maximum amount of memory. Use %q to not specify a limit (Docker/Podman only)", constants.MaxResources, constants.NoLimit))
@@ -169,6 +211,7 @@ func initMinikubeFlags() {
 	startCmd.Flags().StringSlice(isoURL, download.DefaultISOURLs(), "Locations to fetch the minikube ISO from.")
 	startCmd.Flags().String(kicBaseImage, kic.BaseImage, "The base image to use for docker/podman drivers. Intended for local development.")
 	startCmd.Flags().Bool(keepContext, false, "This will keep the existing kubectl context and will create a minikube context.")
+	startCmd.Flags().String(kubeconfigMode, kubeconfig.ModeShared, fmt.Sprintf("Where to write the kubectl context of the cluster. %q adds it to the kubeconfig from $KUBECONFIG or ~/.kube/config, %q writes it to a kubeconfig file of its own, whose path is printed by 'minikube kubeconfig'.", kubeconfig.ModeShared, kubeconfig.ModeSeparate))
 	startCmd.Flags().Bool(embedCerts, false, "if true, will embed the certs in kubeconfig.")
 	startCmd.Flags().String(containerRuntime, constants.DefaultContainerRuntime, fmt.Sprintf("The container runtime to be used. Valid options: %s (default: auto)", strings.Join(cruntime.ValidRuntimes(), ", ")))
 	startCmd.Flags().Bool(createMount, false, "This will start the mount daemon and automatically mount files into minikube.")
@@ -192,22 +235,53 @@ func initMinikubeFlags() {
 	startCmd.Flags().Bool(autoUpdate, true, "If set, automatically updates drivers to the latest version. Defaults to true.")
 	startCmd.Flags().Bool(installAddons, true, "If set, install addons. Defaults to true.")
 	startCmd.Flags().Bool(ha, false, "Create Highly Available Multi-Control Plane Cluster with a minimum of three control-plane nodes that will also be marked for work.")
+	startCmd.Flags().String(haVIP, "", "The virtual IP of the API servers of an HA cluster, in the subnet of its nodes. Defaults to the last IP of that subnet.")
+	startCmd.Flags().String(haLBMode, "", "How the virtual IP of an HA cluster is served, defaults to arp: kube-vip announces it with ARP, or advertises it to --ha-bgp-peers with BGP, or haproxy balances it across the API servers (docker and podman drivers only). Options include: ["+strings.Join(kubevip.Modes, ",")+"]")
+	startCmd.Flags().StringSlice(haBGPPeers, nil, "The BGP peers that kube-vip advertises the virtual IP of an HA cluster to, with --ha-lb-mode=bgp, as address:AS, eg: 192.168.49.1:65001")
 	startCmd.Flags().IntP(nodes, "n", 1, "The total number of nodes to spin up. Defaults to 1.")
 	startCmd.Flags().Bool(preload, true, "If set, download tarball of preloaded images if available to improve start time. Defaults to true.")
 	startCmd.Flags().Bool(noKubernetes, false, "If set, minikube VM/container will start without starting or configuring Kubernetes. (only works on new clusters)")
 	startCmd.Flags().Bool(deleteOnFailure, false, "If set, delete the current cluster if start fails and try again. Defaults to false.")
+	startCmd.Flags().Bool(resume, false, "Resume the start of an existing cluster that failed part way, eg: after a machine was created but before Kubernetes was bootstrapped on it, with the configuration of that start: the flags that configure the cluster are ignored. Every start skips the phases of provisioning a node that completed.")
+	startCmd.Flags().Bool(sharedImageCache, false, "(docker and podman driver only) If true, the nodes pull Docker Hub images through a registry mirror they share, so that an image pulled by one node is available to the others.")
+	startCmd.Flags().Bool(backgroundImages, true, "If true, start returns once the API server and CNI are ready, while a background process loads the cached images and pulls the images of the enabled addons. Its progress is shown by 'minikube status --detailed'.")
 	startCmd.Flags().Bool(forceSystemd, false, "If set, force the container runtime to use systemd as cgroup manager. Defaults to false.")
 	startCmd.Flags().String(network, "", "network to run minikube with. Now it is used by docker/podman and KVM drivers. If left empty, minikube will create a new network.")
 	startCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
 	startCmd.Flags().String(trace, "", "Send trace events. Options include: [gcp]")
 	startCmd.Flags().Int(extraDisks, 0, "Number of extra disks created and attached to the minikube VM (currently only implemented for hyperkit, kvm2, and qemu2 drivers)")
 	startCmd.Flags().Duration(certExpiration, constants.DefaultCertExpiration, "Duration until minikube certificate expiration, defaults to three years (26280h).")
-	startCmd.Flags().String(binaryMirror, "", "Location to fetch kubectl, kubelet, & kubeadm binaries from.")
+	startCmd.Flags().String(certsDir, "", "Directory with the ca.crt and ca.key of a CA, or an intermediate CA, that signs the cluster certificates instead of the minikube CA. It may also hold a pre-issued apiserver.crt and apiserver.key.")
+	startCmd.Flags().String(oidcIssuerURL, "", "HTTPS URL of an OpenID Connect issuer that the API server authenticates users with, eg: your SSO provider. 'dex' enables the dex addon, with a test user, as the issuer.")
+	startCmd.Flags().String(oidcClientID, "minikube", "Client ID of the cluster at the --oidc-issuer-url")
+	startCmd.Flags().String(oidcUsernameClaim, "email", "Claim of the OIDC ID token used as the user name")
+	startCmd.Flags().String(oidcGroupsClaim, "groups", "Claim of the OIDC ID token used as the groups of the user")
+	startCmd.Flags().String(encryptSecrets, "", "Encrypt secrets at rest in etcd, with a key that minikube generates (aescbc), or with a KMS v2 plugin listening on "+bootstrapper.KMSPluginSocket+" on the control-plane nodes (kms). Options include: [aescbc,kms]")
+	startCmd.Flags().Lookup(encryptSecrets).NoOptDefVal = "aescbc"
+	startCmd.Flags().String(podSecurityLevel, "", "Pod Security Standard that the API server enforces, audits and warns about in every namespace but "+strings.Join(bootstrapper.PodSecurityExemptNamespaces, ", ")+", unless its labels say otherwise. Options include: ["+strings.Join(bootstrapper.PodSecurityLevels, ",")+"]")
+	startCmd.Flags().Bool(seccompDefault, false, "If true, the kubelet runs every container with the RuntimeDefault seccomp profile, unless its pod sets another one. Requires Kubernetes v1.25 or later.")
+	startCmd.Flags().String(securityProfilesDir, "", "Directory of seccomp profiles (.json files), installed in "+bootstrapper.SeccompProfilesDir+" on every node, and of AppArmor profiles (the other files), loaded on every node that supports AppArmor")
+	startCmd.Flags().String(auditPolicy, "", "Audit policy of the API server, eg: policy.yaml. The requests it matches are logged on the control-plane nodes, and shown by 'minikube logs --audit-k8s'.")
+	startCmd.Flags().StringSlice(externalEtcd, nil, "Client URLs of an existing etcd cluster that the API server uses, instead of running etcd on the control-plane nodes, eg: https://10.0.0.5:2379. It cannot be changed once the cluster is created.")
+	startCmd.Flags().String(externalEtcdCAFile, "", "CA of the --external-etcd, required with HTTPS endpoints")
+	startCmd.Flags().String(externalEtcdCertFile, "", "Client certificate of the API server for the --external-etcd, required with HTTPS endpoints")
+	startCmd.Flags().String(externalEtcdKeyFile, "", "Client key of the API server for the --external-etcd, required with HTTPS endpoints")
+	startCmd.Flags().String(pullSecretsProvider, "", "Cloud provider whose CLI on the host mints short-lived tokens of the --pull-secrets-registry, which minikube keeps refreshed as the imagePullSecrets of the --pull-secrets-namespaces. Options include: ["+strings.Join(node.PullSecretsProviders, ",")+"]")
+	startCmd.Flags().String(pullSecretsRegistry, "", "Private registry of the --pull-secrets-provider, eg: us-docker.pkg.dev, 123456789012.dkr.ecr.us-east-1.amazonaws.com or myregistry.azurecr.io")
+	startCmd.Flags().StringSlice(pullSecretsNamespaces, []string{"default"}, "Namespaces whose default service account pulls from the --pull-secrets-registry")
+	startCmd.Flags().StringSlice(registryAuth, nil, "Registry credentials that every node pulls images with, as docker config.json files, eg: ~/.docker/config.json, or registry=user:password. The files are read again on each start.")
+	startCmd.Flags().Duration(clusterTTL, 0, "Time after which the cluster is deleted, eg: 30m. Disabled when 0, which also unsets the TTL of an existing cluster.")
+	startCmd.Flags().String(eventLog, "", "File that the JSON events of minikube start are written to as well, eg: as an artifact of a CI pipeline")
+	startCmd.Flags().Bool(parallelNodes, false, "If set, starts the worker nodes concurrently once the control-plane nodes are up, eg: with --nodes, rather than one after the other")
+	startCmd.Flags().Bool(githubOutput, false, "Writes the kubeconfig path, API server URL, node IPs and docker-env variables of the cluster to the outputs and environment of the GitHub Actions step, and groups the logs of each step of minikube start")
+	startCmd.Flags().String(topology, "", "A topology file of the nodes of a new cluster, with their roles, resources, labels and taints, instead of --nodes and --ha")
+	startCmd.Flags().String(binaryMirror, "", "Location to fetch kubectl, kubelet, & kubeadm binaries from. If the mirror fails, they are fetched from the default release host.")
 	startCmd.Flags().Bool(disableOptimizations, false, "If set, disables optimizations that are set for local Kubernetes. Including decreasing CoreDNS replicas from 2 to 1. Defaults to false.")
 	startCmd.Flags().Bool(disableMetrics, false, "If set, disables metrics reporting (CPU and memory usage), this can improve CPU usage. Defaults to false.")
 	startCmd.Flags().String(staticIP, "", "Set a static IP for the minikube cluster, the IP must be: private, IPv4, and the last octet must be between 2 and 254, for example 192.168.200.200 (Docker and Podman drivers only)")
 	startCmd.Flags().StringP(gpus, "g", "", "Allow pods to use your NVIDIA GPUs. Options include: [all,nvidia] (Docker driver with Docker container-runtime only)")
 	startCmd.Flags().Duration(autoPauseInterval, time.Minute*1, "Duration of inactivity before the minikube VM is paused (default 1m0s)")
+	startCmd.Flags().Duration(memoryAutoShrink, 0, "(kvm2 and hyperv driver only) Duration of inactivity before the memory of the guests is shrunk to half of --memory. It is restored on activity. Disabled when 0.")
 }
 
 // initKubernetesFlags inits the commandline flags for Kubernetes related options
@@ -218,7 +292,9 @@ func initKubernetesFlags() {
 		`A set of key=value pairs that describe configuration that may be passed to different components.
 		The key should be '.' separated, and the first part before the dot is the component to apply the configuration to.
 		Valid components are: kubelet, kubeadm, apiserver, controller-manager, etcd, proxy, scheduler
+		The kubelet configuration of a single node is prefixed with node:NAME:, eg: node:m02:kubelet.eviction-hard=memory.available<5%
 		Valid kubeadm parameters: `+fmt.Sprintf("%s, %s", strings.Join(bsutil.KubeadmExtraArgsAllowed[bsutil.KubeadmCmdParam], ", "), strings.Join(bsutil.KubeadmExtraArgsAllowed[bsutil.KubeadmConfigParam], ",")))
+	startCmd.Flags().String(kubeadmPatches, "", "A directory of kubeadm patches of all the nodes, applied by kubeadm init, join and upgrade, eg: kube-apiserver+strategic.yaml to change the static pod of the API server. Files follow the kubeadm naming convention: target[suffix][+patchtype].extension. The patches of 'minikube node add --kubeadm-patches' with the same name replace them.")
 	startCmd.Flags().String(featureGates, "", "A set of key=value pairs that describe feature gates for alpha/experimental features.")
 	startCmd.Flags().String(dnsDomain, constants.ClusterDNSDomain, "The cluster dns domain name used in the Kubernetes cluster")
 	startCmd.Flags().Int(apiServerPort, constants.APIServerPort, "The apiserver listening port")
@@ -273,10 +349,12 @@ func initDriverFlags() {
 // initNetworkingFlags inits the commandline flags for connectivity related flags for start
 func initNetworkingFlags() {
 	startCmd.Flags().StringSliceVar(&insecureRegistry, "insecure-registry", nil, "Insecure Docker registries to pass to the Docker daemon.  The default service CIDR range will automatically be added.")
-	startCmd.Flags().StringSliceVar(&registryMirror, "registry-mirror", nil, "Registry mirrors to pass to the Docker daemon")
+	startCmd.Flags().StringSliceVar(&registryMirror, "registry-mirror", nil, "Registry mirrors of Docker Hub that the container runtime pulls through, eg: https://mirror.example.com")
 	startCmd.Flags().String(imageRepository, "", "Alternative image repository to pull docker images from. This can be used when you have limited access to gcr.io. Set it to \"auto\" to let minikube decide one for you. For Chinese mainland users, you may use local gcr.io mirrors such as registry.cn-hangzhou.aliyuncs.com/google_containers")
 	startCmd.Flags().String(imageMirrorCountry, "", "Country code of the image mirror to be used. Leave empty to use the global one. For Chinese mainland users, set it to cn.")
 	startCmd.Flags().String(serviceCIDR, constants.DefaultServiceCIDR, "The CIDR to be used for service cluster IPs.")
+	startCmd.Flags().Bool(dualStack, false, "Give the pods and services IPv6 addresses as well as IPv4 ones, with the docker, podman and kvm2 drivers, and the bridge or kindnet CNI")
+	startCmd.Flags().String(kubeProxyMode, "", "The mode of kube-proxy, defaults to iptables. Options include: ["+strings.Join(bsutil.KubeProxyModes, ",")+"]")
 	startCmd.Flags().StringArrayVar(&config.DockerEnv, "docker-env", nil, "Environment variables to pass to the Docker daemon. (format: key=value)")
 	startCmd.Flags().StringArrayVar(&config.DockerOpt, "docker-opt", nil, "Specify arbitrary flags to pass to the Docker daemon. (format: key=value)")
 
@@ -300,7 +378,11 @@ func ClusterFlagValue() string {
 func generateClusterConfig(cmd *cobra.Command, existing *config.ClusterConfig, k8sVersion string, rtime string, drvName string) (config.ClusterConfig, config.Node, error) {
 	var cc config.ClusterConfig
 	if existing != nil {
-		cc = updateExistingConfigFromFlags(cmd, existing)
+		// with --resume, the start that failed goes on with its configuration
+		cc = *existing
+		if !viper.GetBool(resume) {
+			cc = updateExistingConfigFromFlags(cmd, existing)
+		}
 
 		// identify appropriate cni then configure cruntime accordingly
 		if _, err := cni.New(&cc); err != nil {
@@ -405,6 +487,99 @@ func getDiskSize() int {
 	return diskSize
 }
 
+// getCertsDir returns the absolute path of the --certs-dir flag, or "" if it is not set
+func getCertsDir() string {
+	dir := viper.GetString(certsDir)
+	if dir == "" {
+		return ""
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid --certs-dir {{.dir}}: {{.error}}", out.V{"dir": dir, "error": err})
+	}
+	return abs
+}
+
+func getSecurityProfilesDir() string {
+	dir := viper.GetString(securityProfilesDir)
+	if dir == "" {
+		return ""
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid --security-profiles-dir {{.dir}}: {{.error}}", out.V{"dir": dir, "error": err})
+	}
+	return abs
+}
+
+func getAuditPolicy() string {
+	policy := viper.GetString(auditPolicy)
+	if policy == "" {
+		return ""
+	}
+	abs, err := filepath.Abs(policy)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid --audit-policy {{.policy}}: {{.error}}", out.V{"policy": policy, "error": err})
+	}
+	return abs
+}
+
+// getRegistryAuth returns the --registry-auth, with the absolute paths of its docker config.json files
+func getRegistryAuth() []string {
+	var auths []string
+	for _, v := range viper.GetStringSlice(registryAuth) {
+		if _, err := os.Stat(v); err == nil {
+			abs, err := filepath.Abs(v)
+			if err != nil {
+				exit.Message(reason.Usage, "Invalid --registry-auth {{.file}}: {{.error}}", out.V{"file": v, "error": err})
+			}
+			v = abs
+		}
+		auths = append(auths, v)
+	}
+	return auths
+}
+
+// getExternalEtcd returns the --external-etcd, with the absolute paths of its files
+func getExternalEtcd() config.ExternalEtcdConfig {
+	e := config.ExternalEtcdConfig{Endpoints: viper.GetStringSlice(externalEtcd)}
+	for flag, p := range map[string]*string{externalEtcdCAFile: &e.CAFile, externalEtcdCertFile: &e.CertFile, externalEtcdKeyFile: &e.KeyFile} {
+		f := viper.GetString(flag)
+		if f == "" {
+			continue
+		}
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			exit.Message(reason.Usage, "Invalid --{{.flag}} {{.file}}: {{.error}}", out.V{"flag": flag, "file": f, "error": err})
+		}
+		*p = abs
+	}
+	return e
+}
+
+// getTTL returns the TTL of --ttl from now. Unlike the clusters of libminikube, it has no owner process: the shell
+// that ran minikube start, eg: of a CI step, often exits right away, while the cluster is meant to outlive it.
+func getTTL() config.TTLConfig {
+	d := viper.GetDuration(clusterTTL)
+	if d == 0 {
+		return config.TTLConfig{}
+	}
+	return config.TTLConfig{Expires: time.Now().Add(d)}
+}
+
+// getKubeadmPatches returns the kubeadm patches in the --kubeadm-patches directory, for kubeadm of kubernetesVersion
+func getKubeadmPatches(kubernetesVersion string) map[string]string {
+	dir := viper.GetString(kubeadmPatches)
+	if dir == "" {
+		return nil
+	}
+	patches, err := readKubeadmPatches(dir, kubernetesVersion)
+	if err != nil {
+		exit.Message(reason.Usage, "Invalid kubeadm patches: {{.error}}", out.V{"error": err})
+	}
+	return patches
+}
+
 func getExtraOptions() config.ExtraOptionSlice {
 	options := []string{}
 	if detect.IsCloudShell() {
@@ -413,6 +588,10 @@ func getExtraOptions() config.ExtraOptionSlice {
 	if viper.GetBool(disableMetrics) {
 		options = append(options, "kubelet.housekeeping-interval=5m")
 	}
+	// set with `minikube config set extra-config` as a comma separated list, unless passed as flags
+	if eo := viper.GetString("extra-config"); eo != "" && len(config.ExtraOptions) == 0 {
+		options = append(options, config.SplitExtraOptions(eo)...)
+	}
 	for _, eo := range options {
 		if config.ExtraOptions.Exists(eo) {
 			klog.Infof("skipping extra-config %q.", eo)
@@ -423,7 +602,8 @@ func getExtraOptions() config.ExtraOptionSlice {
 			exit.Error(reason.InternalConfigSet, "failed to set extra option", err)
 		}
 	}
-	return config.ExtraOptions
+	// the options scoped to a single node are kept in that node, see withNodeExtraOptions
+	return config.ExtraOptions.ForCluster()
 }
 
 func getRepository(cmd *cobra.Command, k8sVersion string) string {
@@ -526,6 +706,7 @@ func generateNewConfigFromFlags(cmd *cobra.Command, k8sVersion string, rtime str
 	cc = config.ClusterConfig{
 		Name:                    ClusterFlagValue(),
 		KeepContext:             viper.GetBool(keepContext),
+		KubeconfigMode:          viper.GetString(kubeconfigMode),
 		EmbedCerts:              viper.GetBool(embedCerts),
 		MinikubeISO:             viper.GetString(isoURL),
 		KicBaseImage:            viper.GetString(kicBaseImage),
@@ -599,14 +780,42 @@ func generateNewConfigFromFlags(cmd *cobra.Command, k8sVersion string, rtime str
 			CRISocket:              viper.GetString(criSocket),
 			NetworkPlugin:          chosenNetworkPlugin,
 			ServiceCIDR:            viper.GetString(serviceCIDR),
+			KubeProxyMode:          viper.GetString(kubeProxyMode),
+			HAVIP:                  viper.GetString(haVIP),
+			HALBMode:               viper.GetString(haLBMode),
+			HABGPPeers:             viper.GetStringSlice(haBGPPeers),
+			DualStack:              viper.GetBool(dualStack),
 			ImageRepository:        getRepository(cmd, k8sVersion),
 			ExtraOptions:           getExtraOptions(),
+			KubeadmPatches:         getKubeadmPatches(k8sVersion),
 			ShouldLoadCachedImages: viper.GetBool(cacheImages),
 			CNI:                    getCNIConfig(cmd),
 		},
 		MultiNodeRequested: viper.GetInt(nodes) > 1 || viper.GetBool(ha),
 		GPUs:               viper.GetString(gpus),
 		AutoPauseInterval:  viper.GetDuration(autoPauseInterval),
+		SharedImageCache:   viper.GetBool(sharedImageCache),
+		MemoryAutoShrink:   viper.GetDuration(memoryAutoShrink),
+		CertsDir:           getCertsDir(),
+		OIDC: config.OIDCConfig{
+			IssuerURL:     viper.GetString(oidcIssuerURL),
+			ClientID:      viper.GetString(oidcClientID),
+			UsernameClaim: viper.GetString(oidcUsernameClaim),
+			GroupsClaim:   viper.GetString(oidcGroupsClaim),
+		},
+		EncryptSecrets:      viper.GetString(encryptSecrets),
+		PodSecurityLevel:    viper.GetString(podSecurityLevel),
+		SeccompDefault:      viper.GetBool(seccompDefault),
+		SecurityProfilesDir: getSecurityProfilesDir(),
+		AuditPolicy:         getAuditPolicy(),
+		ExternalEtcd:        getExternalEtcd(),
+		PullSecrets: config.PullSecretsConfig{
+			Provider:   viper.GetString(pullSecretsProvider),
+			Registry:   viper.GetString(pullSecretsRegistry),
+			Namespaces: viper.GetStringSlice(pullSecretsNamespaces),
+		},
+		RegistryAuth: getRegistryAuth(),
+		TTL:          getTTL(),
 	}
 	cc.VerifyComponents = interpretWaitFlag(*cmd)
 	if viper.GetBool(createMount) && driver.IsKIC(drvName) {
@@ -675,8 +884,8 @@ func validateHANodeCount(cmd *cobra.Command) {
 		return
 	}
 
-	// set total number of nodes in ha (multi-control plane) cluster to 3, if not otherwise defined by user
-	if !cmd.Flags().Changed(nodes) {
+	// set total number of nodes in ha (multi-control plane) cluster to 3, if not otherwise defined by user or by the topology file
+	if !cmd.Flags().Changed(nodes) && topologyNodes == nil {
 		viper.Set(nodes, 3)
 	}
 
@@ -781,6 +990,7 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	}
 
 	updateBoolFromFlag(cmd, &cc.KeepContext, keepContext)
+	updateStringFromFlag(cmd, &cc.KubeconfigMode, kubeconfigMode)
 	updateBoolFromFlag(cmd, &cc.EmbedCerts, embedCerts)
 	updateStringFromFlag(cmd, &cc.MinikubeISO, isoURL)
 	updateStringFromFlag(cmd, &cc.KicBaseImage, kicBaseImage)
@@ -801,6 +1011,7 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	updateStringFromFlag(cmd, &cc.UUID, uuid)
 	updateBoolFromFlag(cmd, &cc.NoVTXCheck, noVTXCheck)
 	updateBoolFromFlag(cmd, &cc.DNSProxy, dnsProxy)
+	updateBoolFromFlag(cmd, &cc.SharedImageCache, sharedImageCache)
 	updateBoolFromFlag(cmd, &cc.HostDNSResolver, hostDNSResolver)
 	updateStringFromFlag(cmd, &cc.HostOnlyNicType, hostOnlyNicType)
 	updateStringFromFlag(cmd, &cc.NatNicType, natNicType)
@@ -819,6 +1030,11 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	updateStringFromFlag(cmd, &cc.KubernetesConfig.CRISocket, criSocket)
 	updateStringFromFlag(cmd, &cc.KubernetesConfig.NetworkPlugin, networkPlugin)
 	updateStringFromFlag(cmd, &cc.KubernetesConfig.ServiceCIDR, serviceCIDR)
+	updateStringFromFlag(cmd, &cc.KubernetesConfig.KubeProxyMode, kubeProxyMode)
+	updateStringFromFlag(cmd, &cc.KubernetesConfig.HAVIP, haVIP)
+	updateStringFromFlag(cmd, &cc.KubernetesConfig.HALBMode, haLBMode)
+	updateStringSliceFromFlag(cmd, &cc.KubernetesConfig.HABGPPeers, haBGPPeers)
+	updateBoolFromFlag(cmd, &cc.KubernetesConfig.DualStack, dualStack)
 	updateBoolFromFlag(cmd, &cc.KubernetesConfig.ShouldLoadCachedImages, cacheImages)
 	updateDurationFromFlag(cmd, &cc.CertExpiration, certExpiration)
 	updateBoolFromFlag(cmd, &cc.Mount, createMount)
@@ -837,6 +1053,55 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	updateStringFromFlag(cmd, &cc.SocketVMnetClientPath, socketVMnetClientPath)
 	updateStringFromFlag(cmd, &cc.SocketVMnetPath, socketVMnetPath)
 	updateDurationFromFlag(cmd, &cc.AutoPauseInterval, autoPauseInterval)
+	updateDurationFromFlag(cmd, &cc.MemoryAutoShrink, memoryAutoShrink)
+	if cmd.Flags().Changed(certsDir) {
+		cc.CertsDir = getCertsDir()
+	}
+	updateStringFromFlag(cmd, &cc.OIDC.IssuerURL, oidcIssuerURL)
+	updateStringFromFlag(cmd, &cc.OIDC.ClientID, oidcClientID)
+	updateStringFromFlag(cmd, &cc.OIDC.UsernameClaim, oidcUsernameClaim)
+	updateStringFromFlag(cmd, &cc.OIDC.GroupsClaim, oidcGroupsClaim)
+	if cmd.Flags().Changed(encryptSecrets) {
+		// the secrets encrypted already could no longer be read
+		if cc.EncryptSecrets != "" && viper.GetString(encryptSecrets) == "" {
+			exit.Message(reason.Usage, "Secrets of an existing cluster cannot be decrypted, to disable encryption delete the cluster first: minikube delete -p {{.profile}}", out.V{"profile": cc.Name})
+		}
+		cc.EncryptSecrets = viper.GetString(encryptSecrets)
+	}
+	updateStringFromFlag(cmd, &cc.PodSecurityLevel, podSecurityLevel)
+	updateBoolFromFlag(cmd, &cc.SeccompDefault, seccompDefault)
+	if cmd.Flags().Changed(securityProfilesDir) {
+		cc.SecurityProfilesDir = getSecurityProfilesDir()
+	}
+	if cmd.Flags().Changed(auditPolicy) {
+		cc.AuditPolicy = getAuditPolicy()
+	}
+	if cmd.Flags().Changed(externalEtcd) {
+		// the data of the cluster is in the etcd it was created with
+		if config.IsExternalEtcd(cc) != (len(viper.GetStringSlice(externalEtcd)) > 0) {
+			exit.Message(reason.Usage, "The etcd of an existing cluster cannot be changed between local and external, delete the cluster first: minikube delete -p {{.profile}}", out.V{"profile": cc.Name})
+		}
+	}
+	e := getExternalEtcd()
+	updateStringSliceFromFlag(cmd, &cc.ExternalEtcd.Endpoints, externalEtcd)
+	if cmd.Flags().Changed(externalEtcdCAFile) {
+		cc.ExternalEtcd.CAFile = e.CAFile
+	}
+	if cmd.Flags().Changed(externalEtcdCertFile) {
+		cc.ExternalEtcd.CertFile = e.CertFile
+	}
+	if cmd.Flags().Changed(externalEtcdKeyFile) {
+		cc.ExternalEtcd.KeyFile = e.KeyFile
+	}
+	updateStringFromFlag(cmd, &cc.PullSecrets.Provider, pullSecretsProvider)
+	updateStringFromFlag(cmd, &cc.PullSecrets.Registry, pullSecretsRegistry)
+	updateStringSliceFromFlag(cmd, &cc.PullSecrets.Namespaces, pullSecretsNamespaces)
+	if cmd.Flags().Changed(registryAuth) {
+		cc.RegistryAuth = getRegistryAuth()
+	}
+	if cmd.Flags().Changed(clusterTTL) {
+		cc.TTL = getTTL()
+	}
 
 	if cmd.Flags().Changed(kubernetesVersion) {
 		kubeVer, err := getKubernetesVersion(existing)
@@ -849,7 +1114,12 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 		cc.KubernetesConfig.ContainerRuntime = getContainerRuntime(existing)
 	}
 
-	if cmd.Flags().Changed("extra-config") {
+	if cmd.Flags().Changed(kubeadmPatches) {
+		cc.KubernetesConfig.KubeadmPatches = getKubeadmPatches(cc.KubernetesConfig.KubernetesVersion)
+	}
+
+	// only scoping options to single nodes keeps the ones of the cluster
+	if cmd.Flags().Changed("extra-config") && len(config.ExtraOptions.ForCluster()) > 0 {
 		cc.KubernetesConfig.ExtraOptions = getExtraOptions()
 	}
 
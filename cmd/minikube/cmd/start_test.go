@@ -928,3 +928,104 @@ func TestValidateAutoPause(t *testing.T) {
 		}
 	}
 }
+
+func TestDryRunPlan(t *testing.T) {
+	for k, v := range map[string]interface{}{nodes: 3, ha: true, installAddons: true, cfg.AddonListFlag: []string{"ingress"}} {
+		old := viper.Get(k)
+		viper.Set(k, v)
+		t.Cleanup(func() { viper.Set(k, old) })
+	}
+	cc := cfg.ClusterConfig{
+		Name:             "p1",
+		Driver:           driver.Docker,
+		KicBaseImage:     "kicbase",
+		CPUs:             2,
+		Memory:           3000,
+		DiskSize:         20000,
+		APIServerPort:    8443,
+		KubernetesConfig: cfg.KubernetesConfig{KubernetesVersion: "v1.30.0", ContainerRuntime: constants.Containerd},
+	}
+	cc.Nodes = []cfg.Node{{ControlPlane: true, Worker: true}}
+
+	p := dryRunPlan(cc, cc.Nodes[0], nil)
+	if p.Action != "create" || p.KicBaseImage != "kicbase" || p.ISO != "" || p.CNI != "kindnet" {
+		t.Errorf("dryRunPlan() = %+v, want a new docker cluster with kindnet", p)
+	}
+	want := []plannedNode{
+		{Name: "p1", ControlPlane: true, Worker: true, CPUs: 2, Memory: 3000, DiskSize: 20000},
+		{Name: "p1-m02", ControlPlane: true, Worker: true, CPUs: 2, Memory: 3000, DiskSize: 20000},
+		{Name: "p1-m03", ControlPlane: true, Worker: true, CPUs: 2, Memory: 3000, DiskSize: 20000},
+	}
+	if fmt.Sprint(p.Nodes) != fmt.Sprint(want) {
+		t.Errorf("nodes = %+v, want %+v", p.Nodes, want)
+	}
+	if !strings.Contains(strings.Join(p.Addons, ","), "ingress") {
+		t.Errorf("addons = %v, want ingress", p.Addons)
+	}
+	if len(p.Steps) == 0 || !strings.HasPrefix(p.Steps[0], "pull the base image") || !strings.HasPrefix(p.Steps[len(p.Steps)-1], "join p1-m03") {
+		t.Errorf("steps = %q", p.Steps)
+	}
+}
+
+func TestValidateHALoadBalancer(t *testing.T) {
+	cps := []cfg.Node{
+		{Name: "", IP: "192.168.49.2", ControlPlane: true},
+		{Name: "m02", IP: "192.168.49.3", ControlPlane: true},
+		{Name: "m03", IP: "192.168.49.4", ControlPlane: true},
+	}
+	tests := []struct {
+		name        string
+		driver      string
+		nodes       []cfg.Node
+		k           cfg.KubernetesConfig
+		shouldError bool
+	}{
+		{"vip", "docker", cps, cfg.KubernetesConfig{HAVIP: "192.168.49.100"}, false},
+		{"arp", "kvm2", cps, cfg.KubernetesConfig{HALBMode: "arp"}, false},
+		{"bgp", "kvm2", cps, cfg.KubernetesConfig{HALBMode: "bgp", HABGPPeers: []string{"192.168.39.1:65001"}}, false},
+		{"haproxy", "podman", cps, cfg.KubernetesConfig{HALBMode: "haproxy", HAVIP: "192.168.49.100"}, false},
+		{"not ha", "docker", cps[:1], cfg.KubernetesConfig{HAVIP: "192.168.49.100"}, true},
+		{"invalid vip", "docker", cps, cfg.KubernetesConfig{HAVIP: "fd00::100"}, true},
+		{"vip of a node", "docker", cps, cfg.KubernetesConfig{HAVIP: "192.168.49.3"}, true},
+		{"unknown mode", "docker", cps, cfg.KubernetesConfig{HALBMode: "nginx"}, true},
+		{"haproxy on a vm", "kvm2", cps, cfg.KubernetesConfig{HALBMode: "haproxy"}, true},
+		{"bgp without peers", "kvm2", cps, cfg.KubernetesConfig{HALBMode: "bgp"}, true},
+		{"peers without bgp", "kvm2", cps, cfg.KubernetesConfig{HABGPPeers: []string{"192.168.39.1:65001"}}, true},
+		{"peer without AS", "kvm2", cps, cfg.KubernetesConfig{HALBMode: "bgp", HABGPPeers: []string{"192.168.39.1"}}, true},
+		{"invalid AS", "kvm2", cps, cfg.KubernetesConfig{HALBMode: "bgp", HABGPPeers: []string{"192.168.39.1:as65001"}}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cc := cfg.ClusterConfig{Name: "minikube", Driver: tc.driver, Nodes: tc.nodes, KubernetesConfig: tc.k}
+			err := validateHALoadBalancer(cc)
+			if err != nil && !tc.shouldError {
+				t.Errorf("validateHALoadBalancer() failed, expected it to pass: %v", err)
+			}
+			if err == nil && tc.shouldError {
+				t.Errorf("validateHALoadBalancer() passed, expected it to fail")
+			}
+		})
+	}
+}
+
+func TestGetExtraOptionsFromConfig(t *testing.T) {
+	old, oldOpts := viper.Get("extra-config"), cfg.ExtraOptions
+	t.Cleanup(func() {
+		viper.Set("extra-config", old)
+		cfg.ExtraOptions = oldOpts
+	})
+	viper.Set("extra-config", "kubelet.eviction-hard=memory.available<5%,nodefs.available<10%,node:m02:kubelet.max-pods=50,apiserver.v=2")
+	cfg.ExtraOptions = nil
+
+	got := getExtraOptions()
+	want := cfg.ExtraOptionSlice{
+		{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<5%,nodefs.available<10%"},
+		{Component: "apiserver", Key: "v", Value: "2"},
+	}
+	if got.String() != want.String() {
+		t.Errorf("getExtraOptions() = %q, want %q", got.String(), want.String())
+	}
+	if !strings.Contains(cfg.ExtraOptions.String(), "node:m02:kubelet.max-pods=50") {
+		t.Errorf("ExtraOptions = %q, want the node:m02: entry kept", cfg.ExtraOptions.String())
+	}
+}
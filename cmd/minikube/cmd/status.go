@@ -22,22 +22,27 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"text/template"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
 	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/provision"
 	"github.com/docker/machine/libmachine/state"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
@@ -57,6 +62,8 @@ var (
 	output       string
 	layout       string
 	watch        time.Duration
+	checkConfig  bool
+	detailed     bool
 )
 
 // Additional legacy states
@@ -141,6 +148,18 @@ type Status struct {
 	TimeToStop string `json:",omitempty"`
 	DockerEnv  string `json:",omitempty"`
 	PodManEnv  string `json:",omitempty"`
+	// Images is the progress of the images loaded in the background after start, only set with --detailed
+	Images string `json:",omitempty"`
+	// CertsExpiry is the time left until the first certificate of a running control-plane node expires
+	CertsExpiry string `json:",omitempty"`
+	// Drift lists the differences between the profile and the node, only set with --check-config
+	Drift []cluster.Drift `json:",omitempty"`
+	// IP, OS, KubernetesVersion, ContainerRuntime and Runtime, the state of the container runtime, are only set with --output wide or json
+	IP                string `json:",omitempty"`
+	OS                string `json:",omitempty"`
+	KubernetesVersion string `json:",omitempty"`
+	ContainerRuntime  string `json:",omitempty"`
+	Runtime           string `json:",omitempty"`
 }
 
 // ClusterState holds a cluster state representation
@@ -181,6 +200,7 @@ const (
 	minikubeNotRunningStatusFlag = 1 << 0
 	clusterNotRunningStatusFlag  = 1 << 1
 	k8sNotRunningStatusFlag      = 1 << 2
+	configDriftStatusFlag        = 1 << 3
 	defaultStatusFormat          = `{{.Name}}
 type: Control Plane
 host: {{.Host}}
@@ -196,6 +216,12 @@ docker-env: {{.DockerEnv}}
 {{- if .PodManEnv }}
 podman-env: {{.PodManEnv}}
 {{- end }}
+{{- if .Images }}
+images: {{.Images}}
+{{- end }}
+{{- if .CertsExpiry }}
+certs: {{.CertsExpiry}}
+{{- end }}
 
 `
 	workerStatusFormat = `{{.Name}}
@@ -212,7 +238,8 @@ var statusCmd = &cobra.Command{
 	Short: "Gets the status of a local Kubernetes cluster",
 	Long: `Gets the status of a local Kubernetes cluster.
 	Exit status contains the status of minikube's VM, cluster and Kubernetes encoded on it's bits in this order from right to left.
-	Eg: 7 meaning: 1 (for minikube NOK) + 2 (for cluster NOK) + 4 (for Kubernetes NOK)`,
+	Eg: 7 meaning: 1 (for minikube NOK) + 2 (for cluster NOK) + 4 (for Kubernetes NOK)
+	With --check-config, 8 is added when the nodes drifted from the profile.`,
 	Run: func(cmd *cobra.Command, _ []string) {
 		output = strings.ToLower(output)
 		if output != "text" && statusFormat != defaultStatusFormat {
@@ -248,6 +275,15 @@ func writeStatusesAtInterval(duration time.Duration, api libmachine.API, cc *con
 			if err != nil {
 				klog.Errorf("status error: %v", err)
 			}
+			if checkConfig {
+				nodeDrift(api, *cc, *n, st)
+			}
+			if detailed && config.IsPrimaryControlPlane(*cc, *n) {
+				st.Images = backgroundImagesStatus(cc.Name)
+			}
+			if output == "wide" || output == "json" {
+				nodeDetails(api, *cc, *n, st)
+			}
 			statuses = append(statuses, st)
 		} else {
 			for _, n := range cc.Nodes {
@@ -262,10 +298,23 @@ func writeStatusesAtInterval(duration time.Duration, api libmachine.API, cc *con
 				if st.Host == Nonexistent {
 					klog.Errorf("The %q host does not exist!", machineName)
 				}
+				if checkConfig {
+					nodeDrift(api, *cc, n, st)
+				}
+				if detailed && config.IsPrimaryControlPlane(*cc, n) {
+					st.Images = backgroundImagesStatus(cc.Name)
+				}
+				if output == "wide" || output == "json" {
+					nodeDetails(api, *cc, n, st)
+				}
 				statuses = append(statuses, st)
 			}
 		}
 
+		if nodeName == "" {
+			updatePromptCache(cc, statuses)
+		}
+
 		switch output {
 		case "text":
 			for _, st := range statuses {
@@ -273,6 +322,10 @@ func writeStatusesAtInterval(duration time.Duration, api libmachine.API, cc *con
 					exit.Error(reason.InternalStatusText, "status text failure", err)
 				}
 			}
+		case "wide":
+			if err := statusWide(statuses, os.Stdout); err != nil {
+				exit.Error(reason.InternalStatusText, "status text failure", err)
+			}
 		case "json":
 			// Layout is currently only supported for JSON mode
 			if layout == "cluster" {
@@ -285,7 +338,7 @@ func writeStatusesAtInterval(duration time.Duration, api libmachine.API, cc *con
 				}
 			}
 		default:
-			exit.Message(reason.Usage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", output))
+			exit.Message(reason.Usage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'wide', 'json'", output))
 		}
 
 		if duration == 0 {
@@ -308,6 +361,12 @@ func exitCode(statuses []*Status) int {
 		if st.Kubeconfig != Configured && st.Kubeconfig != Irrelevant {
 			c |= k8sNotRunningStatusFlag
 		}
+		if len(st.Drift) > 0 {
+			c |= configDriftStatusFlag
+		}
+		if st.Runtime != "" && st.Runtime != state.Running.String() {
+			c |= clusterNotRunningStatusFlag
+		}
 	}
 	return c
 }
@@ -398,6 +457,12 @@ func nodeStatus(api libmachine.API, cc config.ClusterConfig, n config.Node) (*St
 		return st, nil
 	}
 
+	if guest, err := bootstrapper.GuestCertsExpiry(cr, n); err != nil {
+		klog.Warningf("unable to read the certificates of %s: %v", name, err)
+	} else {
+		st.CertsExpiry = certsExpiryText(append(bootstrapper.HostCertsExpiry(cc), guest...))
+	}
+
 	var hostname string
 	var port int
 	if cc.Addons["auto-pause"] {
@@ -413,7 +478,7 @@ func nodeStatus(api libmachine.API, cc config.ClusterConfig, n config.Node) (*St
 	if err != nil {
 		klog.Errorf("forwarded endpoint: %v", err)
 		st.Kubeconfig = Misconfigured
-	} else if err := kubeconfig.VerifyEndpoint(cc.Name, hostname, port, ""); err != nil && st.Host != state.Starting.String() {
+	} else if err := kubeconfig.VerifyEndpoint(cc.Name, hostname, port, kubeconfig.PathForProfile(cc.Name, cc.KubeconfigMode)); err != nil && st.Host != state.Starting.String() {
 		klog.Errorf("kubeconfig endpoint: %v", err)
 		st.Kubeconfig = Misconfigured
 	}
@@ -431,17 +496,143 @@ func nodeStatus(api libmachine.API, cc config.ClusterConfig, n config.Node) (*St
 	return st, nil
 }
 
+// nodeDrift sets the differences between the profile and the running node n on st
+func nodeDrift(api libmachine.API, cc config.ClusterConfig, n config.Node, st *Status) {
+	if st.Host != state.Running.String() {
+		return
+	}
+	name := config.MachineName(cc, n)
+	host, err := machine.LoadHost(api, name)
+	if err != nil {
+		klog.Errorf("load host %s: %v", name, err)
+		return
+	}
+	cr, err := machine.CommandRunner(host)
+	if err != nil {
+		klog.Errorf("command runner %s: %v", name, err)
+		return
+	}
+	drift, err := cluster.CheckConfig(cc, n, cr)
+	if err != nil {
+		klog.Errorf("check config of %s: %v", name, err)
+	}
+	st.Drift = drift
+}
+
+// nodeDetails sets the IP, OS, Kubernetes version and container runtime of the node n on st, and the state of its container runtime
+func nodeDetails(api libmachine.API, cc config.ClusterConfig, n config.Node, st *Status) {
+	st.IP = n.IP
+	st.KubernetesVersion = n.KubernetesVersion
+	st.ContainerRuntime = n.ContainerRuntime
+	if st.ContainerRuntime == "" {
+		st.ContainerRuntime = cc.KubernetesConfig.ContainerRuntime
+	}
+	if st.Host != state.Running.String() && st.Host != codeNames[InsufficientStorage] {
+		st.Runtime = st.Kubelet
+		return
+	}
+
+	name := config.MachineName(cc, n)
+	host, err := machine.LoadHost(api, name)
+	if err != nil {
+		klog.Errorf("load host %s: %v", name, err)
+		return
+	}
+	r, err := machine.CommandRunner(host)
+	if err != nil {
+		klog.Errorf("command runner %s: %v", name, err)
+		return
+	}
+	if rr, err := r.RunCmd(exec.Command("cat", "/etc/os-release")); err != nil {
+		klog.Errorf("os-release of %s: %v", name, err)
+	} else if osr, err := provision.NewOsRelease(rr.Stdout.Bytes()); err == nil {
+		st.OS = osr.PrettyName
+	}
+	cr, err := cruntime.New(cruntime.Config{Type: st.ContainerRuntime, Runner: r, Socket: cc.KubernetesConfig.CRISocket})
+	if err != nil {
+		klog.Errorf("container runtime of %s: %v", name, err)
+		st.Runtime = state.Error.String()
+		return
+	}
+	st.Runtime = state.Stopped.String()
+	if cr.Active() {
+		st.Runtime = state.Running.String()
+	}
+}
+
+// statusWide writes the statuses as a table, one node per row, with the details of nodeDetails
+func statusWide(sts []*Status, w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tTYPE\tHOST\tKUBELET\tAPISERVER\tRUNTIME\tIP\tOS\tVERSION\tCONTAINER-RUNTIME")
+	for _, st := range sts {
+		typ := "Control Plane"
+		if st.Worker {
+			typ = "Worker"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", st.Name, typ, st.Host, st.Kubelet, st.APIServer,
+			orNone(st.Runtime), orNone(st.IP), orNone(st.OS), orNone(st.KubernetesVersion), orNone(st.ContainerRuntime))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, st := range sts {
+		if st.Kubeconfig == Misconfigured {
+			_, err := w.Write([]byte("\nWARNING: Your kubectl is pointing to stale minikube-vm.\nTo fix the kubectl context, run `minikube update-context`\n"))
+			return err
+		}
+	}
+	return nil
+}
+
+// orNone returns s, or <none> if it is empty, as kubectl shows the empty columns
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
+
+// certsExpiryText describes the time left until the first of certs expires
+func certsExpiryText(certs []bootstrapper.CertExpiry) string {
+	if len(certs) == 0 {
+		return ""
+	}
+	days := certsDaysLeft(certs, time.Now())
+	if days < 0 {
+		return "expired, run 'minikube certs rotate'"
+	}
+	if days == 1 {
+		return "expire in 1 day"
+	}
+	return fmt.Sprintf("expire in %d days", days)
+}
+
+// backgroundImagesStatus returns the progress of the images loaded in the background by start, or "" if there were none
+func backgroundImagesStatus(profile string) string {
+	bi, err := node.ReadBackgroundImages(profile)
+	if err != nil {
+		klog.Errorf("background images of %s: %v", profile, err)
+		return ""
+	}
+	if bi == nil {
+		return ""
+	}
+	return bi.Status()
+}
+
 func init() {
 	statusCmd.Flags().StringVarP(&statusFormat, "format", "f", defaultStatusFormat,
 		`Go template format string for the status output.  The format for Go templates can be found here: https://pkg.go.dev/text/template
 For the list accessible variables for the template, see the struct values here: https://pkg.go.dev/k8s.io/minikube/cmd/minikube/cmd#Status`)
 	statusCmd.Flags().StringVarP(&output, "output", "o", "text",
-		`minikube status --output OUTPUT. json, text`)
+		`minikube status --output OUTPUT. json, text, or wide for a table of the nodes with their IP, OS, Kubernetes version and container runtime`)
 	statusCmd.Flags().StringVarP(&layout, "layout", "l", "nodes",
 		`output layout (EXPERIMENTAL, JSON only): 'nodes' or 'cluster'`)
 	statusCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to check status for. Defaults to control plane. Leave blank with default format for status on all nodes.")
 	statusCmd.Flags().DurationVarP(&watch, "watch", "w", 1*time.Second, "Continuously listing/getting the status with optional interval duration.")
 	statusCmd.Flags().Lookup("watch").NoOptDefVal = "1s"
+	statusCmd.Flags().BoolVar(&detailed, "detailed", false, "Also show the progress of the images that start loads in the background.")
+	statusCmd.Flags().BoolVar(&checkConfig, "check-config", false, "Compare the profile against the running nodes (memory, mounts, registries, addons and node labels) and report any drift with the command to reconcile it.")
 }
 
 func statusText(st *Status, w io.Writer) error {
@@ -455,6 +646,11 @@ func statusText(st *Status, w io.Writer) error {
 	if err := tmpl.Execute(w, st); err != nil {
 		return err
 	}
+	if len(st.Drift) > 0 && statusFormat == defaultStatusFormat {
+		if err := driftText(st.Drift, w); err != nil {
+			return err
+		}
+	}
 	if st.Kubeconfig == Misconfigured {
 		_, err := w.Write([]byte("\nWARNING: Your kubectl is pointing to stale minikube-vm.\nTo fix the kubectl context, run `minikube update-context`\n"))
 		return err
@@ -462,6 +658,19 @@ func statusText(st *Status, w io.Writer) error {
 	return nil
 }
 
+func driftText(drift []cluster.Drift, w io.Writer) error {
+	if _, err := fmt.Fprintln(w, "config drift:"); err != nil {
+		return err
+	}
+	for _, d := range drift {
+		if _, err := fmt.Fprintf(w, "  %s: saved %s, actual %s\n    fix: %s\n", d.Setting, d.Saved, d.Actual, d.Fix); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
 func statusJSON(st []*Status, w io.Writer) error {
 	var js []byte
 	var err error
@@ -20,6 +20,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/cluster"
 )
 
 func TestExitCode(t *testing.T) {
@@ -32,6 +36,8 @@ func TestExitCode(t *testing.T) {
 		{"paused", 2, &Status{Host: "Running", Kubelet: "Stopped", APIServer: "Paused", Kubeconfig: Configured}},
 		{"down", 7, &Status{Host: "Stopped", Kubelet: "Stopped", APIServer: "Stopped", Kubeconfig: Misconfigured}},
 		{"missing", 7, &Status{Host: "Nonexistent", Kubelet: "Nonexistent", APIServer: "Nonexistent", Kubeconfig: "Nonexistent"}},
+		{"drift", 8, &Status{Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, Drift: []cluster.Drift{{Setting: "memory"}}}},
+		{"runtime down", 2, &Status{Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, Runtime: "Stopped"}},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -64,6 +70,21 @@ func TestStatusText(t *testing.T) {
 			state: &Status{Name: "minikube", Host: "Stopped", Kubelet: "Stopped", APIServer: "Stopped", Kubeconfig: Misconfigured},
 			want:  "minikube\ntype: Control Plane\nhost: Stopped\nkubelet: Stopped\napiserver: Stopped\nkubeconfig: Misconfigured\n\n\nWARNING: Your kubectl is pointing to stale minikube-vm.\nTo fix the kubectl context, run `minikube update-context`\n",
 		},
+		{
+			name:  "images",
+			state: &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, Images: "Loading (2/5)"},
+			want:  "minikube\ntype: Control Plane\nhost: Running\nkubelet: Running\napiserver: Running\nkubeconfig: Configured\nimages: Loading (2/5)\n\n",
+		},
+		{
+			name:  "certs",
+			state: &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, CertsExpiry: "expire in 364 days"},
+			want:  "minikube\ntype: Control Plane\nhost: Running\nkubelet: Running\napiserver: Running\nkubeconfig: Configured\ncerts: expire in 364 days\n\n",
+		},
+		{
+			name:  "drift",
+			state: &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, Drift: []cluster.Drift{{Setting: "addon dashboard", Saved: "enabled", Actual: "disabled", Fix: "minikube addons enable dashboard -p minikube"}}},
+			want:  "minikube\ntype: Control Plane\nhost: Running\nkubelet: Running\napiserver: Running\nkubeconfig: Configured\n\nconfig drift:\n  addon dashboard: saved enabled, actual disabled\n    fix: minikube addons enable dashboard -p minikube\n\n",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -81,6 +102,42 @@ func TestStatusText(t *testing.T) {
 	}
 }
 
+func TestCertsDaysLeft(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	certs := []bootstrapper.CertExpiry{
+		{Path: "ca.crt", NotAfter: now.AddDate(10, 0, 0)},
+		{Path: "apiserver.crt", NotAfter: now.AddDate(0, 0, 364)},
+		{Path: "apiserver-kubelet-client.crt", NotAfter: now.AddDate(0, 0, 30).Add(-time.Hour)},
+	}
+	if got := certsDaysLeft(certs, now); got != 29 {
+		t.Errorf("certsDaysLeft() = %d, want: 29", got)
+	}
+	if got := certsDaysLeft(certs, now.AddDate(0, 0, 30)); got != -1 {
+		t.Errorf("certsDaysLeft() after the expiry = %d, want: -1", got)
+	}
+	if got := certsExpiryText(nil); got != "" {
+		t.Errorf("certsExpiryText(nil) = %q, want: \"\"", got)
+	}
+}
+
+func TestStatusWide(t *testing.T) {
+	sts := []*Status{
+		{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, Runtime: "Running", IP: "192.168.49.2", OS: "Ubuntu 22.04.4 LTS", KubernetesVersion: "v1.30.1", ContainerRuntime: "containerd"},
+		{Name: "minikube-m02", Host: "Stopped", Kubelet: "Stopped", APIServer: Irrelevant, Kubeconfig: Irrelevant, Worker: true, Runtime: "Stopped", KubernetesVersion: "v1.30.1", ContainerRuntime: "containerd"},
+	}
+	want := `NAME          TYPE           HOST     KUBELET  APISERVER   RUNTIME  IP            OS                  VERSION  CONTAINER-RUNTIME
+minikube      Control Plane  Running  Running  Running     Running  192.168.49.2  Ubuntu 22.04.4 LTS  v1.30.1  containerd
+minikube-m02  Worker         Stopped  Stopped  Irrelevant  Stopped  <none>        <none>              v1.30.1  containerd
+`
+	var b bytes.Buffer
+	if err := statusWide(sts, &b); err != nil {
+		t.Fatalf("statusWide() error: %v", err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("statusWide() = \n%s, want:\n%s", got, want)
+	}
+}
+
 func TestStatusJSON(t *testing.T) {
 	var tests = []struct {
 		name  string
@@ -19,6 +19,8 @@ package cmd
 import (
 	"os"
 	"runtime"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/machine/libmachine"
@@ -27,7 +29,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	kubevip "k8s.io/minikube/pkg/minikube/cluster/ha/kube-vip"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
@@ -46,6 +51,7 @@ var (
 	keepActive            bool
 	scheduledStopDuration time.Duration
 	cancelScheduledStop   bool
+	nodeStopTimeout       time.Duration
 )
 
 // stopCmd represents the stop command
@@ -61,7 +67,9 @@ func init() {
 	stopCmd.Flags().BoolVar(&keepActive, "keep-context-active", false, "keep the kube-context active after cluster is stopped. Defaults to false.")
 	stopCmd.Flags().DurationVar(&scheduledStopDuration, "schedule", 0*time.Second, "Set flag to stop cluster after a set amount of time (e.g. --schedule=5m)")
 	stopCmd.Flags().BoolVar(&cancelScheduledStop, "cancel-scheduled", false, "cancel any existing scheduled stop requests")
+	stopCmd.Flags().DurationVar(&nodeStopTimeout, "node-timeout", 2*time.Minute, "The time to wait for each node to stop, before forcing it off")
 	stopCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Format to print stdout in. Options include: [text,json]")
+	addLockTimeoutFlag(stopCmd)
 
 	if err := viper.GetViper().BindPFlags(stopCmd.Flags()); err != nil {
 		exit.Error(reason.InternalBindFlags, "unable to bind flags", err)
@@ -127,6 +135,8 @@ func stopProfile(profile string) int {
 	register.Reg.SetStep(register.Stopping)
 
 	// end new code
+	defer mustLockProfile(profile).Release()
+	defer invalidatePromptCache(profile)
 	api, cc := mustload.Partial(profile)
 	defer api.Close()
 
@@ -134,19 +144,61 @@ func stopProfile(profile string) int {
 		out.WarningT("Unable to kill mount process: {{.error}}", out.V{"error": err})
 	}
 
-	// stop nodes in reverse order, so last one being primary control-plane node, that will start first next time
-	for i := len(cc.Nodes) - 1; i >= 0; i-- {
-		n := cc.Nodes[i]
-		machineName := config.MachineName(*cc, n)
+	// the nodes are stopped in parallel, but the primary control-plane node last, so that it is the one that will start first next time
+	var secondary []string
+	// warm nodes are started again with the cluster, and do not count as stopped nodes
+	warm := map[string]bool{}
+	for _, n := range cc.WarmNodes {
+		warm[config.MachineName(*cc, n)] = true
+		secondary = append(secondary, config.MachineName(*cc, n))
+	}
+	var primary []string
+	for _, n := range cc.Nodes {
+		if config.IsPrimaryControlPlane(*cc, n) {
+			primary = append(primary, config.MachineName(*cc, n))
+		} else {
+			secondary = append(secondary, config.MachineName(*cc, n))
+		}
+	}
+
+	var failed []string
+	for _, machines := range [][]string{secondary, primary} {
+		for _, r := range stopMachines(api, machines) {
+			switch {
+			case r.err != nil:
+				out.FailureT(`Failed to stop node "{{.name}}": {{.error}}`, out.V{"name": r.name, "error": r.err})
+				failed = append(failed, r.name)
+			case !r.nonexistent && !warm[r.name]:
+				stoppedNodes++
+			}
+		}
+	}
+	if len(failed) > 0 {
+		exit.Message(reason.GuestStopTimeout, "Unable to stop {{.nodes}}", out.V{"nodes": strings.Join(failed, ", ")})
+	}
+
+	if cc.SharedImageCache && driver.IsKIC(cc.Driver) {
+		ociBin := oci.Docker
+		if cc.Driver == driver.Podman {
+			ociBin = oci.Podman
+		}
+		if err := oci.StopRegistryMirror(ociBin, cc.Name); err != nil {
+			klog.Warningf("unable to stop the shared registry mirror: %v", err)
+		}
+	}
 
-		nonexistent := stop(api, machineName)
-		if !nonexistent {
-			stoppedNodes++
+	if kubevip.Mode(*cc) == kubevip.ModeHAProxy && driver.IsKIC(cc.Driver) {
+		ociBin := oci.Docker
+		if cc.Driver == driver.Podman {
+			ociBin = oci.Podman
+		}
+		if err := oci.StopHAProxy(ociBin, cc.Name); err != nil {
+			klog.Warningf("unable to stop the load balancer of the API servers: %v", err)
 		}
 	}
 
 	if !keepActive {
-		if err := kubeconfig.DeleteContext(profile, kubeconfig.PathFromEnv()); err != nil {
+		if err := kubeconfig.DeleteContext(profile, kubeconfig.PathForProfile(profile, cc.KubeconfigMode)); err != nil {
 			exit.Error(reason.HostKubeconfigDeleteCtx, "delete ctx", err)
 		}
 	}
@@ -154,7 +206,53 @@ func stopProfile(profile string) int {
 	return stoppedNodes
 }
 
-func stop(api libmachine.API, machineName string) bool {
+// stopResult is the outcome of stopping the machine of a node
+type stopResult struct {
+	name        string
+	nonexistent bool
+	err         error
+}
+
+// stopMachines stops the machines in parallel, each one within nodeStopTimeout, and returns their results in the same order
+func stopMachines(api libmachine.API, machines []string) []stopResult {
+	results := make([]stopResult, len(machines))
+	var wg sync.WaitGroup
+	for i, m := range machines {
+		wg.Add(1)
+		go func(i int, m string) {
+			defer wg.Done()
+			nonexistent, err := stopWithTimeout(api, m)
+			results[i] = stopResult{name: m, nonexistent: nonexistent, err: err}
+		}(i, m)
+	}
+	wg.Wait()
+	return results
+}
+
+// stopWithTimeout stops the machine, and forces it off if it does not stop within nodeStopTimeout
+func stopWithTimeout(api libmachine.API, machineName string) (bool, error) {
+	type result struct {
+		nonexistent bool
+		err         error
+	}
+	// buffered, as a stuck stop is left behind
+	done := make(chan result, 1)
+	go func() {
+		nonexistent, err := stop(api, machineName)
+		done <- result{nonexistent, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.nonexistent, r.err
+	case <-time.After(nodeStopTimeout):
+		klog.Warningf("%s did not stop within %s", machineName, nodeStopTimeout)
+		out.WarningT(`"{{.name}}" did not stop within {{.timeout}}`, out.V{"name": machineName, "timeout": nodeStopTimeout})
+		return false, machine.KillHost(api, machineName)
+	}
+}
+
+func stop(api libmachine.API, machineName string) (bool, error) {
 	nonexistent := false
 
 	tryStop := func() (err error) {
@@ -175,8 +273,8 @@ func stop(api libmachine.API, machineName string) bool {
 	}
 
 	if err := retry.Expo(tryStop, 1*time.Second, 120*time.Second, 5); err != nil {
-		exit.Error(reason.GuestStopTimeout, "Unable to stop VM", err)
+		return false, errors.Wrap(err, "Unable to stop VM")
 	}
 
-	return nonexistent
+	return nonexistent, nil
 }
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// ttlCmd is the process started by 'minikube start --ttl' and by the clusters of libminikube with a TTL
+var ttlCmd = &cobra.Command{
+	Use:    "ttl",
+	Short:  "Deletes an ephemeral cluster once it expires or the process that created it exits",
+	Long:   "Deletes the cluster once its --ttl expires, or the process that created it exits. Started by 'minikube start --ttl', it exits once the cluster is deleted or its TTL is unset.",
+	Hidden: true,
+	Run: func(_ *cobra.Command, _ []string) {
+		// outlive the terminal and the interrupts of the owner, whose exit is what deletes the cluster
+		signal.Ignore(os.Interrupt, syscall.SIGHUP)
+
+		cname := ClusterFlagValue()
+		expired, err := node.WatchTTL(cname)
+		if err != nil {
+			exit.Error(reason.GuestTTL, "Failed to watch the TTL of the cluster", err)
+		}
+		if !expired {
+			return
+		}
+		profile, err := config.LoadProfile(cname)
+		if err != nil {
+			exit.Error(reason.GuestTTL, "Failed to load the expired cluster", err)
+		}
+		klog.Infof("deleting the expired cluster %s", cname)
+		if errs := DeleteProfiles([]*config.Profile{profile}); len(errs) > 0 {
+			HandleDeletionErrors(errs)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(ttlCmd)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/libminikube"
+	"k8s.io/minikube/pkg/minikube/browser"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/tui"
+)
+
+// uiRefresh is how often the UI reloads the status of the cluster
+const uiRefresh = 5 * time.Second
+
+// uiCmd represents the ui command
+var uiCmd = &cobra.Command{
+	Use:   "ui",
+	Short: "Manages the clusters in an interactive terminal UI",
+	Long: `Shows the clusters, and the nodes, addons, services and recent events of the selected cluster, in an interactive terminal UI.
+The nodes can be started, stopped, paused and unpaused, the addons enabled and disabled, and the services opened in the browser.`,
+	Run: func(_ *cobra.Command, _ []string) {
+		if !out.IsTerminal(os.Stdin) || !out.IsTerminal(os.Stdout) {
+			exit.Message(reason.Usage, "minikube ui must be run in a terminal")
+		}
+		m := tui.New(libminikube.New(libminikube.Options{}), browser.OpenURL)
+		m.Select(ClusterFlagValue())
+		if err := tui.Run(m, os.Stdin, os.Stdout, uiRefresh); err != nil {
+			exit.Error(reason.InternalUI, "Unable to run the terminal UI", err)
+		}
+	},
+}
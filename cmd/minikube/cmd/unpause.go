@@ -95,6 +95,7 @@ var unpauseCmd = &cobra.Command{
 			ids = append(ids, uids...)
 		}
 
+		invalidatePromptCache(co.Config.Name)
 		register.Reg.SetStep(register.Done)
 
 		if namespaces == nil {
@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/mustload"
@@ -35,9 +36,11 @@ var updateContextCmd = &cobra.Command{
 	Run: func(_ *cobra.Command, _ []string) {
 		cname := ClusterFlagValue()
 		co := mustload.Running(cname)
+		kubeconfigPath := kubeconfig.PathForProfile(cname, co.Config.KubeconfigMode)
 		//	cluster extension metada for kubeconfig
 
-		updated, err := kubeconfig.UpdateEndpoint(cname, co.CP.Hostname, co.CP.Port, kubeconfig.PathFromEnv(), kubeconfig.NewExtension())
+		keepContext := config.KeepsContext(*co.Config)
+		updated, err := kubeconfig.UpdateEndpoint(cname, co.CP.Hostname, co.CP.Port, kubeconfigPath, kubeconfig.NewExtension(), keepContext)
 		if err != nil {
 			exit.Error(reason.HostKubeconfigUpdate, "update config", err)
 		}
@@ -47,7 +50,9 @@ var updateContextCmd = &cobra.Command{
 			out.Styled(style.Meh, `No changes required for the "{{.context}}" context`, out.V{"context": cname})
 		}
 
-		if err := kubeconfig.SetCurrentContext(cname, kubeconfig.PathFromEnv()); err != nil {
+		if keepContext {
+			out.Styled(style.Kubectl, "To connect to this cluster, use: --context={{.context}}", out.V{"context": cname})
+		} else if err := kubeconfig.SetCurrentContext(cname, kubeconfigPath); err != nil {
 			out.ErrT(style.Sad, `Error while setting kubectl current context:  {{.error}}`, out.V{"error": err})
 		} else {
 			out.Styled(style.Kubectl, `Current context is "{{.context}}"`, out.V{"context": cname})
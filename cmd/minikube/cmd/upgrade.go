@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/version"
+)
+
+var upgradeK8sVersion string
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade the Kubernetes version of a running cluster in place",
+	Long: `Upgrades the Kubernetes version of a running cluster in place, without deleting it: kubeadm upgrades the control-plane nodes, then the workers are drained, upgraded and uncordoned one at a time.
+Kubernetes is upgraded one minor version at a time, eg: from v1.29 to v1.30. A failed upgrade is resumed by running the command again.`,
+	Example: "minikube upgrade --kubernetes-version=v1.30.1",
+	Run: func(_ *cobra.Command, args []string) {
+		if len(args) != 0 || upgradeK8sVersion == "" {
+			exit.Message(reason.Usage, "Usage: minikube upgrade --kubernetes-version=<version>")
+		}
+		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+
+		co := mustload.Healthy(cname)
+		from := co.Config.KubernetesConfig.KubernetesVersion
+		to, err := upgradeVersion(from, upgradeK8sVersion)
+		if err != nil {
+			exit.Message(reason.Usage, "Unable to upgrade Kubernetes {{.old}}: {{.error}}", out.V{"old": from, "error": err})
+		}
+		if err := node.Upgrade(co.API, co.Config, to); err != nil {
+			exit.Error(reason.KubernetesUpgradeFailed, "Unable to upgrade Kubernetes", err)
+		}
+		out.Step(style.Ready, "Upgraded \"{{.name}}\" from Kubernetes {{.old}} to {{.new}}", out.V{"name": cname, "old": from, "new": to})
+	},
+}
+
+// upgradeVersion returns the version that a cluster of Kubernetes from is upgraded to, for the --kubernetes-version to,
+// which can also be stable or latest, as for minikube start.
+// kubeadm upgrades one minor version at a time, and never downgrades.
+func upgradeVersion(from, to string) (string, error) {
+	switch to {
+	case "stable":
+		to = constants.DefaultKubernetesVersion
+	case "latest":
+		to = constants.NewestKubernetesVersion
+	}
+	if !strings.HasPrefix(to, version.VersionPrefix) {
+		to = version.VersionPrefix + to
+	}
+	if from == constants.NoKubernetesVersion {
+		return "", fmt.Errorf("the cluster runs without Kubernetes")
+	}
+	fv, err := semver.Make(strings.TrimPrefix(from, version.VersionPrefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid version of the cluster %q: %v", from, err)
+	}
+	tv, err := semver.Make(strings.TrimPrefix(to, version.VersionPrefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid version %q: %v", to, err)
+	}
+	switch {
+	case tv.EQ(fv):
+		return "", fmt.Errorf("the cluster runs %s already", to)
+	case tv.LT(fv):
+		return "", fmt.Errorf("%s is older, and kubeadm does not downgrade clusters", to)
+	case tv.Major != fv.Major || tv.Minor > fv.Minor+1:
+		return "", fmt.Errorf("kubeadm upgrades one minor version at a time, upgrade to %s%d.%d first", version.VersionPrefix, fv.Major, fv.Minor+1)
+	}
+	return to, nil
+}
+
+func init() {
+	upgradeCmd.Flags().StringVar(&upgradeK8sVersion, kubernetesVersion, "", "The Kubernetes version to upgrade to, eg: v1.30.1, or stable or latest")
+	addLockTimeoutFlag(upgradeCmd)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/constants"
+)
+
+func TestUpgradeVersion(t *testing.T) {
+	tests := []struct {
+		description string
+		from        string
+		to          string
+		want        string
+		err         string
+	}{
+		{"patch", "v1.30.0", "v1.30.1", "v1.30.1", ""},
+		{"minor", "v1.29.5", "v1.30.1", "v1.30.1", ""},
+		{"without prefix", "v1.29.5", "1.30.1", "v1.30.1", ""},
+		{"stable", "v1.29.5", "stable", constants.DefaultKubernetesVersion, ""},
+		{"same", "v1.30.1", "v1.30.1", "", "runs v1.30.1 already"},
+		{"downgrade", "v1.30.1", "v1.29.5", "", "does not downgrade"},
+		{"two minors", "v1.28.3", "v1.30.1", "", "upgrade to v1.29 first"},
+		{"invalid", "v1.30.1", "v1.x", "", "invalid version"},
+		{"no kubernetes", constants.NoKubernetesVersion, "v1.30.1", "", "without Kubernetes"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := upgradeVersion(tc.from, tc.to)
+			if tc.err == "" && err != nil || tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("upgradeVersion(%q, %q) = %v, want %q", tc.from, tc.to, err, tc.err)
+			}
+			if got != tc.want {
+				t.Errorf("upgradeVersion(%q, %q) = %q, want %q", tc.from, tc.to, got, tc.want)
+			}
+		})
+	}
+}
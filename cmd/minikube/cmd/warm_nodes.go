@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/reason"
+)
+
+// warmNodesCmd is the process started by 'minikube start' and 'minikube node add' with the warm-nodes setting
+var warmNodesCmd = &cobra.Command{
+	Use:    "warm-nodes",
+	Short:  "Boots the guests kept ready to join a cluster",
+	Long:   "Boots the guests kept ready to join a cluster, as many as the warm-nodes setting, so that 'minikube node add' only has to join one of them. Started by 'minikube start' and 'minikube node add'.",
+	Hidden: true,
+	Run: func(_ *cobra.Command, _ []string) {
+		cname := ClusterFlagValue()
+		defer mustLockProfile(cname).Release()
+
+		cc, err := config.Load(cname)
+		if err != nil {
+			exit.Error(reason.HostConfigLoad, "Error getting cluster config", err)
+		}
+		if err := node.WarmNodes(cc); err != nil {
+			exit.Error(reason.GuestNodeWarm, "Failed to warm nodes", err)
+		}
+	},
+}
+
+func init() {
+	addLockTimeoutFlag(warmNodesCmd)
+	RootCmd.AddCommand(warmNodesCmd)
+}
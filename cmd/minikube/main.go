@@ -70,6 +70,7 @@ var (
 )
 
 func main() {
+	localpath.ApplySharedHome()
 	bridgeLogMessages()
 	defer klog.Flush()
 
@@ -178,4 +178,8 @@ var (
 	// YakdAssets assets for yakd addon
 	//go:embed yakd/*.yaml yakd/*.tmpl
 	YakdAssets embed.FS
+
+	// DexAssets assets for dex addon
+	//go:embed dex/*.tmpl
+	DexAssets embed.FS
 )
@@ -60,7 +60,7 @@ func enableOrDisableAutoPause(cc *config.ClusterConfig, name, val string) error
 		}
 	}
 
-	updated, err := kubeconfig.UpdateEndpoint(cc.Name, co.CP.Hostname, port, kubeconfig.PathFromEnv(), kubeconfig.NewExtension())
+	updated, err := kubeconfig.UpdateEndpoint(cc.Name, co.CP.Hostname, port, kubeconfig.PathForProfile(cc.Name, cc.KubeconfigMode), kubeconfig.NewExtension(), config.KeepsContext(*cc))
 	if err != nil {
 		klog.ErrorS(err, "failed to update kubeconfig", "auto-pause proxy endpoint")
 		return err
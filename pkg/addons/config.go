@@ -242,4 +242,9 @@ var Addons = []*Addon{
 		set:       SetBool,
 		callbacks: []setFn{EnableOrDisableAddon},
 	},
+	{
+		name:      "dex",
+		set:       SetBool,
+		callbacks: []setFn{EnableOrDisableAddon},
+	},
 }
@@ -34,6 +34,7 @@ import (
 	"github.com/pkg/errors"
 
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/util"
 )
 
@@ -170,6 +171,10 @@ func MakeDiskImage(d *drivers.BaseDriver, boot2dockerURL string, diskSize int) e
 
 func fixMachinePermissions(path string) error {
 	klog.Infof("Fixing permissions on %s ...", path)
+	// files of a shared minikube home may belong to any member of its group, which can not chown them
+	if localpath.SharedHome() {
+		return localpath.Share(path)
+	}
 	if err := os.Chown(path, syscall.Getuid(), syscall.Getegid()); err != nil {
 		return errors.Wrap(err, "chown dir")
 	}
@@ -99,7 +99,7 @@ func (d *Driver) Create() error {
 		networkName = d.NodeConfig.ClusterName
 	}
 	staticIP := d.NodeConfig.StaticIP
-	if gateway, err := oci.CreateNetwork(d.OCIBinary, networkName, d.NodeConfig.Subnet, staticIP); err != nil {
+	if gateway, err := oci.CreateNetwork(d.OCIBinary, networkName, d.NodeConfig.Subnet, staticIP, d.NodeConfig.IPv6); err != nil {
 		msg := "Unable to create dedicated network, this might result in cluster IP change after restart: {{.error}}"
 		args := out.V{"error": err}
 		if staticIP != "" {
@@ -114,7 +114,8 @@ func (d *Driver) Create() error {
 		ip := gateway.To4()
 		// calculate the container IP based on guessing the machine index
 		index := driver.IndexFromMachineName(d.NodeConfig.MachineName)
-		if int(ip[3])+index > 253 { // reserve last client ip address for multi-control-plane loadbalancer vip address in ha cluster
+		// reserve the last client ip address for multi-control-plane loadbalancer vip address in ha cluster, and the one before for the registry mirror
+		if int(ip[3])+index > 252 {
 			return fmt.Errorf("too many machines to calculate an IP")
 		}
 		ip[3] += byte(index)
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// haproxyImage is the load balancer of the API servers of an HA cluster with --ha-lb-mode=haproxy
+	haproxyImage = "haproxy:2.8-alpine"
+	// haproxyConfigLabelKey is the hash of the config the haproxy container was created with, to recreate it when that changes
+	haproxyConfigLabelKey = "haproxy.minikube.sigs.k8s.io"
+)
+
+// HAProxyName returns the name of the container of the load balancer of the API servers of an HA cluster
+func HAProxyName(clusterName string) string {
+	return clusterName + "-haproxy"
+}
+
+// EnsureHAProxy runs haproxy on the virtual IP vip of the API servers of an HA cluster, in the network of the cluster,
+// balancing the connections to port across the API servers at backends. It is recreated if vip, port or backends changed.
+// Its container is labeled like the primary node, so that it is deleted with the cluster.
+func EnsureHAProxy(ociBin string, clusterName string, network string, vip string, port int, backends []string) error {
+	name := HAProxyName(clusterName)
+	cfg := haproxyConfig(port, backends)
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(vip+"\n"+cfg)))
+
+	exists, err := ContainerExists(ociBin, name)
+	if err != nil {
+		return errors.Wrap(err, "container exists")
+	}
+	if exists {
+		current, err := inspect(ociBin, name, fmt.Sprintf("{{index .Config.Labels %q}}", haproxyConfigLabelKey))
+		if err == nil && len(current) == 1 && current[0] == hash {
+			if running, _ := ContainerRunning(ociBin, name); !running {
+				return errors.Wrap(StartContainer(ociBin, name), "start")
+			}
+			return nil
+		}
+		klog.Infof("recreating %s, as its config changed", name)
+		if err := DeleteContainer(context.Background(), ociBin, name); err != nil {
+			return errors.Wrap(err, "delete")
+		}
+	}
+
+	klog.Infof("creating haproxy %s on %s in network %s, with backends %v", name, vip, network, backends)
+	args := []string{"run", "-d", "--name", name, "--hostname", name, "--network", network, "--ip", vip,
+		"--label", fmt.Sprintf("%s=%s", CreatedByLabelKey, "true"),
+		"--label", fmt.Sprintf("%s=%s", ProfileLabelKey, clusterName),
+		"--label", fmt.Sprintf("%s=%s", haproxyConfigLabelKey, hash),
+		"--env", "HAPROXY_CFG=" + cfg,
+		"--entrypoint", "/bin/sh", haproxyImage,
+		"-c", `printf '%s' "$HAPROXY_CFG" > /tmp/haproxy.cfg && exec haproxy -W -db -f /tmp/haproxy.cfg`}
+	if _, err := runCmd(exec.Command(ociBin, args...)); err != nil {
+		return errors.Wrap(err, "run")
+	}
+	return nil
+}
+
+// StopHAProxy stops the load balancer of the API servers of an HA cluster, if it is running
+func StopHAProxy(ociBin string, clusterName string) error {
+	name := HAProxyName(clusterName)
+	if running, _ := ContainerRunning(ociBin, name); !running {
+		return nil
+	}
+	_, err := runCmd(exec.Command(ociBin, "stop", name))
+	return err
+}
+
+// DeleteHAProxy deletes the load balancer of the API servers of an HA cluster, if there is one, eg: once kube-vip serves its virtual IP instead
+func DeleteHAProxy(ociBin string, clusterName string) error {
+	name := HAProxyName(clusterName)
+	if exists, err := ContainerExists(ociBin, name); err != nil || !exists {
+		return err
+	}
+	return DeleteContainer(context.Background(), ociBin, name)
+}
+
+// haproxyConfig returns the config of haproxy, that balances TCP connections to port across the API servers at backends,
+// skipping those that do not accept connections
+func haproxyConfig(port int, backends []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `global
+  log stdout format raw local0
+
+defaults
+  log global
+  mode tcp
+  option tcplog
+  timeout connect 5s
+  timeout client 1h
+  timeout server 1h
+
+frontend apiserver
+  bind *:%d
+  default_backend apiserver
+
+backend apiserver
+  balance roundrobin
+  option tcp-check
+`, port)
+	for i, be := range backends {
+		fmt.Fprintf(&b, "  server apiserver%d %s check inter 2s fall 2 rise 2\n", i+1, be)
+	}
+	return b.String()
+}
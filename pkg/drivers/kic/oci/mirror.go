@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os/exec"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// registryMirrorImage is the pull-through cache of Docker Hub shared by the nodes of a cluster
+	registryMirrorImage = "registry:2.8.3@sha256:4fac7a8257b1d7a86599043fcc181dfbdf9c8f57e337db763ac94b0e67c6cfb5"
+	// registryMirrorPort is the port the registry mirror listens on, in the network of the cluster
+	registryMirrorPort = "5000"
+)
+
+// RegistryMirrorName returns the name of the container, and of the volume, of the registry mirror shared by the nodes of a cluster
+func RegistryMirrorName(clusterName string) string {
+	return clusterName + "-mirror"
+}
+
+// EnsureRegistryMirror runs the pull-through registry mirror of Docker Hub shared by the nodes of a cluster, in the network of the cluster,
+// and returns its address. Its container and volume are labeled like the primary node, so that they are deleted with the cluster.
+// It has a fixed address, out of the ones the nodes take, as an address assigned by the network would be the one of the next node.
+func EnsureRegistryMirror(ociBin string, clusterName string, network string) (string, error) {
+	name := RegistryMirrorName(clusterName)
+	info, err := containerNetworkInspect(ociBin, network)
+	if err != nil {
+		return "", errors.Wrapf(err, "inspect network %s", network)
+	}
+	ip, err := registryMirrorIP(info.subnet)
+	if err != nil {
+		return "", err
+	}
+	exists, err := ContainerExists(ociBin, name)
+	if err != nil {
+		return "", errors.Wrap(err, "container exists")
+	}
+	// the mirrors created before the address was fixed have the one of a node, the cache is kept in their volume
+	if exists {
+		if current, _, err := ContainerIPs(ociBin, name); err == nil && current != "" && current != ip {
+			klog.Infof("recreating registry mirror %s on %s, it has %s", name, ip, current)
+			if _, err := runCmd(exec.Command(ociBin, "rm", "-f", name)); err != nil {
+				return "", errors.Wrap(err, "remove")
+			}
+			exists = false
+		}
+	}
+
+	labels := []string{"--label", fmt.Sprintf("%s=%s", CreatedByLabelKey, "true"), "--label", fmt.Sprintf("%s=%s", ProfileLabelKey, clusterName)}
+	if !exists {
+		klog.Infof("creating registry mirror %s in network %s", name, network)
+		if !volumeExists(ociBin, name) {
+			if _, err := runCmd(exec.Command(ociBin, append([]string{"volume", "create", name}, labels...)...)); err != nil {
+				return "", errors.Wrap(err, "create volume")
+			}
+		}
+		args := []string{"run", "-d", "--name", name, "--hostname", name, "--network", network, "--ip", ip,
+			"--volume", name + ":/var/lib/registry",
+			"--env", "REGISTRY_PROXY_REMOTEURL=https://registry-1.docker.io"}
+		args = append(args, labels...)
+		if _, err := runCmd(exec.Command(ociBin, append(args, registryMirrorImage)...)); err != nil {
+			return "", errors.Wrap(err, "run")
+		}
+	} else if running, _ := ContainerRunning(ociBin, name); !running {
+		if err := StartContainer(ociBin, name); err != nil {
+			return "", errors.Wrap(err, "start")
+		}
+	}
+
+	return net.JoinHostPort(ip, registryMirrorPort), nil
+}
+
+// registryMirrorIP returns the address of the registry mirror in subnet: the one before its last client address,
+// which is the virtual IP of HA clusters. The nodes take the addresses after the gateway, by their index, below both.
+func registryMirrorIP(subnet *net.IPNet) (string, error) {
+	if subnet == nil || subnet.IP.To4() == nil {
+		return "", fmt.Errorf("no IPv4 subnet for the registry mirror")
+	}
+	ip := binary.BigEndian.Uint32(subnet.IP.To4())
+	mask := binary.BigEndian.Uint32(net.IP(subnet.Mask).To4())
+	broadcast := (ip & mask) | ^mask
+	// after the gateway, the first address of the subnet
+	if broadcast-2 <= (ip&mask)+1 {
+		return "", fmt.Errorf("the subnet %s is too small for the registry mirror", subnet)
+	}
+	mirror := make(net.IP, 4)
+	binary.BigEndian.PutUint32(mirror, broadcast-2)
+	return mirror.String(), nil
+}
+
+// StopRegistryMirror stops the registry mirror shared by the nodes of a cluster, if it is running
+func StopRegistryMirror(ociBin string, clusterName string) error {
+	name := RegistryMirrorName(clusterName)
+	if running, _ := ContainerRunning(ociBin, name); !running {
+		return nil
+	}
+	_, err := runCmd(exec.Command(ociBin, "stop", name))
+	return err
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"net"
+	"testing"
+)
+
+func TestRegistryMirrorIP(t *testing.T) {
+	var tests = []struct {
+		subnet   string
+		expected string
+		err      bool
+	}{
+		// the nodes are 192.168.49.2-252, the virtual IP of HA clusters 192.168.49.254
+		{subnet: "192.168.49.0/24", expected: "192.168.49.253"},
+		{subnet: "172.19.0.0/16", expected: "172.19.255.253"},
+		{subnet: "10.0.0.0/30", err: true},
+		{subnet: "fd00::/64", err: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.subnet, func(t *testing.T) {
+			_, subnet, err := net.ParseCIDR(tc.subnet)
+			if err != nil {
+				t.Fatalf("parse %s: %v", tc.subnet, err)
+			}
+			got, err := registryMirrorIP(subnet)
+			if (err != nil) != tc.err {
+				t.Fatalf("registryMirrorIP(%s) error = %v, expected error: %v", tc.subnet, err, tc.err)
+			}
+			if got != tc.expected {
+				t.Errorf("registryMirrorIP(%s) = %q, expected %q", tc.subnet, got, tc.expected)
+			}
+		})
+	}
+}
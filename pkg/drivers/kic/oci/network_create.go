@@ -63,8 +63,9 @@ func firstSubnetAddr(subnet string) string {
 	return subnet
 }
 
-// CreateNetwork creates a network returns gateway and error, minikube creates one network per cluster
-func CreateNetwork(ociBin, networkName, subnet, staticIP string) (net.IP, error) {
+// CreateNetwork creates a network returns gateway and error, minikube creates one network per cluster.
+// With ipv6, the network also has the IPv6 subnet that pairs with its IPv4 one, for dual-stack clusters.
+func CreateNetwork(ociBin, networkName, subnet, staticIP string, ipv6 bool) (net.IP, error) {
 	defaultBridgeName := defaultBridgeName(ociBin)
 	if networkName == defaultBridgeName {
 		klog.Infof("skipping creating network since default network %s was specified", networkName)
@@ -103,7 +104,7 @@ func CreateNetwork(ociBin, networkName, subnet, staticIP string) (net.IP, error)
 			klog.Errorf("failed to find free subnet for %s network %s after %d attempts: %v", ociBin, networkName, 20, err)
 			return nil, fmt.Errorf("un-retryable: %w", err)
 		}
-		info.gateway, err = tryCreateDockerNetwork(ociBin, subnet, info.mtu, networkName)
+		info.gateway, err = tryCreateDockerNetwork(ociBin, subnet, info.mtu, networkName, ipv6)
 		if err == nil {
 			klog.Infof("%s network %s %s created", ociBin, networkName, subnet.CIDR)
 			return info.gateway, nil
@@ -119,7 +120,7 @@ func CreateNetwork(ociBin, networkName, subnet, staticIP string) (net.IP, error)
 	return info.gateway, fmt.Errorf("failed to create %s network %s: %w", ociBin, networkName, err)
 }
 
-func tryCreateDockerNetwork(ociBin string, subnet *network.Parameters, mtu int, name string) (net.IP, error) {
+func tryCreateDockerNetwork(ociBin string, subnet *network.Parameters, mtu int, name string, ipv6 bool) (net.IP, error) {
 	gateway := net.ParseIP(subnet.Gateway)
 	klog.Infof("attempt to create %s network %s %s with gateway %s and MTU of %d ...", ociBin, name, subnet.CIDR, subnet.Gateway, mtu)
 	args := []string{
@@ -129,6 +130,10 @@ func tryCreateDockerNetwork(ociBin string, subnet *network.Parameters, mtu int,
 		fmt.Sprintf("--subnet=%s", subnet.CIDR),
 		fmt.Sprintf("--gateway=%s", subnet.Gateway),
 	}
+	if ipv6 {
+		cidr, gateway := network.IPv6Subnet(subnet.IP)
+		args = append(args, "--ipv6", fmt.Sprintf("--subnet=%s", cidr), fmt.Sprintf("--gateway=%s", gateway))
+	}
 	if ociBin == Docker {
 		// options documentation https://docs.docker.com/engine/reference/commandline/network_create/#bridge-driver-options
 		args = append(args, "-o")
@@ -168,6 +173,7 @@ type netInfo struct {
 	subnet  *net.IPNet
 	gateway net.IP
 	mtu     int
+	ipv6    bool
 }
 
 func containerNetworkInspect(ociBin string, name string) (netInfo, error) {
@@ -187,13 +193,14 @@ type networkInspect struct {
 	Subnet       string
 	Gateway      string
 	MTU          int
+	IPv6         bool
 	ContainerIPs []string
 }
 
 var dockerInspectGetter = func(name string) (*RunResult, error) {
 	// hack -- 'support ancient versions of docker again (template parsing issue) #10362' and resolve 'Template parsing error: template: :1: unexpected "=" in operand' / 'exit status 64'
 	// note: docker v18.09.7 and older use go v1.10.8 and older, whereas support for '=' operator in go templates came in go v1.11
-	cmd := exec.Command(Docker, "network", "inspect", name, "--format", `{"Name": "{{.Name}}","Driver": "{{.Driver}}","Subnet": "{{range .IPAM.Config}}{{.Subnet}}{{end}}","Gateway": "{{range .IPAM.Config}}{{.Gateway}}{{end}}","MTU": {{if (index .Options "com.docker.network.driver.mtu")}}{{(index .Options "com.docker.network.driver.mtu")}}{{else}}0{{end}}, "ContainerIPs": [{{range $k,$v := .Containers }}"{{$v.IPv4Address}}",{{end}}]}`)
+	cmd := exec.Command(Docker, "network", "inspect", name, "--format", `{"Name": "{{.Name}}","Driver": "{{.Driver}}","Subnet": "{{range $i, $c := .IPAM.Config}}{{if eq $i 0}}{{$c.Subnet}}{{end}}{{end}}","Gateway": "{{range $i, $c := .IPAM.Config}}{{if eq $i 0}}{{$c.Gateway}}{{end}}{{end}}","MTU": {{if (index .Options "com.docker.network.driver.mtu")}}{{(index .Options "com.docker.network.driver.mtu")}}{{else}}0{{end}}, "IPv6": {{.EnableIPv6}}, "ContainerIPs": [{{range $k,$v := .Containers }}"{{$v.IPv4Address}}",{{end}}]}`)
 	rr, err := runCmd(cmd)
 	// remove extra ',' after the last element in the ContainerIPs slice
 	rr.Stdout = *bytes.NewBuffer(bytes.ReplaceAll(rr.Stdout.Bytes(), []byte(",]"), []byte("]")))
@@ -221,6 +228,7 @@ func dockerNetworkInspect(name string) (netInfo, error) {
 
 	info.gateway = net.ParseIP(vals.Gateway)
 	info.mtu = vals.MTU
+	info.ipv6 = vals.IPv6
 
 	_, info.subnet, err = net.ParseCIDR(vals.Subnet)
 	if err != nil {
@@ -235,7 +243,7 @@ var podmanInspectGetter = func(name string) (*RunResult, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "podman version")
 	}
-	format := `{{range .}}{{if eq .Driver "bridge"}}{{(index .Subnets 0).Subnet}},{{(index .Subnets 0).Gateway}}{{end}}{{end}}`
+	format := `{{range .}}{{if eq .Driver "bridge"}}{{(index .Subnets 0).Subnet}},{{(index .Subnets 0).Gateway}},{{.IPv6Enabled}}{{end}}{{end}}`
 	if v.LT(semver.Version{Major: 4, Minor: 0, Patch: 0}) {
 		// format was changed in Podman 4.0.0: https://github.com/kubernetes/minikube/issues/13861#issuecomment-1082639236
 		format = `{{range .plugins}}{{if eq .type "bridge"}}{{(index (index .ipam.ranges 0) 0).subnet}},{{(index (index .ipam.ranges 0) 0).gateway}}{{end}}{{end}}`
@@ -261,12 +269,15 @@ func podmanNetworkInspect(name string) (netInfo, error) {
 		return info, fmt.Errorf("no bridge network found for %s", name)
 	}
 
-	// results looks like 172.17.0.0/16,172.17.0.1,1500
+	// results looks like 172.17.0.0/16,172.17.0.1,false
 	vals := strings.Split(output, ",")
 
 	if len(vals) >= 2 {
 		info.gateway = net.ParseIP(vals[1])
 	}
+	if len(vals) >= 3 {
+		info.ipv6 = vals[2] == "true"
+	}
 
 	_, info.subnet, err = net.ParseCIDR(vals[0])
 	if err != nil {
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// CopyVolume creates the volume of the node to, labeled like the ones of PrepareContainerNode, with the content of the volume from.
+// Volumes can not be renamed, and their labels can not be changed, so the container of a renamed node is created again on a copy.
+func CopyVolume(ociBin string, imageName string, from string, to string) error {
+	if volumeExists(ociBin, to) {
+		return fmt.Errorf("volume %s already exists", to)
+	}
+	if err := createVolume(ociBin, to, to); err != nil {
+		return errors.Wrapf(err, "create volume %s", to)
+	}
+	cmdArgs := []string{"run", "--rm", "--entrypoint", "/bin/cp"}
+	// see ExtractTarballToVolume
+	if ociBin == Podman && runtime.GOOS == "linux" {
+		cmdArgs = append(cmdArgs, "--security-opt", "label=disable")
+	}
+	cmdArgs = append(cmdArgs, "-v", fmt.Sprintf("%s:/from:ro", from), "-v", fmt.Sprintf("%s:/to", to), imageName, "-a", "/from/.", "/to/")
+	if _, err := runCmd(exec.Command(ociBin, cmdArgs...)); err != nil {
+		if rerr := RemoveVolume(ociBin, to); rerr != nil {
+			klog.Warningf("failed to remove volume %s: %v", to, rerr)
+		}
+		return errors.Wrapf(err, "copy volume %s to %s", from, to)
+	}
+	return nil
+}
+
+// RenameNetwork replaces the network oldName, which must have no containers left, with a network newName of the same subnet
+func RenameNetwork(ociBin string, oldName string, newName string) error {
+	info, err := containerNetworkInspect(ociBin, oldName)
+	if err != nil {
+		if errors.Is(err, ErrNetworkNotFound) {
+			klog.Infof("network %s not found, nothing to rename", oldName)
+			return nil
+		}
+		return errors.Wrapf(err, "inspect network %s", oldName)
+	}
+	if err := RemoveNetwork(ociBin, oldName); err != nil {
+		return errors.Wrapf(err, "remove network %s", oldName)
+	}
+	subnet := ""
+	if info.subnet != nil {
+		subnet = info.subnet.IP.String()
+	}
+	if _, err := CreateNetwork(ociBin, newName, subnet, "", info.ipv6); err != nil {
+		return errors.Wrapf(err, "create network %s", newName)
+	}
+	return nil
+}
@@ -67,6 +67,7 @@ type Config struct {
 	Network           string            // network to run with kic
 	Subnet            string            // subnet to be used on kic cluster
 	StaticIP          string            // static IP for the kic cluster
+	IPv6              bool              // whether the network of the kic cluster also has IPv6, for dual-stack clusters
 	ExtraArgs         []string          // a list of any extra option to pass to oci binary during creation time, for example --expose 8080...
 	ListenAddress     string            // IP Address to listen to
 	GPUs              string            // add NVIDIA GPU devices to the container
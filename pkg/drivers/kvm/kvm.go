@@ -94,6 +94,9 @@ type Driver struct {
 
 	// Extra Disks XML
 	ExtraDisksXML []string
+
+	// Whether the private network also has IPv6, for dual-stack clusters
+	IPv6 bool
 }
 
 const (
@@ -46,12 +46,18 @@ const networkTmpl = `
     </dhcp>
   </ip>
   {{end}}
+  {{if .IPv6Gateway}}
+  <ip family='ipv6' address='{{.IPv6Gateway}}' prefix='64'/>
+  {{end}}
 </network>
 `
 
 type kvmNetwork struct {
 	Name string
 	network.Parameters
+	// IPv6Gateway is the address of the host in the IPv6 subnet of dual-stack networks,
+	// whose router advertisements give the VMs their IPv6 addresses
+	IPv6Gateway string
 }
 
 type kvmIface struct {
@@ -209,6 +215,9 @@ func (d *Driver) createNetwork() error {
 			Name:       d.PrivateNetwork,
 			Parameters: *subnet,
 		}
+		if d.IPv6 {
+			_, tryNet.IPv6Gateway = network.IPv6Subnet(subnet.IP)
+		}
 		tmpl := template.Must(template.New("network").Parse(networkTmpl))
 		var networkXML bytes.Buffer
 		if err = tmpl.Execute(&networkXML, tryNet); err != nil {
@@ -91,6 +91,8 @@ type Driver struct {
 	SocketVMNetPath       string
 	SocketVMNetClientPath string
 	ExtraDisks            int
+	// Emulated is whether the VM is of another CPU architecture than the host, so that it cannot be accelerated
+	Emulated bool
 }
 
 func (d *Driver) GetMachineName() string {
@@ -412,7 +414,11 @@ func (d *Driver) Start() error {
 	}
 
 	// hardware acceleration is important, it increases performance by 10x
-	if runtime.GOOS == "darwin" {
+	if d.Emulated {
+		// the instructions of another arch are translated by the Tiny Code Generator
+		startCmd = append(startCmd,
+			"-accel", "tcg")
+	} else if runtime.GOOS == "darwin" {
 		// On macOS, enable the Hypervisor framework accelerator.
 		startCmd = append(startCmd,
 			"-accel", "hvf")
@@ -35,6 +35,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	watchtools "k8s.io/client-go/tools/watch"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/proxy"
 	"k8s.io/minikube/pkg/minikube/vmpath"
 	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
@@ -47,9 +49,13 @@ var (
 	ReasonableStartTime = time.Minute * 5
 )
 
-// ClientConfig returns the client configuration for a kubectl context
+// ClientConfig returns the client configuration for a kubectl context. The context of a profile
+// with --kubeconfig-mode=separate is read from its own kubeconfig, as it is only written there.
 func ClientConfig(context string) (*rest.Config, error) {
 	loader := clientcmd.NewDefaultClientConfigLoadingRules()
+	if cc, err := config.Load(context); err == nil && cc.KubeconfigMode == kubeconfig.ModeSeparate {
+		loader.ExplicitPath = kubeconfig.PathForProfile(context, cc.KubeconfigMode)
+	}
 	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, &clientcmd.ConfigOverrides{CurrentContext: context})
 	c, err := cc.ClientConfig()
 	if err != nil {
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestClientConfigSeparateKubeconfig(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	// the shared kubeconfig does not have the context of the profile
+	shared := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(shared, nil, 0600); err != nil {
+		t.Fatalf("write shared kubeconfig: %v", err)
+	}
+	t.Setenv("KUBECONFIG", shared)
+
+	profile := "separate"
+	cc := &config.ClusterConfig{Name: profile, KubeconfigMode: kubeconfig.ModeSeparate}
+	if err := config.SaveProfile(profile, cc); err != nil {
+		t.Fatalf("save profile: %v", err)
+	}
+	kcfg := api.NewConfig()
+	kcfg.Clusters[profile] = &api.Cluster{Server: "https://192.168.49.2:8443"}
+	kcfg.AuthInfos[profile] = &api.AuthInfo{Token: "token"}
+	kcfg.Contexts[profile] = &api.Context{Cluster: profile, AuthInfo: profile}
+	if err := clientcmd.WriteToFile(*kcfg, localpath.Kubeconfig(profile)); err != nil {
+		t.Fatalf("write profile kubeconfig: %v", err)
+	}
+
+	c, err := ClientConfig(profile)
+	if err != nil {
+		t.Fatalf("ClientConfig(%q): %v", profile, err)
+	}
+	if c.Host != "https://192.168.49.2:8443" {
+		t.Errorf("ClientConfig(%q).Host = %q, expected the server of the profile kubeconfig", profile, c.Host)
+	}
+	if _, err := Client(profile); err != nil {
+		t.Errorf("Client(%q): %v", profile, err)
+	}
+
+	// the other contexts are still read from the shared kubeconfig, which does not have this one
+	if _, err := ClientConfig("shared"); err == nil {
+		t.Errorf("ClientConfig(%q) succeeded without a context", "shared")
+	}
+}
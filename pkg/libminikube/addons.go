@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libminikube
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/addons"
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// Addons returns whether each addon is enabled on a cluster, like minikube addons list
+func (c *Client) Addons(cluster string) (map[string]bool, error) {
+	enabled := map[string]bool{}
+	err := c.run(cluster, false, nil, func() error {
+		cc, err := config.Load(cluster)
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		for name, a := range assets.Addons {
+			enabled[name] = a.IsEnabled(cc)
+		}
+		return nil
+	})
+	return enabled, err
+}
+
+// SetAddon enables or disables an addon of a running cluster, like minikube addons enable and disable
+func (c *Client) SetAddon(cluster, name string, enable bool) error {
+	return c.run(cluster, true, nil, func() error {
+		if _, err := config.Load(cluster); err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		err := addons.SetAndSave(cluster, name, strconv.FormatBool(enable))
+		if err != nil && !errors.Is(err, addons.ErrSkipThisAddon) {
+			return errors.Wrapf(err, "set addon %s", name)
+		}
+		return nil
+	})
+}
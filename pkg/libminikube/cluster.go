@@ -0,0 +1,425 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libminikube
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/docker/machine/libmachine/mcnerror"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/drivers/kic"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/delete"
+	"k8s.io/minikube/pkg/minikube/download"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out/register"
+)
+
+// ClusterOptions configures a new cluster, like the flags of minikube start. The zero values are the defaults of minikube start.
+type ClusterOptions struct {
+	// Name is the name of the cluster, its minikube profile and kubectl context. It is required.
+	Name string
+	// Driver runs the nodes, eg: docker or kvm2. Defaults to the best one installed.
+	Driver string
+	// ContainerRuntime is docker, containerd or crio. Defaults to docker.
+	ContainerRuntime string
+	// KubernetesVersion eg: v1.30.1. Defaults to the default version of minikube.
+	KubernetesVersion string
+	// CPUs of each node. Defaults to 2.
+	CPUs int
+	// Memory of each node, in MB. Defaults to 4096.
+	Memory int
+	// DiskSize of each node, in MB. Defaults to 20000.
+	DiskSize int
+	// Nodes is the number of nodes, including the control plane. Defaults to 1.
+	Nodes int
+	// Addons to enable, eg: ingress
+	Addons []string
+	// TTL makes the cluster ephemeral: it is deleted once the TTL expires, or the program exits, eg: at the end of the tests.
+	// Zero keeps the cluster until it is deleted. The cluster is deleted by a process of the minikube binary of Options.
+	TTL time.Duration
+}
+
+const (
+	defaultCPUs     = 2
+	defaultMemory   = 4096
+	defaultDiskSize = 20000
+)
+
+// Clusters returns the names of the clusters of the minikube home directory
+func (c *Client) Clusters() ([]string, error) {
+	valid, err := config.ListValidProfiles()
+	// there is no profiles directory before the first cluster is created
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var names []string
+	for _, p := range valid {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// NodeOptions configures a node added to a cluster, like the flags of minikube node add
+type NodeOptions struct {
+	// ControlPlane makes the node a control-plane node, which requires a cluster created with several control planes
+	ControlPlane bool
+	// NoWorker keeps the workloads off a control-plane node
+	NoWorker bool
+	// Memory (in MB) and CPUs of the node, instead of the cluster's when set
+	Memory int
+	CPUs   int
+}
+
+// CreateCluster creates and starts a cluster, and sets it as the current kubectl context.
+// It fails if the cluster exists already.
+func (c *Client) CreateCluster(o ClusterOptions) error {
+	if o.Nodes < 0 {
+		return fmt.Errorf("invalid number of nodes: %d", o.Nodes)
+	}
+	if o.TTL < 0 {
+		return fmt.Errorf("invalid TTL: %s", o.TTL)
+	}
+	var binary string
+	if o.TTL > 0 {
+		var err error
+		if binary, err = c.binary(); err != nil {
+			return err
+		}
+	}
+	settings := map[string]interface{}{config.AddonListFlag: o.Addons}
+	return c.run(o.Name, true, settings, func() error {
+		if config.ProfileExists(o.Name) {
+			return fmt.Errorf("cluster %q exists already", o.Name)
+		}
+		if o.Driver == "" {
+			pick, _, _ := driver.Suggest(driver.Choices(false))
+			if pick.Name == "" {
+				return errors.New("no driver is installed, see https://minikube.sigs.k8s.io/docs/drivers/")
+			}
+			o.Driver = pick.Name
+		}
+		if driver.Status(o.Driver).Name == "" {
+			return fmt.Errorf("driver %q is not supported on this host", o.Driver)
+		}
+		cc, err := clusterConfig(o)
+		if err != nil {
+			return err
+		}
+		if driver.IsVM(cc.Driver) && !driver.IsSSH(cc.Driver) {
+			cc.MinikubeISO, err = download.ISO(download.DefaultISOURLs(), false)
+			if err != nil {
+				return errors.Wrap(err, "cache ISO")
+			}
+		}
+
+		register.Reg.SetStep(register.InitialSetup)
+		cp := cc.Nodes[0]
+		runner, preExists, api, host, err := node.Provision(&cc, &cp, false)
+		// before the error is handled, so that a cluster that failed to start does not outlive the program either
+		if binary != "" && config.ProfileExists(cc.Name) {
+			if err := node.StartTTL(&cc, binary); err != nil {
+				return errors.Wrap(err, "watch TTL")
+			}
+		}
+		if err != nil {
+			return errors.Wrap(err, "provision")
+		}
+		if _, err := node.Start(node.Starter{
+			Runner:         runner,
+			PreExists:      preExists,
+			MachineAPI:     api,
+			Host:           host,
+			Cfg:            &cc,
+			Node:           &cp,
+			ExistingAddons: map[string]bool{},
+		}); err != nil {
+			return errors.Wrap(err, "start")
+		}
+
+		for i := 1; i < max(o.Nodes, 1); i++ {
+			n := config.Node{
+				Name:              node.Name(i + 1),
+				Port:              cc.APIServerPort,
+				KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+				ContainerRuntime:  cc.KubernetesConfig.ContainerRuntime,
+				Worker:            true,
+			}
+			if err := node.Add(&cc, n, false); err != nil {
+				return errors.Wrapf(err, "add node %s", n.Name)
+			}
+		}
+		return nil
+	})
+}
+
+// clusterConfig returns the config of a new cluster, with the defaults of minikube start for the options that are not set
+func clusterConfig(o ClusterOptions) (config.ClusterConfig, error) {
+	if o.ContainerRuntime == "" {
+		o.ContainerRuntime = constants.Docker
+	}
+	if o.KubernetesVersion == "" {
+		o.KubernetesVersion = constants.DefaultKubernetesVersion
+	}
+	if o.CPUs == 0 {
+		o.CPUs = defaultCPUs
+	}
+	if o.Memory == 0 {
+		o.Memory = defaultMemory
+	}
+	if o.DiskSize == 0 {
+		o.DiskSize = defaultDiskSize
+	}
+	if !slices.Contains([]string{constants.Docker, constants.Containerd, constants.CRIO}, o.ContainerRuntime) {
+		return config.ClusterConfig{}, fmt.Errorf("invalid container runtime %q", o.ContainerRuntime)
+	}
+
+	cc := config.ClusterConfig{
+		Name:               o.Name,
+		KicBaseImage:       kic.BaseImage,
+		Memory:             o.Memory,
+		CPUs:               o.CPUs,
+		DiskSize:           o.DiskSize,
+		Driver:             o.Driver,
+		APIServerPort:      constants.APIServerPort,
+		StartHostTimeout:   6 * time.Minute,
+		SSHUser:            "root",
+		SSHPort:            22,
+		CertExpiration:     constants.DefaultCertExpiration,
+		MultiNodeRequested: o.Nodes > 1,
+		KubernetesConfig: config.KubernetesConfig{
+			KubernetesVersion:      o.KubernetesVersion,
+			ClusterName:            o.Name,
+			Namespace:              "default",
+			APIServerName:          constants.APIServerName,
+			DNSDomain:              constants.ClusterDNSDomain,
+			ContainerRuntime:       o.ContainerRuntime,
+			ServiceCIDR:            constants.DefaultServiceCIDR,
+			ShouldLoadCachedImages: true,
+		},
+		VerifyComponents: kverify.DefaultComponents,
+		Nodes: []config.Node{{
+			Port:              constants.APIServerPort,
+			KubernetesVersion: o.KubernetesVersion,
+			ContainerRuntime:  o.ContainerRuntime,
+			ControlPlane:      true,
+			Worker:            true,
+		}},
+	}
+	if o.TTL > 0 {
+		cc.TTL = config.TTLConfig{Expires: time.Now().Add(o.TTL), OwnerPID: os.Getpid()}
+	}
+	return cc, nil
+}
+
+// StartCluster starts the stopped nodes of a cluster, the primary control-plane node first, like minikube start of an existing cluster
+func (c *Client) StartCluster(cluster string) error {
+	return c.run(cluster, true, nil, func() error {
+		cc, err := config.Load(cluster)
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		nodes := primaryLast(*cc)
+		slices.Reverse(nodes)
+		for i := range nodes {
+			if err := startNode(cc, &nodes[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// StopCluster stops the nodes of a cluster, the primary control-plane node last, so that it is the first one to start next time, like minikube stop
+func (c *Client) StopCluster(cluster string) error {
+	return c.run(cluster, true, nil, func() error {
+		cc, err := config.Load(cluster)
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		nodes := primaryLast(*cc)
+		for i := range nodes {
+			if err := stopNode(cc, &nodes[i]); err != nil {
+				return errors.Wrapf(err, "stop %s", config.MachineName(*cc, nodes[i]))
+			}
+		}
+		return nil
+	})
+}
+
+// primaryLast returns the nodes of cc, with the primary control-plane node last
+func primaryLast(cc config.ClusterConfig) []config.Node {
+	var nodes []config.Node
+	var primary []config.Node
+	for _, n := range cc.Nodes {
+		if config.IsPrimaryControlPlane(cc, n) {
+			primary = append(primary, n)
+		} else {
+			nodes = append(nodes, n)
+		}
+	}
+	return append(nodes, primary...)
+}
+
+// AddNode adds a node to a running cluster, and starts it. It returns the name of the node.
+func (c *Client) AddNode(cluster string, o NodeOptions) (string, error) {
+	var name string
+	err := c.run(cluster, true, nil, func() error {
+		cc, err := config.Load(cluster)
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		if driver.BareMetal(cc.Driver) {
+			return fmt.Errorf("the %s driver does not support multi-node clusters", cc.Driver)
+		}
+		if o.ControlPlane && !config.IsHA(*cc) {
+			return errors.New("a control-plane node can only be added to a cluster created with several control planes")
+		}
+		if o.Memory < 0 || o.CPUs < 0 {
+			return fmt.Errorf("invalid resources of the node: %d MB, %d CPUs", o.Memory, o.CPUs)
+		}
+
+		name = node.NextName(cc)
+		// a worker takes over a warm node, which only has to join the cluster
+		if !o.ControlPlane && len(cc.WarmNodes) > 0 {
+			name = cc.WarmNodes[0].Name
+		}
+		n := config.Node{
+			Name:              name,
+			Worker:            !o.ControlPlane || !o.NoWorker,
+			ControlPlane:      o.ControlPlane,
+			KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+			Memory:            o.Memory,
+			CPUs:              o.CPUs,
+		}
+		register.Reg.SetStep(register.InitialSetup)
+		if err := node.Add(cc, n, false); err != nil {
+			return errors.Wrapf(err, "add node %s", name)
+		}
+		return config.SaveProfile(cc.Name, cc)
+	})
+	return name, err
+}
+
+// DeleteNode deletes a node of a cluster, after draining it and removing it from Kubernetes.
+// The primary control-plane node can only be deleted with the cluster. Deleting a node that does not exist is not an error.
+// A control-plane node is not deleted if it would leave etcd without quorum, which returns a *node.QuorumError.
+func (c *Client) DeleteNode(cluster, name string) error {
+	return c.run(cluster, true, nil, func() error {
+		cc, err := config.Load(cluster)
+		if config.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			klog.Infof("%s: %v", cluster, err)
+			return nil
+		}
+		if config.IsPrimaryControlPlane(*cc, *n) {
+			return fmt.Errorf("the primary control-plane node of %s can only be deleted with the cluster", cluster)
+		}
+		api, err := machine.NewAPIClient()
+		if err != nil {
+			return errors.Wrap(err, "api")
+		}
+		defer api.Close()
+		if err := node.CheckQuorum(api, *cc, *n, true); err != nil {
+			return err
+		}
+		register.Reg.SetStep(register.Deleting)
+		if _, err := node.Delete(*cc, name); err != nil {
+			return errors.Wrapf(err, "delete node %s", name)
+		}
+		return nil
+	})
+}
+
+// Kubeconfig returns a kubeconfig of the cluster only, with its certificates embedded, eg: for a Secret
+func (c *Client) Kubeconfig(cluster string) ([]byte, error) {
+	var b []byte
+	err := c.run(cluster, false, nil, func() error {
+		cc, err := config.Load(cluster)
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		b, err = kubeconfig.Standalone(cluster, kubeconfig.PathForProfile(cluster, cc.KubeconfigMode), "")
+		return err
+	})
+	return b, err
+}
+
+// DeleteCluster deletes a cluster, its nodes and its kubectl context. Deleting a cluster that does not exist is not an error.
+func (c *Client) DeleteCluster(cluster string) error {
+	return c.run(cluster, true, nil, func() error {
+		cc, err := config.Load(cluster)
+		if config.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+
+		register.Reg.SetStep(register.Deleting)
+		if driver.IsKIC(cc.Driver) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+			defer cancel()
+			for _, n := range slices.Concat(cc.Nodes, cc.WarmNodes) {
+				delete.PossibleLeftOvers(ctx, config.MachineName(*cc, n), cc.Driver)
+			}
+		}
+
+		api, err := machine.NewAPIClient()
+		if err != nil {
+			return errors.Wrap(err, "api")
+		}
+		defer api.Close()
+		for _, n := range slices.Concat(cc.Nodes, cc.WarmNodes) {
+			m := config.MachineName(*cc, n)
+			if err := machine.DeleteHost(api, m); err != nil {
+				if _, ok := errors.Cause(err).(mcnerror.ErrHostDoesNotExist); !ok {
+					return errors.Wrapf(err, "delete %s", m)
+				}
+				klog.Infof("%s does not exist", m)
+			}
+			if err := os.RemoveAll(localpath.MachinePath(m)); err != nil {
+				return errors.Wrapf(err, "remove machine directory of %s", m)
+			}
+		}
+
+		if err := kubeconfig.DeleteContext(cluster, kubeconfig.PathForProfile(cluster, cc.KubeconfigMode)); err != nil {
+			return errors.Wrap(err, "delete kubectl context")
+		}
+		return config.DeleteProfile(cluster)
+	})
+}
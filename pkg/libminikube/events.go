@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libminikube
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// EventType is the kind of an Event
+type EventType string
+
+const (
+	// StepEvent is a step of an operation, eg: "Creating Container"
+	StepEvent EventType = "step"
+	// DownloadEvent is the start of the download of an artifact, in Data["artifact"]
+	DownloadEvent EventType = "download"
+	// DownloadProgressEvent is the progress of the download of an artifact, in Data["progress"]
+	DownloadProgressEvent EventType = "download.progress"
+	// InfoEvent is extra information, eg: the options of the cluster
+	InfoEvent EventType = "info"
+	// WarningEvent is a problem that the operation goes on despite
+	WarningEvent EventType = "warning"
+	// ErrorEvent is a failure, which ends the operation if Data["exitcode"] is set
+	ErrorEvent EventType = "error"
+)
+
+// cloudEventPrefix prefixes the types of the cloud events of minikube --output=json
+const cloudEventPrefix = "io.k8s.sigs.minikube."
+
+// Event is the progress of an operation of a Client, the same as the output of minikube --output=json
+type Event struct {
+	Time    time.Time
+	Cluster string
+	Type    EventType
+	// Step is the name of the step of the operation that the event belongs to, with its number out of TotalSteps
+	Step        string
+	CurrentStep int
+	TotalSteps  int
+	Message     string
+	// Data holds every field of the event
+	Data map[string]string
+}
+
+// eventWriter turns the cloud events printed by the packages underneath into the Events of a client, one per line
+type eventWriter struct {
+	cluster string
+	events  chan<- Event
+
+	// the packages underneath print from several goroutines
+	mu  sync.Mutex
+	buf bytes.Buffer
+	// lastError is the last ErrorEvent, which describes why the operation exited
+	lastError *Event
+}
+
+// Write implements io.Writer
+func (w *eventWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf.Write(p)
+	for {
+		line, err := w.buf.ReadBytes('\n')
+		if err != nil {
+			// keep the incomplete line for the next write
+			w.buf.Write(line)
+			return len(p), nil
+		}
+		w.event(line)
+	}
+}
+
+// event sends the cloud event of line
+func (w *eventWriter) event(line []byte) {
+	e, err := parseEvent(w.cluster, line)
+	if err != nil {
+		klog.Warningf("unable to decode event %q: %v", line, err)
+		return
+	}
+	e.Time = time.Now()
+	if e.Type == ErrorEvent {
+		w.lastError = &e
+	}
+	if w.events != nil {
+		w.events <- e
+	}
+}
+
+// parseEvent returns the Event of the cloud event of line
+func parseEvent(cluster string, line []byte) (Event, error) {
+	var ce struct {
+		Type string            `json:"type"`
+		Data map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal(line, &ce); err != nil {
+		return Event{}, err
+	}
+	e := Event{
+		Cluster: cluster,
+		Type:    EventType(strings.TrimPrefix(ce.Type, cloudEventPrefix)),
+		Step:    ce.Data["name"],
+		Message: ce.Data["message"],
+		Data:    ce.Data,
+	}
+	// the steps are numbered from 0, eg: "Done" is step 19 of 19
+	e.CurrentStep, _ = strconv.Atoi(ce.Data["currentstep"])
+	e.TotalSteps, _ = strconv.Atoi(ce.Data["totalsteps"])
+	if e.Type == ErrorEvent {
+		// the name of an error is its ID, not a step
+		e.Step = ""
+	}
+	return e, nil
+}
+
+// Events returns the events of the last operation of the minikube binary on a cluster, eg: minikube start,
+// which are recorded in its profile directory. Their Time is when the record was last written.
+func (c *Client) Events(cluster string) ([]Event, error) {
+	path := localpath.EventLog(cluster)
+	fi, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var events []Event
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		e, err := parseEvent(cluster, line)
+		if err != nil {
+			klog.Warningf("unable to decode event %q: %v", line, err)
+			continue
+		}
+		e.Time = fi.ModTime()
+		events = append(events, e)
+	}
+	return events, nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package libminikube creates and manages minikube clusters from Go programs, such as test frameworks,
+// without running the minikube binary and parsing its output.
+//
+// Its API is stable: it only exposes the types of this package, and none of the commands, flags
+// or settings that the minikube binary is built on. The clusters are the same as the ones of the
+// binary, which can be used alongside, eg: minikube -p NAME ssh.
+package libminikube
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+
+	// register the drivers
+	_ "k8s.io/minikube/pkg/minikube/registry/drvs"
+)
+
+// mu serializes the operations of every client, as the packages they run share process-wide settings
+var mu sync.Mutex
+
+// Options configures a Client
+type Options struct {
+	// Events receives the progress of the operations of the client, which block until each event is received.
+	// Nil discards the events.
+	Events chan<- Event
+	// LockTimeout is how long an operation waits for another process, eg: minikube start, to release its cluster.
+	// Zero fails right away.
+	LockTimeout time.Duration
+	// Binary is the minikube binary that deletes the clusters of ClusterOptions.TTL, as the program that created them
+	// may have exited. Defaults to minikube on the PATH.
+	Binary string
+}
+
+// Client creates and manages the clusters of the minikube home directory, $MINIKUBE_HOME or ~/.minikube
+type Client struct {
+	opts Options
+}
+
+// New returns a client with the given options
+func New(opts Options) *Client {
+	return &Client{opts: opts}
+}
+
+// Error is a failure that the minikube binary would have exited with
+type Error struct {
+	// ID identifies the failure, eg: GUEST_PROVISION, as listed on https://minikube.sigs.k8s.io/docs/reference/error-codes/
+	ID string
+	// ExitCode is the exit code of the minikube binary
+	ExitCode int
+	Message  string
+	// Advice is how to fix the failure, if it is known
+	Advice string
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	if e.ID == "" {
+		return fmt.Sprintf("exit code %d: %s", e.ExitCode, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.ID, e.Message)
+}
+
+// binary returns the minikube binary of c
+func (c *Client) binary() (string, error) {
+	if c.opts.Binary != "" {
+		return c.opts.Binary, nil
+	}
+	path, err := exec.LookPath("minikube")
+	if err != nil {
+		return "", errors.Wrap(err, "the minikube binary deletes the clusters with a TTL, set Options.Binary if it is not on the PATH")
+	}
+	return path, nil
+}
+
+// exitPanic is how run stops an operation that the packages underneath exit on
+type exitPanic int
+
+// run runs f for the cluster name, with the settings that the minikube binary sets from its flags, and with the output
+// of the packages underneath sent to the events of c. It returns an *Error instead of exiting.
+// The cluster is locked against other processes while f changes it.
+func (c *Client) run(name string, changes bool, settings map[string]interface{}, f func() error) (err error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if name == "" {
+		return errors.New("the cluster name is required")
+	}
+	if !config.ProfileNameValid(name) {
+		return fmt.Errorf("invalid cluster name %q", name)
+	}
+	if changes {
+		lock, err := config.LockProfile(name, c.opts.LockTimeout)
+		if err != nil {
+			return errors.Wrapf(err, "lock %s", name)
+		}
+		defer lock.Release()
+	}
+
+	viper.Set(config.ProfileName, name)
+	for k, v := range defaultSettings() {
+		viper.Set(k, v)
+	}
+	for k, v := range settings {
+		viper.Set(k, v)
+	}
+
+	w := &eventWriter{cluster: name, events: c.opts.Events}
+	json := out.JSON
+	out.SetJSON(true)
+	register.SetOutputFile(w)
+	exitFunc := exit.SetExitFunc(func(code int) { panic(exitPanic(code)) })
+	defer func() {
+		exit.SetExitFunc(exitFunc)
+		register.SetOutputFile(os.Stdout)
+		out.SetJSON(json)
+		command.FlushTimings()
+	}()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		code, ok := r.(exitPanic)
+		if !ok {
+			panic(r)
+		}
+		e := &Error{ExitCode: int(code)}
+		if last := w.lastError; last != nil {
+			e.ID = last.Data["name"]
+			e.Message = last.Message
+			e.Advice = last.Data["advice"]
+		}
+		klog.Infof("%s exited with %v", name, e)
+		err = e
+	}()
+	return f()
+}
+
+// defaultSettings are the defaults of the start flags that the packages underneath read
+func defaultSettings() map[string]interface{} {
+	return map[string]interface{}{
+		"bootstrapper":                     bootstrapper.Kubeadm,
+		"wait-timeout":                     6 * time.Minute,
+		"cache-images":                     true,
+		"preload":                          true,
+		"delete-on-failure":                false,
+		"background-images":                false,
+		config.AddonListFlag:               []string{},
+		config.WarmNodes:                   0,
+		config.ProvisionAttempts:           1,
+		config.ProvisionBackoff:            10 * time.Second,
+		config.ProvisionRetryPhases:        strings.Join(config.RetryPhases, ","),
+		"force":                            false,
+		"download-only":                    false,
+		"binary-mirror":                    "",
+		config.WantNoneDriverWarning:       false,
+		config.WantVirtualBoxDriverWarning: false,
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libminikube
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+func TestRun(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	events := make(chan Event, 10)
+	c := New(Options{Events: events})
+	exited := -1
+	exit.SetExitFunc(func(code int) { exited = code })
+	defer exit.SetExitFunc(nil)
+
+	err := c.run("sdk", true, nil, func() error {
+		out.Step(style.Happy, "Hello {{.name}}", out.V{"name": "sdk"})
+		exit.Message(reason.Usage, "Invalid {{.flag}}", out.V{"flag": "--foo"})
+		t.Fatal("exit.Message returned")
+		return nil
+	})
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("run() = %v, want an *Error", err)
+	}
+	want := Error{ID: reason.Usage.ID, ExitCode: reason.Usage.ExitCode, Message: "Invalid --foo"}
+	if *e != want {
+		t.Errorf("run() = %+v, want %+v", *e, want)
+	}
+	if out.JSON {
+		t.Errorf("run() left the JSON output enabled")
+	}
+	// the exit func of the program, eg: the one flushing the timings of minikube, is restored
+	exit.SetExitFunc(nil)(3)
+	if exited != 3 {
+		t.Errorf("run() did not restore the previous exit func")
+	}
+
+	close(events)
+	var got []Event
+	for e := range events {
+		got = append(got, e)
+	}
+	if len(got) != 2 || got[0].Type != StepEvent || got[0].Cluster != "sdk" || got[1].Type != ErrorEvent {
+		t.Fatalf("run() sent %+v, want a step and an error", got)
+	}
+
+	if err := c.run("sdk", true, nil, func() error { return nil }); err != nil {
+		t.Errorf("run() after an exit = %v, want the cluster to be unlocked", err)
+	}
+	if err := c.run("-", true, nil, func() error { return nil }); err == nil {
+		t.Errorf("run() with an invalid name = nil, want an error")
+	}
+}
+
+func TestEventWriter(t *testing.T) {
+	events := make(chan Event, 10)
+	w := &eventWriter{cluster: "sdk", events: events}
+
+	step := `{"specversion":"1.0","type":"io.k8s.sigs.minikube.step","data":{"currentstep":"3","message":"Starting","name":"Starting Node","totalsteps":"19"}}` + "\n"
+	// the line is split across writes
+	for _, p := range []string{step[:20], step[20:] + `{"type":"io.k8s.sigs.minikube.error",`, `"data":{"exitcode":"80","message":"boom","name":"GUEST_PROVISION"}}` + "\n"} {
+		if _, err := w.Write([]byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(events)
+
+	var got []Event
+	for e := range events {
+		got = append(got, e)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(got), got)
+	}
+	if s := got[0]; s.Type != StepEvent || s.Step != "Starting Node" || s.CurrentStep != 3 || s.TotalSteps != 19 || s.Message != "Starting" {
+		t.Errorf("step = %+v", s)
+	}
+	if e := got[1]; e.Type != ErrorEvent || e.Step != "" || e.Data["name"] != "GUEST_PROVISION" || e.Message != "boom" {
+		t.Errorf("error = %+v", e)
+	}
+	if w.lastError == nil || w.lastError.Message != "boom" {
+		t.Errorf("lastError = %+v, want the error", w.lastError)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	c := New(Options{})
+	if events, err := c.Events("sdk"); err != nil || events != nil {
+		t.Errorf("Events() without a log = %v, %v", events, err)
+	}
+
+	path := localpath.EventLog("sdk")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	log := `{"specversion":"1.0","type":"io.k8s.sigs.minikube.step","data":{"currentstep":"0","message":"minikube v1.33.1","name":"Initial Minikube Setup","totalsteps":"19"}}
+not an event
+{"specversion":"1.0","type":"io.k8s.sigs.minikube.step","data":{"currentstep":"19","message":"Done!","name":"Done","totalsteps":"19"}}
+`
+	if err := os.WriteFile(path, []byte(log), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	events, err := c.Events("sdk")
+	if err != nil {
+		t.Fatalf("Events() = %v", err)
+	}
+	if len(events) != 2 || events[1].Step != "Done" || events[1].CurrentStep != 19 || events[1].Cluster != "sdk" || events[1].Time.IsZero() {
+		t.Errorf("Events() = %+v", events)
+	}
+}
+
+func TestClusterConfig(t *testing.T) {
+	cc, err := clusterConfig(ClusterOptions{Name: "sdk", Driver: "docker", Nodes: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cc.CPUs != defaultCPUs || cc.Memory != defaultMemory || cc.DiskSize != defaultDiskSize {
+		t.Errorf("resources = %d CPUs, %dMB, %dMB disk, want the defaults", cc.CPUs, cc.Memory, cc.DiskSize)
+	}
+	k := cc.KubernetesConfig
+	if k.KubernetesVersion != constants.DefaultKubernetesVersion || k.ContainerRuntime != constants.Docker || k.ClusterName != "sdk" {
+		t.Errorf("kubernetes config = %+v", k)
+	}
+	if !cc.MultiNodeRequested || len(cc.Nodes) != 1 || !cc.Nodes[0].ControlPlane {
+		t.Errorf("nodes = %+v, want a control plane, the others are added", cc.Nodes)
+	}
+
+	if !cc.TTL.Expires.IsZero() {
+		t.Errorf("TTL = %+v, want none", cc.TTL)
+	}
+
+	cc, err = clusterConfig(ClusterOptions{Name: "sdk", TTL: 30 * time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if left := time.Until(cc.TTL.Expires); left <= 29*time.Minute || left > 30*time.Minute || cc.TTL.OwnerPID != os.Getpid() {
+		t.Errorf("TTL = %+v, want 30m owned by this process", cc.TTL)
+	}
+
+	if _, err := clusterConfig(ClusterOptions{Name: "sdk", ContainerRuntime: "rkt"}); err == nil {
+		t.Errorf("clusterConfig() with an invalid runtime = nil, want an error")
+	}
+}
+
+func TestPrimaryLast(t *testing.T) {
+	cc := config.ClusterConfig{Nodes: []config.Node{{Name: "", ControlPlane: true}, {Name: "m02", ControlPlane: true}, {Name: "m03", Worker: true}}}
+	var names []string
+	for _, n := range primaryLast(cc) {
+		names = append(names, n.Name)
+	}
+	if want := []string{"m02", "m03", ""}; !slices.Equal(names, want) {
+		t.Errorf("primaryLast() = %q, want %q", names, want)
+	}
+}
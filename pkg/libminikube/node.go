@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libminikube
+
+import (
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out/register"
+)
+
+// StartNode starts a stopped node of a cluster, like minikube node start. Starting a running node is not an error.
+// name is the name of the node, eg: m02, or its machine name, as in NodeStatus.
+func (c *Client) StartNode(cluster, name string) error {
+	return c.run(cluster, true, nil, func() error {
+		cc, n, err := loadNode(cluster, name)
+		if err != nil {
+			return err
+		}
+		return startNode(cc, n)
+	})
+}
+
+// startNode starts a node of cc, unless it is running
+func startNode(cc *config.ClusterConfig, n *config.Node) error {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "api")
+	}
+	defer api.Close()
+	if machine.IsRunning(api, config.MachineName(*cc, *n)) {
+		return nil
+	}
+
+	register.Reg.SetStep(register.InitialSetup)
+	runner, preExists, mapi, host, err := node.Provision(cc, n, false)
+	if err != nil {
+		return errors.Wrap(err, "provision")
+	}
+	_, err = node.Start(node.Starter{
+		Runner:         runner,
+		PreExists:      preExists,
+		MachineAPI:     mapi,
+		Host:           host,
+		Cfg:            cc,
+		Node:           n,
+		ExistingAddons: cc.Addons,
+	})
+	return errors.Wrapf(err, "start %s", config.MachineName(*cc, *n))
+}
+
+// StopNode stops a node of a cluster, like minikube node stop. A control-plane node is not stopped if
+// it would leave etcd without quorum, which returns a *node.QuorumError.
+func (c *Client) StopNode(cluster, name string) error {
+	return c.run(cluster, true, nil, func() error {
+		cc, n, err := loadNode(cluster, name)
+		if err != nil {
+			return err
+		}
+		api, err := machine.NewAPIClient()
+		if err != nil {
+			return errors.Wrap(err, "api")
+		}
+		defer api.Close()
+		if err := node.CheckQuorum(api, *cc, *n, false); err != nil {
+			return err
+		}
+		register.Reg.SetStep(register.Stopping)
+		return node.Stop(api, *cc, *n)
+	})
+}
+
+// stopNode stops a node of cc
+func stopNode(cc *config.ClusterConfig, n *config.Node) error {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "api")
+	}
+	defer api.Close()
+	register.Reg.SetStep(register.Stopping)
+	return machine.StopHost(api, config.MachineName(*cc, *n))
+}
+
+// PauseNode pauses the containers of the namespaces of minikube pause on a node of a cluster
+func (c *Client) PauseNode(cluster, name string) error {
+	return c.pauseNode(cluster, name, true)
+}
+
+// UnpauseNode unpauses the containers that PauseNode paused on a node of a cluster
+func (c *Client) UnpauseNode(cluster, name string) error {
+	return c.pauseNode(cluster, name, false)
+}
+
+// pauseNode pauses or unpauses the containers of a node of the cluster cname
+func (c *Client) pauseNode(cname, name string, pause bool) error {
+	return c.run(cname, true, nil, func() error {
+		cc, n, err := loadNode(cname, name)
+		if err != nil {
+			return err
+		}
+		api, err := machine.NewAPIClient()
+		if err != nil {
+			return errors.Wrap(err, "api")
+		}
+		defer api.Close()
+		host, err := machine.LoadHost(api, config.MachineName(*cc, *n))
+		if err != nil {
+			return errors.Wrap(err, "load host")
+		}
+		r, err := machine.CommandRunner(host)
+		if err != nil {
+			return errors.Wrap(err, "command runner")
+		}
+		cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+		if err != nil {
+			return errors.Wrap(err, "runtime")
+		}
+		if pause {
+			register.Reg.SetStep(register.Pausing)
+			_, err = cluster.Pause(cr, r, constants.DefaultNamespaces)
+		} else {
+			register.Reg.SetStep(register.Unpausing)
+			_, err = cluster.Unpause(cr, r, constants.DefaultNamespaces)
+		}
+		return err
+	})
+}
+
+// loadNode returns the config of a cluster and of one of its nodes, by name or machine name
+func loadNode(cluster, name string) (*config.ClusterConfig, *config.Node, error) {
+	cc, err := config.Load(cluster)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "load cluster")
+	}
+	n, _, err := node.Retrieve(*cc, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cc, n, nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libminikube
+
+import (
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/service"
+)
+
+// serviceURLTemplate is the format of the URLs of Service, the default of minikube service --url
+var serviceURLTemplate = template.Must(template.New("serviceURL").Parse("http://{{.IP}}:{{.Port}}"))
+
+// Service is a Kubernetes service of a cluster
+type Service struct {
+	Namespace string
+	Name      string
+	// URLs are the URLs of the node ports of the service, as printed by minikube service --url.
+	// Those of the docker driver on macOS and Windows are only reachable with minikube service.
+	URLs []string
+}
+
+// Services returns the services of every namespace of a running cluster, like minikube service list
+func (c *Client) Services(cluster string) ([]Service, error) {
+	var svcs []Service
+	err := c.run(cluster, false, nil, func() error {
+		if _, err := config.Load(cluster); err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		api, err := machine.NewAPIClient()
+		if err != nil {
+			return errors.Wrap(err, "api")
+		}
+		defer api.Close()
+		urls, err := service.GetServiceURLs(api, cluster, "", serviceURLTemplate)
+		if err != nil {
+			return errors.Wrap(err, "service URLs")
+		}
+		for _, u := range urls {
+			svcs = append(svcs, Service{Namespace: u.Namespace, Name: u.Name, URLs: u.URLs})
+		}
+		return nil
+	})
+	return svcs, err
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libminikube
+
+import (
+	"os/exec"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/provision"
+	"github.com/docker/machine/libmachine/state"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/machine"
+)
+
+// NodeStatus is the status of a node, as shown by minikube status. The states are Running, Stopped, Paused, Error,
+// or Nonexistent, and Irrelevant for the API server of a worker.
+type NodeStatus struct {
+	Name         string
+	ControlPlane bool
+	Host         string
+	Kubelet      string
+	APIServer    string
+	// OS is the operating system of a running node, eg: Buildroot 2023.02.9
+	OS string
+}
+
+const (
+	nonexistent = "Nonexistent"
+	irrelevant  = "Irrelevant"
+)
+
+// Status returns the status of each node of a cluster
+func (c *Client) Status(cluster string) ([]NodeStatus, error) {
+	var statuses []NodeStatus
+	err := c.run(cluster, false, nil, func() error {
+		cc, err := config.Load(cluster)
+		if err != nil {
+			return errors.Wrap(err, "load cluster")
+		}
+		api, err := machine.NewAPIClient()
+		if err != nil {
+			return errors.Wrap(err, "api")
+		}
+		defer api.Close()
+
+		for _, n := range cc.Nodes {
+			st, err := nodeStatus(api, *cc, n)
+			if err != nil {
+				return errors.Wrapf(err, "status of %s", st.Name)
+			}
+			statuses = append(statuses, st)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// nodeStatus returns the status of the node n of cc
+func nodeStatus(api libmachine.API, cc config.ClusterConfig, n config.Node) (NodeStatus, error) {
+	st := NodeStatus{
+		Name:         config.MachineName(cc, n),
+		ControlPlane: n.ControlPlane,
+		Host:         nonexistent,
+		Kubelet:      nonexistent,
+		APIServer:    nonexistent,
+	}
+	hs, err := machine.Status(api, st.Name)
+	if err != nil {
+		return st, errors.Wrap(err, "host")
+	}
+	if hs == state.None.String() {
+		return st, nil
+	}
+	st.Host = hs
+	if hs != state.Running.String() {
+		st.Kubelet = hs
+		st.APIServer = hs
+		return st, nil
+	}
+
+	h, err := machine.LoadHost(api, st.Name)
+	if err != nil {
+		return st, err
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return st, err
+	}
+	if rr, err := r.RunCmd(exec.Command("cat", "/etc/os-release")); err != nil {
+		klog.Warningf("%s os-release: %v", st.Name, err)
+	} else if osr, err := provision.NewOsRelease(rr.Stdout.Bytes()); err == nil {
+		st.OS = osr.PrettyName
+	}
+	st.Kubelet = kverify.ServiceStatus(r, "kubelet").String()
+	if !n.ControlPlane {
+		st.APIServer = irrelevant
+		return st, nil
+	}
+
+	hostname, _, port, err := driver.ControlPlaneEndpoint(&cc, &n, h.DriverName)
+	if err != nil {
+		return st, errors.Wrap(err, "control plane endpoint")
+	}
+	as, err := kverify.APIServerStatus(r, hostname, port)
+	if err != nil {
+		klog.Warningf("%s apiserver status: %v", st.Name, err)
+		st.APIServer = state.Error.String()
+		return st, nil
+	}
+	st.APIServer = as.String()
+	return st, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/version"
+)
+
+// definitionKind is the kind of the YAML definitions of a cluster
+const definitionKind = "ClusterDefinition"
+
+// definitionFile is a YAML definition of a cluster. Its fields have the names of the profile config.json.
+type definitionFile struct {
+	Kind            string               `json:"kind"`
+	MinikubeVersion string               `json:"minikubeVersion"`
+	Cluster         config.ClusterConfig `json:"cluster"`
+}
+
+// mapFields are the maps of a cluster config whose false and empty values are kept, eg: an addon disabled on purpose
+var mapFields = map[string]bool{"Addons": true, "VerifyComponents": true, "CustomAddonImages": true, "CustomAddonRegistries": true, "Labels": true, "KubeadmPatches": true}
+
+// zeroTime is how encoding/json encodes an unset time.Time
+var zeroTime = time.Time{}.Format(time.RFC3339Nano)
+
+// Definition returns the settings, nodes and addons of cc, without the paths on the host, and the state of the machines,
+// that only make sense on the host it was created on. A cluster started from it is equivalent to cc.
+func Definition(cc config.ClusterConfig) config.ClusterConfig {
+	d := cc
+	// paths on the host
+	d.CertsDir, d.SecurityProfilesDir, d.AuditPolicy = "", "", ""
+	d.ExternalEtcd.CAFile, d.ExternalEtcd.CertFile, d.ExternalEtcd.KeyFile = "", "", ""
+	d.Mount, d.MountString, d.ContainerVolumeMounts, d.NFSShare = false, "", nil, nil
+	d.SSHKey, d.SSHAuthSock, d.SSHAgentPID = "", "", 0
+	d.HyperkitVpnKitSock, d.CustomQemuFirmwarePath, d.SocketVMnetClientPath, d.SocketVMnetPath = "", "", "", ""
+	// paths on the host, and credentials that are not shared with the definition
+	d.RegistryAuth = nil
+	// state of the machines, set again by the next start
+	d.UUID = ""
+	d.ScheduledStop, d.TTL, d.WarmNodes = nil, config.TTLConfig{}, nil
+	d.KubernetesConfig.APIServerHAVIP = ""
+	d.Nodes = make([]config.Node, len(cc.Nodes))
+	for i, n := range cc.Nodes {
+		n.IP, n.IPv6, n.Phase = "", "", ""
+		d.Nodes[i] = n
+	}
+	return d
+}
+
+// MarshalDefinition returns the definition of cc as YAML, without the settings that are unset
+func MarshalDefinition(cc config.ClusterConfig) ([]byte, error) {
+	b, err := json.Marshal(definitionFile{Kind: definitionKind, MinikubeVersion: version.GetVersion(), Cluster: Definition(cc)})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal")
+	}
+	// JSON is YAML, and decoding it into a MapSlice keeps the order of the fields
+	var ms yaml.MapSlice
+	if err := yaml.Unmarshal(b, &ms); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return yaml.Marshal(prune(ms))
+}
+
+// UnmarshalDefinition parses a YAML definition of a cluster. Unknown settings are rejected, rather than ignored.
+func UnmarshalDefinition(b []byte) (*config.ClusterConfig, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, errors.Wrap(err, "parse")
+	}
+	j, err := json.Marshal(jsonValue(v))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse")
+	}
+	var d definitionFile
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&d); err != nil {
+		return nil, errors.Wrap(err, "parse")
+	}
+	if d.Kind != definitionKind {
+		return nil, fmt.Errorf("not a minikube cluster definition: kind is %q, not %q", d.Kind, definitionKind)
+	}
+	return &d.Cluster, nil
+}
+
+// ExportDefinition writes the definition of cc to dest
+func ExportDefinition(cc *config.ClusterConfig, dest string) error {
+	b, err := MarshalDefinition(*cc)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dest, b, 0644)
+}
+
+// ImportDefinition creates the profile of the definition src, for the next start to create its machines.
+// If the profile already exists, its config is returned along with ErrProfileExists.
+func ImportDefinition(src string) (*config.ClusterConfig, error) {
+	b, err := os.ReadFile(src)
+	if err != nil {
+		return nil, errors.Wrap(err, "read")
+	}
+	cc, err := UnmarshalDefinition(b)
+	if err != nil {
+		return nil, errors.Wrap(err, src)
+	}
+	if !config.ProfileNameValid(cc.Name) {
+		return nil, fmt.Errorf("invalid profile name %q in %s", cc.Name, src)
+	}
+	if config.ProfileExists(cc.Name) {
+		return cc, errors.Wrap(ErrProfileExists, cc.Name)
+	}
+	// the definition may come from a hand written file, so only keep what it is allowed to set
+	d := Definition(*cc)
+	return &d, config.SaveProfile(d.Name, &d)
+}
+
+// prune removes the unset fields of ms, recursively
+func prune(ms yaml.MapSlice) yaml.MapSlice {
+	var pruned yaml.MapSlice
+	for _, item := range ms {
+		if k, ok := item.Key.(string); !ok || !mapFields[k] {
+			item.Value = pruneValue(item.Value)
+		}
+		if !unset(item.Value) {
+			pruned = append(pruned, item)
+		}
+	}
+	return pruned
+}
+
+func pruneValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		return prune(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = pruneValue(v[i])
+		}
+	}
+	return v
+}
+
+// unset returns whether v is the zero value of its type, which decodes the same as a missing field
+func unset(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == "" || v == zeroTime
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case yaml.MapSlice:
+		return len(v) == 0
+	}
+	return false
+}
+
+// jsonValue converts the maps decoded by yaml, whose keys may be of any type, into maps that encoding/json accepts
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := map[string]interface{}{}
+		for k, e := range v {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = jsonValue(v[i])
+		}
+	}
+	return v
+}
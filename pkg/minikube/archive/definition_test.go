@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestDefinition(t *testing.T) {
+	cc := config.ClusterConfig{
+		Name:             "p1",
+		Driver:           "kvm2",
+		Memory:           4096,
+		CertsDir:         "/home/me/certs",
+		MountString:      "/home/me/src:/src",
+		Mount:            true,
+		MemoryAutoShrink: 5 * time.Minute,
+		TTL:              config.TTLConfig{OwnerPID: 42},
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.30.0", APIServerIPs: []net.IP{net.ParseIP("10.0.0.1")}, APIServerHAVIP: "192.168.39.254"},
+		Nodes: []config.Node{
+			{Name: "", IP: "192.168.39.2", Port: 8443, ControlPlane: true, Worker: true, Phase: config.NodeBootstrapped},
+			{Name: "m02", IP: "192.168.39.3", Worker: true, Labels: map[string]string{"gpu": "true"}},
+		},
+		Addons: map[string]bool{"dashboard": true, "storage-provisioner": false},
+	}
+
+	b, err := MarshalDefinition(cc)
+	if err != nil {
+		t.Fatalf("MarshalDefinition: %v", err)
+	}
+	for _, s := range []string{"/home/me", "192.168.39", "OwnerPID", "Phase", "HyperkitVpnKitSock"} {
+		if strings.Contains(string(b), s) {
+			t.Errorf("definition contains %q:\n%s", s, b)
+		}
+	}
+
+	got, err := UnmarshalDefinition(b)
+	if err != nil {
+		t.Fatalf("UnmarshalDefinition: %v\n%s", err, b)
+	}
+	if diff := cmp.Diff(Definition(cc), *got); diff != "" {
+		t.Errorf("definition did not round trip (-want +got):\n%s\n%s", diff, b)
+	}
+	if !got.Nodes[0].ControlPlane || got.Nodes[1].Labels["gpu"] != "true" || got.Addons["storage-provisioner"] || !got.Addons["dashboard"] {
+		t.Errorf("unexpected definition: %+v", got)
+	}
+	if _, ok := got.Addons["storage-provisioner"]; !ok {
+		t.Errorf("disabled addon was not kept:\n%s", b)
+	}
+
+	if _, err := UnmarshalDefinition([]byte("kind: ClusterDefinition\ncluster:\n  Name: p1\n  Memroy: 4096\n")); err == nil {
+		t.Errorf("UnmarshalDefinition accepted an unknown setting")
+	}
+	if _, err := UnmarshalDefinition([]byte("kind: Pod\n")); err == nil {
+		t.Errorf("UnmarshalDefinition accepted another kind")
+	}
+}
+
+func TestImportDefinition(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	cc := &config.ClusterConfig{Name: "p1", Driver: "docker", Nodes: []config.Node{{ControlPlane: true, Worker: true}}}
+	src := filepath.Join(t.TempDir(), "p1.yaml")
+	if err := ExportDefinition(cc, src); err != nil {
+		t.Fatalf("ExportDefinition: %v", err)
+	}
+
+	if _, err := ImportDefinition(src); err != nil {
+		t.Fatalf("ImportDefinition: %v", err)
+	}
+	got, err := config.Load("p1")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got.Driver != "docker" || len(got.Nodes) != 1 {
+		t.Errorf("imported profile = %+v", got)
+	}
+	if _, err := ImportDefinition(src); !errors.Is(err, ErrProfileExists) {
+		t.Errorf("ImportDefinition of an existing profile: %v, want ErrProfileExists", err)
+	}
+	if _, err := os.Stat(localpath.Profile("p1")); err != nil {
+		t.Errorf("profile dir: %v", err)
+	}
+}
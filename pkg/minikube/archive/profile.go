@@ -0,0 +1,493 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package archive packages a profile and everything it needs into a single file that can be restored on another host.
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/detect"
+	"k8s.io/minikube/pkg/minikube/download"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/version"
+)
+
+// manifestName is the first entry of every archive
+const manifestName = "minikube-export.json"
+
+// sharedCAFiles are the certificate authorities shared between profiles, relative to the minikube home
+var sharedCAFiles = []string{"ca.crt", "ca.key", "proxy-client-ca.crt", "proxy-client-ca.key"}
+
+// transientFiles are profile files that only describe the state of the host they were written on
+var transientFiles = map[string]bool{"events.json": true, "pid": true, "prompt.json": true}
+
+// Manifest describes the contents of a profile archive
+type Manifest struct {
+	Profile         string
+	MinikubeVersion string
+	Created         time.Time
+	// MiniPath is the minikube home of the exporting host, used to rewrite absolute paths on import
+	MiniPath      string
+	IncludesDisks bool
+}
+
+// ErrProfileExists is returned when importing a profile that already exists
+var ErrProfileExists = errors.New("profile already exists")
+
+// Export writes the profile config, certificates and cached artifacts of cc to dest as a zstd compressed tarball.
+// Machine disks are only included if includeDisks is set.
+func Export(cc *config.ClusterConfig, dest string, includeDisks bool) (*Manifest, error) {
+	m := &Manifest{
+		Profile:         cc.Name,
+		MinikubeVersion: version.GetVersion(),
+		Created:         time.Now(),
+		MiniPath:        localpath.MiniPath(),
+		IncludesDisks:   includeDisks,
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return nil, errors.Wrap(err, "create")
+	}
+	defer f.Close()
+
+	zw, err := zstd.NewWriter(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "zstd")
+	}
+	tw := tar.NewWriter(zw)
+
+	b, err := json.MarshalIndent(m, "", "    ")
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal manifest")
+	}
+	hdr := &tar.Header{Name: manifestName, Mode: 0644, Size: int64(len(b)), ModTime: m.Created}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return nil, errors.Wrap(err, "write manifest")
+	}
+	if _, err := tw.Write(b); err != nil {
+		return nil, errors.Wrap(err, "write manifest")
+	}
+
+	for _, p := range exportPaths(cc, includeDisks) {
+		if err := addPath(tw, m.MiniPath, p); err != nil {
+			return nil, errors.Wrapf(err, "add %s", p)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "tar")
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errors.Wrap(err, "zstd")
+	}
+	return m, f.Close()
+}
+
+// exportPaths returns the paths to export for cc, relative to the minikube home. Some of them may not exist.
+func exportPaths(cc *config.ClusterConfig, includeDisks bool) []string {
+	paths := []string{path.Join("profiles", cc.Name)}
+	paths = append(paths, sharedCAFiles...)
+
+	k8s := cc.KubernetesConfig
+	for _, p := range []string{download.TarballPath(k8s.KubernetesVersion, k8s.ContainerRuntime), download.PreloadChecksumPath(k8s.KubernetesVersion, k8s.ContainerRuntime)} {
+		paths = append(paths, relative(p))
+	}
+	paths = append(paths, relative(localpath.MakeMiniPath("cache", "linux", detect.EffectiveArch(), k8s.KubernetesVersion)))
+	if driver.IsVM(cc.Driver) && cc.MinikubeISO != "" {
+		paths = append(paths, relative(strings.TrimPrefix(download.LocalISOResource(cc.MinikubeISO), "file://")))
+	}
+
+	if includeDisks {
+		for _, n := range cc.Nodes {
+			paths = append(paths, path.Join("machines", config.MachineName(*cc, n)))
+		}
+	}
+
+	var rel []string
+	for _, p := range paths {
+		if p != "" {
+			rel = append(rel, p)
+		}
+	}
+	return rel
+}
+
+// relative returns p relative to the minikube home, or "" if it is outside of it
+func relative(p string) string {
+	rel, err := filepath.Rel(localpath.MiniPath(), p)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
+
+// addPath adds the file or directory rel below home to tw, skipping it if it does not exist
+func addPath(tw *tar.Writer, home, rel string) error {
+	root := filepath.Join(home, filepath.FromSlash(rel))
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		klog.Infof("skipping %s: not found", root)
+		return nil
+	}
+	return filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if transientFiles[fi.Name()] || strings.HasSuffix(fi.Name(), ".lock") {
+			return nil
+		}
+		// sockets, pipes and the like can not be restored anywhere else
+		if !fi.Mode().IsRegular() && !fi.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(home, p)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		klog.Infof("exporting %s (%d bytes)", name, fi.Size())
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+}
+
+// sharedCacheDirs are the caches that Export adds, relative to the minikube home, which are restored if missing
+var sharedCacheDirs = []string{"cache/preloaded-tarball/", "cache/linux/", "cache/iso/"}
+
+// Import restores a profile archive written by Export into the minikube home.
+// Only the files of the profile, the machines of its nodes, and the shared CA and caches are restored.
+// Shared files already present locally are kept. If the local CA differs from the exported one,
+// the profile certificates are removed so that the next start signs new ones.
+// If the import fails, the files it wrote are removed.
+// If the profile already exists, the manifest is returned along with ErrProfileExists.
+func Import(src string) (*Manifest, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return nil, errors.Wrap(err, "open")
+	}
+	defer f.Close()
+
+	zr, err := zstd.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "zstd")
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	m, err := readManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+	if !config.ProfileNameValid(m.Profile) {
+		return nil, fmt.Errorf("invalid profile name %q in %s", m.Profile, src)
+	}
+	if config.ProfileExists(m.Profile) {
+		return m, errors.Wrap(ErrProfileExists, m.Profile)
+	}
+
+	imp := &importer{home: localpath.MiniPath(), profile: m.Profile, machines: map[string]bool{}, checked: map[string]bool{}}
+	if err := imp.run(tr, m); err != nil {
+		imp.undo()
+		return nil, err
+	}
+	return m, nil
+}
+
+// importer restores the entries of a profile archive, and keeps track of what it wrote to undo it
+type importer struct {
+	home    string
+	profile string
+	// machines are the machines of the nodes of the profile, known once its config is restored
+	machines map[string]bool
+	// checked are the machines that were checked not to exist locally
+	checked map[string]bool
+	// created are the files and directories written, in order
+	created []string
+}
+
+func (imp *importer) run(tr *tar.Reader, m *Manifest) error {
+	caMismatch := false
+	var rewrite []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "read archive")
+		}
+		name := path.Clean(hdr.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid path %q in archive", hdr.Name)
+		}
+		owned, err := imp.owned(name)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(imp.home, filepath.FromSlash(name))
+
+		if hdr.Typeflag == tar.TypeDir {
+			if err := imp.mkdirAll(dst); err != nil {
+				return errors.Wrap(err, "mkdir")
+			}
+			continue
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if !owned {
+			if _, err := os.Stat(dst); err == nil {
+				if name == "ca.crt" {
+					same, err := sameContent(dst, tr)
+					if err != nil {
+						return err
+					}
+					caMismatch = !same
+				}
+				klog.Infof("keeping existing %s", dst)
+				continue
+			}
+		}
+
+		// only the permissions of the owner may be more than read and execute
+		if err := imp.writeFile(dst, tr, os.FileMode(hdr.Mode)&0o755); err != nil {
+			return errors.Wrapf(err, "write %s", dst)
+		}
+		if name == path.Join("profiles", imp.profile, "config.json") {
+			if err := imp.readMachines(dst); err != nil {
+				return err
+			}
+		}
+		if owned && strings.HasSuffix(name, ".json") {
+			rewrite = append(rewrite, dst)
+		}
+	}
+
+	if m.MiniPath != imp.home {
+		for _, p := range rewrite {
+			if err := rewritePaths(p, m.MiniPath, imp.home); err != nil {
+				return errors.Wrapf(err, "rewrite %s", p)
+			}
+		}
+	}
+
+	if caMismatch {
+		klog.Infof("local CA differs from the exported one, removing certificates of %q", m.Profile)
+		if err := removeProfileCerts(m.Profile); err != nil {
+			return errors.Wrap(err, "remove profile certs")
+		}
+	}
+	return nil
+}
+
+// owned returns whether the archive entry name belongs to the imported profile, which restores it as is,
+// rather than to the files shared with the other profiles, which are only restored if missing.
+// Every other entry, eg: of another profile, or of the files synced into the nodes, is an error.
+func (imp *importer) owned(name string) (bool, error) {
+	parts := strings.Split(name, "/")
+	switch {
+	case parts[0] == "profiles" && len(parts) > 1 && parts[1] == imp.profile:
+		return true, nil
+	case parts[0] == "machines" && len(parts) > 1:
+		m := parts[1]
+		if !imp.machines[m] {
+			return false, fmt.Errorf("%q in archive is not a machine of profile %q", name, imp.profile)
+		}
+		if !imp.checked[m] {
+			if _, err := os.Stat(localpath.MachinePath(m)); err == nil {
+				return false, fmt.Errorf("machine %q already exists", m)
+			}
+			imp.checked[m] = true
+		}
+		return true, nil
+	}
+	for _, f := range sharedCAFiles {
+		if name == f {
+			return false, nil
+		}
+	}
+	for _, d := range sharedCacheDirs {
+		if strings.HasPrefix(name+"/", d) {
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("unexpected path %q in archive", name)
+}
+
+// readMachines reads the machines of the nodes from the restored profile config p
+func (imp *importer) readMachines(p string) error {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	var cc config.ClusterConfig
+	if err := json.Unmarshal(b, &cc); err != nil {
+		return errors.Wrapf(err, "parse %s", p)
+	}
+	cc.Name = imp.profile
+	for _, n := range cc.Nodes {
+		imp.machines[config.MachineName(cc, n)] = true
+	}
+	return nil
+}
+
+// mkdirAll creates dir and its missing parents, which it keeps track of
+func (imp *importer) mkdirAll(dir string) error {
+	var missing []string
+	for d := dir; d != filepath.Dir(d); d = filepath.Dir(d) {
+		if _, err := os.Stat(d); err == nil {
+			break
+		}
+		missing = append(missing, d)
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		if err := os.Mkdir(missing[i], 0755); err != nil {
+			return err
+		}
+		imp.created = append(imp.created, missing[i])
+	}
+	return nil
+}
+
+// writeFile writes r to dst, which is kept track of
+func (imp *importer) writeFile(dst string, r io.Reader, mode os.FileMode) error {
+	if err := imp.mkdirAll(filepath.Dir(dst)); err != nil {
+		return err
+	}
+	if _, err := os.Stat(dst); os.IsNotExist(err) {
+		imp.created = append(imp.created, dst)
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// undo removes the files and directories written by a failed import, the last ones first
+func (imp *importer) undo() {
+	for i := len(imp.created) - 1; i >= 0; i-- {
+		if err := os.RemoveAll(imp.created[i]); err != nil {
+			klog.Warningf("failed to remove %s: %v", imp.created[i], err)
+		}
+	}
+}
+
+// readManifest reads the manifest, which must be the first entry of the archive
+func readManifest(tr *tar.Reader) (*Manifest, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "read archive")
+	}
+	if hdr.Name != manifestName {
+		return nil, fmt.Errorf("not a minikube profile archive: missing %s", manifestName)
+	}
+	m := &Manifest{}
+	if err := json.NewDecoder(tr).Decode(m); err != nil {
+		return nil, errors.Wrap(err, "decode manifest")
+	}
+	return m, nil
+}
+
+func sameContent(p string, r io.Reader) (bool, error) {
+	local, err := os.ReadFile(p)
+	if err != nil {
+		return false, err
+	}
+	exported, err := io.ReadAll(r)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(local, exported), nil
+}
+
+// rewritePaths replaces the minikube home of the exporting host in a json file, eg: the SSH key path of a machine config
+func rewritePaths(p, from, to string) error {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	// paths are JSON encoded, so escape them the same way
+	oldPath, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	newPath, err := json.Marshal(to)
+	if err != nil {
+		return err
+	}
+	oldPath, newPath = bytes.Trim(oldPath, `"`), bytes.Trim(newPath, `"`)
+	if !bytes.Contains(b, oldPath) {
+		return nil
+	}
+	return os.WriteFile(p, bytes.ReplaceAll(b, oldPath, newPath), 0644)
+}
+
+// removeProfileCerts removes the certificates signed by the shared CA, so that they are generated again
+func removeProfileCerts(profile string) error {
+	dir := localpath.Profile(profile)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		n := e.Name()
+		for _, prefix := range []string{"apiserver.", "client.", "proxy-client."} {
+			if strings.HasPrefix(n, prefix) && (strings.Contains(n, ".crt") || strings.Contains(n, ".key")) {
+				if err := os.Remove(filepath.Join(dir, n)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func writeFiles(t *testing.T, home string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(home, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	src := t.TempDir()
+	t.Setenv(localpath.MinikubeHome, src)
+
+	cc := &config.ClusterConfig{Name: "p1", Driver: "kvm2", Nodes: []config.Node{{ControlPlane: true}}}
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		t.Fatalf("SaveProfile: %v", err)
+	}
+	writeFiles(t, localpath.MiniPath(), map[string]string{
+		"ca.crt":                     "exported CA",
+		"profiles/p1/client.crt":     "client",
+		"profiles/p1/apiserver.key":  "apiserver",
+		"profiles/p1/events.json":    "{}",
+		"machines/p1/config.json":    `{"SSHKeyPath": "` + localpath.MakeMiniPath("machines", "p1", "id_rsa") + `"}`,
+		"machines/p1/disk.img":       "disk",
+		"machines/other/config.json": "{}",
+	})
+
+	dest := filepath.Join(t.TempDir(), "p1.tar.zst")
+	if _, err := Export(cc, dest, true); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	dst := t.TempDir()
+	t.Setenv(localpath.MinikubeHome, dst)
+	writeFiles(t, localpath.MiniPath(), map[string]string{"ca.crt": "local CA"})
+
+	m, err := Import(dest)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if m.Profile != "p1" || !m.IncludesDisks {
+		t.Errorf("manifest = %+v, want profile p1 with disks", m)
+	}
+	if !config.ProfileExists("p1") {
+		t.Errorf("profile p1 was not imported")
+	}
+
+	home := localpath.MiniPath()
+	tests := []struct {
+		name   string
+		exists bool
+	}{
+		{"machines/p1/disk.img", true},
+		{"machines/other/config.json", false},
+		{"profiles/p1/events.json", false},
+		// signed by the exported CA, which differs from the local one
+		{"profiles/p1/client.crt", false},
+		{"profiles/p1/apiserver.key", false},
+	}
+	for _, tc := range tests {
+		_, err := os.Stat(filepath.Join(home, tc.name))
+		if exists := err == nil; exists != tc.exists {
+			t.Errorf("%s exists = %v, want %v", tc.name, exists, tc.exists)
+		}
+	}
+
+	ca, _ := os.ReadFile(filepath.Join(home, "ca.crt"))
+	if string(ca) != "local CA" {
+		t.Errorf("ca.crt = %q, want the local CA to be kept", ca)
+	}
+	mc, _ := os.ReadFile(filepath.Join(home, "machines", "p1", "config.json"))
+	if strings.Contains(string(mc), filepath.Join(src, ".minikube")) || !strings.Contains(string(mc), filepath.Join(home, "machines", "p1", "id_rsa")) {
+		t.Errorf("machine config paths were not rewritten: %s", mc)
+	}
+
+	if _, err := Import(dest); !errors.Is(err, ErrProfileExists) {
+		t.Errorf("second Import() error = %v, want ErrProfileExists", err)
+	}
+}
+
+// writeArchive writes an archive of profile with the given entries, in order
+func writeArchive(t *testing.T, profile string, entries []tar.Header, contents []string) string {
+	t.Helper()
+	dest := filepath.Join(t.TempDir(), profile+".tar.zst")
+	f, err := os.Create(dest)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer f.Close()
+	zw, err := zstd.NewWriter(f)
+	if err != nil {
+		t.Fatalf("zstd: %v", err)
+	}
+	tw := tar.NewWriter(zw)
+	b, _ := json.Marshal(Manifest{Profile: profile})
+	entries = append([]tar.Header{{Name: manifestName, Mode: 0644}}, entries...)
+	contents = append([]string{string(b)}, contents...)
+	for i, hdr := range entries {
+		hdr.Size = int64(len(contents[i]))
+		if err := tw.WriteHeader(&hdr); err != nil {
+			t.Fatalf("write header: %v", err)
+		}
+		if _, err := tw.Write([]byte(contents[i])); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("tar: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zstd: %v", err)
+	}
+	return dest
+}
+
+func TestImportRestrictsPaths(t *testing.T) {
+	profileConfig := `{"Name": "p2", "Nodes": [{"Name": ""}]}`
+	tests := []struct {
+		description string
+		name        string
+	}{
+		{"another profile", "profiles/p3/config.json"},
+		{"a machine of another profile", "machines/p3/config.json"},
+		{"a certificate trusted by the nodes", "certs/evil.pem"},
+		{"a file synced into the nodes", "files/etc/passwd"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Setenv(localpath.MinikubeHome, t.TempDir())
+			dest := writeArchive(t, "p2", []tar.Header{
+				{Name: "profiles/p2/config.json", Mode: 0644, Typeflag: tar.TypeReg},
+				{Name: tc.name, Mode: 0644, Typeflag: tar.TypeReg},
+			}, []string{profileConfig, "evil"})
+
+			if _, err := Import(dest); err == nil {
+				t.Fatalf("Import() of %s should have failed", tc.name)
+			}
+			for _, p := range []string{tc.name, "profiles/p2"} {
+				if _, err := os.Stat(filepath.Join(localpath.MiniPath(), filepath.FromSlash(p))); !os.IsNotExist(err) {
+					t.Errorf("%s should not exist after a failed import: %v", p, err)
+				}
+			}
+		})
+	}
+}
+
+func TestImportMasksMode(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	dest := writeArchive(t, "p2", []tar.Header{
+		{Name: "profiles/p2/config.json", Mode: 0666, Typeflag: tar.TypeReg},
+		{Name: "machines/p2/tool", Mode: 04777, Typeflag: tar.TypeReg},
+	}, []string{`{"Name": "p2", "Nodes": [{"Name": ""}]}`, "#!/bin/sh"})
+
+	if _, err := Import(dest); err != nil {
+		t.Fatalf("Import() error: %v", err)
+	}
+	for name, want := range map[string]os.FileMode{"profiles/p2/config.json": 0644, "machines/p2/tool": 0755} {
+		fi, err := os.Stat(filepath.Join(localpath.MiniPath(), filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf("stat: %v", err)
+		}
+		if got := fi.Mode() & (os.ModePerm | os.ModeSetuid); got != want {
+			t.Errorf("%s mode = %o, want %o", name, got, want)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -816,6 +817,15 @@ var Addons = map[string]*Addon{
 		map[string]string{
 			"Yakd": "docker.io",
 		}),
+	"dex": NewAddon([]*BinAsset{
+		MustBinAsset(addons.DexAssets, "dex/dex.yaml.tmpl", vmpath.GuestAddonsDir, "dex.yaml", "0640"),
+	}, false, "dex", "3rd party (dexidp.io)", "", "https://minikube.sigs.k8s.io/docs/handbook/addons/dex/",
+		map[string]string{
+			"Dex": "dexidp/dex:v2.41.1",
+		},
+		map[string]string{
+			"Dex": "ghcr.io",
+		}),
 }
 
 // parseMapString creates a map based on `str` which is encoded as <key1>=<value1>,<key2>=<value2>,...
@@ -918,6 +928,29 @@ func SelectAndPersistImages(addon *Addon, cc *config.ClusterConfig) (images, cus
 	return images, customRegistries, nil
 }
 
+// ImageNames returns the full names of the images of addon, as its manifests refer to them for cc
+func ImageNames(addon *Addon, cc *config.ClusterConfig) []string {
+	images := overrideDefaults(addon.Images, cc.CustomAddonImages)
+	customRegistries := filterKeySpace(addon.Images, cc.CustomAddonRegistries)
+	var names []string
+	for name, image := range images {
+		// same precedence as the manifests: custom registry, then image repository, then default registry
+		reg := customRegistries[name]
+		if reg == "" {
+			reg = cc.KubernetesConfig.ImageRepository
+		}
+		if _, custom := cc.CustomAddonImages[name]; reg == "" && !custom {
+			reg = addon.Registries[name]
+		}
+		if reg != "" {
+			image = strings.TrimSuffix(reg, "/") + "/" + image
+		}
+		names = append(names, image)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // GenerateTemplateData generates template data for template assets
 func GenerateTemplateData(addon *Addon, cc *config.ClusterConfig, netInfo NetworkInfo, images, customRegistries map[string]string, enable bool) interface{} {
 	cfg := cc.KubernetesConfig
@@ -954,6 +987,8 @@ func GenerateTemplateData(addon *Addon, cc *config.ClusterConfig, netInfo Networ
 		LegacyPodSecurityPolicy bool
 		LegacyRuntimeClass      bool
 		AutoPauseInterval       time.Duration
+		DexIssuerURL            string
+		OIDCClientID            string
 	}{
 		KubernetesVersion:      make(map[string]uint64),
 		PreOneTwentyKubernetes: false,
@@ -976,6 +1011,8 @@ func GenerateTemplateData(addon *Addon, cc *config.ClusterConfig, netInfo Networ
 		LegacyPodSecurityPolicy: v.LT(semver.Version{Major: 1, Minor: 25}),
 		LegacyRuntimeClass:      v.LT(semver.Version{Major: 1, Minor: 25}),
 		AutoPauseInterval:       cc.AutoPauseInterval,
+		DexIssuerURL:            config.DexIssuerURL(*cc),
+		OIDCClientID:            cc.OIDC.ClientID,
 	}
 	if opts.ImageRepository != "" && !strings.HasSuffix(opts.ImageRepository, "/") {
 		opts.ImageRepository += "/"
@@ -373,3 +373,47 @@ func checkMatches(t *testing.T, name string, got, expected map[string]string) {
 		t.Errorf("expected %q to be %q, but got %q", name, expected[name], got[name])
 	}
 }
+
+func TestImageNames(t *testing.T) {
+	addon := NewAddon(nil, false, "test", "", "", "", map[string]string{
+		"Dashboard": "kubernetesui/dashboard:v2.7.0",
+		"Scraper":   "kubernetesui/metrics-scraper:v1.0.8",
+		"Plain":     "busybox:1.36",
+	}, map[string]string{
+		"Dashboard": "docker.io",
+		"Scraper":   "docker.io",
+	})
+
+	tests := []struct {
+		name string
+		cc   *config.ClusterConfig
+		want []string
+	}{
+		{
+			name: "defaults",
+			cc:   &config.ClusterConfig{},
+			want: []string{"busybox:1.36", "docker.io/kubernetesui/dashboard:v2.7.0", "docker.io/kubernetesui/metrics-scraper:v1.0.8"},
+		},
+		{
+			name: "image repository",
+			cc:   &config.ClusterConfig{KubernetesConfig: config.KubernetesConfig{ImageRepository: "mirror.example.com/"}},
+			want: []string{"mirror.example.com/busybox:1.36", "mirror.example.com/kubernetesui/dashboard:v2.7.0", "mirror.example.com/kubernetesui/metrics-scraper:v1.0.8"},
+		},
+		{
+			name: "custom image and registry",
+			cc: &config.ClusterConfig{
+				CustomAddonImages:     map[string]string{"Dashboard": "registry.k8s.io/echoserver:1.4"},
+				CustomAddonRegistries: map[string]string{"Scraper": "quay.io"},
+			},
+			want: []string{"busybox:1.36", "quay.io/kubernetesui/metrics-scraper:v1.0.8", "registry.k8s.io/echoserver:1.4"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ImageNames(addon, tc.cc)
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("ImageNames() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -149,7 +149,7 @@ func shouldLog() bool {
 	}
 
 	// commands that should not be logged.
-	no := []string{"status", "version", "logs", "generate-docs", "profile"}
+	no := []string{"status", "version", "logs", "generate-docs", "profile", "prompt"}
 	a := pflag.Arg(0)
 	for _, c := range no {
 		if a == c {
@@ -140,6 +140,10 @@ func TestAudit(t *testing.T) {
 				[]string{"minikube", "version"},
 				false,
 			},
+			{
+				[]string{"minikube", "prompt"},
+				false,
+			},
 			{
 				[]string{"minikube"},
 				false,
@@ -39,7 +39,7 @@ func openAuditLog() error {
 	if currentLogFile != nil {
 		return nil
 	}
-	f, err := os.OpenFile(auditPath(), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+	f, err := os.OpenFile(auditPath(), os.O_APPEND|os.O_CREATE|os.O_RDWR, localpath.Perm(0644))
 	if err != nil {
 		return fmt.Errorf("failed to open the audit log: %v", err)
 	}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/util"
+)
+
+// admissionConfigFile is the AdmissionConfiguration of the API server, for the PodSecurity plugin
+const admissionConfigFile = "admission-config.yaml"
+
+// PodSecurityLevels are the levels of the Pod Security Standards
+var PodSecurityLevels = []string{"privileged", "baseline", "restricted"}
+
+// PodSecurityExemptNamespaces are the namespaces whose pods are not checked against the --pod-security-level:
+// the system one, and the ones of the addons that run privileged pods
+var PodSecurityExemptNamespaces = []string{
+	"kube-system",
+	"ingress-nginx",
+	"kubernetes-dashboard",
+	"gcp-auth",
+	"dex",
+}
+
+// AdmissionConfigPath returns the path of the AdmissionConfiguration in the guest
+func AdmissionConfigPath() string {
+	return apiServerFile(admissionConfigFile)
+}
+
+// admissionConfiguration is the subset of apiserver.config.k8s.io/v1 AdmissionConfiguration that minikube writes
+type admissionConfiguration struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Plugins    []admissionPlugin `yaml:"plugins"`
+}
+
+type admissionPlugin struct {
+	Name          string                   `yaml:"name"`
+	Configuration podSecurityConfiguration `yaml:"configuration"`
+}
+
+type podSecurityConfiguration struct {
+	APIVersion string               `yaml:"apiVersion"`
+	Kind       string               `yaml:"kind"`
+	Defaults   podSecurityDefaults  `yaml:"defaults"`
+	Exemptions podSecurityExemption `yaml:"exemptions"`
+}
+
+type podSecurityDefaults struct {
+	Enforce        string `yaml:"enforce"`
+	EnforceVersion string `yaml:"enforce-version"`
+	Audit          string `yaml:"audit"`
+	AuditVersion   string `yaml:"audit-version"`
+	Warn           string `yaml:"warn"`
+	WarnVersion    string `yaml:"warn-version"`
+}
+
+type podSecurityExemption struct {
+	Usernames      []string `yaml:"usernames"`
+	RuntimeClasses []string `yaml:"runtimeClasses"`
+	Namespaces     []string `yaml:"namespaces"`
+}
+
+// generateAdmissionConfig writes the AdmissionConfiguration of cc to the profile directory, and returns its path.
+// The PodSecurity admission plugin enforces, audits and warns about the --pod-security-level in every namespace
+// but the PodSecurityExemptNamespaces, unless their labels say otherwise.
+func generateAdmissionConfig(cc config.ClusterConfig) (string, error) {
+	version, err := util.ParseKubernetesVersion(cc.KubernetesConfig.KubernetesVersion)
+	if err != nil {
+		return "", errors.Wrap(err, "parse kubernetes version")
+	}
+	// the PodSecurity plugin is beta in v1.23 and v1.24, and stable since v1.25
+	var psaVersion string
+	switch {
+	case version.GTE(semver.MustParse("1.25.0")):
+		psaVersion = "v1"
+	case version.GTE(semver.MustParse("1.23.0")):
+		psaVersion = "v1beta1"
+	default:
+		return "", fmt.Errorf("pod security admission requires Kubernetes v1.23 or later, not %s", cc.KubernetesConfig.KubernetesVersion)
+	}
+
+	level := cc.PodSecurityLevel
+	ac := admissionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "AdmissionConfiguration",
+		Plugins: []admissionPlugin{{
+			Name: "PodSecurity",
+			Configuration: podSecurityConfiguration{
+				APIVersion: "pod-security.admission.config.k8s.io/" + psaVersion,
+				Kind:       "PodSecurityConfiguration",
+				Defaults: podSecurityDefaults{
+					Enforce:        level,
+					EnforceVersion: "latest",
+					Audit:          level,
+					AuditVersion:   "latest",
+					Warn:           level,
+					WarnVersion:    "latest",
+				},
+				Exemptions: podSecurityExemption{
+					Usernames:      []string{},
+					RuntimeClasses: []string{},
+					Namespaces:     PodSecurityExemptNamespaces,
+				},
+			},
+		}},
+	}
+	b, err := yaml.Marshal(ac)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal")
+	}
+	klog.Infof("writing %s pod security admission config to %s", level, profileAPIServerFile(cc, admissionConfigFile))
+	return writeAPIServerFile(cc, admissionConfigFile, b, 0o644)
+}
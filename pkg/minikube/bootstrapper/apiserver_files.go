@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+)
+
+// The files of the API server that minikube writes, its configurations and the credentials of an external etcd,
+// are kept in the profile directory, and copied by SetupCerts along with the certificates to the Kubernetes certs
+// directory of the control-plane nodes. kubeadm mounts that directory into the API server already, so its flags
+// can point there without extra mounts.
+
+// apiServerFile returns the path of the API server file name on the control-plane nodes
+func apiServerFile(name string) string {
+	return path.Join(vmpath.GuestKubernetesCertsDir, name)
+}
+
+// profileAPIServerFile returns the path of the API server file name of cc in the profile directory
+func profileAPIServerFile(cc config.ClusterConfig, name string) string {
+	return filepath.Join(localpath.Profile(cc.Name), name)
+}
+
+// writeAPIServerFile writes the API server file name of cc to the profile directory, and returns its path there
+func writeAPIServerFile(cc config.ClusterConfig, name string, b []byte, perm os.FileMode) (string, error) {
+	p := profileAPIServerFile(cc, name)
+	if err := os.WriteFile(p, b, perm); err != nil {
+		return "", errors.Wrapf(err, "write %s", p)
+	}
+	return p, nil
+}
+
+// copyAPIServerFile copies the file src given by the user as the API server file name of cc, see writeAPIServerFile
+func copyAPIServerFile(cc config.ClusterConfig, src string, name string, perm os.FileMode) (string, error) {
+	b, err := os.ReadFile(src)
+	if err != nil {
+		return "", errors.Wrapf(err, "read %s", src)
+	}
+	return writeAPIServerFile(cc, name, b, perm)
+}
+
+// apiServerFiles writes the API server files that cc needs to the profile directory, and returns their paths there
+func apiServerFiles(cc config.ClusterConfig) ([]string, error) {
+	var files []string
+	// every API server must encrypt with the same keys, which are generated once
+	if cc.EncryptSecrets != "" {
+		p, err := generateEncryptionConfig(cc)
+		if err != nil {
+			return nil, errors.Wrap(err, "generate encryption config")
+		}
+		files = append(files, p)
+	}
+	if cc.AuditPolicy != "" {
+		p, err := copyAPIServerFile(cc, cc.AuditPolicy, auditPolicyFile, 0o644)
+		if err != nil {
+			return nil, errors.Wrap(err, "copy audit policy")
+		}
+		files = append(files, p)
+	}
+	if config.IsExternalEtcd(cc) {
+		ps, err := copyExternalEtcdCerts(cc)
+		if err != nil {
+			return nil, errors.Wrap(err, "copy external etcd certs")
+		}
+		files = append(files, ps...)
+	}
+	if cc.PodSecurityLevel != "" {
+		p, err := generateAdmissionConfig(cc)
+		if err != nil {
+			return nil, errors.Wrap(err, "generate admission config")
+		}
+		files = append(files, p)
+	}
+	return files, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// AuditLogDir is the directory of the audit log of the API server on the control-plane nodes, which is mounted into the API server
+const AuditLogDir = "/var/log/kubernetes/audit"
+
+// auditPolicyFile is the copy of the --audit-policy of the user
+const auditPolicyFile = "audit-policy.yaml"
+
+// AuditLogPath returns the path of the audit log of the API server on the control-plane nodes.
+// It is rotated by the API server, which keeps the rotated logs next to it.
+func AuditLogPath() string {
+	return path.Join(AuditLogDir, "audit.log")
+}
+
+// AuditPolicyPath returns the path of the audit policy in the guest
+func AuditPolicyPath() string {
+	return apiServerFile(auditPolicyFile)
+}
+
+// ValidateAuditPolicy returns an error if the file at p is not an audit.k8s.io Policy
+func ValidateAuditPolicy(p string) error {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	var policy struct {
+		APIVersion string        `yaml:"apiVersion"`
+		Kind       string        `yaml:"kind"`
+		Rules      []interface{} `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal(b, &policy); err != nil {
+		return errors.Wrap(err, "unmarshal")
+	}
+	if policy.Kind != "Policy" || path.Dir(policy.APIVersion) != "audit.k8s.io" {
+		return fmt.Errorf("not an audit.k8s.io Policy: apiVersion %q, kind %q", policy.APIVersion, policy.Kind)
+	}
+	if len(policy.Rules) == 0 {
+		return fmt.Errorf("the policy has no rules")
+	}
+	return nil
+}
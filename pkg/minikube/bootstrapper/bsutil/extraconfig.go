@@ -19,13 +19,17 @@ package bsutil
 
 import (
 	"fmt"
+	"path"
 	"sort"
 	"strings"
 
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/vmpath"
 )
 
 // enum to differentiate kubeadm command line parameters from kubeadm config file parameters (see the
@@ -169,7 +173,7 @@ func newComponentOptions(opts config.ExtraOptionSlice, version semver.Version, f
 			kubeadmExtraArgs = append(kubeadmExtraArgs, componentOptions{
 				Component: kubeadmComponentKey,
 				ExtraArgs: extraConfig,
-				Pairs:     optionPairsForComponent(component, cp),
+				Pairs:     optionPairsForComponent(component, cp, extraConfig),
 			})
 		}
 	}
@@ -178,11 +182,16 @@ func newComponentOptions(opts config.ExtraOptionSlice, version semver.Version, f
 }
 
 // optionPairsForComponent generates a map of value pairs for a k8s component
-func optionPairsForComponent(component string, cp config.Node) map[string]string {
+func optionPairsForComponent(component string, cp config.Node, extraArgs map[string]string) map[string]string {
 	if component == Apiserver {
-		return map[string]string{
+		pairs := map[string]string{
 			"certSANs": fmt.Sprintf(`["127.0.0.1", "localhost", "%s"]`, cp.IP),
 		}
+		// the audit log is written to the node, unlike the other files of the API server, which are read only
+		if path.Dir(extraArgs["audit-log-path"]) == bootstrapper.AuditLogDir {
+			pairs["extraVolumes"] = fmt.Sprintf(`[{name: audit-log, hostPath: %s, mountPath: %s, pathType: DirectoryOrCreate}]`, bootstrapper.AuditLogDir, bootstrapper.AuditLogDir)
+		}
+		return pairs
 	}
 	return nil
 }
@@ -207,9 +216,64 @@ func createExtraComponentConfig(extraOptions config.ExtraOptionSlice, version se
 	return validComponents, nil
 }
 
-// createKubeProxyOptions generates a map of extra config for kube-proxy
-func createKubeProxyOptions(extraOptions config.ExtraOptionSlice) map[string]string {
-	kubeProxyOptions := extraOptions.AsMap().Get(Kubeproxy)
+// withAPIServerOptions returns the extra options of cc, with the apiserver flags of its settings:
+// the ones that authenticate users with its OIDC issuer, the one that encrypts secrets at rest,
+// the one that sets the defaults of the pod security admission, and the ones that audit its requests.
+// The flags set with --extra-config take precedence.
+func withAPIServerOptions(cc config.ClusterConfig) config.ExtraOptionSlice {
+	flags := map[string]string{}
+	if issuer := config.OIDCIssuerURL(cc); issuer != "" {
+		flags["oidc-issuer-url"] = issuer
+		flags["oidc-client-id"] = cc.OIDC.ClientID
+		flags["oidc-username-claim"] = cc.OIDC.UsernameClaim
+		flags["oidc-groups-claim"] = cc.OIDC.GroupsClaim
+		// the certificate of the dex addon is signed by the cluster CA
+		if cc.OIDC.IssuerURL == constants.DexIssuer {
+			flags["oidc-ca-file"] = path.Join(vmpath.GuestKubernetesCertsDir, "ca.crt")
+		}
+	}
+	if cc.EncryptSecrets != "" {
+		flags["encryption-provider-config"] = bootstrapper.EncryptionConfigPath()
+	}
+	if cc.PodSecurityLevel != "" {
+		flags["admission-control-config-file"] = bootstrapper.AdmissionConfigPath()
+	}
+	if cc.AuditPolicy != "" {
+		flags["audit-policy-file"] = bootstrapper.AuditPolicyPath()
+		flags["audit-log-path"] = bootstrapper.AuditLogPath()
+		// rotated by size, keeping up to 50MB
+		flags["audit-log-maxsize"] = "10"
+		flags["audit-log-maxbackup"] = "4"
+	}
+
+	opts := append(config.ExtraOptionSlice{}, cc.KubernetesConfig.ExtraOptions...)
+	keys := []string{}
+	for k := range flags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if flags[k] == "" || opts.Get(k, Apiserver) != "" {
+			continue
+		}
+		opts = append(opts, config.ExtraOption{Component: Apiserver, Key: k, Value: flags[k]})
+	}
+	return opts
+}
+
+// KubeProxyModes are the modes of kube-proxy that --kube-proxy-mode accepts
+var KubeProxyModes = []string{"iptables", "ipvs"}
+
+// createKubeProxyOptions generates a map of extra config for kube-proxy,
+// with the mode of --kube-proxy-mode unless the extra config sets one
+func createKubeProxyOptions(k8s config.KubernetesConfig) map[string]string {
+	kubeProxyOptions := k8s.ExtraOptions.AsMap().Get(Kubeproxy)
+	if k8s.KubeProxyMode != "" && kubeProxyOptions["mode"] == "" {
+		if kubeProxyOptions == nil {
+			kubeProxyOptions = map[string]string{}
+		}
+		kubeProxyOptions["mode"] = k8s.KubeProxyMode
+	}
 	return kubeProxyOptions
 }
 
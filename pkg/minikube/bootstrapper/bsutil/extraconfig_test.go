@@ -57,3 +57,130 @@ func TestFindInvalidExtraConfigFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestWithAPIServerOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		cc   config.ClusterConfig
+		want config.ExtraOptionSlice
+	}{
+		{
+			name: "without issuer",
+			cc:   config.ClusterConfig{OIDC: config.OIDCConfig{ClientID: "minikube"}},
+			want: config.ExtraOptionSlice{},
+		},
+		{
+			name: "with issuer",
+			cc: config.ClusterConfig{
+				OIDC: config.OIDCConfig{IssuerURL: "https://sso.example.com", ClientID: "minikube", UsernameClaim: "email"},
+			},
+			want: config.ExtraOptionSlice{
+				{Component: Apiserver, Key: "oidc-client-id", Value: "minikube"},
+				{Component: Apiserver, Key: "oidc-issuer-url", Value: "https://sso.example.com"},
+				{Component: Apiserver, Key: "oidc-username-claim", Value: "email"},
+			},
+		},
+		{
+			name: "with extra-config",
+			cc: config.ClusterConfig{
+				KubernetesConfig: config.KubernetesConfig{
+					ExtraOptions: config.ExtraOptionSlice{{Component: Apiserver, Key: "oidc-client-id", Value: "other"}},
+				},
+				OIDC: config.OIDCConfig{IssuerURL: "https://sso.example.com", ClientID: "minikube"},
+			},
+			want: config.ExtraOptionSlice{
+				{Component: Apiserver, Key: "oidc-client-id", Value: "other"},
+				{Component: Apiserver, Key: "oidc-issuer-url", Value: "https://sso.example.com"},
+			},
+		},
+		{
+			name: "with dex",
+			cc: config.ClusterConfig{
+				Nodes: []config.Node{{IP: "192.168.49.2"}},
+				OIDC:  config.OIDCConfig{IssuerURL: "dex", ClientID: "minikube"},
+			},
+			want: config.ExtraOptionSlice{
+				{Component: Apiserver, Key: "oidc-ca-file", Value: "/var/lib/minikube/certs/ca.crt"},
+				{Component: Apiserver, Key: "oidc-client-id", Value: "minikube"},
+				{Component: Apiserver, Key: "oidc-issuer-url", Value: "https://192.168.49.2:32000/dex"},
+			},
+		},
+		{
+			name: "with encrypted secrets",
+			cc:   config.ClusterConfig{EncryptSecrets: "aescbc"},
+			want: config.ExtraOptionSlice{
+				{Component: Apiserver, Key: "encryption-provider-config", Value: "/var/lib/minikube/certs/encryption-config.yaml"},
+			},
+		},
+		{
+			name: "with a pod security level",
+			cc:   config.ClusterConfig{PodSecurityLevel: "restricted"},
+			want: config.ExtraOptionSlice{
+				{Component: Apiserver, Key: "admission-control-config-file", Value: "/var/lib/minikube/certs/admission-config.yaml"},
+			},
+		},
+		{
+			name: "with an audit policy",
+			cc:   config.ClusterConfig{AuditPolicy: "/home/user/policy.yaml"},
+			want: config.ExtraOptionSlice{
+				{Component: Apiserver, Key: "audit-log-maxbackup", Value: "4"},
+				{Component: Apiserver, Key: "audit-log-maxsize", Value: "10"},
+				{Component: Apiserver, Key: "audit-log-path", Value: "/var/log/kubernetes/audit/audit.log"},
+				{Component: Apiserver, Key: "audit-policy-file", Value: "/var/lib/minikube/certs/audit-policy.yaml"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withAPIServerOptions(tt.cc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("withAPIServerOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionPairsForComponent(t *testing.T) {
+	cp := config.Node{IP: "192.168.49.2"}
+	pairs := optionPairsForComponent(Apiserver, cp, map[string]string{})
+	if _, ok := pairs["extraVolumes"]; ok {
+		t.Errorf("extraVolumes = %q without an audit log, want none", pairs["extraVolumes"])
+	}
+
+	pairs = optionPairsForComponent(Apiserver, cp, map[string]string{"audit-log-path": "/var/log/kubernetes/audit/audit.log"})
+	want := "[{name: audit-log, hostPath: /var/log/kubernetes/audit, mountPath: /var/log/kubernetes/audit, pathType: DirectoryOrCreate}]"
+	if got := pairs["extraVolumes"]; got != want {
+		t.Errorf("extraVolumes = %q, want %q", got, want)
+	}
+
+	// an audit log elsewhere is mounted by the user
+	pairs = optionPairsForComponent(Apiserver, cp, map[string]string{"audit-log-path": "-"})
+	if _, ok := pairs["extraVolumes"]; ok {
+		t.Errorf("extraVolumes = %q for an audit log on stdout, want none", pairs["extraVolumes"])
+	}
+}
+
+func TestCreateKubeProxyOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		k8s  config.KubernetesConfig
+		want map[string]string
+	}{
+		{"default", config.KubernetesConfig{}, nil},
+		{"mode", config.KubernetesConfig{KubeProxyMode: "ipvs"}, map[string]string{"mode": "ipvs"}},
+		{
+			name: "extra config",
+			k8s: config.KubernetesConfig{KubeProxyMode: "ipvs", ExtraOptions: config.ExtraOptionSlice{
+				{Component: Kubeproxy, Key: "mode", Value: "iptables"},
+				{Component: Kubeproxy, Key: "ipvs.scheduler", Value: "wrr"},
+			}},
+			want: map[string]string{"mode": "iptables", "ipvs.scheduler": "wrr"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createKubeProxyOptions(tt.k8s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createKubeProxyOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -61,6 +61,18 @@ controlPlaneEndpoint: {{.ControlPlaneAddress}}:{{.APIServerPort}}
 dns:
   type: CoreDNS
 etcd:
+{{- if .ExternalEtcd.Endpoints}}
+  external:
+    endpoints:
+{{- range .ExternalEtcd.Endpoints}}
+      - {{.}}
+{{- end}}
+{{- if .ExternalEtcd.CAFile}}
+    caFile: {{.ExternalEtcd.CAFile}}
+    certFile: {{.ExternalEtcd.CertFile}}
+    keyFile: {{.ExternalEtcd.KeyFile}}
+{{- end}}
+{{- else}}
   local:
     dataDir: {{.EtcdDataDir}}
     extraArgs:
@@ -68,6 +80,7 @@ etcd:
 {{- range $i, $val := printMapInOrder .EtcdExtraArgs ": " }}
       {{$val}}
 {{- end}}
+{{- end}}
 kubernetesVersion: {{.KubernetesVersion}}
 networking:
   dnsDomain: {{if .DNSDomain}}{{.DNSDomain}}{{else}}cluster.local{{end}}
@@ -21,11 +21,13 @@ import (
 	"bytes"
 	"fmt"
 	"path"
+	"strings"
 
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/ktmpl"
 	"k8s.io/minikube/pkg/minikube/cni"
 	"k8s.io/minikube/pkg/minikube/config"
@@ -38,6 +40,14 @@ import (
 // Container runtimes
 const remoteContainerRuntime = "remote"
 
+// externalEtcd is the etcd of the kubeadm config, when it is external. The files are in the guest.
+type externalEtcd struct {
+	Endpoints []string
+	CAFile    string
+	CertFile  string
+	KeyFile   string
+}
+
 // GenerateKubeadmYAML generates the kubeadm.yaml file for primary control-plane node.
 func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Manager) ([]byte, error) {
 	k8s := cc.KubernetesConfig
@@ -66,7 +76,7 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 		return nil, errors.Wrap(err, "getting cgroup driver")
 	}
 
-	componentOpts, err := createExtraComponentConfig(k8s.ExtraOptions, version, componentFeatureArgs, n)
+	componentOpts, err := createExtraComponentConfig(withAPIServerOptions(cc), version, componentFeatureArgs, n)
 	if err != nil {
 		return nil, errors.Wrap(err, "generating extra component config for kubeadm")
 	}
@@ -81,6 +91,9 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 	if overrideCIDR != "" {
 		podCIDR = overrideCIDR
 	}
+	if k8s.DualStack && !strings.Contains(podCIDR, ",") {
+		podCIDR += "," + cni.DefaultPodCIDRv6
+	}
 	klog.Infof("Using pod CIDR: %s", podCIDR)
 
 	// ref: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/#kubelet-config-k8s-io-v1beta1-KubeletConfiguration
@@ -116,6 +129,7 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 		KubernetesVersion          string
 		EtcdDataDir                string
 		EtcdExtraArgs              map[string]string
+		ExternalEtcd               externalEtcd
 		ClusterName                string
 		NodeName                   string
 		DNSDomain                  string
@@ -149,19 +163,31 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 		ComponentOptions:           componentOpts,
 		FeatureArgs:                kubeadmFeatureArgs,
 		DNSDomain:                  k8s.DNSDomain,
-		NodeIP:                     n.IP,
+		NodeIP:                     nodeIP(cc, n),
 		CgroupDriver:               cgroupDriver,
 		ClientCAFile:               path.Join(vmpath.GuestKubernetesCertsDir, "ca.crt"),
 		StaticPodPath:              vmpath.GuestManifestsDir,
 		ControlPlaneAddress:        constants.ControlPlaneAlias,
-		KubeProxyOptions:           createKubeProxyOptions(k8s.ExtraOptions),
+		KubeProxyOptions:           createKubeProxyOptions(k8s),
 		ResolvConfSearchRegression: HasResolvConfSearchRegression(k8s.KubernetesVersion),
 		KubeletConfigOpts:          kubeletConfigOpts,
 	}
 
+	if config.IsExternalEtcd(cc) {
+		opts.ExternalEtcd.Endpoints = cc.ExternalEtcd.Endpoints
+		if cc.ExternalEtcd.CAFile != "" {
+			opts.ExternalEtcd.CAFile = bootstrapper.ExternalEtcdCAPath()
+			opts.ExternalEtcd.CertFile = bootstrapper.ExternalEtcdCertPath()
+			opts.ExternalEtcd.KeyFile = bootstrapper.ExternalEtcdKeyPath()
+		}
+	}
 	if k8s.ServiceCIDR != "" {
 		opts.ServiceCIDR = k8s.ServiceCIDR
 	}
+	// the IPv4 service CIDR is the primary one, so that the kubernetes service keeps its IPv4 cluster IP
+	if k8s.DualStack {
+		opts.ServiceCIDR += "," + constants.DefaultServiceCIDRv6
+	}
 
 	configTmpl := ktmpl.V1Beta1
 	// v1beta2 isn't required until v1.17.
@@ -184,6 +210,10 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 	if err := configTmpl.Execute(&b, opts); err != nil {
 		return nil, err
 	}
+	// the kubeadm patches are not part of the config, but the control plane is reconfigured with them when they change
+	if h := kubeadmPatchesHash(cc, n); h != "" {
+		fmt.Fprintf(&b, "# kubeadm patches: %s\n", h)
+	}
 	klog.Infof("kubeadm config:\n%s\n", b.String())
 
 	return b.Bytes(), nil
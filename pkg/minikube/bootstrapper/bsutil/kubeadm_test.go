@@ -353,3 +353,84 @@ func TestKubeletConfig(t *testing.T) {
 		t.Errorf("machines mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestGenerateKubeadmYAMLDualStack(t *testing.T) {
+	fcr := command.NewFakeCommandRunner()
+	fcr.SetCommandToOutput(map[string]string{
+		"sudo crictl info": "{\"config\": {\"containerd\": {\"runtimes\": {\"runc\": {\"options\": {\"SystemdCgroup\": true}}}}}}",
+	})
+	runtime, err := cruntime.New(cruntime.Config{Type: constants.Containerd, Runner: fcr, Socket: "/run/containerd/containerd.sock"})
+	if err != nil {
+		t.Fatalf("runtime: %v", err)
+	}
+	n := config.Node{IP: "192.168.49.2", IPv6: "fd00:c0a8:3100::2", Name: "mk", ControlPlane: true}
+	cfg := config.ClusterConfig{
+		Name: "mk",
+		KubernetesConfig: config.KubernetesConfig{
+			KubernetesVersion: constants.DefaultKubernetesVersion,
+			ContainerRuntime:  constants.Containerd,
+			ServiceCIDR:       constants.DefaultServiceCIDR,
+			DualStack:         true,
+		},
+		Nodes: []config.Node{n},
+	}
+	got, err := GenerateKubeadmYAML(cfg, n, runtime)
+	if err != nil {
+		t.Fatalf("GenerateKubeadmYAML() error: %v", err)
+	}
+	for _, want := range []string{
+		`podSubnet: "10.244.0.0/16,fd00:10:244::/56"`,
+		"serviceSubnet: 10.96.0.0/12,fd00:10:96::/112",
+		`clusterCIDR: "10.244.0.0/16,fd00:10:244::/56"`,
+		"node-ip: 192.168.49.2,fd00:c0a8:3100::2",
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("GenerateKubeadmYAML() has no %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGenerateKubeadmYAMLExternalEtcd(t *testing.T) {
+	fcr := command.NewFakeCommandRunner()
+	fcr.SetCommandToOutput(map[string]string{
+		"docker info --format {{.CgroupDriver}}": "systemd\n",
+	})
+	runtime, err := cruntime.New(cruntime.Config{Type: "docker", Runner: fcr})
+	if err != nil {
+		t.Fatalf("runtime: %v", err)
+	}
+	n := config.Node{IP: "192.168.49.2", Name: "mk", ControlPlane: true}
+	cfg := config.ClusterConfig{
+		Name: "mk",
+		KubernetesConfig: config.KubernetesConfig{
+			KubernetesVersion: constants.DefaultKubernetesVersion,
+			ContainerRuntime:  "docker",
+		},
+		ExternalEtcd: config.ExternalEtcdConfig{
+			Endpoints: []string{"https://10.0.0.5:2379", "https://10.0.0.6:2379"},
+			CAFile:    "/home/me/etcd/ca.crt",
+			CertFile:  "/home/me/etcd/client.crt",
+			KeyFile:   "/home/me/etcd/client.key",
+		},
+		Nodes: []config.Node{n},
+	}
+	got, err := GenerateKubeadmYAML(cfg, n, runtime)
+	if err != nil {
+		t.Fatalf("GenerateKubeadmYAML() error: %v", err)
+	}
+	want := `etcd:
+  external:
+    endpoints:
+      - https://10.0.0.5:2379
+      - https://10.0.0.6:2379
+    caFile: /var/lib/minikube/certs/external-etcd-ca.crt
+    certFile: /var/lib/minikube/certs/apiserver-external-etcd-client.crt
+    keyFile: /var/lib/minikube/certs/apiserver-external-etcd-client.key
+kubernetesVersion:`
+	if !strings.Contains(string(got), want) {
+		t.Errorf("GenerateKubeadmYAML() has no %q:\n%s", want, got)
+	}
+	if strings.Contains(string(got), "dataDir") {
+		t.Errorf("GenerateKubeadmYAML() has a local etcd:\n%s", got)
+	}
+}
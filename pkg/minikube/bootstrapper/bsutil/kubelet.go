@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sort"
+	"strings"
 
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
@@ -62,6 +64,15 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 		extraOpts[k] = v
 	}
 
+	// options set for this node only take precedence over the cluster-wide ones
+	nodeOpts, err := extraConfigForComponent(Kubelet, nc.ExtraOptions, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "generating extra configuration for kubelet on node %q", nc.Name)
+	}
+	for k, v := range nodeOpts {
+		extraOpts[k] = v
+	}
+
 	// avoid "Failed to start ContainerManager failed to initialise top level QOS containers" error (ref: https://github.com/kubernetes/kubernetes/issues/43856)
 	// avoid "kubelet crashes with: root container [kubepods] doesn't exist" (ref: https://github.com/kubernetes/kubernetes/issues/95488)
 	if mc.Driver == oci.Docker && mc.KubernetesConfig.ContainerRuntime == constants.CRIO {
@@ -83,7 +94,7 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 	}
 
 	if _, ok := extraOpts["node-ip"]; !ok {
-		extraOpts["node-ip"] = nc.IP
+		extraOpts["node-ip"] = nodeIP(mc, nc)
 	}
 
 	if _, ok := extraOpts["hostname-override"]; !ok {
@@ -91,6 +102,17 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 		extraOpts["hostname-override"] = nodeName
 	}
 
+	// the node registers with its labels and taints, so that no pod is scheduled on it before it is tainted.
+	// The labels that the kubelet may not set, eg: under k8s.io, are applied once it joined the cluster.
+	if _, ok := extraOpts["node-labels"]; !ok {
+		if labels := kubeletNodeLabels(nc.Labels); labels != "" {
+			extraOpts["node-labels"] = labels
+		}
+	}
+	if _, ok := extraOpts["register-with-taints"]; !ok && len(nc.Taints) > 0 {
+		extraOpts["register-with-taints"] = strings.Join(nc.Taints, ",")
+	}
+
 	// Handled by CRI in 1.24+, and not by kubelet
 	if version.LT(semver.MustParse("1.24.0-alpha.2")) {
 		pauseImage := images.Pause(version, k8s.ImageRepository)
@@ -106,6 +128,15 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 		kubeletConfigParams = append(kubeletConfigParams, "container-runtime-endpoint")
 	}
 
+	if mc.SeccompDefault {
+		if version.LT(semver.MustParse("1.25.0")) {
+			return nil, fmt.Errorf("the RuntimeDefault seccomp profile requires Kubernetes v1.25 or later, not %s", k8s.KubernetesVersion)
+		}
+		if _, ok := extraOpts["seccomp-default"]; !ok {
+			extraOpts["seccomp-default"] = "true"
+		}
+	}
+
 	// parses a map of the feature gates for kubelet
 	_, kubeletFeatureArgs, err := parseFeatureArgs(k8s.FeatureGates)
 	if err != nil {
@@ -124,6 +155,33 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 	return extraOpts, nil
 }
 
+// kubeletNodeLabels returns the labels that the kubelet may set on its node, sorted, in the syntax of --node-labels.
+// The kubelet refuses the labels under kubernetes.io and k8s.io, except under kubelet.kubernetes.io and node.kubernetes.io.
+// ref: https://kubernetes.io/docs/reference/access-authn-authz/admission-controllers/#noderestriction
+func kubeletNodeLabels(labels map[string]string) string {
+	under := func(prefix, domain string) bool {
+		return prefix == domain || strings.HasSuffix(prefix, "."+domain)
+	}
+	var l []string
+	for k, v := range labels {
+		if prefix, _, ok := strings.Cut(k, "/"); ok && (under(prefix, "kubernetes.io") || under(prefix, "k8s.io")) &&
+			!under(prefix, "kubelet.kubernetes.io") && !under(prefix, "node.kubernetes.io") {
+			continue
+		}
+		l = append(l, k+"="+v)
+	}
+	sort.Strings(l)
+	return strings.Join(l, ",")
+}
+
+// nodeIP returns the node-ip of the kubelet of n, with its IPv6 address as well in dual-stack clusters
+func nodeIP(cc config.ClusterConfig, n config.Node) string {
+	if cc.KubernetesConfig.DualStack && n.IPv6 != "" {
+		return n.IP + "," + n.IPv6
+	}
+	return n.IP
+}
+
 // NewKubeletConfig generates a new systemd unit containing a configured kubelet
 // based on the options present in the KubernetesConfig.
 func NewKubeletConfig(mc config.ClusterConfig, nc config.Node, r cruntime.Manager) ([]byte, error) {
@@ -203,3 +203,139 @@ ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhoo
 		})
 	}
 }
+
+func TestExtraKubeletOptsPerNode(t *testing.T) {
+	cfg := config.ClusterConfig{
+		Name: "minikube",
+		KubernetesConfig: config.KubernetesConfig{
+			KubernetesVersion: constants.DefaultKubernetesVersion,
+			ContainerRuntime:  "containerd",
+			ExtraOptions: config.ExtraOptionSlice{
+				{Component: Kubelet, Key: "eviction-hard", Value: "memory.available<100Mi"},
+				{Component: Kubelet, Key: "max-pods", Value: "50"},
+			},
+		},
+	}
+	n := config.Node{
+		IP:   "192.168.1.101",
+		Name: "m02",
+		ExtraOptions: config.ExtraOptionSlice{
+			{Component: Kubelet, Key: "eviction-hard", Value: "memory.available<5%"},
+			{Component: Kubelet, Key: "node-ip", Value: "10.0.0.2"},
+		},
+	}
+	runtime, err := cruntime.New(cruntime.Config{Type: cfg.KubernetesConfig.ContainerRuntime})
+	if err != nil {
+		t.Fatalf("runtime: %v", err)
+	}
+	opts, err := extraKubeletOpts(cfg, n, runtime)
+	if err != nil {
+		t.Fatalf("extraKubeletOpts() error: %v", err)
+	}
+	want := map[string]string{
+		"eviction-hard": "memory.available<5%",
+		"max-pods":      "50",
+		"node-ip":       "10.0.0.2",
+	}
+	for k, v := range want {
+		if opts[k] != v {
+			t.Errorf("%s = %q, want %q", k, opts[k], v)
+		}
+	}
+}
+
+func TestExtraKubeletOptsSeccompDefault(t *testing.T) {
+	n := config.Node{IP: "192.168.1.100", Name: "minikube", ControlPlane: true}
+	tests := []struct {
+		version string
+		want    string
+		wantErr bool
+	}{
+		{version: constants.DefaultKubernetesVersion, want: "true"},
+		{version: "v1.24.17", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.version, func(t *testing.T) {
+			cfg := config.ClusterConfig{
+				Name:             "minikube",
+				SeccompDefault:   true,
+				KubernetesConfig: config.KubernetesConfig{KubernetesVersion: tc.version, ContainerRuntime: "containerd"},
+			}
+			runtime, err := cruntime.New(cruntime.Config{Type: cfg.KubernetesConfig.ContainerRuntime})
+			if err != nil {
+				t.Fatalf("runtime: %v", err)
+			}
+			opts, err := extraKubeletOpts(cfg, n, runtime)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("extraKubeletOpts() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("extraKubeletOpts() error: %v", err)
+			}
+			if got := opts["seccomp-default"]; got != tc.want {
+				t.Errorf("seccomp-default = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExtraKubeletOptsLabelsAndTaints(t *testing.T) {
+	cfg := config.ClusterConfig{
+		Name:             "minikube",
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: constants.DefaultKubernetesVersion, ContainerRuntime: "containerd"},
+	}
+	n := config.Node{
+		IP:     "192.168.1.101",
+		Name:   "m02",
+		Labels: map[string]string{"tier": "batch", "accelerator": "gpu", "minikube.k8s.io/nodepool": "gpu", "node.kubernetes.io/instance-type": "large"},
+		Taints: []string{"dedicated=gpu:NoSchedule", "spot:PreferNoSchedule"},
+	}
+	runtime, err := cruntime.New(cruntime.Config{Type: cfg.KubernetesConfig.ContainerRuntime})
+	if err != nil {
+		t.Fatalf("runtime: %v", err)
+	}
+	opts, err := extraKubeletOpts(cfg, n, runtime)
+	if err != nil {
+		t.Fatalf("extraKubeletOpts() error: %v", err)
+	}
+	want := map[string]string{
+		"node-labels":          "accelerator=gpu,node.kubernetes.io/instance-type=large,tier=batch",
+		"register-with-taints": "dedicated=gpu:NoSchedule,spot:PreferNoSchedule",
+	}
+	for k, v := range want {
+		if opts[k] != v {
+			t.Errorf("%s = %q, want %q", k, opts[k], v)
+		}
+	}
+}
+
+func TestExtraKubeletOptsDualStack(t *testing.T) {
+	n := config.Node{IP: "192.168.49.2", IPv6: "fd00:c0a8:3100::2", Name: "minikube", ControlPlane: true}
+	tests := []struct {
+		dualStack bool
+		want      string
+	}{
+		{false, "192.168.49.2"},
+		{true, "192.168.49.2,fd00:c0a8:3100::2"},
+	}
+	for _, tc := range tests {
+		cfg := config.ClusterConfig{
+			Name:             "minikube",
+			KubernetesConfig: config.KubernetesConfig{KubernetesVersion: constants.DefaultKubernetesVersion, ContainerRuntime: "containerd", DualStack: tc.dualStack},
+		}
+		runtime, err := cruntime.New(cruntime.Config{Type: cfg.KubernetesConfig.ContainerRuntime})
+		if err != nil {
+			t.Fatalf("runtime: %v", err)
+		}
+		opts, err := extraKubeletOpts(cfg, n, runtime)
+		if err != nil {
+			t.Fatalf("extraKubeletOpts() error: %v", err)
+		}
+		if got := opts["node-ip"]; got != tc.want {
+			t.Errorf("node-ip = %q with dual-stack %v, want %q", got, tc.dualStack, tc.want)
+		}
+	}
+}
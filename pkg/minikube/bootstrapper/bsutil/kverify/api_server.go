@@ -28,6 +28,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -258,6 +259,13 @@ func apiServerHealthzNow(hostname string, port int) (state.State, error) {
 	}
 	pool := x509.NewCertPool()
 	pool.AppendCertsFromPEM(cert)
+	// the apiserver of a cluster started with --certs-dir is signed by the CA supplied for it
+	cas, _ := filepath.Glob(filepath.Join(localpath.MiniPath(), "profiles", "*", "ca.crt"))
+	for _, ca := range cas {
+		if c, err := os.ReadFile(ca); err == nil {
+			pool.AppendCertsFromPEM(c)
+		}
+	}
 	tr := &http.Transport{
 		Proxy:           nil, // Avoid using a proxy to speak to a local host
 		TLSClientConfig: &tls.Config{RootCAs: pool},
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bsutil
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+)
+
+// kubeadmPatchTargets are the components kubeadm can patch, mapped to the first version supporting them
+// ref: https://kubernetes.io/docs/setup/production-environment/tools/kubeadm/control-plane-flags/#patches
+var kubeadmPatchTargets = map[string]semver.Version{
+	"etcd":                    semver.MustParse("1.22.0"),
+	"kube-apiserver":          semver.MustParse("1.22.0"),
+	"kube-controller-manager": semver.MustParse("1.22.0"),
+	"kube-scheduler":          semver.MustParse("1.22.0"),
+	"kubeletconfiguration":    semver.MustParse("1.25.0"),
+}
+
+// ValidateKubeadmPatch returns an error if name does not follow the kubeadm "target[suffix][+patchtype].extension" convention,
+// or if kubeadm of the given version does not support patching its target
+func ValidateKubeadmPatch(name string, version semver.Version) error {
+	ext := path.Ext(name)
+	if ext != ".yaml" && ext != ".json" {
+		return fmt.Errorf("kubeadm patch %q must have a .yaml or .json extension", name)
+	}
+	base := strings.TrimSuffix(name, ext)
+	if i := strings.Index(base, "+"); i >= 0 {
+		switch base[i+1:] {
+		case "strategic", "merge", "json":
+		default:
+			return fmt.Errorf("kubeadm patch %q has unknown patch type %q, must be one of strategic, merge or json", name, base[i+1:])
+		}
+		base = base[:i]
+	}
+	for target, minVersion := range kubeadmPatchTargets {
+		if !strings.HasPrefix(base, target) {
+			continue
+		}
+		if version.LT(minVersion) {
+			return fmt.Errorf("kubeadm patch %q requires Kubernetes v%s or later", name, minVersion)
+		}
+		return nil
+	}
+	return fmt.Errorf("kubeadm patch %q has unknown target, must start with one of %s", name, strings.Join(patchTargetNames(), ", "))
+}
+
+func patchTargetNames() []string {
+	names := []string{}
+	for t := range kubeadmPatchTargets {
+		names = append(names, t)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// KubeadmPatches returns the kubeadm patches of the node n of cc: the ones of the cluster, and those of the node, which replace them
+func KubeadmPatches(cc config.ClusterConfig, n config.Node) map[string]string {
+	patches := map[string]string{}
+	for name, content := range cc.KubernetesConfig.KubeadmPatches {
+		patches[name] = content
+	}
+	for name, content := range n.KubeadmPatches {
+		patches[name] = content
+	}
+	return patches
+}
+
+// KubeadmPatchFiles returns the kubeadm patches of the node n of cc, to be copied to constants.KubeadmPatchesDir
+func KubeadmPatchFiles(cc config.ClusterConfig, n config.Node) []assets.CopyableFile {
+	files := []assets.CopyableFile{}
+	for name, content := range KubeadmPatches(cc, n) {
+		files = append(files, assets.NewMemoryAssetTarget([]byte(content), path.Join(constants.KubeadmPatchesDir, name), "0644"))
+	}
+	return files
+}
+
+// kubeadmPatchesHash returns a hash of the kubeadm patches of the node n of cc, empty if it has none
+func kubeadmPatchesHash(cc config.ClusterConfig, n config.Node) string {
+	patches := KubeadmPatches(cc, n)
+	if len(patches) == 0 {
+		return ""
+	}
+	names := []string{}
+	for name := range patches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s\n%s\n", name, patches[name])
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
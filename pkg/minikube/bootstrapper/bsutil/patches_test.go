@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bsutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestValidateKubeadmPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		valid   bool
+	}{
+		{"kubeletconfiguration.yaml", "1.30.0", true},
+		{"kubeletconfiguration+merge.yaml", "1.30.0", true},
+		{"kube-apiserver0+json.json", "1.30.0", true},
+		{"etcd+strategic.yaml", "1.22.0", true},
+		{"kubeletconfiguration+merge.yaml", "1.24.0", false},
+		{"kube-proxy.yaml", "1.30.0", false},
+		{"kubeletconfiguration+patch.yaml", "1.30.0", false},
+		{"kubeletconfiguration.txt", "1.30.0", false},
+	}
+	for _, tc := range tests {
+		err := ValidateKubeadmPatch(tc.name, semver.MustParse(tc.version))
+		if tc.valid && err != nil {
+			t.Errorf("ValidateKubeadmPatch(%q, %s) returned error: %v", tc.name, tc.version, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("ValidateKubeadmPatch(%q, %s) returned no error", tc.name, tc.version)
+		}
+	}
+}
+
+func TestKubeadmPatches(t *testing.T) {
+	cc := config.ClusterConfig{KubernetesConfig: config.KubernetesConfig{KubeadmPatches: map[string]string{
+		"kube-apiserver+strategic.yaml":   "cluster",
+		"kubeletconfiguration+merge.yaml": "cluster",
+	}}}
+	n := config.Node{Name: "m02", KubeadmPatches: map[string]string{"kubeletconfiguration+merge.yaml": "node"}}
+
+	expected := map[string]string{
+		"kube-apiserver+strategic.yaml":   "cluster",
+		"kubeletconfiguration+merge.yaml": "node",
+	}
+	if got := KubeadmPatches(cc, n); !reflect.DeepEqual(got, expected) {
+		t.Errorf("KubeadmPatches() = %v, expected %v", got, expected)
+	}
+	if got := len(KubeadmPatchFiles(cc, n)); got != 2 {
+		t.Errorf("KubeadmPatchFiles() returned %d files, expected 2", got)
+	}
+
+	if h := kubeadmPatchesHash(config.ClusterConfig{}, config.Node{}); h != "" {
+		t.Errorf("kubeadmPatchesHash() = %q without patches, expected none", h)
+	}
+	h := kubeadmPatchesHash(cc, n)
+	if h == "" || h == kubeadmPatchesHash(cc, config.Node{}) {
+		t.Errorf("kubeadmPatchesHash() = %q does not change with the patches", h)
+	}
+}
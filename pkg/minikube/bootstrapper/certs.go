@@ -26,13 +26,13 @@ import (
 	"os/exec"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	// WARNING: use path for kic/iso and path/filepath for user os
 	"path"
 	"path/filepath"
 
-	"github.com/juju/mutex/v2"
 	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
 
@@ -71,6 +71,16 @@ func SetupCerts(k8s config.ClusterConfig, n config.Node, pcpCmd command.Runner,
 	if err != nil {
 		return errors.Wrap(err, "generate shared ca certs")
 	}
+	// the CA certs may have been regenerated ahead of time, by GenerateSharedCACerts
+	regen = sharedCARegenerated.Swap(false) || regen
+
+	if err := setupCertsDir(k8s); err != nil {
+		return errors.Wrap(err, "certs dir")
+	}
+	if k8s.CertsDir != "" {
+		sharedCerts.caCert = filepath.Join(localPath, "ca.crt")
+		sharedCerts.caKey = filepath.Join(localPath, "ca.key")
+	}
 
 	xfer := []string{
 		sharedCerts.caCert,
@@ -88,6 +98,14 @@ func SetupCerts(k8s config.ClusterConfig, n config.Node, pcpCmd command.Runner,
 		xfer = append(xfer, profileCerts...)
 	}
 
+	if n.ControlPlane {
+		files, err := apiServerFiles(k8s)
+		if err != nil {
+			return err
+		}
+		xfer = append(xfer, files...)
+	}
+
 	copyableFiles := []assets.CopyableFile{}
 	defer func() {
 		for _, f := range copyableFiles {
@@ -110,6 +128,10 @@ func SetupCerts(k8s config.ClusterConfig, n config.Node, pcpCmd command.Runner,
 	if err != nil {
 		return errors.Wrap(err, "collect ca certs")
 	}
+	if k8s.CertsDir != "" {
+		delete(caCerts, localpath.CACert())
+		caCerts[sharedCerts.caCert] = path.Join(vmpath.GuestCertAuthDir, "minikubeCA.pem")
+	}
 
 	for src, dst := range caCerts {
 		// note: these are all public certs, so should be world-readeable
@@ -138,6 +160,10 @@ func SetupCerts(k8s config.ClusterConfig, n config.Node, pcpCmd command.Runner,
 				{vmpath.GuestKubernetesCertsDir + "/etcd", "ca.key", "etcd-ca.key"},
 			}
 			for _, c := range pcpCerts {
+				// there is no etcd CA with an external etcd
+				if config.IsExternalEtcd(k8s) && strings.HasPrefix(c.dstFile, "etcd-") {
+					continue
+				}
 				// get cert from primary control-plane node
 				f := assets.NewMemoryAsset(nil, c.srcDir, c.srcFile, properPerms(c.dstFile))
 				if err := pcpCmd.CopyFrom(f); err != nil {
@@ -182,6 +208,15 @@ func SetupCerts(k8s config.ClusterConfig, n config.Node, pcpCmd command.Runner,
 		return errors.Wrap(err, "install cert symlinks")
 	}
 
+	// the security profiles are distributed to every node, like the certs
+	if err := setupSecurityProfiles(k8s, cmd); err != nil {
+		return errors.Wrap(err, "setup security profiles")
+	}
+
+	if err := setupRegistryAuth(k8s, cmd); err != nil {
+		return errors.Wrap(err, "setup registry credentials")
+	}
+
 	if err := renewExpiredKubeadmCerts(cmd, k8s); err != nil {
 		return errors.Wrap(err, "renew expired kubeadm certs")
 	}
@@ -189,6 +224,55 @@ func SetupCerts(k8s config.ClusterConfig, n config.Node, pcpCmd command.Runner,
 	return nil
 }
 
+// setupCertsDir copies the CA supplied with --certs-dir, and the apiserver cert if there is one, into the profile directory,
+// where they replace the minikube CA and the generated apiserver cert. Without --certs-dir, it removes the supplied CA.
+func setupCertsDir(cc config.ClusterConfig) error {
+	profilePath := localpath.Profile(cc.Name)
+	if cc.CertsDir == "" {
+		for _, f := range []string{"ca.crt", "ca.key"} {
+			if err := os.Remove(filepath.Join(profilePath, f)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	files := []string{"ca.crt", "ca.key"}
+	if canRead(filepath.Join(cc.CertsDir, "apiserver.crt")) {
+		files = append(files, "apiserver.crt", "apiserver.key")
+		// the generated apiserver certs are kept by hash, and would be used again once the supplied one is gone
+		generated, err := filepath.Glob(filepath.Join(profilePath, "apiserver.*.*"))
+		if err != nil {
+			return err
+		}
+		for _, g := range generated {
+			if err := os.Remove(g); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range files {
+		src := filepath.Join(cc.CertsDir, f)
+		klog.Infof("copying %s -> %s", src, profilePath)
+		if err := copy.Copy(src, filepath.Join(profilePath, f)); err != nil {
+			return errors.Wrapf(err, "copy %s", src)
+		}
+	}
+	return nil
+}
+
+// sharedCARegenerated is set when GenerateSharedCACerts regenerated the CA certs, whose profile certs must then be regenerated by SetupCerts
+var sharedCARegenerated atomic.Bool
+
+// GenerateSharedCACerts generates the CA certs shared by all profiles ahead of SetupCerts, so that it can overlap with starting the host.
+func GenerateSharedCACerts() error {
+	_, regen, err := generateSharedCACerts()
+	if regen {
+		sharedCARegenerated.Store(true)
+	}
+	return err
+}
+
 // generateSharedCACerts generates minikube Root CA and Proxy Client CA certs, but only if missing or expired.
 func generateSharedCACerts() (sharedCACerts, bool, error) {
 	klog.Info("generating shared ca certs ...")
@@ -224,7 +308,7 @@ func generateSharedCACerts() (sharedCACerts, bool, error) {
 	spec := lock.PathMutexSpec(hold)
 	spec.Timeout = 1 * time.Minute
 	klog.Infof("acquiring lock for ca certs: %+v", spec)
-	releaser, err := mutex.Acquire(spec)
+	releaser, err := lock.Acquire(spec)
 	if err != nil {
 		return cc, false, errors.Wrapf(err, "acquire lock for ca certs %+v", spec)
 	}
@@ -238,7 +322,7 @@ func generateSharedCACerts() (sharedCACerts, bool, error) {
 
 		regenProfileCerts = true
 		klog.Infof("generating %q ca cert: %s", ca.subject, ca.keyPath)
-		if err := util.GenerateCACert(ca.certPath, ca.keyPath, ca.subject); err != nil {
+		if err := util.GenerateCACert(ca.certPath, ca.keyPath, ca.subject, localpath.Perm(0600)); err != nil {
 			return cc, false, errors.Wrapf(err, "generate %q ca cert: %s", ca.subject, ca.keyPath)
 		}
 	}
@@ -299,6 +383,11 @@ func generateProfileCerts(cfg config.ClusterConfig, n config.Node, shared shared
 	hi = slices.Compact(hi)
 
 	profilePath := localpath.Profile(k8s.ClusterName)
+	// the dex addon is served on the primary control-plane node, see config.DexIssuerURL
+	dexIP := ""
+	if len(cfg.Nodes) > 0 {
+		dexIP = cfg.Nodes[0].IP
+	}
 
 	specs := []struct {
 		certPath string
@@ -339,10 +428,23 @@ func generateProfileCerts(cfg config.ClusterConfig, n config.Node, shared shared
 			caCertPath:     shared.proxyCert,
 			caKeyPath:      shared.proxyKey,
 		},
+		{ // dex addon serving cert, for --oidc-issuer-url=dex
+			hash:           fmt.Sprintf("%x", sha1.Sum([]byte(dexIP)))[0:8],
+			certPath:       filepath.Join(profilePath, "dex.crt"),
+			keyPath:        filepath.Join(profilePath, "dex.key"),
+			subject:        "dex",
+			ips:            []net.IP{net.ParseIP(dexIP)},
+			alternateNames: []string{},
+			caCertPath:     shared.caCert,
+			caKeyPath:      shared.caKey,
+		},
 	}
 
 	xfer := []string{}
 	for _, spec := range specs {
+		if spec.subject == "dex" && cfg.OIDC.IssuerURL != constants.DexIssuer {
+			continue
+		}
 		if spec.subject != "minikube-user" {
 			xfer = append(xfer, spec.certPath)
 			xfer = append(xfer, spec.keyPath)
@@ -355,7 +457,12 @@ func generateProfileCerts(cfg config.ClusterConfig, n config.Node, shared shared
 			kp = kp + "." + spec.hash
 		}
 
-		if !regen && isValid(cp, kp) {
+		if spec.subject == "minikube" && cfg.CertsDir != "" && canRead(filepath.Join(cfg.CertsDir, "apiserver.crt")) {
+			klog.Infof("using the apiserver cert supplied with --certs-dir: %s", spec.certPath)
+			continue
+		}
+
+		if !regen && isValid(cp, kp) && isSignedBy(cp, spec.caCertPath) {
 			klog.Infof("skipping valid signed profile cert regeneration for %q: %s", spec.subject, kp)
 			continue
 		}
@@ -371,7 +478,7 @@ func generateProfileCerts(cfg config.ClusterConfig, n config.Node, shared shared
 			cp, kp, spec.subject,
 			spec.ips, spec.alternateNames,
 			spec.caCertPath, spec.caKeyPath,
-			cfg.CertExpiration,
+			cfg.CertExpiration, localpath.Perm(0600),
 		)
 		if err != nil {
 			return nil, errors.Wrapf(err, "generate signed profile cert for %q", spec.subject)
@@ -402,6 +509,10 @@ func renewExpiredKubeadmCerts(cmd command.Runner, cc config.ClusterConfig) error
 
 	expiredCerts := false
 	certs := []string{"apiserver-etcd-client", "apiserver-kubelet-client", "etcd-server", "etcd-healthcheck-client", "etcd-peer", "front-proxy-client"}
+	// kubeadm does not generate the etcd certs with an external etcd
+	if config.IsExternalEtcd(cc) {
+		certs = []string{"apiserver-kubelet-client", "front-proxy-client"}
+	}
 	for _, cert := range certs {
 		certPath := []string{vmpath.GuestPersistentDir, "certs"}
 		// certs starting with "etcd-" are in the "etcd" dir
@@ -418,12 +529,7 @@ func renewExpiredKubeadmCerts(cmd command.Runner, cc config.ClusterConfig) error
 		return nil
 	}
 	out.WarningT("kubeadm certificates have expired. Generating new ones...")
-	kubeadmPath := path.Join(vmpath.GuestPersistentDir, "binaries", cc.KubernetesConfig.KubernetesVersion)
-	bashCmd := fmt.Sprintf("sudo env PATH=\"%s:$PATH\" kubeadm certs renew all --config %s", kubeadmPath, constants.KubeadmYamlPath)
-	if _, err := cmd.RunCmd(exec.Command("/bin/bash", "-c", bashCmd)); err != nil {
-		return errors.Wrap(err, "kubeadm certs renew")
-	}
-	return nil
+	return RenewKubeadmCerts(cmd, cc)
 }
 
 // isValidPEMCertificate checks whether the input file is a valid PEM certificate (with at least one CERTIFICATE block)
@@ -630,6 +736,34 @@ func isValid(certPath, keyPath string) bool {
 	return true
 }
 
+// isSignedBy returns true if the cert at certPath was issued by the CA at caCertPath, eg: it is not signed by a CA that was replaced since
+func isSignedBy(certPath, caCertPath string) bool {
+	cert, err := readCert(certPath)
+	if err != nil {
+		klog.Infof("failed to read cert %s: %v", certPath, err)
+		return false
+	}
+	ca, err := readCert(caCertPath)
+	if err != nil {
+		klog.Infof("failed to read ca cert %s: %v", caCertPath, err)
+		return false
+	}
+	return cert.CheckSignatureFrom(ca) == nil
+}
+
+// readCert reads the first certificate of a PEM file
+func readCert(certPath string) (*x509.Certificate, error) {
+	b, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", certPath)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
 func isKubeadmCertValid(cmd command.Runner, certPath string) bool {
 	_, err := cmd.RunCmd(exec.Command("openssl", "x509", "-noout", "-in", certPath, "-checkend", "86400"))
 	if err != nil {
@@ -643,7 +777,7 @@ func properPerms(cert string) string {
 	perms := "0644"
 
 	ext := strings.ToLower(filepath.Ext(cert))
-	if ext == ".key" || ext == ".pem" {
+	if ext == ".key" || ext == ".pem" || filepath.Base(cert) == encryptionConfigFile {
 		perms = "0600"
 	}
 
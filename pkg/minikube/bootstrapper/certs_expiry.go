@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+)
+
+// ExpiryWarning is how long before a certificate expires that minikube warns about it
+const ExpiryWarning = 30 * 24 * time.Hour
+
+// guestCerts are the certificates of a node that are not copied from the host: the ones kubeadm issues on control-plane nodes,
+// the client cert of the kubelet and the server cert of the docker daemon
+var guestCerts = []string{
+	path.Join(vmpath.GuestKubernetesCertsDir, "apiserver-kubelet-client.crt"),
+	path.Join(vmpath.GuestKubernetesCertsDir, "apiserver-etcd-client.crt"),
+	path.Join(vmpath.GuestKubernetesCertsDir, "front-proxy-client.crt"),
+	path.Join(vmpath.GuestKubernetesCertsDir, "etcd", "server.crt"),
+	path.Join(vmpath.GuestKubernetesCertsDir, "etcd", "peer.crt"),
+	path.Join(vmpath.GuestKubernetesCertsDir, "etcd", "healthcheck-client.crt"),
+	"/var/lib/kubelet/pki/kubelet-client-current.pem",
+	"/etc/docker/server.pem",
+}
+
+// CertExpiry is when a certificate of a cluster expires
+type CertExpiry struct {
+	// Node is the node the certificate is on, or "" for the host
+	Node     string    `json:"node"`
+	Path     string    `json:"path"`
+	NotAfter time.Time `json:"notAfter"`
+}
+
+// ExpiresWithin returns true if the certificate has expired, or expires within d
+func (c CertExpiry) ExpiresWithin(d time.Duration) bool {
+	return time.Until(c.NotAfter) < d
+}
+
+// HostCertsExpiry returns the expiry of the certificates of cc kept on the host: the CAs, the profile certs and the docker client cert.
+// Missing certificates, eg: before the first start, are skipped.
+func HostCertsExpiry(cc config.ClusterConfig) []CertExpiry {
+	profilePath := localpath.Profile(cc.Name)
+	paths := []string{
+		localpath.ClusterCACert(cc.Name),
+		filepath.Join(localpath.MiniPath(), "proxy-client-ca.crt"),
+		localpath.ClientCert(cc.Name),
+		filepath.Join(profilePath, "apiserver.crt"),
+		filepath.Join(profilePath, "proxy-client.crt"),
+		localpath.MakeMiniPath("certs", "ca.pem"),
+		localpath.MakeMiniPath("certs", "cert.pem"),
+	}
+
+	var certs []CertExpiry
+	for _, p := range paths {
+		c, err := readCert(p)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				klog.Warningf("skipping %s: %v", p, err)
+			}
+			continue
+		}
+		certs = append(certs, CertExpiry{Path: p, NotAfter: c.NotAfter})
+	}
+	return certs
+}
+
+// GuestCertsExpiry returns the expiry of the certificates of node n that are not copied from the host, see guestCerts
+func GuestCertsExpiry(cmd command.Runner, n config.Node) ([]CertExpiry, error) {
+	script := fmt.Sprintf(`for f in %s; do if sudo test -f "$f"; then echo "$f $(sudo openssl x509 -noout -enddate -in "$f")"; fi; done`, strings.Join(guestCerts, " "))
+	rr, err := cmd.RunCmd(exec.Command("/bin/bash", "-c", script))
+	if err != nil {
+		return nil, errors.Wrap(err, "openssl enddate")
+	}
+	return parseEnddates(n.Name, rr.Stdout.String())
+}
+
+// parseEnddates parses the lines of 'path notAfter=date' that GuestCertsExpiry prints for each certificate
+func parseEnddates(node, out string) ([]CertExpiry, error) {
+	var certs []CertExpiry
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if line == "" {
+			continue
+		}
+		p, date, ok := strings.Cut(line, " notAfter=")
+		if !ok {
+			return nil, fmt.Errorf("unexpected output: %q", line)
+		}
+		t, err := time.Parse("Jan _2 15:04:05 2006 MST", strings.TrimSpace(date))
+		if err != nil {
+			return nil, errors.Wrapf(err, "expiry of %s", p)
+		}
+		certs = append(certs, CertExpiry{Node: node, Path: p, NotAfter: t})
+	}
+	return certs, nil
+}
+
+// RemoveProfileCerts removes the certificates that minikube signs for the profile of cc, so that SetupCerts issues new ones
+func RemoveProfileCerts(cc config.ClusterConfig) error {
+	profilePath := localpath.Profile(cc.Name)
+	generated, err := filepath.Glob(filepath.Join(profilePath, "apiserver.*"))
+	if err != nil {
+		return err
+	}
+	files := append(generated, localpath.ClientCert(cc.Name), localpath.ClientKey(cc.Name),
+		filepath.Join(profilePath, "proxy-client.crt"), filepath.Join(profilePath, "proxy-client.key"))
+	for _, f := range files {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenewKubeadmCerts renews the certificates that kubeadm manages on a control-plane node, and the kubeconfigs of its components
+func RenewKubeadmCerts(cmd command.Runner, cc config.ClusterConfig) error {
+	kubeadmPath := path.Join(vmpath.GuestPersistentDir, "binaries", cc.KubernetesConfig.KubernetesVersion)
+	bashCmd := fmt.Sprintf("sudo env PATH=\"%s:$PATH\" kubeadm certs renew all --config %s", kubeadmPath, constants.KubeadmYamlPath)
+	if _, err := cmd.RunCmd(exec.Command("/bin/bash", "-c", bashCmd)); err != nil {
+		return errors.Wrap(err, "kubeadm certs renew")
+	}
+	return nil
+}
@@ -18,14 +18,22 @@ package bootstrapper
 
 import (
 	"os"
+	"path"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"gopkg.in/yaml.v2"
+
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/tests"
+	"k8s.io/minikube/pkg/minikube/vmpath"
 	"k8s.io/minikube/pkg/util"
 )
 
@@ -48,7 +56,7 @@ func TestSetupCerts(t *testing.T) {
 	if err := util.GenerateCACert(
 		filepath.Join(tempDir, "certs", "mycert.pem"),
 		filepath.Join(tempDir, "certs", "mykey.pem"),
-		"Test Certificate",
+		"Test Certificate", 0600,
 	); err != nil {
 		t.Fatalf("error generating certificate: %v", err)
 	}
@@ -68,3 +76,252 @@ func TestSetupCerts(t *testing.T) {
 		t.Fatalf("Error starting cluster: %v", err)
 	}
 }
+
+func TestIsSignedBy(t *testing.T) {
+	tempDir := tests.MakeTempDir(t)
+	path := func(name string) string { return filepath.Join(tempDir, name) }
+
+	for _, ca := range []string{"ca", "otherca"} {
+		if err := util.GenerateCACert(path(ca+".crt"), path(ca+".key"), ca, 0600); err != nil {
+			t.Fatalf("error generating %s: %v", ca, err)
+		}
+	}
+	if err := util.GenerateSignedCert(path("apiserver.crt"), path("apiserver.key"), "minikube", nil, nil, path("ca.crt"), path("ca.key"), constants.DefaultCertExpiration, 0600); err != nil {
+		t.Fatalf("error generating signed cert: %v", err)
+	}
+
+	if !isSignedBy(path("apiserver.crt"), path("ca.crt")) {
+		t.Errorf("isSignedBy(apiserver.crt, ca.crt) = false, want true")
+	}
+	if isSignedBy(path("apiserver.crt"), path("otherca.crt")) {
+		t.Errorf("isSignedBy(apiserver.crt, otherca.crt) = true, want false")
+	}
+	if isSignedBy(path("missing.crt"), path("ca.crt")) {
+		t.Errorf("isSignedBy(missing.crt, ca.crt) = true, want false")
+	}
+}
+
+func TestParseEnddates(t *testing.T) {
+	out := `/var/lib/minikube/certs/apiserver-kubelet-client.crt notAfter=Oct 15 09:30:00 2027 GMT
+/etc/docker/server.pem notAfter=Jan  2 00:00:01 2029 GMT
+`
+	got, err := parseEnddates("m02", out)
+	if err != nil {
+		t.Fatalf("parseEnddates: %v", err)
+	}
+	want := []CertExpiry{
+		{Node: "m02", Path: "/var/lib/minikube/certs/apiserver-kubelet-client.crt", NotAfter: time.Date(2027, time.October, 15, 9, 30, 0, 0, time.UTC)},
+		{Node: "m02", Path: "/etc/docker/server.pem", NotAfter: time.Date(2029, time.January, 2, 0, 0, 1, 0, time.UTC)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("parseEnddates returned %d certs, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].Node != want[i].Node || got[i].Path != want[i].Path || !got[i].NotAfter.Equal(want[i].NotAfter) {
+			t.Errorf("cert %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if _, err := parseEnddates("", "unable to load certificate"); err == nil {
+		t.Errorf("parseEnddates of an openssl error succeeded, want an error")
+	}
+}
+
+func TestGenerateEncryptionConfig(t *testing.T) {
+	tests.MakeTempDir(t)
+	cc := config.ClusterConfig{Name: "minikube", EncryptSecrets: "aescbc"}
+	if err := os.MkdirAll(localpath.Profile(cc.Name), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	read := func(p string) encryptionConfiguration {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatalf("read %s: %v", p, err)
+		}
+		var ec encryptionConfiguration
+		if err := yaml.Unmarshal(b, &ec); err != nil {
+			t.Fatalf("unmarshal %s: %v", p, err)
+		}
+		return ec
+	}
+
+	p, err := generateEncryptionConfig(cc)
+	if err != nil {
+		t.Fatalf("generateEncryptionConfig: %v", err)
+	}
+	providers := read(p).Resources[0].Providers
+	if len(providers) != 2 || providers[0].AESCBC == nil || providers[1].Identity == nil {
+		t.Fatalf("providers = %+v, want aescbc and identity", providers)
+	}
+	key := providers[0].AESCBC.Keys[0].Secret
+
+	// the key is kept when the provider changes, to read the secrets it encrypted
+	cc.EncryptSecrets = "kms"
+	if _, err := generateEncryptionConfig(cc); err != nil {
+		t.Fatalf("generateEncryptionConfig: %v", err)
+	}
+	providers = read(p).Resources[0].Providers
+	if len(providers) != 3 || providers[0].KMS == nil || providers[1].AESCBC == nil || providers[2].Identity == nil {
+		t.Fatalf("providers = %+v, want kms, aescbc and identity", providers)
+	}
+	if got := providers[1].AESCBC.Keys[0].Secret; got != key {
+		t.Errorf("aescbc key = %q, want the key generated first %q", got, key)
+	}
+}
+
+func TestGenerateAdmissionConfig(t *testing.T) {
+	tests.MakeTempDir(t)
+	if err := os.MkdirAll(localpath.Profile("minikube"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	tcs := []struct {
+		version     string
+		wantVersion string
+		wantErr     bool
+	}{
+		{version: "v1.30.0", wantVersion: "pod-security.admission.config.k8s.io/v1"},
+		{version: "v1.24.17", wantVersion: "pod-security.admission.config.k8s.io/v1beta1"},
+		{version: "v1.22.17", wantErr: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.version, func(t *testing.T) {
+			cc := config.ClusterConfig{Name: "minikube", PodSecurityLevel: "restricted", KubernetesConfig: config.KubernetesConfig{KubernetesVersion: tc.version}}
+			p, err := generateAdmissionConfig(cc)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("generateAdmissionConfig(%s) succeeded, want an error", tc.version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("generateAdmissionConfig: %v", err)
+			}
+			b, err := os.ReadFile(p)
+			if err != nil {
+				t.Fatalf("read %s: %v", p, err)
+			}
+			var ac admissionConfiguration
+			if err := yaml.Unmarshal(b, &ac); err != nil {
+				t.Fatalf("unmarshal %s: %v", p, err)
+			}
+			psa := ac.Plugins[0].Configuration
+			if psa.APIVersion != tc.wantVersion {
+				t.Errorf("apiVersion = %q, want %q", psa.APIVersion, tc.wantVersion)
+			}
+			if d := psa.Defaults; d.Enforce != "restricted" || d.Audit != "restricted" || d.Warn != "restricted" {
+				t.Errorf("defaults = %+v, want restricted", d)
+			}
+			if len(psa.Exemptions.Namespaces) == 0 || psa.Exemptions.Namespaces[0] != "kube-system" {
+				t.Errorf("exempt namespaces = %v, want kube-system first", psa.Exemptions.Namespaces)
+			}
+		})
+	}
+}
+
+func TestSecurityProfiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, f := range []string{"audit.json", "k8s-deny-write", ".hidden.json"} {
+		if err := os.WriteFile(filepath.Join(dir, f), []byte("{}"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", f, err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "subdir"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	seccomp, apparmor, err := securityProfiles(dir)
+	if err != nil {
+		t.Fatalf("securityProfiles: %v", err)
+	}
+	if want := []string{filepath.Join(dir, "audit.json")}; !reflect.DeepEqual(seccomp, want) {
+		t.Errorf("seccomp = %v, want %v", seccomp, want)
+	}
+	if want := []string{filepath.Join(dir, "k8s-deny-write")}; !reflect.DeepEqual(apparmor, want) {
+		t.Errorf("apparmor = %v, want %v", apparmor, want)
+	}
+}
+
+func TestValidateAuditPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{name: "metadata", policy: "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Metadata\n"},
+		{name: "no rules", policy: "apiVersion: audit.k8s.io/v1\nkind: Policy\n", wantErr: true},
+		{name: "not a policy", policy: "apiVersion: v1\nkind: ConfigMap\n", wantErr: true},
+		{name: "not yaml", policy: "rules: [", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "policy.yaml")
+			if err := os.WriteFile(p, []byte(tc.policy), 0o644); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			if err := ValidateAuditPolicy(p); (err != nil) != tc.wantErr {
+				t.Errorf("ValidateAuditPolicy() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExternalEtcd(t *testing.T) {
+	dir := t.TempDir()
+	ca, cert, key := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	if err := util.GenerateCACert(cert, key, "client", 0600); err != nil {
+		t.Fatalf("generate client cert: %v", err)
+	}
+	if err := util.GenerateCACert(ca, filepath.Join(dir, "ca.key"), "etcd-ca", 0600); err != nil {
+		t.Fatalf("generate CA: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		etcd    config.ExternalEtcdConfig
+		wantErr bool
+	}{
+		{name: "https", etcd: config.ExternalEtcdConfig{Endpoints: []string{"https://10.0.0.5:2379"}, CAFile: ca, CertFile: cert, KeyFile: key}},
+		{name: "http", etcd: config.ExternalEtcdConfig{Endpoints: []string{"http://10.0.0.5:2379"}}},
+		{name: "https without certs", etcd: config.ExternalEtcdConfig{Endpoints: []string{"https://10.0.0.5:2379"}}, wantErr: true},
+		{name: "no key", etcd: config.ExternalEtcdConfig{Endpoints: []string{"https://10.0.0.5:2379"}, CAFile: ca, CertFile: cert}, wantErr: true},
+		{name: "key of another cert", etcd: config.ExternalEtcdConfig{Endpoints: []string{"https://10.0.0.5:2379"}, CAFile: ca, CertFile: ca, KeyFile: key}, wantErr: true},
+		{name: "not a CA", etcd: config.ExternalEtcdConfig{Endpoints: []string{"https://10.0.0.5:2379"}, CAFile: key, CertFile: cert, KeyFile: key}, wantErr: true},
+		{name: "not a URL", etcd: config.ExternalEtcdConfig{Endpoints: []string{"10.0.0.5:2379"}}, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := ValidateExternalEtcd(tc.etcd); (err != nil) != tc.wantErr {
+				t.Errorf("ValidateExternalEtcd() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIServerFiles(t *testing.T) {
+	tests.MakeTempDir(t)
+	if err := os.MkdirAll(localpath.Profile("minikube"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	policy := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(policy, []byte("apiVersion: audit.k8s.io/v1\nkind: Policy\n"), 0o600); err != nil {
+		t.Fatalf("write policy: %v", err)
+	}
+
+	cc := config.ClusterConfig{Name: "minikube", AuditPolicy: policy, PodSecurityLevel: "baseline", KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.30.0"}}
+	files, err := apiServerFiles(cc)
+	if err != nil {
+		t.Fatalf("apiServerFiles: %v", err)
+	}
+	want := []string{profileAPIServerFile(cc, auditPolicyFile), profileAPIServerFile(cc, admissionConfigFile)}
+	if !slices.Equal(files, want) {
+		t.Fatalf("apiServerFiles = %v, want %v", files, want)
+	}
+	if b, err := os.ReadFile(files[0]); err != nil || !strings.Contains(string(b), "kind: Policy") {
+		t.Errorf("audit policy = %q, %v, want the copy of %s", b, err, policy)
+	}
+	if got := AuditPolicyPath(); got != path.Join(vmpath.GuestKubernetesCertsDir, auditPolicyFile) {
+		t.Errorf("AuditPolicyPath() = %q, want it in the Kubernetes certs directory", got)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+)
+
+// encryptionConfigFile is the EncryptionConfiguration of the API server. It holds the aescbc key, so it is only readable by root.
+const encryptionConfigFile = "encryption-config.yaml"
+
+// KMSPluginSocket is where the API server expects a KMS v2 plugin when secrets are encrypted with kms
+const KMSPluginSocket = "/var/run/kmsplugin/socket.sock"
+
+// EncryptionConfigPath returns the path of the EncryptionConfiguration in the guest
+func EncryptionConfigPath() string {
+	return apiServerFile(encryptionConfigFile)
+}
+
+// encryptionConfiguration is the subset of apiserver.config.k8s.io/v1 EncryptionConfiguration that minikube writes
+type encryptionConfiguration struct {
+	APIVersion string               `yaml:"apiVersion"`
+	Kind       string               `yaml:"kind"`
+	Resources  []encryptionResource `yaml:"resources"`
+}
+
+type encryptionResource struct {
+	Resources []string             `yaml:"resources"`
+	Providers []encryptionProvider `yaml:"providers"`
+}
+
+type encryptionProvider struct {
+	AESCBC   *aescbcProvider `yaml:"aescbc,omitempty"`
+	KMS      *kmsProvider    `yaml:"kms,omitempty"`
+	Identity *struct{}       `yaml:"identity,omitempty"`
+}
+
+type aescbcProvider struct {
+	Keys []encryptionKey `yaml:"keys"`
+}
+
+type encryptionKey struct {
+	Name   string `yaml:"name"`
+	Secret string `yaml:"secret"`
+}
+
+type kmsProvider struct {
+	APIVersion string `yaml:"apiVersion"`
+	Name       string `yaml:"name"`
+	Endpoint   string `yaml:"endpoint"`
+	Timeout    string `yaml:"timeout"`
+}
+
+// generateEncryptionConfig writes the EncryptionConfiguration of cc to the profile directory, and returns its path.
+// The aescbc key is generated once, and kept when the provider changes, so that the secrets it encrypted can still be read
+// until they are rewrapped. The identity provider reads the secrets written before encryption was enabled.
+func generateEncryptionConfig(cc config.ClusterConfig) (string, error) {
+	p := profileAPIServerFile(cc, encryptionConfigFile)
+
+	var keys []encryptionKey
+	if b, err := os.ReadFile(p); err == nil {
+		var existing encryptionConfiguration
+		if err := yaml.Unmarshal(b, &existing); err != nil {
+			return "", errors.Wrapf(err, "unmarshal %s", p)
+		}
+		for _, r := range existing.Resources {
+			for _, pr := range r.Providers {
+				if pr.AESCBC != nil {
+					keys = pr.AESCBC.Keys
+				}
+			}
+		}
+	}
+	if len(keys) == 0 {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return "", errors.Wrap(err, "generate key")
+		}
+		keys = []encryptionKey{{Name: "key1", Secret: base64.StdEncoding.EncodeToString(secret)}}
+	}
+
+	aescbc := encryptionProvider{AESCBC: &aescbcProvider{Keys: keys}}
+	var providers []encryptionProvider
+	switch cc.EncryptSecrets {
+	case "aescbc":
+		providers = []encryptionProvider{aescbc}
+	case "kms":
+		kms := encryptionProvider{KMS: &kmsProvider{APIVersion: "v2", Name: "minikube", Endpoint: "unix://" + KMSPluginSocket, Timeout: "3s"}}
+		providers = []encryptionProvider{kms, aescbc}
+	default:
+		return "", fmt.Errorf("unknown encryption provider %q", cc.EncryptSecrets)
+	}
+	providers = append(providers, encryptionProvider{Identity: &struct{}{}})
+
+	ec := encryptionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "EncryptionConfiguration",
+		Resources:  []encryptionResource{{Resources: []string{"secrets"}, Providers: providers}},
+	}
+	b, err := yaml.Marshal(ec)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal")
+	}
+	klog.Infof("writing %s encryption config to %s", cc.EncryptSecrets, p)
+	return writeAPIServerFile(cc, encryptionConfigFile, b, 0o600)
+}
+
+// RewrapSecrets rewrites every secret of the cluster, so that they are encrypted by the first provider of the EncryptionConfiguration
+func RewrapSecrets(cmd command.Runner, cc config.ClusterConfig) error {
+	kubectl := kapi.KubectlBinaryPath(cc.KubernetesConfig.KubernetesVersion)
+	kubeconfig := path.Join(vmpath.GuestPersistentDir, "kubeconfig")
+	rewrap := fmt.Sprintf("sudo %s --kubeconfig=%s get secrets --all-namespaces -o json | sudo %s --kubeconfig=%s replace -f -", kubectl, kubeconfig, kubectl, kubeconfig)
+	rr, err := cmd.RunCmd(exec.Command("/bin/bash", "-c", rewrap))
+	if err != nil {
+		return errors.Wrap(err, "replace secrets")
+	}
+	klog.Infof("replaced %d secrets", strings.Count(rr.Stdout.String(), "replaced"))
+	return nil
+}
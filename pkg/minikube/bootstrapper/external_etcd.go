@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// The API server files of an external etcd: its CA, and the client cert and key of the API server
+const (
+	externalEtcdCAFile   = "external-etcd-ca.crt"
+	externalEtcdCertFile = "apiserver-external-etcd-client.crt"
+	externalEtcdKeyFile  = "apiserver-external-etcd-client.key"
+)
+
+// ExternalEtcdCAPath returns the path of the CA of the external etcd in the guest
+func ExternalEtcdCAPath() string {
+	return apiServerFile(externalEtcdCAFile)
+}
+
+// ExternalEtcdCertPath returns the path of the client cert of the external etcd in the guest
+func ExternalEtcdCertPath() string {
+	return apiServerFile(externalEtcdCertFile)
+}
+
+// ExternalEtcdKeyPath returns the path of the client key of the external etcd in the guest
+func ExternalEtcdKeyPath() string {
+	return apiServerFile(externalEtcdKeyFile)
+}
+
+// ValidateExternalEtcd returns an error if the endpoints of e are not etcd client URLs, or its files are not a CA,
+// and a client cert and its key. The files are required with HTTPS endpoints.
+func ValidateExternalEtcd(e config.ExternalEtcdConfig) error {
+	secure := false
+	for _, ep := range e.Endpoints {
+		u, err := url.Parse(ep)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("%q is not an HTTP or HTTPS URL", ep)
+		}
+		secure = secure || u.Scheme == "https"
+	}
+	if e.CAFile == "" && e.CertFile == "" && e.KeyFile == "" {
+		if secure {
+			return fmt.Errorf("the CA, client cert and client key are required with HTTPS endpoints")
+		}
+		return nil
+	}
+	if e.CAFile == "" || e.CertFile == "" || e.KeyFile == "" {
+		return fmt.Errorf("the CA, client cert and client key are required together")
+	}
+	ca, err := os.ReadFile(e.CAFile)
+	if err != nil {
+		return err
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(ca) {
+		return fmt.Errorf("%s has no PEM certificate", e.CAFile)
+	}
+	if _, err := tls.LoadX509KeyPair(e.CertFile, e.KeyFile); err != nil {
+		return errors.Wrap(err, "client cert")
+	}
+	return nil
+}
+
+// copyExternalEtcdCerts copies the CA, client cert and key of the external etcd of cc, if any, and returns their paths in the profile directory.
+// They are all kept private, as the client key is.
+func copyExternalEtcdCerts(cc config.ClusterConfig) ([]string, error) {
+	e := cc.ExternalEtcd
+	if e.CAFile == "" {
+		return nil, nil
+	}
+	var copied []string
+	for _, f := range []struct{ src, name string }{{e.CAFile, externalEtcdCAFile}, {e.CertFile, externalEtcdCertFile}, {e.KeyFile, externalEtcdKeyFile}} {
+		p, err := copyAPIServerFile(cc, f.src, f.name, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		copied = append(copied, p)
+	}
+	return copied, nil
+}
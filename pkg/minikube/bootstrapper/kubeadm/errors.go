@@ -41,5 +41,23 @@ func (f *FailFastError) Error() string {
 // this error could be seen on docker/podman or none driver.
 var ErrNoExecLinux = &FailFastError{errors.New("mounted kubeadm binary is not executable")}
 
+// DownloadError is an error downloading the binaries of a node, eg: of the network, that trying again may solve
+type DownloadError struct {
+	Err error
+}
+
+func (d *DownloadError) Error() string {
+	return d.Err.Error()
+}
+
+func (d *DownloadError) Unwrap() error {
+	return d.Err
+}
+
+// Cause returns the failure, so that errors.Cause of github.com/pkg/errors looks through the DownloadError
+func (d *DownloadError) Cause() error {
+	return d.Err
+}
+
 // ErrInitTimedout is thrown if kubeadm init takes longer than max time allowed
 var ErrInitTimedout = fmt.Errorf("kubeadm init timed out in %d minutes", initTimeoutMinutes)
@@ -26,6 +26,8 @@ import (
 	"os/exec"
 	"path"
 	"runtime"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -223,6 +225,15 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig) error {
 
 	k.clearStaleConfigs(cfg)
 
+	// patches of the cluster and of this node, copied by UpdateNode
+	pcp, err := config.ControlPlane(cfg)
+	if err != nil {
+		return errors.Wrap(err, "get primary control-plane node")
+	}
+	if len(bsutil.KubeadmPatches(cfg, pcp)) > 0 {
+		extraFlags += " --patches=" + constants.KubeadmPatchesDir
+	}
+
 	conf := constants.KubeadmYamlPath
 	ctx, cancel := context.WithTimeout(context.Background(), initTimeoutMinutes*time.Minute)
 	defer cancel()
@@ -398,7 +409,13 @@ func (k *Bootstrapper) StartCluster(cfg config.ClusterConfig) error {
 		klog.Warningf("unpause failed: %v", err)
 	}
 
-	if err := bsutil.ExistingConfig(k.c); err == nil {
+	if err := bsutil.ExistingConfig(k.c); err == nil && len(cfg.Nodes) > 0 && !config.Bootstrapped(cfg.Nodes[0], true) {
+		// the kubeadm init of a previous start did not complete, so there is no cluster to restart
+		klog.Infof("found configuration files of an incomplete kubeadm init, will reset cluster")
+		if err := k.DeleteCluster(cfg.KubernetesConfig); err != nil {
+			klog.Warningf("delete failed: %v", err)
+		}
+	} else if err == nil {
 		// if the guest already exists and was stopped, re-establish the apiserver tunnel so checks pass
 		if err := k.tunnelToAPIServer(cfg); err != nil {
 			klog.Warningf("apiserver tunnel failed: %v", err)
@@ -561,7 +578,12 @@ func (k *Bootstrapper) WaitForNode(cfg config.ClusterConfig, n config.Node, time
 		}
 
 		if cfg.VerifyComponents[kverify.AppsRunningKey] {
-			if err := kverify.WaitForAppsRunning(client, kverify.AppsRunningList, timeout); err != nil {
+			apps := kverify.AppsRunningList
+			// there is no etcd pod with an external etcd
+			if config.IsExternalEtcd(cfg) {
+				apps = slices.DeleteFunc(slices.Clone(apps), func(a string) bool { return a == "etcd" })
+			}
+			if err := kverify.WaitForAppsRunning(client, apps, timeout); err != nil {
 				return errors.Wrap(err, "waiting for apps_running")
 			}
 		}
@@ -606,7 +628,7 @@ func (k *Bootstrapper) restartPrimaryControlPlane(cfg config.ClusterConfig) erro
 	}
 
 	// Save the costly tax of reinstalling Kubernetes if the only issue is a missing kube context
-	if _, err := kubeconfig.UpdateEndpoint(cfg.Name, host, port, kubeconfig.PathFromEnv(), kubeconfig.NewExtension()); err != nil {
+	if _, err := kubeconfig.UpdateEndpoint(cfg.Name, host, port, kubeconfig.PathForProfile(cfg.Name, cfg.KubeconfigMode), kubeconfig.NewExtension(), config.KeepsContext(cfg)); err != nil {
 		klog.Warningf("unable to update kubeconfig (cluster will likely require a reset): %v", err)
 	}
 
@@ -648,13 +670,23 @@ func (k *Bootstrapper) restartPrimaryControlPlane(cfg config.ClusterConfig) erro
 		return errors.Wrap(err, "cp")
 	}
 
+	// the phases that generate what the patches target apply them, the kubelet config ones since v1.25
+	patches, kubeletPatches := "", ""
+	if len(bsutil.KubeadmPatches(cfg, pcp)) > 0 {
+		patches = " --patches=" + constants.KubeadmPatchesDir
+		if v, err := util.ParseKubernetesVersion(cfg.KubernetesConfig.KubernetesVersion); err == nil && v.GTE(semver.MustParse("1.25.0")) {
+			kubeletPatches = patches
+		}
+	}
 	baseCmd := fmt.Sprintf("%s init", bsutil.InvokeKubeadm(cfg.KubernetesConfig.KubernetesVersion))
 	cmds := []string{
 		fmt.Sprintf("%s phase certs all --config %s", baseCmd, conf),
 		fmt.Sprintf("%s phase kubeconfig all --config %s", baseCmd, conf),
-		fmt.Sprintf("%s phase kubelet-start --config %s", baseCmd, conf),
-		fmt.Sprintf("%s phase control-plane all --config %s", baseCmd, conf),
-		fmt.Sprintf("%s phase etcd local --config %s", baseCmd, conf),
+		fmt.Sprintf("%s phase kubelet-start --config %s%s", baseCmd, conf, kubeletPatches),
+		fmt.Sprintf("%s phase control-plane all --config %s%s", baseCmd, conf, patches),
+	}
+	if !config.IsExternalEtcd(cfg) {
+		cmds = append(cmds, fmt.Sprintf("%s phase etcd local --config %s%s", baseCmd, conf, patches))
 	}
 
 	// Run commands one at a time so that it is easier to root cause failures.
@@ -761,6 +793,11 @@ func (k *Bootstrapper) JoinCluster(cc config.ClusterConfig, n config.Node, joinC
 			" --apiserver-bind-port=" + strconv.Itoa(n.Port)
 	}
 
+	// patches of the cluster and of this node, copied by UpdateNode
+	if len(bsutil.KubeadmPatches(cc, n)) > 0 {
+		joinCmd += " --patches=" + constants.KubeadmPatchesDir
+	}
+
 	if _, err := k.c.RunCmd(exec.Command("/bin/bash", "-c", joinCmd)); err != nil {
 		return errors.Wrapf(err, "kubeadm join")
 	}
@@ -895,24 +932,29 @@ func (k *Bootstrapper) UpdateCluster(cfg config.ClusterConfig) error {
 		return errors.Wrap(err, "runtime")
 	}
 
-	if err := r.Preload(cfg); err != nil {
-		switch err.(type) {
-		case *cruntime.ErrISOFeature:
-			out.ErrT(style.Tip, "Existing disk is missing new features ({{.error}}). To upgrade, run 'minikube delete'", out.V{"error": err})
-		default:
-			klog.Infof("preload failed, will try to load cached images: %v", err)
-		}
+	pcp, err := config.ControlPlane(cfg)
+	if err != nil || !config.IsPrimaryControlPlane(cfg, pcp) {
+		return errors.Wrap(err, "get primary control-plane node")
 	}
 
-	if cfg.KubernetesConfig.ShouldLoadCachedImages {
-		if err := machine.LoadCachedImages(&cfg, k.c, images, detect.ImageCacheDir(), false); err != nil {
-			out.FailureT("Unable to load cached images: {{.error}}", out.V{"error": err})
+	// the preload and the cached images are the ones of the host's arch, so a node of another arch pulls its images instead
+	if arch := config.NodeArch(pcp); arch != detect.EffectiveArch() {
+		klog.Infof("skipping the preload and the cached images for the %s node", arch)
+	} else {
+		if err := r.Preload(cfg); err != nil {
+			switch err.(type) {
+			case *cruntime.ErrISOFeature:
+				out.ErrT(style.Tip, "Existing disk is missing new features ({{.error}}). To upgrade, run 'minikube delete'", out.V{"error": err})
+			default:
+				klog.Infof("preload failed, will try to load cached images: %v", err)
+			}
 		}
-	}
 
-	pcp, err := config.ControlPlane(cfg)
-	if err != nil || !config.IsPrimaryControlPlane(cfg, pcp) {
-		return errors.Wrap(err, "get primary control-plane node")
+		if cfg.KubernetesConfig.ShouldLoadCachedImages {
+			if err := machine.LoadCachedImages(&cfg, config.MachineName(cfg, pcp), k.c, images, detect.ImageCacheDir(), false); err != nil {
+				out.FailureT("Unable to load cached images: {{.error}}", out.V{"error": err})
+			}
+		}
 	}
 
 	err = k.UpdateNode(cfg, pcp, r)
@@ -943,6 +985,11 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 		assets.NewMemoryAssetTarget(kubeletCfg, bsutil.KubeletSystemdConfFile, "0644"),
 		assets.NewMemoryAssetTarget(kubeletService, bsutil.KubeletServiceFile, "0644"),
 	}
+	// the patches that were removed since the last start are not applied anymore
+	if _, err := k.c.RunCmd(exec.Command("sudo", "rm", "-rf", constants.KubeadmPatchesDir)); err != nil {
+		return errors.Wrap(err, "remove kubeadm patches")
+	}
+	files = append(files, bsutil.KubeadmPatchFiles(cfg, n)...)
 
 	if n.ControlPlane {
 		// for primary control-plane node only, generate kubeadm config based on current params
@@ -955,8 +1002,8 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 			}
 			files = append(files, assets.NewMemoryAssetTarget(kubeadmCfg, constants.KubeadmYamlPath+".new", "0640"))
 		}
-		// deploy kube-vip for ha (multi-control plane) cluster
-		if config.IsHA(cfg) {
+		// deploy kube-vip for ha (multi-control plane) cluster, unless haproxy serves its vip
+		if kubevip.Deployed(cfg) {
 			// workaround for kube-vip
 			// only applicable for k8s v1.29+ during primary control-plane node's kubeadm init (ie, first boot)
 			// TODO (prezha): remove when fixed upstream - ref: https://github.com/kube-vip/kube-vip/issues/684#issuecomment-1864855405
@@ -971,13 +1018,17 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 			} else {
 				files = append(files, assets.NewMemoryAssetTarget(kubevipCfg, path.Join(vmpath.GuestManifestsDir, kubevip.Manifest), "0600"))
 			}
+		} else if config.IsHA(cfg) {
+			if _, err := k.c.RunCmd(exec.Command("sudo", "rm", "-f", path.Join(vmpath.GuestManifestsDir, kubevip.Manifest))); err != nil {
+				return errors.Wrap(err, "remove kube-vip manifest")
+			}
 		}
 	}
 
 	sm := sysinit.New(k.c)
 
-	if err := bsutil.TransferBinaries(cfg.KubernetesConfig, k.c, sm, cfg.BinaryMirror); err != nil {
-		return errors.Wrap(err, "downloading binaries")
+	if err := bsutil.TransferBinaries(cfg.KubernetesConfig, k.c, sm, cfg.BinaryMirror, config.NodeArch(n)); err != nil {
+		return &DownloadError{errors.Wrap(err, "downloading binaries")}
 	}
 
 	// Installs compatibility shims for non-systemd environments
@@ -1010,6 +1061,13 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 		return errors.Wrap(err, "add control-plane alias")
 	}
 
+	// kube-proxy fails to start in ipvs mode without its kernel modules
+	if cfg.KubernetesConfig.KubeProxyMode == "ipvs" {
+		if _, err := k.c.RunCmd(exec.Command("sudo", "modprobe", "--all", "ip_vs", "ip_vs_rr", "ip_vs_wrr", "ip_vs_sh", "nf_conntrack")); err != nil {
+			out.WarningT("Unable to load the ipvs kernel modules of kube-proxy on {{.name}}: {{.error}}", out.V{"name": config.MachineName(cfg, n), "error": err})
+		}
+	}
+
 	// "ensure" kubelet is started, intentionally non-fatal in case of an error
 	if err := sysinit.New(k.c).Start("kubelet"); err != nil {
 		klog.Errorf("Couldn't ensure kubelet is started this might cause issues (will continue): %v", err)
@@ -1018,6 +1076,67 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 	return nil
 }
 
+// UpgradeNode upgrades n to the Kubernetes version of cfg with kubeadm upgrade: apply on the primary control-plane node, node on the other ones,
+// then restarts its kubelet with the new binary. The workers are expected to be drained beforehand.
+func (k *Bootstrapper) UpgradeNode(cfg config.ClusterConfig, n config.Node) error {
+	klog.Infof("upgrading node %v to %s ...", n, cfg.KubernetesConfig.KubernetesVersion)
+
+	version, err := util.ParseKubernetesVersion(cfg.KubernetesConfig.KubernetesVersion)
+	if err != nil {
+		return errors.Wrap(err, "parsing Kubernetes version")
+	}
+	r, err := cruntime.New(cruntime.Config{
+		Type:              cfg.KubernetesConfig.ContainerRuntime,
+		Runner:            k.c,
+		Socket:            cfg.KubernetesConfig.CRISocket,
+		KubernetesVersion: version,
+	})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
+
+	sm := sysinit.New(k.c)
+	if err := bsutil.TransferBinaries(cfg.KubernetesConfig, k.c, sm, cfg.BinaryMirror, config.NodeArch(n)); err != nil {
+		return errors.Wrap(err, "downloading binaries")
+	}
+	// the transfer stops the kubelet, whose old version runs the control plane until kubeadm upgrades it
+	if err := sm.Start("kubelet"); err != nil {
+		return errors.Wrap(err, "starting kubelet")
+	}
+
+	// the certs are left to minikube, which signs the ones of the apiserver, see SetupCerts
+	upgradeCmd := fmt.Sprintf("%s upgrade node --certificate-renewal=false", bsutil.InvokeKubeadm(cfg.KubernetesConfig.KubernetesVersion))
+	if config.IsPrimaryControlPlane(cfg, n) {
+		// the preflight checks are the ones of a production cluster, and minikube checked that the cluster is healthy beforehand
+		upgradeCmd = fmt.Sprintf("%s upgrade apply %s --yes --certificate-renewal=false --ignore-preflight-errors=all",
+			bsutil.InvokeKubeadm(cfg.KubernetesConfig.KubernetesVersion), cfg.KubernetesConfig.KubernetesVersion)
+	}
+	if len(bsutil.KubeadmPatches(cfg, n)) > 0 {
+		if err := bsutil.CopyFiles(k.c, bsutil.KubeadmPatchFiles(cfg, n)); err != nil {
+			return errors.Wrap(err, "copy kubeadm patches")
+		}
+		upgradeCmd += " --patches=" + constants.KubeadmPatchesDir
+	}
+	if _, err := k.c.RunCmd(exec.Command("/bin/bash", "-c", upgradeCmd)); err != nil {
+		return errors.Wrap(err, "kubeadm upgrade")
+	}
+
+	if err := k.UpdateNode(cfg, n, r); err != nil {
+		return errors.Wrap(err, "update node")
+	}
+	if config.IsPrimaryControlPlane(cfg, n) {
+		// so that the next start does not see a drift of the kubeadm config, and reconfigure the cluster
+		conf := constants.KubeadmYamlPath
+		if _, err := k.c.RunCmd(exec.Command("sudo", "cp", conf+".new", conf)); err != nil {
+			return errors.Wrap(err, "cp")
+		}
+	}
+	if err := sm.Restart("kubelet"); err != nil {
+		return errors.Wrap(err, "restarting kubelet")
+	}
+	return nil
+}
+
 // copyResolvConf is a workaround for a regression introduced with https://github.com/kubernetes/kubernetes/pull/109441
 // The regression is resolved by making a copy of /etc/resolv.conf, removing the line "search ." from the copy, and setting kubelet to use the copy
 // Only Kubernetes v1.25.0 is affected by this regression
@@ -1074,8 +1193,18 @@ func (k *Bootstrapper) labelAndUntaintNode(cfg config.ClusterConfig, n config.No
 
 	// example:
 	// sudo /var/lib/minikube/binaries/<version>/kubectl --kubeconfig=/var/lib/minikube/kubeconfig label --overwrite nodes test-357 minikube.k8s.io/version=<version> minikube.k8s.io/commit=aa91f39ffbcf27dcbb93c4ff3f457c54e585cf4a-dirty minikube.k8s.io/name=p1 minikube.k8s.io/updated_at=2020_02_20T12_05_35_0700
-	cmd := exec.CommandContext(ctx, "sudo", kubectlPath(cfg), fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")),
-		"label", "--overwrite", "nodes", nodeName, createdAtLbl, verLbl, commitLbl, profileNameLbl, primaryLbl)
+	args := []string{kubectlPath(cfg), fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")),
+		"label", "--overwrite", "nodes", nodeName, createdAtLbl, verLbl, commitLbl, profileNameLbl, primaryLbl}
+	// labels of the node itself, eg: from a topology file
+	keys := make([]string, 0, len(n.Labels))
+	for k := range n.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, k+"="+n.Labels[k])
+	}
+	cmd := exec.CommandContext(ctx, "sudo", args...)
 	if _, err := k.c.RunCmd(cmd); err != nil {
 		if ctx.Err() == context.DeadlineExceeded {
 			return errors.Wrapf(err, "timeout apply node labels")
@@ -1097,6 +1226,20 @@ func (k *Bootstrapper) labelAndUntaintNode(cfg config.ClusterConfig, n config.No
 		}
 	}
 
+	if len(n.Taints) > 0 {
+		// example:
+		// sudo /var/lib/minikube/binaries/<version>/kubectl --kubeconfig=/var/lib/minikube/kubeconfig taint --overwrite nodes test-357-m02 dedicated=gpu:NoSchedule
+		args := append([]string{kubectlPath(cfg), fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")),
+			"taint", "--overwrite", "nodes", nodeName}, n.Taints...)
+		cmd := exec.CommandContext(ctx, "sudo", args...)
+		if _, err := k.c.RunCmd(cmd); err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return errors.Wrapf(err, "timeout apply node taints")
+			}
+			return errors.Wrapf(err, "apply node taints")
+		}
+	}
+
 	return nil
 }
 
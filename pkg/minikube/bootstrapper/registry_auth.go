@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// RegistryAuthFile is where the registry credentials of --registry-auth are written on every node. The kubelet passes
+// the credentials of the registry of each image it pulls on to the container runtime, whichever it is.
+const RegistryAuthFile = "/var/lib/kubelet/config.json"
+
+// dockerConfig is the subset of a docker config.json that holds the credentials of registries
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	// Auth is the base64 encoded user:password
+	Auth     string `json:"auth,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// ParseRegistryAuth returns the registry, user and password of a registry=user:password value of --registry-auth.
+// Its errors do not echo the password back.
+func ParseRegistryAuth(v string) (registry, user, password string, err error) {
+	registry, creds, ok := strings.Cut(v, "=")
+	if !ok {
+		return "", "", "", fmt.Errorf("neither a file nor registry=user:password")
+	}
+	user, password, ok = strings.Cut(creds, ":")
+	if registry == "" || user == "" || !ok {
+		return "", "", "", fmt.Errorf("the credentials of %q are not user:password", registry)
+	}
+	return registry, user, password, nil
+}
+
+// registryAuths returns the credentials of each registry of the --registry-auth values: the docker config.json files,
+// eg: ~/.docker/config.json, and the registry=user:password values. The later values take precedence.
+// The registries whose credentials a docker config.json keeps in a credential helper are skipped.
+func registryAuths(values []string) (map[string]dockerAuth, error) {
+	auths := map[string]dockerAuth{}
+	for _, v := range values {
+		if _, err := os.Stat(v); err == nil {
+			b, err := os.ReadFile(v)
+			if err != nil {
+				return nil, errors.Wrap(err, "read docker config")
+			}
+			var dc dockerConfig
+			if err := json.Unmarshal(b, &dc); err != nil {
+				return nil, errors.Wrapf(err, "parse docker config %s", v)
+			}
+			for reg, a := range dc.Auths {
+				if a.Auth == "" && a.Username == "" {
+					klog.Infof("skipping the credentials of %s in %s, which are kept in a credential helper", reg, v)
+					continue
+				}
+				auths[reg] = a
+			}
+			continue
+		}
+		reg, user, password, err := ParseRegistryAuth(v)
+		if err != nil {
+			return nil, err
+		}
+		auths[reg] = dockerAuth{Auth: base64.StdEncoding.EncodeToString([]byte(user + ":" + password))}
+	}
+	return auths, nil
+}
+
+// setupRegistryAuth writes the registry credentials of the --registry-auth of cc to the node of cmd.
+// The docker config.json files are read again on each start, so that the credentials they were updated with are used.
+func setupRegistryAuth(cc config.ClusterConfig, cmd command.Runner) error {
+	if len(cc.RegistryAuth) == 0 {
+		return nil
+	}
+	auths, err := registryAuths(config.WithoutSealed(cc.RegistryAuth))
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(dockerConfig{Auths: auths})
+	if err != nil {
+		return errors.Wrap(err, "marshal registry credentials")
+	}
+	if err := cmd.Copy(assets.NewMemoryAssetTarget(b, RegistryAuthFile, "0600")); err != nil {
+		return errors.Wrapf(err, "copy %s", path.Base(RegistryAuthFile))
+	}
+	klog.Infof("installed the credentials of %d registries", len(auths))
+	return nil
+}
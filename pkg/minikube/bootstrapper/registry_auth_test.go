@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegistryAuths(t *testing.T) {
+	dockerConfig := filepath.Join(t.TempDir(), "config.json")
+	b := `{"auths": {"registry.example.com": {"auth": "dXNlcjpwYXNz"}, "ghcr.io": {}, "quay.io": {"auth": "b2xkOm9sZA=="}}, "credsStore": "desktop"}`
+	if err := os.WriteFile(dockerConfig, []byte(b), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := registryAuths([]string{dockerConfig, "quay.io=robot:p:ss=word"})
+	if err != nil {
+		t.Fatalf("registryAuths() error: %v", err)
+	}
+	want := map[string]dockerAuth{
+		"registry.example.com": {Auth: "dXNlcjpwYXNz"},
+		// robot:p:ss=word
+		"quay.io": {Auth: "cm9ib3Q6cDpzcz13b3Jk"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("registryAuths() = %v, want: %v", got, want)
+	}
+
+	for _, v := range []string{"registry.example.com", "=user:secret", "registry.example.com=secret", "registry.example.com=:secret"} {
+		_, err := registryAuths([]string{v})
+		if err == nil {
+			t.Errorf("registryAuths(%q) succeeded, want an error", v)
+		} else if strings.Contains(err.Error(), "secret") {
+			t.Errorf("registryAuths(%q) error echoes the password: %v", v, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapper
+
+import (
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+const (
+	// SeccompProfilesDir is where the seccomp profiles of --security-profiles-dir are copied to on every node.
+	// Pods refer to them relative to the seccomp directory of the kubelet, eg: localhostProfile: profiles/audit.json
+	SeccompProfilesDir = "/var/lib/kubelet/seccomp/profiles"
+	// AppArmorProfilesDir is where the AppArmor profiles of --security-profiles-dir are copied to on every node, before they are loaded
+	AppArmorProfilesDir = "/etc/apparmor.d/minikube"
+)
+
+// securityProfiles returns the seccomp profiles of dir, its .json files, and its AppArmor profiles, the other files
+func securityProfiles(dir string) (seccomp []string, apparmor []string, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		p := filepath.Join(dir, e.Name())
+		if filepath.Ext(e.Name()) == ".json" {
+			seccomp = append(seccomp, p)
+		} else {
+			apparmor = append(apparmor, p)
+		}
+	}
+	return seccomp, apparmor, nil
+}
+
+// setupSecurityProfiles copies the profiles of the --security-profiles-dir of cc to the node of cmd, and loads its AppArmor profiles.
+// The AppArmor profiles are skipped on the nodes without AppArmor.
+func setupSecurityProfiles(cc config.ClusterConfig, cmd command.Runner) error {
+	if cc.SecurityProfilesDir == "" {
+		return nil
+	}
+	seccomp, apparmor, err := securityProfiles(cc.SecurityProfilesDir)
+	if err != nil {
+		return errors.Wrap(err, "read security profiles")
+	}
+
+	if len(apparmor) > 0 {
+		if _, err := cmd.RunCmd(exec.Command("sudo", "which", "apparmor_parser")); err != nil {
+			out.WarningT("Skipping the AppArmor profiles of {{.dir}}, as the node does not support AppArmor", out.V{"dir": cc.SecurityProfilesDir})
+			apparmor = nil
+		}
+	}
+
+	install := func(src, dir string) error {
+		f, err := assets.NewFileAsset(src, dir, filepath.Base(src), "0644")
+		if err != nil {
+			return errors.Wrapf(err, "create file asset for %s", src)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				klog.Warningf("error closing the file %s: %v", f.GetSourcePath(), err)
+			}
+		}()
+		return cmd.Copy(f)
+	}
+	for _, p := range seccomp {
+		if err := install(p, SeccompProfilesDir); err != nil {
+			return errors.Wrapf(err, "copy seccomp profile %s", p)
+		}
+	}
+	for _, p := range apparmor {
+		if err := install(p, AppArmorProfilesDir); err != nil {
+			return errors.Wrapf(err, "copy apparmor profile %s", p)
+		}
+		// -r replaces the profile if it is loaded already
+		guest := path.Join(AppArmorProfilesDir, filepath.Base(p))
+		if _, err := cmd.RunCmd(exec.Command("sudo", "apparmor_parser", "-r", guest)); err != nil {
+			return errors.Wrapf(err, "load apparmor profile %s", p)
+		}
+	}
+	klog.Infof("installed %d seccomp and %d apparmor profiles", len(seccomp), len(apparmor))
+	return nil
+}
@@ -0,0 +1,442 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capi is a Cluster API infrastructure provider backed by minikube: it reconciles the MinikubeCluster and
+// MinikubeMachine objects of a management cluster into minikube clusters and their nodes, on the host it runs on.
+package capi
+
+import (
+	"context"
+	_ "embed" // for the CRDs
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/libminikube"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// CRDs are the CustomResourceDefinitions of MinikubeCluster, MinikubeMachine and MinikubeMachineTemplate
+//
+//go:embed crds.yaml
+var CRDs string
+
+const (
+	// Group is the API group of the infrastructure providers of Cluster API
+	Group = "infrastructure.cluster.x-k8s.io"
+	// Version is the version of the contract of Cluster API that the provider implements
+	Version = "v1beta1"
+
+	finalizer = "minikube." + Group
+	// clusterNameLabel is set by Cluster API on the objects of a Cluster
+	clusterNameLabel = "cluster.x-k8s.io/cluster-name"
+	// controlPlaneLabel is set by Cluster API on the Machines of the control plane
+	controlPlaneLabel = "cluster.x-k8s.io/control-plane"
+	// secretType is the type of the Secrets of Cluster API
+	secretType = "cluster.x-k8s.io/secret"
+	// providerIDPrefix is followed by the name of the node in Kubernetes, eg: minikube://dev-m02
+	providerIDPrefix = "minikube://"
+)
+
+var (
+	clustersResource = schema.GroupVersionResource{Group: Group, Version: Version, Resource: "minikubeclusters"}
+	machinesResource = schema.GroupVersionResource{Group: Group, Version: Version, Resource: "minikubemachines"}
+)
+
+// Minikube creates and changes the clusters of the provider, like libminikube.Client does
+type Minikube interface {
+	CreateCluster(o libminikube.ClusterOptions) error
+	DeleteCluster(cluster string) error
+	AddNode(cluster string, o libminikube.NodeOptions) (string, error)
+	DeleteNode(cluster, name string) error
+	Status(cluster string) ([]libminikube.NodeStatus, error)
+	Kubeconfig(cluster string) ([]byte, error)
+}
+
+// Provider reconciles the objects of the provider in a management cluster
+type Provider struct {
+	client   dynamic.Interface
+	core     kubernetes.Interface
+	minikube Minikube
+	// workload returns a client of a minikube cluster, which the provider sets the providerIDs of the nodes with
+	workload func(cluster string) (kubernetes.Interface, error)
+}
+
+// NewProvider returns a provider that reconciles the objects of the management cluster of client and core with mk
+func NewProvider(client dynamic.Interface, core kubernetes.Interface, mk Minikube) *Provider {
+	return &Provider{
+		client:   client,
+		core:     core,
+		minikube: mk,
+		workload: func(cluster string) (kubernetes.Interface, error) { return kapi.Client(cluster) },
+	}
+}
+
+// Run reconciles every interval until ctx is done
+func (p *Provider) Run(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.Reconcile(ctx); err != nil {
+			klog.Warningf("reconcile (will retry): %v", err)
+		}
+	}, interval)
+}
+
+// Reconcile creates, deletes and scales the minikube clusters of every MinikubeCluster and MinikubeMachine once.
+// The errors of an object do not hold back the others.
+func (p *Provider) Reconcile(ctx context.Context) error {
+	clusters, err := p.client.Resource(clustersResource).Namespace(meta.NamespaceAll).List(ctx, meta.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "list MinikubeClusters")
+	}
+	machines, err := p.client.Resource(machinesResource).Namespace(meta.NamespaceAll).List(ctx, meta.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "list MinikubeMachines")
+	}
+
+	var errs []string
+	for i := range clusters.Items {
+		c := &clusters.Items[i]
+		if err := p.reconcileCluster(ctx, c); err != nil {
+			errs = append(errs, fmt.Sprintf("MinikubeCluster %s/%s: %v", c.GetNamespace(), c.GetName(), err))
+		}
+	}
+	for i := range machines.Items {
+		m := &machines.Items[i]
+		if err := p.reconcileMachine(ctx, m, clusters.Items, machines.Items); err != nil {
+			errs = append(errs, fmt.Sprintf("MinikubeMachine %s/%s: %v", m.GetNamespace(), m.GetName(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// reconcileCluster creates the minikube cluster of c, named after c, writes its kubeconfig Secret and sets its endpoint,
+// or deletes it along with c
+func (p *Provider) reconcileCluster(ctx context.Context, c *unstructured.Unstructured) error {
+	res := p.client.Resource(clustersResource).Namespace(c.GetNamespace())
+	name := c.GetName()
+	owner := c.GetLabels()[clusterNameLabel]
+
+	if c.GetDeletionTimestamp() != nil {
+		if !slices.Contains(c.GetFinalizers(), finalizer) {
+			return nil
+		}
+		out.Step(style.DeletingHost, "Deleting cluster {{.name}} of {{.namespace}}/{{.cluster}}", out.V{"name": name, "namespace": c.GetNamespace(), "cluster": owner})
+		if err := p.minikube.DeleteCluster(name); err != nil {
+			return errors.Wrap(err, "delete cluster")
+		}
+		if owner != "" {
+			err := p.core.CoreV1().Secrets(c.GetNamespace()).Delete(ctx, owner+"-kubeconfig", meta.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrap(err, "delete kubeconfig secret")
+			}
+		}
+		c.SetFinalizers(slices.DeleteFunc(c.GetFinalizers(), func(f string) bool { return f == finalizer }))
+		return update(ctx, res, c)
+	}
+	if ready(c) || failed(c) {
+		return nil
+	}
+	if owner == "" {
+		klog.Infof("waiting for Cluster API to set the Cluster of MinikubeCluster %s/%s", c.GetNamespace(), name)
+		return nil
+	}
+
+	_, err := p.minikube.Status(name)
+	exists := err == nil
+	if !slices.Contains(c.GetFinalizers(), finalizer) {
+		// a cluster that the provider did not create is not taken over, as it would be deleted along with c
+		if exists {
+			return p.fail(ctx, res, c, "ClusterExists", fmt.Sprintf("minikube cluster %s exists already", name))
+		}
+		c.SetFinalizers(append(c.GetFinalizers(), finalizer))
+		if err := update(ctx, res, c); err != nil {
+			return err
+		}
+	}
+	if !exists {
+		o := libminikube.ClusterOptions{Name: name}
+		o.Driver, _, _ = unstructured.NestedString(c.Object, "spec", "driver")
+		o.ContainerRuntime, _, _ = unstructured.NestedString(c.Object, "spec", "containerRuntime")
+		o.KubernetesVersion, _, _ = unstructured.NestedString(c.Object, "spec", "kubernetesVersion")
+		cpus, _, _ := unstructured.NestedInt64(c.Object, "spec", "cpus")
+		memory, _, _ := unstructured.NestedInt64(c.Object, "spec", "memory")
+		o.CPUs, o.Memory = int(cpus), int(memory)
+
+		out.Step(style.Provisioning, "Creating cluster {{.name}} for {{.namespace}}/{{.cluster}}", out.V{"name": name, "namespace": c.GetNamespace(), "cluster": owner})
+		if err := p.minikube.CreateCluster(o); err != nil {
+			return p.failOn(ctx, res, c, err)
+		}
+	}
+
+	kc, err := p.minikube.Kubeconfig(name)
+	if err != nil {
+		return errors.Wrap(err, "kubeconfig")
+	}
+	host, port, err := endpoint(kc)
+	if err != nil {
+		return err
+	}
+	if err := p.writeKubeconfig(ctx, c.GetNamespace(), owner, kc); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(c.Object, host, "spec", "controlPlaneEndpoint", "host"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(c.Object, int64(port), "spec", "controlPlaneEndpoint", "port"); err != nil {
+		return err
+	}
+	if err := update(ctx, res, c); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(c.Object, true, "status", "ready"); err != nil {
+		return err
+	}
+	return updateStatus(ctx, res, c)
+}
+
+// endpoint returns the address of the API server of the kubeconfig of a cluster
+func endpoint(kc []byte) (string, int, error) {
+	cfg, err := clientcmd.Load(kc)
+	if err != nil {
+		return "", 0, errors.Wrap(err, "load kubeconfig")
+	}
+	ctx, ok := cfg.Contexts[cfg.CurrentContext]
+	if !ok || cfg.Clusters[ctx.Cluster] == nil {
+		return "", 0, fmt.Errorf("the kubeconfig has no cluster for the context %q", cfg.CurrentContext)
+	}
+	server := cfg.Clusters[ctx.Cluster].Server
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", 0, errors.Wrap(err, "url parse")
+	}
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "port of %s", server)
+	}
+	return u.Hostname(), port, nil
+}
+
+// writeKubeconfig creates or updates the kubeconfig Secret of the Cluster owner, which Cluster API reaches it with
+func (p *Provider) writeKubeconfig(ctx context.Context, namespace, owner string, kc []byte) error {
+	secret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      owner + "-kubeconfig",
+			Namespace: namespace,
+			Labels:    map[string]string{clusterNameLabel: owner},
+		},
+		Type: secretType,
+		Data: map[string][]byte{"value": kc},
+	}
+	secrets := p.core.CoreV1().Secrets(namespace)
+	if _, err := secrets.Update(ctx, secret, meta.UpdateOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrap(err, "update kubeconfig secret")
+		}
+		if _, err := secrets.Create(ctx, secret, meta.CreateOptions{}); err != nil {
+			return errors.Wrap(err, "create kubeconfig secret")
+		}
+	}
+	return nil
+}
+
+// reconcileMachine adds the node of m to the minikube cluster of its MinikubeCluster, or deletes it along with m.
+// The first control-plane Machine takes the primary control-plane node, which is created with the cluster.
+func (p *Provider) reconcileMachine(ctx context.Context, m *unstructured.Unstructured, clusters, machines []unstructured.Unstructured) error {
+	res := p.client.Resource(machinesResource).Namespace(m.GetNamespace())
+	owner := m.GetLabels()[clusterNameLabel]
+	c := clusterOf(clusters, m.GetNamespace(), owner)
+	providerID, _, _ := unstructured.NestedString(m.Object, "spec", "providerID")
+
+	if m.GetDeletionTimestamp() != nil {
+		if !slices.Contains(m.GetFinalizers(), finalizer) {
+			return nil
+		}
+		// the primary control-plane node is deleted with the cluster
+		if c != nil && c.GetDeletionTimestamp() == nil && providerID != "" && providerID != providerIDPrefix+c.GetName() {
+			name := strings.TrimPrefix(providerID, providerIDPrefix+c.GetName()+"-")
+			out.Step(style.DeletingHost, "Deleting node {{.node}} of {{.name}}", out.V{"node": name, "name": c.GetName()})
+			if err := p.minikube.DeleteNode(c.GetName(), name); err != nil {
+				return errors.Wrapf(err, "delete node %s", name)
+			}
+		}
+		m.SetFinalizers(slices.DeleteFunc(m.GetFinalizers(), func(f string) bool { return f == finalizer }))
+		return update(ctx, res, m)
+	}
+	if ready(m) || failed(m) {
+		return nil
+	}
+	if c == nil || !ready(c) {
+		klog.Infof("waiting for the MinikubeCluster of MinikubeMachine %s/%s", m.GetNamespace(), m.GetName())
+		return nil
+	}
+	name := c.GetName()
+
+	if !slices.Contains(m.GetFinalizers(), finalizer) {
+		m.SetFinalizers(append(m.GetFinalizers(), finalizer))
+		if err := update(ctx, res, m); err != nil {
+			return err
+		}
+	}
+	if providerID == "" {
+		_, controlPlane := m.GetLabels()[controlPlaneLabel]
+		primary := providerIDPrefix + name
+		if controlPlane && !claimed(machines, m.GetNamespace(), primary) {
+			providerID = primary
+		} else {
+			out.Step(style.Provisioning, "Adding node to {{.name}} for {{.namespace}}/{{.machine}}", out.V{"name": name, "namespace": m.GetNamespace(), "machine": m.GetName()})
+			n, err := p.minikube.AddNode(name, libminikube.NodeOptions{ControlPlane: controlPlane})
+			if err != nil {
+				return p.failOn(ctx, res, m, err)
+			}
+			providerID = primary + "-" + n
+		}
+		if err := unstructured.SetNestedField(m.Object, providerID, "spec", "providerID"); err != nil {
+			return err
+		}
+		if err := update(ctx, res, m); err != nil {
+			return err
+		}
+	}
+
+	// Cluster API matches a Machine with the Node of the same providerID
+	if err := p.setNodeProviderID(ctx, name, providerID); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(m.Object, true, "status", "ready"); err != nil {
+		return err
+	}
+	return updateStatus(ctx, res, m)
+}
+
+// setNodeProviderID sets providerID on its node of the minikube cluster name
+func (p *Provider) setNodeProviderID(ctx context.Context, name, providerID string) error {
+	client, err := p.workload(name)
+	if err != nil {
+		return errors.Wrap(err, "client")
+	}
+	nodes := client.CoreV1().Nodes()
+	n, err := nodes.Get(ctx, strings.TrimPrefix(providerID, providerIDPrefix), meta.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "get node")
+	}
+	if n.Spec.ProviderID == providerID {
+		return nil
+	}
+	if n.Spec.ProviderID != "" {
+		return fmt.Errorf("node %s has the providerID %s already", n.Name, n.Spec.ProviderID)
+	}
+	n.Spec.ProviderID = providerID
+	if _, err := nodes.Update(ctx, n, meta.UpdateOptions{}); err != nil {
+		return errors.Wrap(err, "update node")
+	}
+	return nil
+}
+
+// clusterOf returns the MinikubeCluster of the Cluster owner in namespace, or nil
+func clusterOf(clusters []unstructured.Unstructured, namespace, owner string) *unstructured.Unstructured {
+	if owner == "" {
+		return nil
+	}
+	for i := range clusters {
+		if clusters[i].GetNamespace() == namespace && clusters[i].GetLabels()[clusterNameLabel] == owner {
+			return &clusters[i]
+		}
+	}
+	return nil
+}
+
+// claimed returns whether a MinikubeMachine of namespace has providerID
+func claimed(machines []unstructured.Unstructured, namespace, providerID string) bool {
+	for _, m := range machines {
+		id, _, _ := unstructured.NestedString(m.Object, "spec", "providerID")
+		if m.GetNamespace() == namespace && id == providerID {
+			return true
+		}
+	}
+	return false
+}
+
+func ready(obj *unstructured.Unstructured) bool {
+	r, _, _ := unstructured.NestedBool(obj.Object, "status", "ready")
+	return r
+}
+
+// failed returns whether the failure of obj was recorded, which Cluster API does not retry
+func failed(obj *unstructured.Unstructured) bool {
+	r, _, _ := unstructured.NestedString(obj.Object, "status", "failureReason")
+	return r != ""
+}
+
+// failOn records err as the failure of obj if minikube would have exited on it, or returns it to be retried
+func (p *Provider) failOn(ctx context.Context, res dynamic.ResourceInterface, obj *unstructured.Unstructured, err error) error {
+	var e *libminikube.Error
+	if !errors.As(err, &e) {
+		return err
+	}
+	return p.fail(ctx, res, obj, e.ID, e.Message)
+}
+
+// fail records the failure of obj
+func (p *Provider) fail(ctx context.Context, res dynamic.ResourceInterface, obj *unstructured.Unstructured, reason, message string) error {
+	klog.Warningf("%s %s/%s failed: %s: %s", obj.GetKind(), obj.GetNamespace(), obj.GetName(), reason, message)
+	if err := unstructured.SetNestedField(obj.Object, reason, "status", "failureReason"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(obj.Object, message, "status", "failureMessage"); err != nil {
+		return err
+	}
+	return updateStatus(ctx, res, obj)
+}
+
+// update updates obj, and sets it to the updated object
+func update(ctx context.Context, res dynamic.ResourceInterface, obj *unstructured.Unstructured) error {
+	u, err := res.Update(ctx, obj, meta.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "update %s", obj.GetName())
+	}
+	*obj = *u
+	return nil
+}
+
+// updateStatus updates the status of obj, and sets it to the updated object
+func updateStatus(ctx context.Context, res dynamic.ResourceInterface, obj *unstructured.Unstructured) error {
+	u, err := res.UpdateStatus(ctx, obj, meta.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "update status of %s", obj.GetName())
+	}
+	*obj = *u
+	return nil
+}
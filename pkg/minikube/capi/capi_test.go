@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/minikube/pkg/libminikube"
+)
+
+const kubeconfig = `apiVersion: v1
+kind: Config
+current-context: dev
+contexts:
+- name: dev
+  context:
+    cluster: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://192.168.49.2:8443
+`
+
+// fakeMinikube keeps the nodes of its clusters, as the names of their machines
+type fakeMinikube struct {
+	clusters map[string][]string
+	fail     error
+}
+
+func (f *fakeMinikube) CreateCluster(o libminikube.ClusterOptions) error {
+	if f.fail != nil {
+		return f.fail
+	}
+	f.clusters[o.Name] = []string{o.Name}
+	return nil
+}
+
+func (f *fakeMinikube) DeleteCluster(cluster string) error {
+	delete(f.clusters, cluster)
+	return nil
+}
+
+func (f *fakeMinikube) AddNode(cluster string, _ libminikube.NodeOptions) (string, error) {
+	name := fmt.Sprintf("m%02d", len(f.clusters[cluster])+1)
+	f.clusters[cluster] = append(f.clusters[cluster], cluster+"-"+name)
+	return name, nil
+}
+
+func (f *fakeMinikube) DeleteNode(cluster, name string) error {
+	var nodes []string
+	for _, n := range f.clusters[cluster] {
+		if n != cluster+"-"+name {
+			nodes = append(nodes, n)
+		}
+	}
+	f.clusters[cluster] = nodes
+	return nil
+}
+
+func (f *fakeMinikube) Status(cluster string) ([]libminikube.NodeStatus, error) {
+	nodes, ok := f.clusters[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", cluster)
+	}
+	var st []libminikube.NodeStatus
+	for _, n := range nodes {
+		st = append(st, libminikube.NodeStatus{Name: n})
+	}
+	return st, nil
+}
+
+func (f *fakeMinikube) Kubeconfig(string) ([]byte, error) {
+	return []byte(kubeconfig), nil
+}
+
+func object(kind, name string, labels map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(Group + "/" + Version)
+	u.SetKind(kind)
+	u.SetNamespace("default")
+	u.SetName(name)
+	u.SetLabels(labels)
+	return u
+}
+
+func newProvider(mk *fakeMinikube, objs ...runtime.Object) (*Provider, kubernetes.Interface) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		clustersResource: "MinikubeClusterList",
+		machinesResource: "MinikubeMachineList",
+	}, objs...)
+	mgmt := fake.NewSimpleClientset()
+	workload := fake.NewSimpleClientset()
+	p := NewProvider(client, mgmt, mk)
+	p.workload = func(cluster string) (kubernetes.Interface, error) {
+		// the nodes of the workload cluster are registered as minikube adds them
+		for _, n := range mk.clusters[cluster] {
+			_, _ = workload.CoreV1().Nodes().Create(context.Background(), &core.Node{ObjectMeta: meta.ObjectMeta{Name: n}}, meta.CreateOptions{})
+		}
+		return workload, nil
+	}
+	return p, mgmt
+}
+
+func get(t *testing.T, p *Provider, kind, name string) *unstructured.Unstructured {
+	t.Helper()
+	res := clustersResource
+	if kind == "MinikubeMachine" {
+		res = machinesResource
+	}
+	u, err := p.client.Resource(res).Namespace("default").Get(context.Background(), name, meta.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func TestReconcile(t *testing.T) {
+	mk := &fakeMinikube{clusters: map[string][]string{}}
+	cp := map[string]string{clusterNameLabel: "dev", controlPlaneLabel: ""}
+	worker := map[string]string{clusterNameLabel: "dev"}
+	p, mgmt := newProvider(mk,
+		object("MinikubeCluster", "dev", worker),
+		object("MinikubeMachine", "dev-cp", cp),
+		object("MinikubeMachine", "dev-md-1", worker),
+		object("MinikubeMachine", "dev-md-2", worker),
+	)
+	ctx := context.Background()
+
+	if err := p.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	if got := strings.Join(mk.clusters["dev"], " "); got != "dev dev-m02 dev-m03" {
+		t.Errorf("nodes = %q, want the primary control plane and two workers", got)
+	}
+
+	c := get(t, p, "MinikubeCluster", "dev")
+	host, _, _ := unstructured.NestedString(c.Object, "spec", "controlPlaneEndpoint", "host")
+	port, _, _ := unstructured.NestedInt64(c.Object, "spec", "controlPlaneEndpoint", "port")
+	if !ready(c) || host != "192.168.49.2" || port != 8443 {
+		t.Errorf("MinikubeCluster = %v, want ready at 192.168.49.2:8443", c.Object)
+	}
+	s, err := mgmt.CoreV1().Secrets("default").Get(ctx, "dev-kubeconfig", meta.GetOptions{})
+	if err != nil || string(s.Data["value"]) != kubeconfig || s.Type != secretType {
+		t.Errorf("kubeconfig secret = %v (%v), want the kubeconfig of dev", s, err)
+	}
+
+	want := map[string]string{"dev-cp": "minikube://dev", "dev-md-1": "minikube://dev-m02", "dev-md-2": "minikube://dev-m03"}
+	for name, id := range want {
+		m := get(t, p, "MinikubeMachine", name)
+		got, _, _ := unstructured.NestedString(m.Object, "spec", "providerID")
+		if got != id || !ready(m) {
+			t.Errorf("MinikubeMachine %s = %v, want ready with the providerID %s", name, m.Object, id)
+		}
+	}
+	workload, _ := p.workload("dev")
+	n, err := workload.CoreV1().Nodes().Get(ctx, "dev-m02", meta.GetOptions{})
+	if err != nil || n.Spec.ProviderID != "minikube://dev-m02" {
+		t.Errorf("node dev-m02 = %v (%v), want the providerID minikube://dev-m02", n, err)
+	}
+
+	// scaling down deletes a worker
+	m := get(t, p, "MinikubeMachine", "dev-md-2")
+	now := meta.Now()
+	m.SetDeletionTimestamp(&now)
+	if _, err := p.client.Resource(machinesResource).Namespace("default").Update(ctx, m, meta.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	if got := strings.Join(mk.clusters["dev"], " "); got != "dev dev-m02" {
+		t.Errorf("nodes = %q, want dev-m03 deleted", got)
+	}
+	if m := get(t, p, "MinikubeMachine", "dev-md-2"); len(m.GetFinalizers()) != 0 {
+		t.Errorf("finalizers of the deleted MinikubeMachine = %v, want none", m.GetFinalizers())
+	}
+
+	// deleting the cluster deletes the minikube cluster and its kubeconfig
+	c = get(t, p, "MinikubeCluster", "dev")
+	c.SetDeletionTimestamp(&now)
+	if _, err := p.client.Resource(clustersResource).Namespace("default").Update(ctx, c, meta.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	if _, ok := mk.clusters["dev"]; ok {
+		t.Errorf("cluster dev was not deleted")
+	}
+	if _, err := mgmt.CoreV1().Secrets("default").Get(ctx, "dev-kubeconfig", meta.GetOptions{}); err == nil {
+		t.Errorf("the kubeconfig secret was not deleted")
+	}
+}
+
+func TestReconcileFailures(t *testing.T) {
+	tests := []struct {
+		description string
+		clusters    map[string][]string
+		fail        error
+		reason      string
+	}{
+		{"existing cluster", map[string][]string{"dev": {"dev"}}, nil, "ClusterExists"},
+		{"exit", map[string][]string{}, &libminikube.Error{ID: "RSRC_INSUFFICIENT_CORES", Message: "not enough CPUs"}, "RSRC_INSUFFICIENT_CORES"},
+		{"retried", map[string][]string{}, errors.New("lock timeout"), ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			mk := &fakeMinikube{clusters: tc.clusters, fail: tc.fail}
+			p, _ := newProvider(mk, object("MinikubeCluster", "dev", map[string]string{clusterNameLabel: "dev"}))
+			err := p.Reconcile(context.Background())
+			if (err != nil) != (tc.reason == "") {
+				t.Errorf("Reconcile() = %v", err)
+			}
+			c := get(t, p, "MinikubeCluster", "dev")
+			reason, _, _ := unstructured.NestedString(c.Object, "status", "failureReason")
+			if reason != tc.reason || ready(c) {
+				t.Errorf("MinikubeCluster = %v, want the failure reason %q", c.Object, tc.reason)
+			}
+		})
+	}
+}
+
+func TestCRDs(t *testing.T) {
+	d := yaml.NewYAMLOrJSONDecoder(strings.NewReader(CRDs), 4096)
+	var kinds []string
+	for {
+		var u unstructured.Unstructured
+		if err := d.Decode(&u.Object); err != nil {
+			break
+		}
+		if u.GetLabels()["cluster.x-k8s.io/"+Version] != Version {
+			t.Errorf("%s has no contract label", u.GetName())
+		}
+		kind, _, _ := unstructured.NestedString(u.Object, "spec", "names", "kind")
+		kinds = append(kinds, kind)
+	}
+	if got := strings.Join(kinds, " "); got != "MinikubeCluster MinikubeMachine MinikubeMachineTemplate" {
+		t.Errorf("CRDs = %q", got)
+	}
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+)
+
+// Drift is a setting of a node that differs between the saved profile and the guest
+type Drift struct {
+	Node    string
+	Setting string
+	Saved   string
+	Actual  string
+	// Fix is a command that reconciles the guest with the profile
+	Fix string
+}
+
+// minGuestMemoryRatio is the part of the assigned memory that a VM kernel reports at least, the rest being reserved
+const minGuestMemoryRatio = 0.85
+
+// CheckConfig compares the saved profile cc against the state of node n, reachable through r.
+// Cluster wide settings, such as the addons and the node labels, are only checked on the primary control plane.
+func CheckConfig(cc config.ClusterConfig, n config.Node, r command.Runner) ([]Drift, error) {
+	name := config.MachineName(cc, n)
+	checks := []func(config.ClusterConfig, command.Runner) ([]Drift, error){memoryDrift, registryDrift}
+	if config.IsPrimaryControlPlane(cc, n) {
+		checks = append(checks, mountDrift, addonDrift, labelDrift)
+	}
+
+	var drifts []Drift
+	for _, check := range checks {
+		ds, err := check(cc, r)
+		if err != nil {
+			return drifts, err
+		}
+		for _, d := range ds {
+			if d.Node == "" {
+				d.Node = name
+			}
+			drifts = append(drifts, d)
+		}
+	}
+	return drifts, nil
+}
+
+// recreate is the fix for settings that are only applied when a machine is created
+func recreate(cc config.ClusterConfig, flags string) string {
+	return strings.TrimSpace(fmt.Sprintf("minikube delete -p %s && minikube start -p %s %s", cc.Name, cc.Name, flags))
+}
+
+// memoryDrift compares the memory of the guest with the profile. Machines of the none and ssh drivers are not sized by minikube.
+func memoryDrift(cc config.ClusterConfig, r command.Runner) ([]Drift, error) {
+	if driver.BareMetal(cc.Driver) || driver.IsSSH(cc.Driver) {
+		return nil, nil
+	}
+	saved := fmt.Sprintf("%dMB", cc.Memory)
+	if cc.Memory == 0 {
+		saved = constants.NoLimit
+	}
+	fix := recreate(cc, fmt.Sprintf("--memory=%s", strings.TrimSuffix(saved, "B")))
+
+	if driver.IsKIC(cc.Driver) {
+		rr, err := r.RunCmd(exec.Command("/bin/bash", "-c", "cat /sys/fs/cgroup/memory.max 2>/dev/null || cat /sys/fs/cgroup/memory/memory.limit_in_bytes"))
+		if err != nil {
+			return nil, errors.Wrap(err, "memory limit")
+		}
+		limit := strings.TrimSpace(rr.Stdout.String())
+		bytes, err := strconv.ParseInt(limit, 10, 64)
+		// cgroup v1 reports the absence of a limit as a huge page aligned number
+		if limit == "max" || (err == nil && bytes >= 1<<62) {
+			if cc.Memory != 0 {
+				// the container runtime may lack the memory cgroup, in which case no limit was set either
+				klog.Infof("container has no memory limit, expected %s", saved)
+			}
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse memory limit %q", limit)
+		}
+		if mb := int(bytes / 1024 / 1024); mb != cc.Memory {
+			return []Drift{{Setting: "memory", Saved: saved, Actual: fmt.Sprintf("%dMB", mb), Fix: fix}}, nil
+		}
+		return nil, nil
+	}
+
+	rr, err := r.RunCmd(exec.Command("grep", "MemTotal", "/proc/meminfo"))
+	if err != nil {
+		return nil, errors.Wrap(err, "meminfo")
+	}
+	fields := strings.Fields(rr.Stdout.String())
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("unexpected meminfo: %q", rr.Stdout.String())
+	}
+	kb, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse meminfo %q", rr.Stdout.String())
+	}
+	mb := kb / 1024
+	if mb > cc.Memory || float64(mb) < float64(cc.Memory)*minGuestMemoryRatio {
+		return []Drift{{Setting: "memory", Saved: saved, Actual: fmt.Sprintf("%dMB", mb), Fix: fix}}, nil
+	}
+	return nil, nil
+}
+
+// mountDrift checks that the mounts of the profile are mounted in the guest
+func mountDrift(cc config.ClusterConfig, r command.Runner) ([]Drift, error) {
+	want := map[string]string{}
+	if cc.Mount && cc.MountString != "" {
+		// the host path may contain a colon, eg: C:\Users
+		if i := strings.LastIndex(cc.MountString, ":"); i > 0 {
+			fix := fmt.Sprintf("minikube start -p %s", cc.Name)
+			if driver.IsKIC(cc.Driver) {
+				fix = recreate(cc, fmt.Sprintf("--mount --mount-string=%q", cc.MountString))
+			}
+			want[cc.MountString[i+1:]] = fix
+		}
+	}
+	if driver.IsKIC(cc.Driver) {
+		for _, m := range cc.ContainerVolumeMounts {
+			parts := strings.Split(m, ":")
+			if len(parts) >= 2 {
+				want[parts[1]] = recreate(cc, fmt.Sprintf("--container-volume-mounts=%q", m))
+			}
+		}
+	}
+	if len(want) == 0 {
+		return nil, nil
+	}
+
+	rr, err := r.RunCmd(exec.Command("cat", "/proc/mounts"))
+	if err != nil {
+		return nil, errors.Wrap(err, "mounts")
+	}
+	mounted := map[string]bool{}
+	s := bufio.NewScanner(&rr.Stdout)
+	for s.Scan() {
+		if fields := strings.Fields(s.Text()); len(fields) > 1 {
+			mounted[fields[1]] = true
+		}
+	}
+
+	var drifts []Drift
+	for _, target := range sortedKeys(want) {
+		if !mounted[target] {
+			drifts = append(drifts, Drift{Setting: "mount " + target, Saved: "mounted", Actual: "not mounted", Fix: want[target]})
+		}
+	}
+	return drifts, nil
+}
+
+// registryDrift checks that the insecure registries and registry mirrors of the profile are configured in the container runtime
+func registryDrift(cc config.ClusterConfig, r command.Runner) ([]Drift, error) {
+	if len(cc.InsecureRegistry) == 0 && len(cc.RegistryMirror) == 0 {
+		return nil, nil
+	}
+	var drifts []Drift
+	missing := func(setting, value string) {
+		drifts = append(drifts, Drift{Setting: setting, Saved: value, Actual: "not configured", Fix: fmt.Sprintf("minikube node refresh-runtime-config -p %s", cc.Name)})
+	}
+	// mirrorsMissing reports the registry mirrors that are not in conf, the mirror config of containerd or cri-o. cri-o has them without their scheme.
+	mirrorsMissing := func(conf string) {
+		var mirrors string
+		if rr, err := r.RunCmd(exec.Command("sudo", "cat", conf)); err == nil {
+			mirrors = rr.Stdout.String()
+		}
+		for _, m := range cc.RegistryMirror {
+			host := m
+			if i := strings.Index(host, "//"); i >= 0 {
+				host = host[i+2:]
+			}
+			if !strings.Contains(mirrors, host) {
+				missing("registry-mirror", m)
+			}
+		}
+	}
+
+	switch cc.KubernetesConfig.ContainerRuntime {
+	case constants.Containerd:
+		for _, reg := range cc.InsecureRegistry {
+			addr := reg
+			if i := strings.Index(addr, "//"); i >= 0 {
+				addr = addr[i+2:]
+			}
+			if _, err := r.RunCmd(exec.Command("sudo", "test", "-f", path.Join("/etc/containerd/certs.d", addr, "hosts.toml"))); err != nil {
+				missing("insecure-registry", reg)
+			}
+		}
+		mirrorsMissing("/etc/containerd/certs.d/docker.io/hosts.toml")
+	case constants.Docker, constants.CRIO:
+		cmd := exec.Command("sudo", "systemctl", "cat", "docker")
+		if cc.KubernetesConfig.ContainerRuntime == constants.CRIO {
+			cmd = exec.Command("cat", "/etc/sysconfig/crio.minikube")
+		}
+		rr, err := r.RunCmd(cmd)
+		if err != nil {
+			return nil, errors.Wrap(err, "runtime options")
+		}
+		opts := rr.Stdout.String()
+		for _, reg := range cc.InsecureRegistry {
+			if !strings.Contains(opts, "--insecure-registry "+reg) {
+				missing("insecure-registry", reg)
+			}
+		}
+		// cri-o is configured with the registry mirrors in a file of its own
+		if cc.KubernetesConfig.ContainerRuntime == constants.CRIO {
+			mirrorsMissing("/etc/containers/registries.conf.d/50-minikube-mirror.conf")
+			break
+		}
+		for _, m := range cc.RegistryMirror {
+			if !strings.Contains(opts, "--registry-mirror "+m) {
+				missing("registry-mirror", m)
+			}
+		}
+	}
+	return drifts, nil
+}
+
+// addonDrift compares the addons enabled in the profile with the addon manifests installed in the guest
+func addonDrift(cc config.ClusterConfig, r command.Runner) ([]Drift, error) {
+	rr, err := r.RunCmd(exec.Command("sudo", "find", vmpath.GuestAddonsDir, "-type", "f"))
+	if err != nil {
+		// the directory only exists once an addon was enabled
+		klog.Infof("unable to list addons: %v", err)
+		rr = &command.RunResult{}
+	}
+	installed := map[string]bool{}
+	for _, f := range strings.Fields(rr.Stdout.String()) {
+		installed[f] = true
+	}
+
+	// manifests shared by several addons tell nothing about either of them
+	owners := map[string]int{}
+	for _, a := range assets.Addons {
+		for _, f := range a.Assets {
+			owners[f.GetTargetPath()]++
+		}
+	}
+
+	var drifts []Drift
+	for _, name := range sortedKeys(assets.Addons) {
+		a := assets.Addons[name]
+		var files []string
+		for _, f := range a.Assets {
+			if f.GetTargetDir() == vmpath.GuestAddonsDir && owners[f.GetTargetPath()] == 1 {
+				files = append(files, f.GetTargetPath())
+			}
+		}
+		if len(files) == 0 {
+			continue
+		}
+		found := false
+		for _, f := range files {
+			found = found || installed[f]
+		}
+		enabled := a.IsEnabled(&cc)
+		if enabled == found {
+			continue
+		}
+		d := Drift{Setting: "addon " + name, Saved: "enabled", Actual: "disabled", Fix: fmt.Sprintf("minikube addons enable %s -p %s", name, cc.Name)}
+		if !enabled {
+			d.Saved, d.Actual, d.Fix = "disabled", "enabled", fmt.Sprintf("minikube addons disable %s -p %s", name, cc.Name)
+		}
+		drifts = append(drifts, d)
+	}
+	return drifts, nil
+}
+
+// labelDrift checks the minikube labels of every node of the cluster
+func labelDrift(cc config.ClusterConfig, r command.Runner) ([]Drift, error) {
+	kubectl := path.Join(vmpath.GuestPersistentDir, "binaries", cc.KubernetesConfig.KubernetesVersion, "kubectl")
+	rr, err := r.RunCmd(exec.Command("sudo", kubectl, "--kubeconfig="+path.Join(vmpath.GuestPersistentDir, "kubeconfig"), "get", "nodes", "-o", "json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "get nodes")
+	}
+	var nodes v1.NodeList
+	if err := json.Unmarshal(rr.Stdout.Bytes(), &nodes); err != nil {
+		return nil, errors.Wrap(err, "decode nodes")
+	}
+	labels := map[string]map[string]string{}
+	for _, n := range nodes.Items {
+		labels[n.Name] = n.Labels
+	}
+
+	var drifts []Drift
+	for _, n := range cc.Nodes {
+		name := config.MachineName(cc, n)
+		// the none driver registers the node under the host name
+		if driver.IsNone(cc.Driver) {
+			if h, err := os.Hostname(); err == nil {
+				name = h
+			}
+		}
+		l, ok := labels[name]
+		if !ok {
+			drifts = append(drifts, Drift{Node: config.MachineName(cc, n), Setting: "node", Saved: "registered", Actual: "not registered", Fix: fmt.Sprintf("minikube start -p %s", cc.Name)})
+			continue
+		}
+		want := map[string]string{
+			"minikube.k8s.io/name":    cc.Name,
+			"minikube.k8s.io/primary": strconv.FormatBool(config.IsPrimaryControlPlane(cc, n)),
+		}
+		for _, k := range sortedKeys(want) {
+			if l[k] == want[k] {
+				continue
+			}
+			actual := l[k]
+			if actual == "" {
+				actual = "not set"
+			}
+			fix := fmt.Sprintf("minikube kubectl -p %s -- label --overwrite node %s %s=%s", cc.Name, name, k, want[k])
+			drifts = append(drifts, Drift{Node: config.MachineName(cc, n), Setting: "label " + k, Saved: want[k], Actual: actual, Fix: fix})
+		}
+	}
+	return drifts, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
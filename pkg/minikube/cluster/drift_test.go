@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestCheckConfig(t *testing.T) {
+	cc := config.ClusterConfig{
+		Name:             "p1",
+		Driver:           "qemu2",
+		Memory:           4000,
+		Mount:            true,
+		MountString:      "/home/user:/data",
+		InsecureRegistry: []string{"10.0.0.0/24"},
+		RegistryMirror:   []string{"https://mirror.example.com"},
+		Addons:           map[string]bool{"dashboard": true, "storage-provisioner": true},
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.31.0", ContainerRuntime: "docker"},
+		Nodes:            []config.Node{{Name: "", ControlPlane: true, Worker: true}},
+	}
+	nodes := `{"items": [{"metadata": {"name": "p1", "labels": {"minikube.k8s.io/name": "p1"}}}]}`
+
+	r := command.NewFakeCommandRunner()
+	r.SetCommandToOutput(map[string]string{
+		"grep MemTotal /proc/meminfo":              "MemTotal:        2000000 kB\n",
+		"sudo systemctl cat docker":                "ExecStart=/usr/bin/dockerd --insecure-registry 10.0.0.0/24 \n",
+		"cat /proc/mounts":                         "proc /proc proc rw 0 0\n",
+		"sudo find /etc/kubernetes/addons -type f": "/etc/kubernetes/addons/storage-provisioner.yaml\n",
+		"sudo /var/lib/minikube/binaries/v1.31.0/kubectl --kubeconfig=/var/lib/minikube/kubeconfig get nodes -o json": nodes,
+	})
+
+	got, err := CheckConfig(cc, cc.Nodes[0], r)
+	if err != nil {
+		t.Fatalf("CheckConfig() error: %v", err)
+	}
+	want := []Drift{
+		{Node: "p1", Setting: "memory", Saved: "4000MB", Actual: "1953MB", Fix: "minikube delete -p p1 && minikube start -p p1 --memory=4000M"},
+		{Node: "p1", Setting: "registry-mirror", Saved: "https://mirror.example.com", Actual: "not configured", Fix: "minikube node refresh-runtime-config -p p1"},
+		{Node: "p1", Setting: "mount /data", Saved: "mounted", Actual: "not mounted", Fix: "minikube start -p p1"},
+		{Node: "p1", Setting: "addon dashboard", Saved: "enabled", Actual: "disabled", Fix: "minikube addons enable dashboard -p p1"},
+		{Node: "p1", Setting: "label minikube.k8s.io/primary", Saved: "true", Actual: "not set", Fix: "minikube kubectl -p p1 -- label --overwrite node p1 minikube.k8s.io/primary=true"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CheckConfig() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -18,8 +18,11 @@ package kubevip
 
 import (
 	"bytes"
+	"fmt"
 	"html/template"
+	"net"
 	"os/exec"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -31,12 +34,42 @@ import (
 
 const Manifest = "kube-vip.yaml"
 
+const (
+	// ModeARP announces the virtual IP from the kube-vip leader with ARP
+	ModeARP = "arp"
+	// ModeBGP advertises the virtual IP from the kube-vip leader to BGP peers
+	ModeBGP = "bgp"
+	// ModeHAProxy serves the virtual IP from an haproxy container instead of kube-vip, for the docker and podman drivers only
+	ModeHAProxy = "haproxy"
+)
+
+// Modes are the ways the virtual IP of an HA cluster can be served
+var Modes = []string{ModeARP, ModeBGP, ModeHAProxy}
+
+// bgpAS is the AS of kube-vip in bgp mode
+const bgpAS = "65000"
+
+// Mode returns how the virtual IP of cc is served
+func Mode(cc config.ClusterConfig) string {
+	if cc.KubernetesConfig.HALBMode == "" {
+		return ModeARP
+	}
+	return cc.KubernetesConfig.HALBMode
+}
+
+// Deployed returns whether the virtual IP of cc is served by kube-vip, rather than by haproxy
+func Deployed(cc config.ClusterConfig) bool {
+	return config.IsHA(cc) && Mode(cc) != ModeHAProxy
+}
+
 // KubeVipTemplate is kube-vip static pod config template
 // ref: https://kube-vip.io/docs/installation/static/
 // update: regenerate with:
 //
 //	export KVVERSION=$(curl -sL https://api.github.com/repos/kube-vip/kube-vip/releases | jq -r ".[0].name")
 //	docker run --rm ghcr.io/kube-vip/kube-vip:$KVVERSION manifest pod --interface eth0 --address 192.168.42.17 --controlplane --arp --leaderElection
+//
+// the bgp settings are the ones of: manifest pod --interface lo --controlplane --bgp --bgpRouterInterface eth0 --localAS 65000 --bgppeers ...
 var kubeVipTemplate = template.Must(template.New("kubeletSystemdTemplate").Parse(`apiVersion: v1
 kind: Pod
 metadata:
@@ -49,7 +82,7 @@ spec:
     - manager
     env:
     - name: vip_arp
-      value: "true"
+      value: "{{ .ARP }}"
     - name: port
       value: "{{ .Port }}"
     - name: vip_nodename
@@ -57,7 +90,7 @@ spec:
         fieldRef:
           fieldPath: spec.nodeName
     - name: vip_interface
-      value: eth0
+      value: {{ .Interface }}
     - name: vip_cidr
       value: "32"
     - name: dns_mode
@@ -80,6 +113,16 @@ spec:
       value: {{ .VIP }}
     - name: prometheus_server
       value: :2112
+    {{- if .BGPPeers }}
+    - name: bgp_enable
+      value: "true"
+    - name: bgp_routerinterface
+      value: eth0
+    - name: bgp_as
+      value: "{{ .BGPAS }}"
+    - name: bgp_peers
+      value: "{{ .BGPPeers }}"
+    {{- end}}
     {{- if .EnableLB }}
     - name : lb_enable
       value: "true"
@@ -110,7 +153,7 @@ spec:
 status: {}
 `))
 
-// Configure takes last client ip address in cluster nodes network subnet as vip address and generates kube-vip.yaml file.
+// Configure generates kube-vip.yaml file, that serves the vip address of cc in its mode.
 func Configure(cc config.ClusterConfig, r command.Runner, kubeadmCfg []byte, workaround bool) ([]byte, error) {
 	klog.Info("generating kube-vip config ...")
 
@@ -119,11 +162,24 @@ func Configure(cc config.ClusterConfig, r command.Runner, kubeadmCfg []byte, wor
 		Port      int
 		AdminConf string
 		EnableLB  bool
+		ARP       bool
+		Interface string
+		BGPAS     string
+		BGPPeers  string
 	}{
 		VIP:       cc.KubernetesConfig.APIServerHAVIP,
 		Port:      cc.APIServerPort,
 		AdminConf: "/etc/kubernetes/admin.conf",
 		EnableLB:  enableCPLB(cc, r, kubeadmCfg),
+		ARP:       true,
+		Interface: "eth0",
+	}
+	if Mode(cc) == ModeBGP {
+		// the vip is bound to the loopback interface of the leader, and routed to it by the peers
+		params.ARP = false
+		params.Interface = "lo"
+		params.BGPAS = bgpAS
+		params.BGPPeers = bgpPeers(cc.KubernetesConfig.HABGPPeers)
 	}
 	if workaround {
 		params.AdminConf = "/etc/kubernetes/super-admin.conf"
@@ -139,6 +195,30 @@ func Configure(cc config.ClusterConfig, r command.Runner, kubeadmCfg []byte, wor
 	return b.Bytes(), nil
 }
 
+// ValidateBGPPeer validates the BGP peer p, given as address:AS
+func ValidateBGPPeer(p string) error {
+	addr, as, ok := strings.Cut(p, ":")
+	if !ok {
+		return fmt.Errorf("%q is not address:AS", p)
+	}
+	if ip := net.ParseIP(addr); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("%q is not an IPv4 address", addr)
+	}
+	if _, err := strconv.ParseUint(as, 10, 32); err != nil {
+		return fmt.Errorf("%q is not an AS number", as)
+	}
+	return nil
+}
+
+// bgpPeers returns peers, given as address:AS, in the address:AS:password:multihop format of kube-vip
+func bgpPeers(peers []string) string {
+	var ps []string
+	for _, p := range peers {
+		ps = append(ps, p+"::false")
+	}
+	return strings.Join(ps, ",")
+}
+
 // enableCPLB auto-enables control-plane load-balancing, if possible - currently only possible with ipvs.
 // ref: https://kube-vip.io/docs/about/architecture/?query=ipvs#control-plane-load-balancing
 func enableCPLB(cc config.ClusterConfig, r command.Runner, kubeadmCfg []byte) bool {
@@ -146,7 +226,7 @@ func enableCPLB(cc config.ClusterConfig, r command.Runner, kubeadmCfg []byte) bo
 	// ref: https://kube-vip.io/docs/about/architecture/?query=ipvs#known-issues
 	// so we only want to enable control-plane load-balancing if kube-proxy mode is not set to ipvs
 	// ref: https://kubernetes.io/docs/reference/networking/virtual-ips/#proxy-mode-ipvs
-	if ipvs := strings.EqualFold(string(kubeadmCfg), "mode: ipvs"); ipvs {
+	if ipvs := strings.EqualFold(string(kubeadmCfg), "mode: ipvs") || cc.KubernetesConfig.KubeProxyMode == "ipvs"; ipvs {
 		klog.Info("giving up enabling control-plane load-balancing as kube-proxy mode appears to be set to ipvs")
 		return false
 	}
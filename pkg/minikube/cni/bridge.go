@@ -49,7 +49,11 @@ var bridgeConf = template.Must(template.New("bridge").Parse(`
       "hairpinMode": true,
       "ipam": {
           "type": "host-local",
+{{- if .PodCIDRv6}}
+          "ranges": [[{"subnet": "{{.PodCIDR}}"}], [{"subnet": "{{.PodCIDRv6}}"}]]
+{{- else}}
           "subnet": "{{.PodCIDR}}"
+{{- end}}
       }
     },
     {
@@ -76,7 +80,7 @@ func (c Bridge) String() string {
 }
 
 func (c Bridge) netconf() (assets.CopyableFile, error) {
-	input := &tmplInput{PodCIDR: DefaultPodCIDR}
+	input := &tmplInput{PodCIDR: DefaultPodCIDR, PodCIDRv6: podCIDRv6(c.cc)}
 
 	b := bytes.Buffer{}
 	if err := bridgeConf.Execute(&b, input); err != nil {
@@ -44,6 +44,9 @@ const (
 	// DefaultPodCIDR is the default CIDR to use in minikube CNI's.
 	DefaultPodCIDR = "10.244.0.0/16"
 
+	// DefaultPodCIDRv6 is the CIDR of the IPv6 pod IPs of dual-stack clusters
+	DefaultPodCIDRv6 = "fd00:10:244::/56"
+
 	// DefaultConfDir is the default CNI Config Directory path
 	DefaultConfDir = "/etc/cni/net.d"
 )
@@ -70,6 +73,7 @@ type Manager interface {
 type tmplInput struct {
 	ImageName    string
 	PodCIDR      string
+	PodCIDRv6    string
 	DefaultRoute string
 	CNIConfDir   string
 }
@@ -107,6 +111,33 @@ func New(cc *config.ClusterConfig) (Manager, error) {
 	return cnm, err
 }
 
+// ValidateDualStack returns an error if the CNI of cc does not give the pods IPv6 addresses as well as IPv4 ones
+func ValidateDualStack(cc config.ClusterConfig) error {
+	cnm, err := New(&cc)
+	if err != nil {
+		return err
+	}
+	switch cnm.(type) {
+	case Bridge, KindNet, Custom:
+		return nil
+	case Disabled:
+		// the user installs a CNI of their own
+		if cc.KubernetesConfig.CNI == "false" {
+			return nil
+		}
+		return fmt.Errorf("dual-stack clusters need a CNI, use --cni=bridge or --cni=kindnet")
+	}
+	return fmt.Errorf("%s does not support dual-stack clusters, use --cni=bridge or --cni=kindnet", cnm)
+}
+
+// podCIDRv6 returns the IPv6 pod CIDR of cc, which is only set in dual-stack clusters
+func podCIDRv6(cc config.ClusterConfig) string {
+	if cc.KubernetesConfig.DualStack {
+		return DefaultPodCIDRv6
+	}
+	return ""
+}
+
 // IsDisabled checks if CNI is disabled
 func IsDisabled(cc config.ClusterConfig) bool {
 	if cc.KubernetesConfig.NetworkPlugin != "" && cc.KubernetesConfig.NetworkPlugin != "cni" {
@@ -127,7 +158,7 @@ func chooseDefault(cc config.ClusterConfig) Manager {
 	// For backwards compatibility with older profiles using --enable-default-cni
 	if cc.KubernetesConfig.EnableDefaultCNI {
 		klog.Infof("EnableDefaultCNI is true, recommending bridge")
-		return Bridge{}
+		return Bridge{cc: cc}
 	}
 
 	if len(cc.Nodes) > 1 || cc.MultiNodeRequested {
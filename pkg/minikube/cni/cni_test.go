@@ -52,3 +52,33 @@ func TestChooseDefault(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDualStack(t *testing.T) {
+	tests := []struct {
+		cni     string
+		version string
+		wantErr bool
+	}{
+		{"", "v1.27.0", false},
+		{"bridge", "v1.27.0", false},
+		{"kindnet", "v1.27.0", false},
+		{"false", "v1.27.0", false},
+		{"", "v1.23.0", true},
+		{"calico", "v1.27.0", true},
+		{"flannel", "v1.27.0", true},
+	}
+	for _, tc := range tests {
+		cc := config.ClusterConfig{
+			Driver: "docker",
+			KubernetesConfig: config.KubernetesConfig{
+				ContainerRuntime:  "docker",
+				KubernetesVersion: tc.version,
+				CNI:               tc.cni,
+				DualStack:         true,
+			},
+		}
+		if err := ValidateDualStack(cc); (err != nil) != tc.wantErr {
+			t.Errorf("ValidateDualStack(%q, %s) = %v, want error %v", tc.cni, tc.version, err, tc.wantErr)
+		}
+	}
+}
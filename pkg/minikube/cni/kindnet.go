@@ -113,7 +113,7 @@ spec:
             fieldRef:
               fieldPath: status.podIP
         - name: POD_SUBNET
-          value: {{.PodCIDR}}
+          value: {{.PodCIDR}}{{if .PodCIDRv6}},{{.PodCIDRv6}}{{end}}
         volumeMounts:
         - name: cni-cfg
           mountPath: /etc/cni/net.d
@@ -165,6 +165,7 @@ func (c KindNet) manifest() (assets.CopyableFile, error) {
 	input := &tmplInput{
 		DefaultRoute: "0.0.0.0/0", // assumes IPv4
 		PodCIDR:      DefaultPodCIDR,
+		PodCIDRv6:    podCIDRv6(c.cc),
 		ImageName:    images.KindNet(c.cc.KubernetesConfig.ImageRepository),
 		CNIConfDir:   DefaultConfDir,
 	}
@@ -79,6 +79,7 @@ func (e *execRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 	if exitError, ok := err.(*exec.ExitError); ok {
 		rr.ExitCode = exitError.ExitCode()
 	}
+	RecordTiming("exec", cmd.Args, start, rr.ExitCode)
 	// Decrease log spam
 	if elapsed > (1 * time.Second) {
 		klog.Infof("Completed: %s: (%s)", rr.Command(), elapsed)
@@ -117,6 +117,10 @@ func (k *kicRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 
 	err := oc.Run()
 	elapsed := time.Since(start)
+	if exitError, ok := err.(*exec.ExitError); ok {
+		rr.ExitCode = exitError.ExitCode()
+	}
+	RecordTiming("kic", cmd.Args, start, rr.ExitCode)
 	if err == nil {
 		// Reduce log spam
 		if elapsed > (1 * time.Second) {
@@ -124,9 +128,6 @@ func (k *kicRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 		}
 		return rr, nil
 	}
-	if exitError, ok := err.(*exec.ExitError); ok {
-		rr.ExitCode = exitError.ExitCode()
-	}
 	return rr, fmt.Errorf("%s: %v\nstdout:\n%s\nstderr:\n%s", rr.Command(), err, rr.Stdout.String(), rr.Stderr.String())
 
 }
@@ -48,6 +48,7 @@ var (
 // It implements the CommandRunner interface.
 type SSHRunner struct {
 	d drivers.Driver
+	// c is a connection of the runner alone, once the shared connection to the guest has no session to spare
 	c *ssh.Client
 	s *ssh.Session
 }
@@ -96,7 +97,7 @@ func (s *sshReadableFile) Close() error {
 }
 
 // NewSSHRunner returns a new SSHRunner that will run commands
-// through the ssh.Client provided.
+// over the SSH connection to the guest of the driver, which it shares with the other runners of the guest.
 func NewSSHRunner(d drivers.Driver) *SSHRunner {
 	return &SSHRunner{d: d, c: nil}
 }
@@ -107,12 +108,11 @@ func (s *SSHRunner) client() (*ssh.Client, error) {
 		return s.c, nil
 	}
 
-	c, err := sshutil.NewSSHClient(s.d)
+	c, err := sshutil.SharedSSHClient(s.d)
 	if err != nil {
 		return nil, errors.Wrap(err, "new client")
 	}
-	s.c = c
-	return s.c, nil
+	return c, nil
 }
 
 // session returns an ssh session, retrying if necessary
@@ -125,12 +125,32 @@ func (s *SSHRunner) session() (*ssh.Session, error) {
 		}
 
 		sess, err = client.NewSession()
-		if err != nil {
+		if err == nil {
+			return nil
+		}
+
+		if s.c != nil {
 			klog.Warningf("session error, resetting client: %v", err)
+			s.c.Close()
 			s.c = nil
 			return err
 		}
-		return nil
+
+		// the guest refusing the session means that the shared connection works, but sshd limits the sessions of a
+		// connection (MaxSessions), so it is kept for the other runners. Otherwise it broke, and is dialed again.
+		var refused *ssh.OpenChannelError
+		if errors.As(err, &refused) {
+			klog.Warningf("session refused on the shared connection, dialing another one: %v", err)
+		} else {
+			klog.Warningf("session error on the shared connection, dropping it and dialing another one: %v", err)
+			sshutil.DropSharedClient(client)
+		}
+		c, derr := sshutil.NewSSHClient(s.d)
+		if derr != nil {
+			return errors.Wrap(derr, "new client")
+		}
+		s.c = c
+		return err
 	}
 
 	if err := retry.Expo(getSession, 250*time.Millisecond, 2*time.Second); err != nil {
@@ -230,6 +250,7 @@ func (s *SSHRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 	if exitError, ok := err.(*exec.ExitError); ok {
 		rr.ExitCode = exitError.ExitCode()
 	}
+	RecordTiming("ssh", cmd.Args, start, rr.ExitCode)
 	// Decrease log spam
 	if elapsed > (1 * time.Second) {
 		klog.Infof("Completed: %s: (%s)", rr.Command(), elapsed)
@@ -411,7 +432,17 @@ func (s *SSHRunner) Copy(f assets.CopyableFile) error {
 	} else if mtime != (time.Time{}) {
 		scp += fmt.Sprintf(" && sudo touch -d \"%s\" %s", mtime.Format(layout), dst)
 	}
+	start := time.Now()
 	out, err := sess.CombinedOutput(scp)
+	exitCode := 0
+	if err != nil {
+		exitCode = -1
+		var exitErr *ssh.ExitError
+		if errors.As(err, &exitErr) {
+			exitCode = exitErr.ExitStatus()
+		}
+	}
+	RecordTiming("ssh", []string{"scp"}, start, exitCode)
 	if err != nil {
 		return fmt.Errorf("%s: %s\noutput: %s", scp, err, out)
 	}
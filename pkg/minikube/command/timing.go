@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// maxTimingLogSize is the size at which the timing log is truncated before appending
+const maxTimingLogSize = 4 * 1024 * 1024
+
+// maxPendingTimings is how many timings are kept in memory before they are written, eg: by a long running process
+const maxPendingTimings = 1000
+
+var (
+	// timingMutex serializes the timings of concurrent runners
+	timingMutex = &sync.Mutex{}
+	// pendingTimings are the timings of this process that are not written yet
+	pendingTimings []Timing
+)
+
+// Timing is the duration of a single command executed by a runner
+type Timing struct {
+	Runner   string        `json:"runner"`
+	Key      string        `json:"key"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	ExitCode int           `json:"exitCode"`
+	PID      int           `json:"pid"`
+}
+
+// TimingSummary aggregates the timings of all commands sharing the same key
+type TimingSummary struct {
+	Runner string
+	Key    string
+	Count  int
+	Total  time.Duration
+	Max    time.Duration
+}
+
+// Average returns the mean duration of the summarized commands
+func (ts TimingSummary) Average() time.Duration {
+	if ts.Count == 0 {
+		return 0
+	}
+	return ts.Total / time.Duration(ts.Count)
+}
+
+// RecordTiming records the duration of a command, which FlushTimings writes to the timing log.
+// Only a short key derived from args is stored, so that file contents or credentials never end up in the log.
+func RecordTiming(runner string, args []string, start time.Time, exitCode int) {
+	t := Timing{
+		Runner:   runner,
+		Key:      commandKey(args),
+		Start:    start,
+		Duration: time.Since(start),
+		ExitCode: exitCode,
+		PID:      os.Getpid(),
+	}
+
+	timingMutex.Lock()
+	defer timingMutex.Unlock()
+	pendingTimings = append(pendingTimings, t)
+	if len(pendingTimings) >= maxPendingTimings {
+		flushTimings()
+	}
+}
+
+// FlushTimings appends the timings recorded since the last flush to the timing log, eg: before the process exits
+func FlushTimings() {
+	timingMutex.Lock()
+	defer timingMutex.Unlock()
+	flushTimings()
+}
+
+// flushTimings writes the pending timings, timingMutex must be held
+func flushTimings() {
+	if len(pendingTimings) == 0 {
+		return
+	}
+	if err := appendTimings(localpath.TimingLog(), pendingTimings); err != nil {
+		klog.Warningf("unable to record command timings: %v", err)
+	}
+	pendingTimings = nil
+}
+
+// appendTimings appends ts to the timing log fp, which is truncated first once it grows too large
+func appendTimings(fp string, ts []Timing) error {
+	var b []byte
+	for _, t := range ts {
+		j, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		b = append(append(b, j...), '\n')
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fp), localpath.Perm(0755)); err != nil {
+		return err
+	}
+	if st, err := os.Stat(fp); err == nil && st.Size() > maxTimingLogSize {
+		if err := os.Truncate(fp, 0); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(fp, os.O_APPEND|os.O_CREATE|os.O_WRONLY, localpath.Perm(0644))
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadTimings reads all command timings recorded in the timing log
+func ReadTimings(fp string) ([]Timing, error) {
+	f, err := os.Open(fp)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ts []Timing
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var t Timing
+		if err := json.Unmarshal(s.Bytes(), &t); err != nil {
+			klog.Warningf("skipping malformed timing %q: %v", s.Text(), err)
+			continue
+		}
+		ts = append(ts, t)
+	}
+	return ts, s.Err()
+}
+
+// SummarizeTimings groups timings by runner and key, ordered by total time spent, highest first
+func SummarizeTimings(ts []Timing) []TimingSummary {
+	m := map[string]*TimingSummary{}
+	for _, t := range ts {
+		id := t.Runner + "\x00" + t.Key
+		s, ok := m[id]
+		if !ok {
+			s = &TimingSummary{Runner: t.Runner, Key: t.Key}
+			m[id] = s
+		}
+		s.Count++
+		s.Total += t.Duration
+		if t.Duration > s.Max {
+			s.Max = t.Duration
+		}
+	}
+
+	sums := []TimingSummary{}
+	for _, s := range m {
+		sums = append(sums, *s)
+	}
+	sort.Slice(sums, func(i, j int) bool {
+		if sums[i].Total != sums[j].Total {
+			return sums[i].Total > sums[j].Total
+		}
+		return sums[i].Key < sums[j].Key
+	})
+	return sums
+}
+
+// commandKey reduces a command line to its program and subcommand, eg: "systemctl restart"
+func commandKey(args []string) string {
+	for len(args) > 0 {
+		a := args[0]
+		switch {
+		case a == "sudo" || a == "env" || strings.HasPrefix(a, "-") || strings.Contains(a, "="):
+			args = args[1:]
+			continue
+		case (path.Base(a) == "bash" || path.Base(a) == "sh") && len(args) > 2 && args[1] == "-c":
+			return commandKey(strings.Fields(args[2]))
+		}
+
+		key := path.Base(a)
+		for _, sub := range args[1:] {
+			if strings.HasPrefix(sub, "-") {
+				continue
+			}
+			if isWord(sub) {
+				key += " " + sub
+			}
+			break
+		}
+		return key
+	}
+	return ""
+}
+
+// isWord returns whether s looks like a subcommand rather than a path or value
+func isWord(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && r != '-' {
+			return false
+		}
+	}
+	return s != ""
+}
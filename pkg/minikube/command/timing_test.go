@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCommandKey(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"sudo", "systemctl", "restart", "docker"}, "systemctl restart"},
+		{[]string{"sudo", "-E", "systemctl", "is-active", "--quiet", "service", "kubelet"}, "systemctl is-active"},
+		{[]string{"/bin/bash", "-c", "sudo env PATH=/usr/bin crictl images --output json"}, "crictl images"},
+		{[]string{"sudo", "/var/lib/minikube/binaries/v1.30.0/kubectl", "apply", "-f", "/etc/x.yaml"}, "kubectl apply"},
+		{[]string{"cat", "/etc/os-release"}, "cat"},
+		{[]string{"scp"}, "scp"},
+		{[]string{"sudo"}, ""},
+	}
+	for _, tc := range tests {
+		got := commandKey(tc.args)
+		if got != tc.want {
+			t.Errorf("commandKey(%q) = %q, want %q", tc.args, got, tc.want)
+		}
+	}
+}
+
+func TestTimingLog(t *testing.T) {
+	fp := filepath.Join(t.TempDir(), "logs", "timings.json")
+	ts := []Timing{
+		{Runner: "ssh", Key: "systemctl restart", Duration: 3 * time.Second},
+		{Runner: "ssh", Key: "cat", Duration: 10 * time.Millisecond},
+		{Runner: "ssh", Key: "systemctl restart", Duration: 1 * time.Second},
+		{Runner: "kic", Key: "cat", Duration: 20 * time.Millisecond},
+	}
+	if err := appendTimings(fp, ts[:1]); err != nil {
+		t.Fatalf("appendTimings() error: %v", err)
+	}
+	if err := appendTimings(fp, ts[1:]); err != nil {
+		t.Fatalf("appendTimings() error: %v", err)
+	}
+
+	got, err := ReadTimings(fp)
+	if err != nil {
+		t.Fatalf("ReadTimings() error: %v", err)
+	}
+	if len(got) != len(ts) {
+		t.Fatalf("ReadTimings() returned %d timings, want %d", len(got), len(ts))
+	}
+
+	sums := SummarizeTimings(got)
+	if len(sums) != 3 {
+		t.Fatalf("SummarizeTimings() returned %d summaries, want 3: %+v", len(sums), sums)
+	}
+	first := sums[0]
+	if first.Key != "systemctl restart" || first.Count != 2 || first.Total != 4*time.Second || first.Max != 3*time.Second {
+		t.Errorf("SummarizeTimings()[0] = %+v, want systemctl restart with 2 runs totalling 4s", first)
+	}
+	if first.Average() != 2*time.Second {
+		t.Errorf("Average() = %s, want 2s", first.Average())
+	}
+}
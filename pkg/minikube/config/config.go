@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -48,6 +50,8 @@ const (
 	SkipAuditFlag = "skip-audit"
 	// Rootless is the key for the global rootless parameter (boolean)
 	Rootless = "rootless"
+	// CI is the key for the global ci parameter, which optimizes the runs of CI pipelines (boolean)
+	CI = "ci"
 	// AddonImages stores custom addon images config
 	AddonImages = "addon-images"
 	// AddonRegistries stores custom addon images config
@@ -58,8 +62,38 @@ const (
 	EmbedCerts = "EmbedCerts"
 	// MaxAuditEntries is the maximum number of audit entries to retain
 	MaxAuditEntries = "MaxAuditEntries"
+	// KeepContext is the key for never switching the current kubectl context, for every profile
+	KeepContext = "keep-context"
+	// WarmNodes is the key for the number of booted guests kept ready to join each cluster, by 'minikube node add'
+	WarmNodes = "warm-nodes"
+	// ProvisionAttempts is the key for the number of times a node is tried to be added, when it fails in a phase of ProvisionRetryPhases
+	ProvisionAttempts = "provision-attempts"
+	// ProvisionBackoff is the key for the wait before a node is tried to be added again, doubled after each attempt
+	ProvisionBackoff = "provision-backoff"
+	// ProvisionRetryPhases is the key for the comma separated phases of adding a node whose failures are tried again, among RetryPhases
+	ProvisionRetryPhases = "provision-retry-phases"
 )
 
+// RetryPhases are the phases of adding a node whose failures can be tried again:
+// downloading its Kubernetes binaries, creating its machine, and joining it to the cluster
+var RetryPhases = []string{"download", "create", "join"}
+
+// ParseRetryPhases parses the comma separated phases s of RetryPhases, eg: create,join
+func ParseRetryPhases(s string) ([]string, error) {
+	var phases []string
+	for _, ph := range strings.Split(s, ",") {
+		ph = strings.TrimSpace(ph)
+		if ph == "" {
+			continue
+		}
+		if !slices.Contains(RetryPhases, ph) {
+			return nil, errors.Errorf("unknown phase %q, valid phases are: %s", ph, strings.Join(RetryPhases, ", "))
+		}
+		phases = append(phases, ph)
+	}
+	return phases, nil
+}
+
 var (
 	// ErrKeyNotFound is the error returned when a key doesn't exist in the config file
 	ErrKeyNotFound = errors.New("specified key could not be found in config")
@@ -227,16 +261,17 @@ func (c *simpleConfigLoader) LoadConfigFromFile(profileName string, miniHome ...
 	if err := json.Unmarshal(data, &cc); err != nil {
 		return nil, errors.Wrap(err, "unmarshal")
 	}
+	unsealSecrets(&cc)
 	return &cc, nil
 }
 
 func (c *simpleConfigLoader) WriteConfigToFile(profileName string, cc *ClusterConfig, miniHome ...string) error {
 	path := profileFilePath(profileName, miniHome...)
-	contents, err := json.MarshalIndent(cc, "", "	")
+	contents, err := json.MarshalIndent(sealSecrets(cc), "", "	")
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(path, contents, 0644)
+	return os.WriteFile(path, contents, localpath.Perm(0644))
 }
 
 // MultiNode returns true if the cluster has multiple nodes or if the request is asking for multinode
@@ -247,6 +282,11 @@ func MultiNode(cc ClusterConfig) bool {
 	return viper.GetInt("nodes") > 1
 }
 
+// IsExternalEtcd returns whether the API server of cc uses an external etcd cluster, rather than the etcd static pods of its control-plane nodes
+func IsExternalEtcd(cc ClusterConfig) bool {
+	return len(cc.ExternalEtcd.Endpoints) > 0
+}
+
 // IsHA returns true if ha (multi-control plane) cluster is requested.
 func IsHA(cc ClusterConfig) bool {
 	if len(ControlPlanes(cc)) > 1 {
@@ -241,3 +241,27 @@ func TestMachineName(t *testing.T) {
 		}
 	}
 }
+
+func TestParseRetryPhases(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+		err  bool
+	}{
+		{"", nil, false},
+		{"download,create,join", []string{"download", "create", "join"}, false},
+		{" join, create ", []string{"join", "create"}, false},
+		{"create,boot", nil, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.s, func(t *testing.T) {
+			got, err := ParseRetryPhases(tc.s)
+			if (err != nil) != tc.err {
+				t.Fatalf("ParseRetryPhases(%q) = %v", tc.s, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ParseRetryPhases(%q) = %v, want %v", tc.s, got, tc.want)
+			}
+		})
+	}
+}
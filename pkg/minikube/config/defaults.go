@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Defaults represents the global defaults file, eg:
+//
+//	driver: docker
+//	memory: 4g
+//	addons: [metrics-server]
+//	profiles:
+//	  ci:
+//	    memory: 2g
+//	presets:
+//	  big:
+//	    cpus: 8
+//	    memory: 16g
+type Defaults struct {
+	// Settings are inherited by every new profile
+	Settings MinikubeConfig `yaml:",inline"`
+	// Profiles holds per-profile overrides of Settings
+	Profiles map[string]MinikubeConfig `yaml:"profiles,omitempty"`
+	// Presets holds user-defined flag bundles, selected with `minikube start --preset`
+	Presets map[string]MinikubeConfig `yaml:"presets,omitempty"`
+}
+
+// ReadDefaults reads the global defaults file. A missing file is not an error.
+func ReadDefaults(path string) (*Defaults, error) {
+	d := &Defaults{}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return d, nil
+		}
+		return nil, errors.Wrap(err, "read")
+	}
+	if err := yaml.Unmarshal(b, d); err != nil {
+		return nil, errors.Wrapf(err, "parse %s", path)
+	}
+	return d, nil
+}
+
+// ForProfile returns the settings inherited by a profile, with the profile's own overrides applied
+func (d *Defaults) ForProfile(name string) MinikubeConfig {
+	m := MinikubeConfig{}
+	for k, v := range d.Settings {
+		m[k] = v
+	}
+	for k, v := range d.Profiles[name] {
+		m[k] = v
+	}
+	return m
+}
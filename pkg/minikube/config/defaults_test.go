@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadDefaults(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := ReadDefaults(filepath.Join(dir, "missing.yaml"))
+	if err != nil {
+		t.Fatalf("ReadDefaults() of a missing file returned error: %v", err)
+	}
+	if len(d.ForProfile("minikube")) != 0 {
+		t.Errorf("ForProfile() of a missing file = %v, want empty", d.ForProfile("minikube"))
+	}
+
+	data := `driver: docker
+memory: 4g
+addons:
+- metrics-server
+profiles:
+  ci:
+    memory: 2g
+    cpus: 2
+presets:
+  big:
+    cpus: 8
+`
+	path := filepath.Join(dir, "defaults.yaml")
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	d, err = ReadDefaults(path)
+	if err != nil {
+		t.Fatalf("ReadDefaults() error: %v", err)
+	}
+
+	tests := []struct {
+		profile string
+		want    MinikubeConfig
+	}{
+		{"minikube", MinikubeConfig{"driver": "docker", "memory": "4g", "addons": []interface{}{"metrics-server"}}},
+		{"ci", MinikubeConfig{"driver": "docker", "memory": "2g", "cpus": 2, "addons": []interface{}{"metrics-server"}}},
+	}
+	for _, tc := range tests {
+		got := d.ForProfile(tc.profile)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ForProfile(%q) = %v, want %v", tc.profile, got, tc.want)
+		}
+	}
+
+	if want := (MinikubeConfig{"cpus": 8}); !reflect.DeepEqual(d.Presets["big"], want) {
+		t.Errorf("Presets[big] = %v, want %v", d.Presets["big"], want)
+	}
+
+	if err := os.WriteFile(path, []byte("memory: [unterminated"), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := ReadDefaults(path); err == nil {
+		t.Errorf("ReadDefaults() of malformed file returned no error")
+	}
+}
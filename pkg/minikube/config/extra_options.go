@@ -18,19 +18,65 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"k8s.io/klog/v2"
 )
 
+// nodeOptionPrefix scopes an extra option to a single node, as node:NAME:component.key=value
+const nodeOptionPrefix = "node:"
+
+var (
+	// envEntry matches the start of a KEY=VALUE entry of docker-env
+	envEntry = regexp.MustCompile(`^\s*[A-Za-z_][A-Za-z0-9_]*=`)
+	// extraOptionEntry matches the start of a [node:NAME:]component.key=value entry of extra-config
+	extraOptionEntry = regexp.MustCompile(`^\s*(node:[^:,]+:)?[a-z-]+\.[A-Za-z0-9_.-]+=`)
+)
+
+// SplitDockerEnv splits a comma separated docker-env value, keeping the commas inside the values
+func SplitDockerEnv(val string) []string {
+	return splitEntries(val, envEntry)
+}
+
+// SplitExtraOptions splits a comma separated extra-config value, keeping the commas inside the values
+func SplitExtraOptions(val string) []string {
+	return splitEntries(val, extraOptionEntry)
+}
+
+// splitEntries splits a comma separated config value on the commas that are followed by the start of an entry.
+// The other commas are part of the values, eg: NO_PROXY=a,b or kubelet.eviction-hard=memory.available<5%,nodefs.available<10%
+func splitEntries(val string, start *regexp.Regexp) []string {
+	var l []string
+	for _, v := range strings.Split(val, ",") {
+		switch {
+		case strings.TrimSpace(v) == "":
+			continue
+		case len(l) > 0 && !start.MatchString(v):
+			l[len(l)-1] += "," + v
+		default:
+			l = append(l, v)
+		}
+	}
+	for i := range l {
+		l[i] = strings.TrimSpace(l[i])
+	}
+	return l
+}
+
 // ExtraOption is an extra option
 type ExtraOption struct {
 	Component string
 	Key       string
 	Value     string
+	// Node is the name of the only node the option applies to, given with the node:NAME: prefix
+	Node string `json:",omitempty"`
 }
 
 func (e *ExtraOption) String() string {
+	if e.Node != "" {
+		return fmt.Sprintf("%s%s:%s.%s=%s", nodeOptionPrefix, e.Node, e.Component, e.Key, e.Value)
+	}
 	return fmt.Sprintf("%s.%s=%s", e.Component, e.Key, e.Value)
 }
 
@@ -56,7 +102,7 @@ func (es *ExtraOptionSlice) Exists(value string) bool {
 	}
 
 	for _, opt := range *es {
-		if opt.Component == componentSplit[0] && opt.Key == keySplit[0] {
+		if opt.Node == "" && opt.Component == componentSplit[0] && opt.Key == keySplit[0] {
 			return true
 		}
 	}
@@ -73,8 +119,18 @@ func (es *ExtraOptionSlice) Set(value string) error {
 		return fmt.Errorf("invalid value: extra-config cannot contain end quotation: %q", value)
 	}
 
+	opt := value
+	node := ""
+	if rest, ok := strings.CutPrefix(value, nodeOptionPrefix); ok {
+		name, o, ok := strings.Cut(rest, ":")
+		if !ok || name == "" {
+			return fmt.Errorf("invalid value: must be node:NAME:component.key=value: %q", value)
+		}
+		node, opt = name, o
+	}
+
 	// The component is the value before the first dot.
-	componentSplit := strings.SplitN(value, ".", 2)
+	componentSplit := strings.SplitN(opt, ".", 2)
 	if len(componentSplit) < 2 {
 		return fmt.Errorf("invalid value: must contain at least one period: %q", value)
 	}
@@ -90,11 +146,64 @@ func (es *ExtraOptionSlice) Set(value string) error {
 		Component: componentSplit[0],
 		Key:       keySplit[0],
 		Value:     keySplit[1],
+		Node:      node,
 	}
 	*es = append(*es, e)
 	return nil
 }
 
+// ForCluster returns the options of es that apply to all the nodes
+func (es *ExtraOptionSlice) ForCluster() ExtraOptionSlice {
+	var ret ExtraOptionSlice
+	for _, opt := range *es {
+		if opt.Node == "" {
+			ret = append(ret, opt)
+		}
+	}
+	return ret
+}
+
+// ForNode returns the options of es scoped to the node with any of names, without their scope
+func (es *ExtraOptionSlice) ForNode(names ...string) ExtraOptionSlice {
+	var ret ExtraOptionSlice
+	for _, opt := range *es {
+		if opt.Node != "" && ContainsParam(names, opt.Node) {
+			opt.Node = ""
+			ret = append(ret, opt)
+		}
+	}
+	return ret
+}
+
+// Nodes returns the names of the nodes that options of es are scoped to
+func (es *ExtraOptionSlice) Nodes() []string {
+	var names []string
+	for _, opt := range *es {
+		if opt.Node != "" && !ContainsParam(names, opt.Node) {
+			names = append(names, opt.Node)
+		}
+	}
+	return names
+}
+
+// Merge returns es with the options of other, which replace those of es with the same component and key
+func (es *ExtraOptionSlice) Merge(other ExtraOptionSlice) ExtraOptionSlice {
+	var ret ExtraOptionSlice
+	for _, opt := range *es {
+		replaced := false
+		for _, o := range other {
+			if o.Component == opt.Component && o.Key == opt.Key {
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			ret = append(ret, opt)
+		}
+	}
+	return append(ret, other...)
+}
+
 // String converts the slice to a string value
 func (es *ExtraOptionSlice) String() string {
 	s := []string{}
@@ -35,6 +35,10 @@ func TestInvalidFlags(t *testing.T) {
 		{"-e", "foo", "-e", "foo", "-e", "foo"},
 		{"-e", "foo", "-e", "foo.bar=baz"},
 		{"-e", "foo", "-e", "foo.bar=baz"},
+		// Options of a single node
+		{"-e", "node:kubelet.foo=bar"},
+		{"-e", "node::kubelet.foo=bar"},
+		{"-e", "node:m02:kubelet"},
 	} {
 		var flags flag.FlagSet
 		flags.Init("test", flag.ContinueOnError)
@@ -64,6 +68,10 @@ func TestValidFlags(t *testing.T) {
 			[]string{"-e", "foo.bar=baz", "-e", "foo.bar.baz=bat"},
 			ExtraOptionSlice{ExtraOption{Component: "foo", Key: "bar", Value: "baz"}, ExtraOption{Component: "foo", Key: "bar.baz", Value: "bat"}},
 		},
+		{
+			[]string{"-e", "node:m02:foo.bar=baz:bat"},
+			ExtraOptionSlice{ExtraOption{Component: "foo", Key: "bar", Value: "baz:bat", Node: "m02"}},
+		},
 	} {
 		var flags flag.FlagSet
 		flags.Init("test", flag.ContinueOnError)
@@ -207,3 +215,40 @@ func TestAsMap(t *testing.T) {
 		t.Errorf("Unexpected value. Expected %s, got %s", expectedRes, res)
 	}
 }
+
+func TestNodeExtraOptions(t *testing.T) {
+	extraOptions := ExtraOptionSlice{
+		ExtraOption{Component: "kubelet", Key: "max-pods", Value: "110"},
+		ExtraOption{Component: "kubelet", Key: "max-pods", Value: "50", Node: "m02"},
+		ExtraOption{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<5%", Node: "minikube-m03"},
+	}
+
+	if res := extraOptions.Exists("kubelet.eviction-hard=memory.available<10%"); res {
+		t.Errorf("Exists() found an option of a single node")
+	}
+	if res, exp := extraOptions.ForCluster(), extraOptions[:1]; !reflect.DeepEqual(res, exp) {
+		t.Errorf("ForCluster() = %v, expected %v", res, exp)
+	}
+	if res, exp := extraOptions.ForNode("m03", "minikube-m03"), (ExtraOptionSlice{{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<5%"}}); !reflect.DeepEqual(res, exp) {
+		t.Errorf("ForNode() = %v, expected %v", res, exp)
+	}
+	if res, exp := extraOptions.Nodes(), []string{"m02", "minikube-m03"}; !reflect.DeepEqual(res, exp) {
+		t.Errorf("Nodes() = %v, expected %v", res, exp)
+	}
+	if res, exp := extraOptions[1].String(), "node:m02:kubelet.max-pods=50"; res != exp {
+		t.Errorf("String() = %q, expected %q", res, exp)
+	}
+
+	nodeOptions := ExtraOptionSlice{
+		ExtraOption{Component: "kubelet", Key: "max-pods", Value: "20"},
+		ExtraOption{Component: "kubelet", Key: "v", Value: "5"},
+	}
+	merged := nodeOptions.Merge(extraOptions.ForNode("m02"))
+	exp := ExtraOptionSlice{
+		ExtraOption{Component: "kubelet", Key: "v", Value: "5"},
+		ExtraOption{Component: "kubelet", Key: "max-pods", Value: "50"},
+	}
+	if !reflect.DeepEqual(merged, exp) {
+		t.Errorf("Merge() = %v, expected %v", merged, exp)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/juju/mutex/v2"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// ErrProfileLocked is returned when another minikube process holds the lock of a profile
+var ErrProfileLocked = errors.New("profile is locked by another minikube process")
+
+// LockHolder describes the minikube process holding the lock of a profile
+type LockHolder struct {
+	PID     int
+	Command string
+	Since   time.Time
+}
+
+// profileLock releases the lock of a profile along with its holder file
+type profileLock struct {
+	name     string
+	releaser mutex.Releaser
+}
+
+// Release removes the holder file and releases the lock
+func (l *profileLock) Release() {
+	if err := os.Remove(localpath.ProfileLock(l.name)); err != nil && !os.IsNotExist(err) {
+		klog.Warningf("unable to remove lock holder of %q: %v", l.name, err)
+	}
+	l.releaser.Release()
+}
+
+// LockProfile acquires the advisory lock of a profile, which must be held by commands that change it, such as start, node add and delete.
+// It waits up to timeout for other minikube processes to release the lock; a timeout of 0 fails immediately if the profile is locked.
+func LockProfile(name string, timeout time.Duration) (mutex.Releaser, error) {
+	spec := lock.PathMutexSpec(localpath.ProfileLock(name))
+	// the mutex waits forever with a zero timeout, so only give an uncontended lock time to be taken
+	spec.Timeout = 100 * time.Millisecond
+	if timeout > 0 {
+		spec.Timeout = timeout
+	}
+
+	klog.Infof("acquiring lock of profile %q: %+v", name, spec)
+	start := time.Now()
+	r, err := lock.Acquire(spec)
+	if err == mutex.ErrTimeout {
+		return nil, errors.Wrap(ErrProfileLocked, name)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "acquire lock of %q", name)
+	}
+	klog.Infof("duration metric: took %s to acquire lock of profile %q", time.Since(start), name)
+
+	if err := writeLockHolder(name); err != nil {
+		klog.Warningf("unable to record lock holder of %q: %v", name, err)
+	}
+	return &profileLock{name: name, releaser: r}, nil
+}
+
+// ProfileLockHolder returns the minikube process holding the lock of a profile, or nil if unknown
+func ProfileLockHolder(name string) *LockHolder {
+	b, err := os.ReadFile(localpath.ProfileLock(name))
+	if err != nil {
+		return nil
+	}
+	h := &LockHolder{}
+	if err := json.Unmarshal(b, h); err != nil {
+		klog.Warningf("unable to parse lock holder of %q: %v", name, err)
+		return nil
+	}
+	return h
+}
+
+func writeLockHolder(name string) error {
+	p := localpath.ProfileLock(name)
+	if err := os.MkdirAll(filepath.Dir(p), localpath.Perm(0755)); err != nil {
+		return err
+	}
+	h := LockHolder{PID: os.Getpid(), Command: strings.Join(os.Args, " "), Since: time.Now()}
+	b, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, b, localpath.Perm(0644))
+}
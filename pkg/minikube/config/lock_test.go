@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestLockProfile(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+
+	r, err := LockProfile("p1", 0)
+	if err != nil {
+		t.Fatalf("LockProfile() error: %v", err)
+	}
+	h := ProfileLockHolder("p1")
+	if h == nil || h.PID != os.Getpid() {
+		t.Errorf("ProfileLockHolder() = %+v, want pid %d", h, os.Getpid())
+	}
+
+	if _, err := LockProfile("p1", 0); !errors.Is(err, ErrProfileLocked) {
+		t.Errorf("LockProfile() of a locked profile error = %v, want ErrProfileLocked", err)
+	}
+
+	// other profiles are not affected
+	other, err := LockProfile("p2", 0)
+	if err != nil {
+		t.Fatalf("LockProfile() of another profile error: %v", err)
+	}
+	other.Release()
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		r.Release()
+	}()
+	r, err = LockProfile("p1", 10*time.Second)
+	if err != nil {
+		t.Fatalf("LockProfile() waiting for release error: %v", err)
+	}
+	r.Release()
+	if h := ProfileLockHolder("p1"); h != nil {
+		t.Errorf("ProfileLockHolder() after release = %+v, want nil", h)
+	}
+}
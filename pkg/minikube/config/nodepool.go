@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// NodePoolLabel is the label of the nodes of a node pool, whose value is the name of the pool, eg: for nodeSelector
+const NodePoolLabel = "minikube.k8s.io/nodepool"
+
+// NodePool is a group of identical worker nodes, which are created, scaled and deleted together
+type NodePool struct {
+	Name string
+	// OS is the operating system of the nodes, only linux is supported
+	OS string
+	// CPUs, Memory and DiskSize are the resources of the nodes, the cluster's if unset. Memory and DiskSize are in MB.
+	CPUs     int `json:",omitempty"`
+	Memory   int `json:",omitempty"`
+	DiskSize int `json:",omitempty"`
+	// Labels and Taints are applied to the nodes, in addition to NodePoolLabel and the minikube ones
+	Labels map[string]string `json:",omitempty"`
+	Taints []string          `json:",omitempty"`
+}
+
+// Validate returns an error if the nodes of the pool cannot be created
+func (p NodePool) Validate() error {
+	if errs := validation.IsDNS1123Label(p.Name); len(errs) != 0 {
+		return fmt.Errorf("invalid node pool name %q: %s", p.Name, strings.Join(errs, "; "))
+	}
+	if p.OS != "linux" {
+		return fmt.Errorf("%s nodes are not supported, only linux nodes are", p.OS)
+	}
+	if p.CPUs < 0 || p.Memory < 0 || p.DiskSize < 0 {
+		return errors.New("cpus, memory and disk size cannot be negative")
+	}
+	if _, ok := p.Labels[NodePoolLabel]; ok {
+		return fmt.Errorf("the %s label is set by minikube", NodePoolLabel)
+	}
+	if err := ValidateLabels(p.Labels); err != nil {
+		return err
+	}
+	return ValidateTaints(p.Taints)
+}
+
+// Node returns a node of the pool, without its name
+func (p NodePool) Node() Node {
+	labels := map[string]string{NodePoolLabel: p.Name}
+	for k, v := range p.Labels {
+		labels[k] = v
+	}
+	return Node{
+		Worker:   true,
+		CPUs:     p.CPUs,
+		Memory:   p.Memory,
+		DiskSize: p.DiskSize,
+		Labels:   labels,
+		Taints:   p.Taints,
+		NodePool: p.Name,
+	}
+}
+
+// FindNodePool returns the node pool name of cc, or nil if there is none
+func FindNodePool(cc ClusterConfig, name string) *NodePool {
+	for i := range cc.NodePools {
+		if cc.NodePools[i].Name == name {
+			return &cc.NodePools[i]
+		}
+	}
+	return nil
+}
+
+// NodePoolNodes returns the nodes of the node pool name of cc, in the order they were added
+func NodePoolNodes(cc ClusterConfig, name string) []Node {
+	var ns []Node
+	for _, n := range cc.Nodes {
+		if n.NodePool == name {
+			ns = append(ns, n)
+		}
+	}
+	sort.Slice(ns, func(i, j int) bool {
+		// m99 < m100
+		if len(ns[i].Name) != len(ns[j].Name) {
+			return len(ns[i].Name) < len(ns[j].Name)
+		}
+		return ns[i].Name < ns[j].Name
+	})
+	return ns
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNodePoolValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		pool        NodePool
+		err         string
+	}{
+		{"valid", NodePool{Name: "gpu", OS: "linux", Memory: 8192, Labels: map[string]string{"accelerator": "gpu"}, Taints: []string{"dedicated=gpu:NoSchedule"}}, ""},
+		{"invalid name", NodePool{Name: "GPU", OS: "linux"}, "invalid node pool name"},
+		{"windows", NodePool{Name: "win", OS: "windows"}, "windows nodes are not supported"},
+		{"negative", NodePool{Name: "gpu", OS: "linux", CPUs: -1}, "cannot be negative"},
+		{"pool label", NodePool{Name: "gpu", OS: "linux", Labels: map[string]string{NodePoolLabel: "other"}}, "set by minikube"},
+		{"invalid taint", NodePool{Name: "gpu", OS: "linux", Taints: []string{"dedicated"}}, "invalid taint"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.pool.Validate()
+			if tc.err == "" && err != nil || tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Errorf("Validate() = %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestNodePoolNodes(t *testing.T) {
+	p := NodePool{Name: "gpu", OS: "linux", CPUs: 4, Labels: map[string]string{"accelerator": "gpu"}}
+	n := p.Node()
+	want := Node{Worker: true, CPUs: 4, NodePool: "gpu", Labels: map[string]string{NodePoolLabel: "gpu", "accelerator": "gpu"}}
+	if !reflect.DeepEqual(n, want) {
+		t.Errorf("Node() = %+v, want %+v", n, want)
+	}
+
+	cc := ClusterConfig{Nodes: []Node{{ControlPlane: true}, {Name: "m100", NodePool: "gpu"}, {Name: "m02"}, {Name: "m99", NodePool: "gpu"}}}
+	var names []string
+	for _, n := range NodePoolNodes(cc, "gpu") {
+		names = append(names, n.Name)
+	}
+	if got := strings.Join(names, ","); got != "m99,m100" {
+		t.Errorf("NodePoolNodes() = %s, want m99,m100", got)
+	}
+	if FindNodePool(cc, "gpu") != nil {
+		t.Errorf("FindNodePool() found a pool that does not exist")
+	}
+}
@@ -19,6 +19,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -27,12 +28,14 @@ import (
 	"github.com/spf13/viper"
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/detect"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/util/lock"
 )
 
-var keywords = []string{"start", "stop", "status", "delete", "config", "open", "profile", "addons", "cache", "logs"}
+var keywords = []string{"start", "stop", "status", "delete", "config", "open", "profile", "addons", "cache", "logs", "export", "import", "rename"}
 
 // ControlPlane returns the first available control-plane node or error, if none found.
 func ControlPlane(cc ClusterConfig) (Node, error) {
@@ -54,6 +57,23 @@ func ControlPlanes(cc ClusterConfig) []Node {
 	return cps
 }
 
+// OIDCIssuerURL returns the URL of the OIDC issuer of cc, or "" if users are not authenticated with OIDC
+func OIDCIssuerURL(cc ClusterConfig) string {
+	if cc.OIDC.IssuerURL == constants.DexIssuer {
+		return DexIssuerURL(cc)
+	}
+	return cc.OIDC.IssuerURL
+}
+
+// DexIssuerURL returns the URL of the issuer of the dex addon, which is served on the primary control-plane node
+func DexIssuerURL(cc ClusterConfig) string {
+	ip := ""
+	if len(cc.Nodes) > 0 {
+		ip = cc.Nodes[0].IP
+	}
+	return fmt.Sprintf("https://%s/dex", net.JoinHostPort(ip, fmt.Sprint(constants.DexNodePort)))
+}
+
 // IsPrimaryControlPlane returns if node is primary control-plane node.
 func IsPrimaryControlPlane(cc ClusterConfig, node Node) bool {
 	// TODO (prezha): find where, for "none" driver, we set first (ie, primary control-plane) node name to "m01" - that should not happen but it's happening before pr #17909
@@ -135,19 +155,19 @@ func SaveNode(cfg *ClusterConfig, node *Node) error {
 
 // SaveProfile creates an profile out of the cfg and stores in $MINIKUBE_HOME/profiles/<profilename>/config.json
 func SaveProfile(name string, cfg *ClusterConfig, miniHome ...string) error {
-	data, err := json.MarshalIndent(cfg, "", "    ")
+	data, err := json.MarshalIndent(sealSecrets(cfg), "", "    ")
 	if err != nil {
 		return err
 	}
 	path := profileFilePath(name, miniHome...)
 	klog.Infof("Saving config to %s ...", path)
-	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+	if err := os.MkdirAll(filepath.Dir(path), localpath.Perm(0700)); err != nil {
 		return err
 	}
 
 	// If no config file exists, don't worry about swapping paths
 	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return lock.WriteFile(path, data, 0600)
+		return lock.WriteFile(path, data, localpath.Perm(0600))
 	}
 
 	tf, err := os.CreateTemp(filepath.Dir(path), "config.json.tmp")
@@ -156,7 +176,7 @@ func SaveProfile(name string, cfg *ClusterConfig, miniHome ...string) error {
 	}
 	defer os.Remove(tf.Name())
 
-	if err = os.WriteFile(tf.Name(), data, 0600); err != nil {
+	if err = os.WriteFile(tf.Name(), data, localpath.Perm(0600)); err != nil {
 		return err
 	}
 
@@ -330,6 +350,34 @@ func ProfileFolderPath(profile string, miniHome ...string) string {
 	return filepath.Join(miniPath, "profiles", profile)
 }
 
+// KeepsContext returns whether minikube must leave the current kubectl context alone for cc,
+// because of either its own keep-context setting or the global one
+func KeepsContext(cc ClusterConfig) bool {
+	return cc.KeepContext || viper.GetBool(KeepContext)
+}
+
+// NodeResources returns cc with the resources of node n, eg: the memory and CPUs its machine is created with
+func NodeResources(cc ClusterConfig, n Node) ClusterConfig {
+	if n.Memory != 0 {
+		cc.Memory = n.Memory
+	}
+	if n.CPUs != 0 {
+		cc.CPUs = n.CPUs
+	}
+	if n.DiskSize != 0 {
+		cc.DiskSize = n.DiskSize
+	}
+	return cc
+}
+
+// NodeArch returns the CPU architecture of node n, eg: the one its Kubernetes binaries are downloaded for
+func NodeArch(n Node) string {
+	if n.Arch != "" {
+		return n.Arch
+	}
+	return detect.EffectiveArch()
+}
+
 // MachineName returns the name of the machine, as seen by the hypervisor given the cluster and node names
 func MachineName(cc ClusterConfig, n Node) string {
 	// For single node cluster, default to back to old naming
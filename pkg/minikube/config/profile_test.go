@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/spf13/viper"
+
+	"k8s.io/minikube/pkg/minikube/detect"
 )
 
 // TestListProfiles uses a different MINIKUBE_HOME with rest of tests since it relies on file list index
@@ -309,3 +311,52 @@ func TestGetPrimaryControlPlane(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeResources(t *testing.T) {
+	cc := ClusterConfig{Memory: 2200, CPUs: 2, DiskSize: 20000}
+	tests := []struct {
+		description string
+		n           Node
+		want        ClusterConfig
+	}{
+		{"cluster's", Node{Name: "m02"}, cc},
+		{"node's", Node{Name: "m02", Memory: 8192, CPUs: 4, DiskSize: 40000}, ClusterConfig{Memory: 8192, CPUs: 4, DiskSize: 40000}},
+		{"memory only", Node{Name: "m02", Memory: 8192}, ClusterConfig{Memory: 8192, CPUs: 2, DiskSize: 20000}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got := NodeResources(cc, tc.n)
+			if got.Memory != tc.want.Memory || got.CPUs != tc.want.CPUs || got.DiskSize != tc.want.DiskSize {
+				t.Errorf("NodeResources() = %d MB, %d CPUs, %d MB disk, want %d MB, %d CPUs, %d MB disk", got.Memory, got.CPUs, got.DiskSize, tc.want.Memory, tc.want.CPUs, tc.want.DiskSize)
+			}
+		})
+	}
+}
+
+func TestNodeArch(t *testing.T) {
+	if got := NodeArch(Node{Name: "m02"}); got != detect.EffectiveArch() {
+		t.Errorf("NodeArch() = %q, want the arch of the host %q", got, detect.EffectiveArch())
+	}
+	if got := NodeArch(Node{Name: "m02", Arch: "s390x"}); got != "s390x" {
+		t.Errorf("NodeArch() = %q, want s390x", got)
+	}
+}
+
+func TestBootstrapped(t *testing.T) {
+	tests := []struct {
+		phase   string
+		existed bool
+		want    bool
+	}{
+		{"", false, false},
+		{"", true, true},
+		{NodeCreated, true, false},
+		{NodeBootstrapped, true, true},
+		{NodeBootstrapped, false, true},
+	}
+	for _, tc := range tests {
+		if got := Bootstrapped(Node{Phase: tc.phase}, tc.existed); got != tc.want {
+			t.Errorf("Bootstrapped(%q, %v) = %v, want %v", tc.phase, tc.existed, got, tc.want)
+		}
+	}
+}
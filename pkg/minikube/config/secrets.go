@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/keychain"
+)
+
+// seal and unseal are replaced in tests
+var (
+	seal   = keychain.Seal
+	unseal = keychain.Unseal
+)
+
+var sealWarning sync.Once
+
+// isSecretEnv returns true if the KEY=VALUE entry of DockerEnv carries credentials,
+// either a proxy URL with a password or a variable named like a password or token
+func isSecretEnv(entry string) bool {
+	k, v, ok := strings.Cut(entry, "=")
+	if !ok || v == "" || keychain.IsSealed(v) {
+		return false
+	}
+	k = strings.ToUpper(k)
+	if strings.HasSuffix(k, "_PROXY") {
+		u, err := url.Parse(v)
+		if err != nil || u.User == nil {
+			return false
+		}
+		_, hasPassword := u.User.Password()
+		return hasPassword
+	}
+	for _, s := range []string{"PASSWORD", "TOKEN", "SECRET"} {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretRegistryAuth returns true if the entry of RegistryAuth is registry=user:password credentials,
+// rather than the path of a docker config.json file
+func isSecretRegistryAuth(entry string) bool {
+	if _, err := os.Stat(entry); err == nil {
+		return false
+	}
+	_, v, ok := strings.Cut(entry, "=")
+	return ok && v != "" && !keychain.IsSealed(v)
+}
+
+// sealSecrets returns a copy of cc whose secrets are encrypted, to be saved.
+// If the host has no usable key store, the secrets are kept as they are.
+func sealSecrets(cc *ClusterConfig) *ClusterConfig {
+	sealed := *cc
+	sealed.DockerEnv = sealEntries(cc.Name, cc.DockerEnv, isSecretEnv)
+	sealed.RegistryAuth = sealEntries(cc.Name, cc.RegistryAuth, isSecretRegistryAuth)
+	return &sealed
+}
+
+// sealEntries returns a copy of the KEY=VALUE entries of profile name, whose secret values are encrypted
+func sealEntries(name string, entries []string, secret func(string) bool) []string {
+	if entries == nil {
+		return nil
+	}
+	sealed := make([]string, len(entries))
+	for i, e := range entries {
+		sealed[i] = e
+		if !secret(e) {
+			continue
+		}
+		k, v, _ := strings.Cut(e, "=")
+		s, err := seal(v)
+		if err != nil {
+			sealWarning.Do(func() {
+				klog.Warningf("unable to encrypt the secrets of profile %q, saving them as plaintext: %v", name, err)
+			})
+			continue
+		}
+		sealed[i] = k + "=" + s
+	}
+	return sealed
+}
+
+// unsealSecrets decrypts the secrets of a loaded profile.
+// Secrets that can not be decrypted, eg: because the profile was imported from another host, are kept sealed,
+// so that saving the profile again does not lose them.
+func unsealSecrets(cc *ClusterConfig) {
+	cc.DockerEnv = unsealEntries(cc.Name, cc.DockerEnv)
+	cc.RegistryAuth = unsealEntries(cc.Name, cc.RegistryAuth)
+}
+
+// unsealEntries decrypts the sealed values of the KEY=VALUE entries of profile name
+func unsealEntries(name string, entries []string) []string {
+	unsealed := entries[:0]
+	for _, e := range entries {
+		k, v, _ := strings.Cut(e, "=")
+		if keychain.IsSealed(v) {
+			p, err := unseal(v)
+			if err != nil {
+				klog.Warningf("unable to decrypt %s of profile %q, it is ignored until it is set again: %v", k, name, err)
+			} else {
+				e = k + "=" + p
+			}
+		}
+		unsealed = append(unsealed, e)
+	}
+	return unsealed
+}
+
+// WithoutSealed returns the KEY=VALUE entries, eg: of DockerEnv, without the ones that are still sealed
+// because they could not be decrypted when the profile was loaded
+func WithoutSealed(entries []string) []string {
+	var plain []string
+	for _, e := range entries {
+		if _, v, _ := strings.Cut(e, "="); !keychain.IsSealed(v) {
+			plain = append(plain, e)
+		}
+	}
+	return plain
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/minikube/pkg/util"
+)
+
+// Roles of the nodes of a topology file
+const (
+	RoleControlPlane = "control-plane"
+	RoleWorker       = "worker"
+)
+
+// Topology is a topology file, which describes the nodes of a cluster, eg: for minikube start --topology
+type Topology struct {
+	Nodes []TopologyNode `yaml:"nodes"`
+}
+
+// TopologyNode is a group of identical nodes of a topology file
+type TopologyNode struct {
+	// Role is control-plane or worker
+	Role string `yaml:"role"`
+	// Count is the number of nodes of the group, 1 if unset
+	Count int `yaml:"count,omitempty"`
+	// OS is the operating system of the nodes, only linux is supported
+	OS string `yaml:"os,omitempty"`
+	// CPUs, Memory and DiskSize are the resources of the nodes, the cluster's if unset. Memory and DiskSize are sizes, eg: 4g.
+	CPUs     int    `yaml:"cpus,omitempty"`
+	Memory   string `yaml:"memory,omitempty"`
+	DiskSize string `yaml:"diskSize,omitempty"`
+	// Labels are applied to the nodes, in addition to the minikube ones
+	Labels map[string]string `yaml:"labels,omitempty"`
+	// Taints are applied to the nodes, in the syntax of kubectl taint, eg: dedicated=gpu:NoSchedule
+	Taints []string `yaml:"taints,omitempty"`
+}
+
+// LoadTopology reads and validates a topology file. Unknown attributes are rejected, rather than ignored.
+func LoadTopology(path string) (Topology, error) {
+	var t Topology
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return t, err
+	}
+	if err := yaml.UnmarshalStrict(b, &t); err != nil {
+		return t, errors.Wrapf(err, "parse %s", path)
+	}
+	return t, t.Validate()
+}
+
+// Validate returns an error if the nodes of the topology cannot be created
+func (t Topology) Validate() error {
+	if len(t.Nodes) == 0 {
+		return errors.New("the topology has no nodes")
+	}
+	for i, g := range t.Nodes {
+		if err := g.validate(); err != nil {
+			return errors.Wrapf(err, "nodes[%d]", i)
+		}
+	}
+	return nil
+}
+
+// ValidateCluster returns an error if the topology is not the one of a whole cluster, eg: for minikube start,
+// rather than nodes to add to an existing one
+func (t Topology) ValidateCluster() error {
+	switch cps := t.ControlPlanes(); {
+	case cps == 0:
+		return errors.New("the topology has no control-plane node")
+	case cps == 2:
+		return errors.New("HA (multi-control plane) clusters require 3 or more control-plane nodes")
+	}
+	return nil
+}
+
+func (g TopologyNode) validate() error {
+	if g.Role != RoleControlPlane && g.Role != RoleWorker {
+		return fmt.Errorf("invalid role %q, must be %s or %s", g.Role, RoleControlPlane, RoleWorker)
+	}
+	if g.Count < 0 || g.CPUs < 0 {
+		return errors.New("count and cpus cannot be negative")
+	}
+	if g.OS != "" && g.OS != "linux" {
+		return fmt.Errorf("%s nodes are not supported, only linux nodes are", g.OS)
+	}
+	for _, size := range []string{g.Memory, g.DiskSize} {
+		if size == "" {
+			continue
+		}
+		if _, err := util.CalculateSizeInMB(size); err != nil {
+			return errors.Wrapf(err, "invalid size %q", size)
+		}
+	}
+	if err := ValidateLabels(g.Labels); err != nil {
+		return err
+	}
+	return ValidateTaints(g.Taints)
+}
+
+// ValidateLabels returns an error if the labels of a node are not valid Kubernetes labels
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if errs := validation.IsQualifiedName(k); len(errs) != 0 {
+			return fmt.Errorf("invalid label %q: %s", k, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) != 0 {
+			return fmt.Errorf("invalid value of label %q: %s", k, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// ValidateTaints returns an error if the taints of a node are not in the syntax of kubectl taint, key[=value]:effect
+func ValidateTaints(taints []string) error {
+	for _, taint := range taints {
+		if err := validateTaint(taint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTaint validates a taint in the syntax of kubectl taint, key[=value]:effect
+func validateTaint(taint string) error {
+	kv, effect, ok := strings.Cut(taint, ":")
+	if !ok {
+		return fmt.Errorf("invalid taint %q, must be key[=value]:effect", taint)
+	}
+	switch effect {
+	case "NoSchedule", "PreferNoSchedule", "NoExecute":
+	default:
+		return fmt.Errorf("invalid effect of taint %q, must be NoSchedule, PreferNoSchedule or NoExecute", taint)
+	}
+	k, v, _ := strings.Cut(kv, "=")
+	if errs := validation.IsQualifiedName(k); len(errs) != 0 {
+		return fmt.Errorf("invalid taint %q: %s", taint, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(v); len(errs) != 0 {
+		return fmt.Errorf("invalid value of taint %q: %s", taint, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// count returns the number of nodes of the group
+func (g TopologyNode) count() int {
+	if g.Count == 0 {
+		return 1
+	}
+	return g.Count
+}
+
+// ControlPlanes returns the number of control-plane nodes of the topology
+func (t Topology) ControlPlanes() int {
+	n := 0
+	for _, g := range t.Nodes {
+		if g.Role == RoleControlPlane {
+			n += g.count()
+		}
+	}
+	return n
+}
+
+// Expand returns the nodes of a validated topology, the control-plane ones first, without their names.
+// Only the roles, resources, labels and taints of the nodes are set.
+func (t Topology) Expand() []Node {
+	var cps, workers []Node
+	for _, g := range t.Nodes {
+		// validated by LoadTopology
+		memory, _ := sizeInMB(g.Memory)
+		disk, _ := sizeInMB(g.DiskSize)
+		for i := 0; i < g.count(); i++ {
+			n := Node{
+				ControlPlane: g.Role == RoleControlPlane,
+				Worker:       true,
+				CPUs:         g.CPUs,
+				Memory:       memory,
+				DiskSize:     disk,
+				Labels:       g.Labels,
+				Taints:       g.Taints,
+			}
+			if n.ControlPlane {
+				cps = append(cps, n)
+			} else {
+				workers = append(workers, n)
+			}
+		}
+	}
+	return append(cps, workers...)
+}
+
+// sizeInMB returns the size in MB, 0 if unset
+func sizeInMB(size string) (int, error) {
+	if size == "" {
+		return 0, nil
+	}
+	return util.CalculateSizeInMB(size)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadTopology(t *testing.T) {
+	tests := []struct {
+		description string
+		file        string
+		err         string
+		cluster     string
+	}{
+		{"valid", "nodes:\n- role: control-plane\n  count: 3\n- role: worker\n  memory: 8g\n  labels:\n    gpu: \"true\"\n  taints: [dedicated=gpu:NoSchedule]\n", "", ""},
+		{"no nodes", "nodes: []\n", "no nodes", ""},
+		{"unknown attribute", "nodes:\n- role: worker\n  replicas: 2\n", "field replicas not found", ""},
+		{"invalid role", "nodes:\n- role: master\n", "invalid role", ""},
+		{"windows", "nodes:\n- role: worker\n  os: windows\n", "windows nodes are not supported", ""},
+		{"invalid memory", "nodes:\n- role: worker\n  memory: lots\n", "invalid size", ""},
+		{"invalid label", "nodes:\n- role: worker\n  labels:\n    -gpu: \"true\"\n", "invalid label", ""},
+		{"invalid taint effect", "nodes:\n- role: worker\n  taints: [dedicated=gpu:Never]\n", "invalid effect", ""},
+		{"taint without effect", "nodes:\n- role: worker\n  taints: [dedicated=gpu]\n", "must be key[=value]:effect", ""},
+		{"workers only", "nodes:\n- role: worker\n  count: 2\n", "", "no control-plane node"},
+		{"two control planes", "nodes:\n- role: control-plane\n  count: 2\n", "", "3 or more control-plane nodes"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "topology.yaml")
+			if err := os.WriteFile(path, []byte(tc.file), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			topo, err := LoadTopology(path)
+			if tc.err == "" && err != nil || tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("LoadTopology() = %v, want %q", err, tc.err)
+			}
+			if tc.err != "" {
+				return
+			}
+			err = topo.ValidateCluster()
+			if tc.cluster == "" && err != nil || tc.cluster != "" && (err == nil || !strings.Contains(err.Error(), tc.cluster)) {
+				t.Errorf("ValidateCluster() = %v, want %q", err, tc.cluster)
+			}
+		})
+	}
+}
+
+func TestTopologyExpand(t *testing.T) {
+	topo := Topology{Nodes: []TopologyNode{
+		{Role: RoleWorker, Count: 2, CPUs: 4, Memory: "8g", Labels: map[string]string{"gpu": "true"}, Taints: []string{"gpu:NoSchedule"}},
+		{Role: RoleControlPlane, DiskSize: "40000mb"},
+	}}
+	worker := Node{Worker: true, CPUs: 4, Memory: 8192, Labels: map[string]string{"gpu": "true"}, Taints: []string{"gpu:NoSchedule"}}
+	want := []Node{{ControlPlane: true, Worker: true, DiskSize: 40000}, worker, worker}
+	if got := topo.Expand(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expand() = %+v, want %+v", got, want)
+	}
+	if got := topo.ControlPlanes(); got != 1 {
+		t.Errorf("ControlPlanes() = %d, want 1", got)
+	}
+}
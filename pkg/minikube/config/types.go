@@ -37,6 +37,7 @@ type ClusterConfig struct {
 	Name                    string
 	KeepContext             bool   // used by start and profile command to or not to switch kubectl's current context
 	EmbedCerts              bool   // used by kubeconfig.Setup
+	KubeconfigMode          string // used by kubeconfig.PathForProfile, either shared or separate
 	MinikubeISO             string // ISO used for VM-drivers.
 	KicBaseImage            string // base-image used for docker/podman drivers.
 	Memory                  int
@@ -109,6 +110,57 @@ type ClusterConfig struct {
 	SSHAgentPID             int
 	GPUs                    string
 	AutoPauseInterval       time.Duration // Specifies interval of time to wait before checking if cluster should be paused
+	WarmNodes               []Node        `json:",omitempty"` // Booted guests that have not joined the cluster yet, claimed by 'minikube node add'
+	SharedImageCache        bool          // Only used by the docker and podman driver: nodes pull Docker Hub images through a registry mirror they share
+	MemoryAutoShrink        time.Duration // Only used by the KVM2 and Hyper-V drivers: idle time after which the memory of the guests is shrunk
+	CertsDir                string        // Directory of the CA, and optionally the apiserver cert, that replace the ones minikube generates
+	OIDC                    OIDCConfig
+	EncryptSecrets          string // Provider that encrypts secrets at rest in etcd: aescbc or kms, or "" for none
+	PodSecurityLevel        string // Pod Security Standard enforced by default: privileged, baseline or restricted, or "" for the Kubernetes defaults
+	SeccompDefault          bool   // The kubelet runs every container with the RuntimeDefault seccomp profile, unless it sets another one
+	SecurityProfilesDir     string // Directory of the seccomp and AppArmor profiles that are installed on every node
+	AuditPolicy             string // Audit policy of the API server, which logs the requests it matches on the control-plane nodes
+	ExternalEtcd            ExternalEtcdConfig
+	PullSecrets             PullSecretsConfig
+	TTL                     TTLConfig
+	NodePools               []NodePool `json:",omitempty"` // Groups of identical workers, created and scaled with 'minikube nodepool'
+	// RegistryAuth are the docker config.json files and registry=user:password credentials that every node pulls images with
+	RegistryAuth []string `json:",omitempty"`
+}
+
+// TTLConfig registers an ephemeral cluster for deletion, once it expires or the process that created it exits
+type TTLConfig struct {
+	// Expires is when the cluster is deleted, or zero for never
+	Expires time.Time
+	// OwnerPID is the process whose exit deletes the cluster, or 0 for none
+	OwnerPID int
+}
+
+// PullSecretsConfig configures the imagePullSecrets that minikube refreshes with short-lived tokens of a private registry
+type PullSecretsConfig struct {
+	// Provider is the cloud provider whose CLI mints the tokens on the host: gcloud, ecr or acr
+	Provider   string
+	Registry   string
+	Namespaces []string
+}
+
+// OIDCConfig configures the API server to authenticate users with an OpenID Connect issuer
+type OIDCConfig struct {
+	// IssuerURL is the URL of the issuer, or constants.DexIssuer for the dex addon
+	IssuerURL     string
+	ClientID      string
+	UsernameClaim string
+	GroupsClaim   string
+}
+
+// ExternalEtcdConfig configures the API server to use an existing etcd cluster, instead of the etcd static pods of the control-plane nodes
+type ExternalEtcdConfig struct {
+	// Endpoints are the client URLs of the etcd cluster, eg: https://10.0.0.5:2379
+	Endpoints []string
+	// CAFile, CertFile and KeyFile are the CA of the etcd cluster, and the client cert and key of the API server, on the host
+	CAFile   string
+	CertFile string
+	KeyFile  string
 }
 
 // KubernetesConfig contains the parameters used to configure the VM Kubernetes.
@@ -117,6 +169,9 @@ type KubernetesConfig struct {
 	ClusterName         string
 	Namespace           string
 	APIServerHAVIP      string
+	HAVIP               string   // the virtual IP of the API servers of an HA cluster, or empty for the last IP of the subnet of its nodes
+	HALBMode            string   // how the virtual IP of an HA cluster is served: arp, bgp or haproxy, or empty for arp
+	HABGPPeers          []string // the BGP peers that kube-vip advertises the virtual IP to in bgp mode, as address:AS
 	APIServerName       string
 	APIServerNames      []string
 	APIServerIPs        []net.IP
@@ -126,12 +181,17 @@ type KubernetesConfig struct {
 	NetworkPlugin       string
 	FeatureGates        string // https://kubernetes.io/docs/reference/command-line-tools-reference/feature-gates/
 	ServiceCIDR         string // the subnet which Kubernetes services will be deployed to
+	KubeProxyMode       string // the mode of kube-proxy, eg: ipvs, or empty for its default
+	DualStack           bool   // whether the pods and services have IPv6 addresses as well as IPv4 ones
 	ImageRepository     string
 	LoadBalancerStartIP string // currently only used by MetalLB addon
 	LoadBalancerEndIP   string // currently only used by MetalLB addon
 	CustomIngressCert   string // used by Ingress addon
 	RegistryAliases     string // currently only used by registry-aliases addon
 	ExtraOptions        ExtraOptionSlice
+	// KubeadmPatches are kubeadm patches of all the nodes, keyed by file name, eg: kube-apiserver+strategic.yaml.
+	// The ones of a node with the same name replace them.
+	KubeadmPatches map[string]string `json:",omitempty"`
 
 	ShouldLoadCachedImages bool
 
@@ -148,6 +208,42 @@ type Node struct {
 	ContainerRuntime  string
 	ControlPlane      bool
 	Worker            bool
+	// ExtraOptions are kubelet options for this node only, applied on top of the cluster's
+	ExtraOptions ExtraOptionSlice `json:",omitempty"`
+	// KubeadmPatches are kubeadm patches applied when this node joins the cluster, keyed by file name, eg: kubeletconfiguration+merge.yaml
+	KubeadmPatches map[string]string `json:",omitempty"`
+	// Memory, CPUs and DiskSize are the resources of this node, instead of the cluster's, when set. Memory and DiskSize are in MB.
+	Memory   int `json:",omitempty"`
+	CPUs     int `json:",omitempty"`
+	DiskSize int `json:",omitempty"`
+	// Labels and Taints are applied to this node, in addition to the minikube ones, eg: from a topology file.
+	// Taints are in the syntax of kubectl taint, eg: dedicated=gpu:NoSchedule.
+	Labels map[string]string `json:",omitempty"`
+	Taints []string          `json:",omitempty"`
+	// NodePool is the name of the node pool of this node, if any
+	NodePool string `json:",omitempty"`
+	// Arch is the CPU architecture of this node, eg: arm64, when it differs from the one of the host
+	Arch string `json:",omitempty"`
+	// IPv6 is the IPv6 address of this node in dual-stack clusters
+	IPv6 string `json:",omitempty"`
+	// Phase is the last phase of provisioning this node that completed, eg: NodeCreated if a start failed before
+	// Kubernetes was bootstrapped on it. It is empty for the nodes created by older versions of minikube.
+	Phase string `json:",omitempty"`
+}
+
+const (
+	// NodeCreated is the phase of a node whose machine is created, but which Kubernetes is not bootstrapped on yet
+	NodeCreated = "created"
+	// NodeBootstrapped is the phase of a node that kubeadm initialized, or joined to the cluster
+	NodeBootstrapped = "bootstrapped"
+)
+
+// Bootstrapped returns whether Kubernetes is bootstrapped on n, or, for the nodes without a phase, whether its machine existed before
+func Bootstrapped(n Node, machineExisted bool) bool {
+	if n.Phase == "" {
+		return machineExisted
+	}
+	return n.Phase == NodeBootstrapped
 }
 
 // VersionedExtraOption holds information on flags to apply to a specific range
@@ -78,6 +78,8 @@ const (
 	ClusterDNSDomain = "cluster.local"
 	// DefaultServiceCIDR is The CIDR to be used for service cluster IPs
 	DefaultServiceCIDR = "10.96.0.0/12"
+	// DefaultServiceCIDRv6 is the CIDR of the IPv6 service cluster IPs of dual-stack clusters
+	DefaultServiceCIDRv6 = "fd00:10:96::/112"
 	// HostAlias is a DNS alias to the container/VM host IP
 	HostAlias = "host.minikube.internal"
 	// ControlPlaneAlias is a DNS alias pointing to the apiserver frontend
@@ -144,6 +146,11 @@ const (
 	// DefaultCertExpiration is the amount of time in the future a certificate will expire in by default, which is 3 years
 	DefaultCertExpiration = time.Hour * 24 * 365 * 3
 
+	// DexIssuer is the --oidc-issuer-url that makes the dex addon the OIDC issuer of the cluster
+	DexIssuer = "dex"
+	// DexNodePort is the node port that the dex addon serves on
+	DexNodePort = 32000
+
 	// Mount9PVersionFlag is the flag used to set the mount 9P version
 	Mount9PVersionFlag = "9p-version"
 	// MountGIDFlag is the flag used to set the mount GID
@@ -212,4 +219,6 @@ var (
 
 	// KubeadmYamlPath is the path to the kubeadm configuration
 	KubeadmYamlPath = path.Join(vmpath.GuestEphemeralDir, "kubeadm.yaml")
+	// KubeadmPatchesDir is the directory of the kubeadm patches passed to kubeadm join
+	KubeadmPatchesDir = path.Join(vmpath.GuestEphemeralDir, "patches")
 )
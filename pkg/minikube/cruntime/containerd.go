@@ -53,6 +53,14 @@ const (
 
 [host."{{.InsecureRegistry -}}"]
   skip_verify = true
+`
+	// containerdMirrorTemplate makes Docker Hub pulls go through the registry mirrors, in order
+	containerdMirrorTemplate = `server = "https://registry-1.docker.io"
+{{- range .Mirrors }}
+
+[host."{{.}}"]
+  capabilities = ["pull", "resolve"]
+{{- end }}
 `
 )
 
@@ -64,6 +72,8 @@ type Containerd struct {
 	KubernetesVersion semver.Version
 	Init              sysinit.Manager
 	InsecureRegistry  []string
+	RegistryMirror    []string
+	SharedMirror      string
 }
 
 // Name is a human readable name for containerd
@@ -192,6 +202,11 @@ func generateContainerdConfig(cr CommandRunner, imageRepository string, kv semve
 		}
 	}
 
+	return generateContainerdRegistryConfig(cr, insecureRegistry)
+}
+
+// generateContainerdRegistryConfig writes the hosts.toml of each insecure registry, that containerd reads on each pull
+func generateContainerdRegistryConfig(cr CommandRunner, insecureRegistry []string) error {
 	for _, registry := range insecureRegistry {
 		addr := registry
 		if strings.HasPrefix(strings.ToLower(registry), "http://") || strings.HasPrefix(strings.ToLower(registry), "https://") {
@@ -224,6 +239,41 @@ func generateContainerdConfig(cr CommandRunner, imageRepository string, kv semve
 	return nil
 }
 
+// generateContainerdMirrorConfig makes containerd pull Docker Hub images through the registry mirrors, and then the one shared by the nodes
+// of the cluster, or stop doing so if there are none
+func generateContainerdMirrorConfig(cr CommandRunner, mirrors []string, shared string) error {
+	hostsPath := path.Join(containerdMirrorsRoot, "docker.io", "hosts.toml")
+	var hosts []string
+	for _, m := range mirrors {
+		hosts = append(hosts, mirrorURL(m))
+	}
+	if shared != "" {
+		hosts = append(hosts, "http://"+shared)
+	}
+	if len(hosts) == 0 {
+		// only remove the configuration written below
+		c := exec.Command("/bin/bash", "-c", fmt.Sprintf("if sudo grep -qs %q %s; then sudo rm -f %s; fi", "registry-1.docker.io", hostsPath, hostsPath))
+		if _, err := cr.RunCmd(c); err != nil {
+			return errors.Wrap(err, "unable to remove shared mirror cfg")
+		}
+		return nil
+	}
+
+	t, err := template.New("hosts.toml").Parse(containerdMirrorTemplate)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse mirror template")
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, struct{ Mirrors []string }{Mirrors: hosts}); err != nil {
+		return errors.Wrap(err, "unable to create mirror template")
+	}
+	c := exec.Command("/bin/bash", "-c", fmt.Sprintf("sudo mkdir -p %s && printf %%s \"%s\" | base64 -d | sudo tee %s", path.Dir(hostsPath), base64.StdEncoding.EncodeToString(b.Bytes()), hostsPath))
+	if _, err := cr.RunCmd(c); err != nil {
+		return errors.Wrap(err, "unable to generate mirror cfg")
+	}
+	return nil
+}
+
 // Enable idempotently enables containerd on a host
 // It is also called by docker.Enable() - if bound to containerd, to enforce proper containerd configuration completed by service restart.
 func (r *Containerd) Enable(disOthers bool, cgroupDriver string, inUserNamespace bool) error {
@@ -249,6 +299,9 @@ func (r *Containerd) Enable(disOthers bool, cgroupDriver string, inUserNamespace
 	if err := generateContainerdConfig(r.Runner, r.ImageRepository, r.KubernetesVersion, cgroupDriver, r.InsecureRegistry, inUserNamespace); err != nil {
 		return err
 	}
+	if err := generateContainerdMirrorConfig(r.Runner, r.RegistryMirror, r.SharedMirror); err != nil {
+		return err
+	}
 	if err := enableIPForwarding(r.Runner); err != nil {
 		return err
 	}
@@ -17,6 +17,9 @@ limitations under the License.
 package cruntime
 
 import (
+	"encoding/base64"
+	"regexp"
+	"strings"
 	"testing"
 
 	"k8s.io/minikube/pkg/version"
@@ -62,3 +65,87 @@ func TestParseContainerdVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateContainerdMirrorConfig(t *testing.T) {
+	var tests = []struct {
+		name    string
+		mirrors []string
+		shared  string
+		want    string
+	}{
+		{"shared", nil, "192.168.49.3:5000", `server = "https://registry-1.docker.io"
+
+[host."http://192.168.49.3:5000"]
+  capabilities = ["pull", "resolve"]
+`},
+		{"mirrors", []string{"mirror.example.com", "http://10.0.0.5:5000"}, "192.168.49.3:5000", `server = "https://registry-1.docker.io"
+
+[host."https://mirror.example.com"]
+  capabilities = ["pull", "resolve"]
+
+[host."http://10.0.0.5:5000"]
+  capabilities = ["pull", "resolve"]
+
+[host."http://192.168.49.3:5000"]
+  capabilities = ["pull", "resolve"]
+`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewFakeRunner(t)
+			if err := generateContainerdMirrorConfig(f, tc.mirrors, tc.shared); err != nil {
+				t.Fatalf("generateContainerdMirrorConfig() error: %v", err)
+			}
+			cmd := strings.Join(f.cmds, " ")
+			m := regexp.MustCompile(`printf %s "([^"]+)" \| base64 -d \| sudo tee (\S+)`).FindStringSubmatch(cmd)
+			if m == nil {
+				t.Fatalf("no hosts.toml written by: %s", cmd)
+			}
+			if m[2] != "/etc/containerd/certs.d/docker.io/hosts.toml" {
+				t.Errorf("hosts.toml written to %s", m[2])
+			}
+			b, err := base64.StdEncoding.DecodeString(m[1])
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if string(b) != tc.want {
+				t.Errorf("hosts.toml = %q, want %q", b, tc.want)
+			}
+		})
+	}
+}
+
+func TestGenerateCRIOMirrorConfig(t *testing.T) {
+	f := NewFakeRunner(t)
+	if err := generateCRIOMirrorConfig(f, []string{"mirror.example.com", "http://10.0.0.5:5000"}, "192.168.49.3:5000"); err != nil {
+		t.Fatalf("generateCRIOMirrorConfig() error: %v", err)
+	}
+	cmd := strings.Join(f.cmds, " ")
+	m := regexp.MustCompile(`printf %s "([^"]+)" \| base64 -d \| sudo tee (\S+)`).FindStringSubmatch(cmd)
+	if m == nil {
+		t.Fatalf("no mirror config written by: %s", cmd)
+	}
+	b, err := base64.StdEncoding.DecodeString(m[1])
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	want := `[[registry]]
+prefix = "docker.io"
+location = "registry-1.docker.io"
+
+[[registry.mirror]]
+location = "mirror.example.com"
+insecure = false
+
+[[registry.mirror]]
+location = "10.0.0.5:5000"
+insecure = true
+
+[[registry.mirror]]
+location = "192.168.49.3:5000"
+insecure = true
+`
+	if string(b) != want {
+		t.Errorf("mirror config = %q, want %q", b, want)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package cruntime
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -42,6 +43,8 @@ import (
 const (
 	// crioConfigFile is the path to the CRI-O configuration
 	crioConfigFile = "/etc/crio/crio.conf.d/02-crio.conf"
+	// crioMirrorConfigFile makes Docker Hub pulls go through the registry mirrors
+	crioMirrorConfigFile = "/etc/containers/registries.conf.d/50-minikube-mirror.conf"
 )
 
 // CRIO contains CRIO runtime state
@@ -51,6 +54,8 @@ type CRIO struct {
 	ImageRepository   string
 	KubernetesVersion semver.Version
 	Init              sysinit.Manager
+	RegistryMirror    []string
+	SharedMirror      string
 }
 
 // generateCRIOConfig sets up pause image and cgroup manager for cri-o in crioConfigFile
@@ -213,6 +218,39 @@ Environment="_CRIO_ROOTLESS=1"
 	return nil
 }
 
+// generateCRIOMirrorConfig makes cri-o pull Docker Hub images through the registry mirrors, and then the one shared by the nodes
+// of the cluster, or stop doing so if there are none
+func generateCRIOMirrorConfig(cr CommandRunner, mirrors []string, shared string) error {
+	var hosts []string
+	for _, m := range mirrors {
+		hosts = append(hosts, mirrorURL(m))
+	}
+	if shared != "" {
+		hosts = append(hosts, "http://"+shared)
+	}
+	if len(hosts) == 0 {
+		if _, err := cr.RunCmd(exec.Command("sudo", "rm", "-f", crioMirrorConfigFile)); err != nil {
+			return errors.Wrap(err, "remove shared mirror cfg")
+		}
+		return nil
+	}
+	conf := `[[registry]]
+prefix = "docker.io"
+location = "registry-1.docker.io"
+`
+	for _, h := range hosts {
+		// cri-o takes the location without its scheme, and pulls over HTTP from the insecure ones
+		location, insecure := strings.CutPrefix(h, "http://")
+		location = strings.TrimPrefix(location, "https://")
+		conf += fmt.Sprintf("\n[[registry.mirror]]\nlocation = %q\ninsecure = %t\n", location, insecure)
+	}
+	c := exec.Command("/bin/bash", "-c", fmt.Sprintf("sudo mkdir -p %s && printf %%s \"%s\" | base64 -d | sudo tee %s", path.Dir(crioMirrorConfigFile), base64.StdEncoding.EncodeToString([]byte(conf)), crioMirrorConfigFile))
+	if _, err := cr.RunCmd(c); err != nil {
+		return errors.Wrap(err, "generate mirror cfg")
+	}
+	return nil
+}
+
 // Enable idempotently enables CRIO on a host
 func (r *CRIO) Enable(disOthers bool, cgroupDriver string, inUserNamespace bool) error {
 	if disOthers {
@@ -229,6 +267,9 @@ func (r *CRIO) Enable(disOthers bool, cgroupDriver string, inUserNamespace bool)
 	if err := enableIPForwarding(r.Runner); err != nil {
 		return err
 	}
+	if err := generateCRIOMirrorConfig(r.Runner, r.RegistryMirror, r.SharedMirror); err != nil {
+		return err
+	}
 	if inUserNamespace {
 		if err := CheckKernelCompatibility(r.Runner, 5, 11); err != nil {
 			// For using overlayfs
@@ -155,8 +155,12 @@ type Config struct {
 	KubernetesVersion semver.Version
 	// InsecureRegistry list of insecure registries
 	InsecureRegistry []string
+	// RegistryMirror list of the mirrors of Docker Hub, that docker takes from the engine options of the provisioner instead
+	RegistryMirror []string
 	// GPUs add GPU devices to the container
 	GPUs bool
+	// SharedMirror is the address of the pull-through registry mirror of Docker Hub shared by the nodes of the cluster, if any
+	SharedMirror string
 }
 
 // ListContainersOptions are the options to use for listing containers
@@ -230,6 +234,7 @@ func New(c Config) (Manager, error) {
 			UseCRI:            (sp != ""), // !dockershim
 			CRIService:        cs,
 			GPUs:              c.GPUs,
+			SharedMirror:      c.SharedMirror,
 		}, nil
 	case "crio", "cri-o":
 		return &CRIO{
@@ -238,6 +243,8 @@ func New(c Config) (Manager, error) {
 			ImageRepository:   c.ImageRepository,
 			KubernetesVersion: c.KubernetesVersion,
 			Init:              sm,
+			RegistryMirror:    c.RegistryMirror,
+			SharedMirror:      c.SharedMirror,
 		}, nil
 	case "containerd":
 		return &Containerd{
@@ -247,12 +254,42 @@ func New(c Config) (Manager, error) {
 			KubernetesVersion: c.KubernetesVersion,
 			Init:              sm,
 			InsecureRegistry:  c.InsecureRegistry,
+			RegistryMirror:    c.RegistryMirror,
+			SharedMirror:      c.SharedMirror,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unknown runtime type: %q", c.Type)
 	}
 }
 
+// ConfigureRegistries renders the insecure registries and registry mirrors of c into the configuration of containerd or cri-o,
+// eg: after they were changed on a running node. Docker, and cri-o for its insecure registries, take them from the engine options
+// of the provisioner instead.
+func ConfigureRegistries(c Config) error {
+	switch c.Type {
+	case "containerd":
+		if err := generateContainerdRegistryConfig(c.Runner, c.InsecureRegistry); err != nil {
+			return err
+		}
+		// containerd reads the hosts.toml of a registry on each pull, it is not restarted
+		return generateContainerdMirrorConfig(c.Runner, c.RegistryMirror, c.SharedMirror)
+	case "crio", "cri-o":
+		if err := generateCRIOMirrorConfig(c.Runner, c.RegistryMirror, c.SharedMirror); err != nil {
+			return err
+		}
+		return sysinit.New(c.Runner).Restart("crio")
+	}
+	return nil
+}
+
+// mirrorURL returns the URL of a registry mirror, which is served over HTTPS unless it says otherwise
+func mirrorURL(mirror string) string {
+	if strings.HasPrefix(mirror, "http://") || strings.HasPrefix(mirror, "https://") {
+		return mirror
+	}
+	return "https://" + mirror
+}
+
 // ContainerStatusCommand works across container runtimes with good formatting
 func ContainerStatusCommand() string {
 	// Fallback to 'docker ps' if it fails (none driver)
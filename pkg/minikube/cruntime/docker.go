@@ -76,6 +76,7 @@ type Docker struct {
 	UseCRI            bool
 	CRIService        string
 	GPUs              bool
+	SharedMirror      string
 }
 
 // Name is a human readable name for Docker
@@ -553,6 +554,8 @@ type dockerDaemonConfig struct {
 	StorageDriver  string                `json:"storage-driver"`
 	DefaultRuntime string                `json:"default-runtime,omitempty"`
 	Runtimes       *dockerDaemonRuntimes `json:"runtimes,omitempty"`
+	// RegistryMirrors must not be set by the --registry-mirror flag of dockerd as well
+	RegistryMirrors []string `json:"registry-mirrors,omitempty"`
 }
 type dockerDaemonLogOpts struct {
 	MaxSize string `json:"max-size"`
@@ -587,6 +590,9 @@ func (r *Docker) configureDocker(driver string) error {
 		runtimes.Nvidia.Path = "/usr/bin/nvidia-container-runtime"
 		daemonConfig.Runtimes = runtimes
 	}
+	if r.SharedMirror != "" {
+		daemonConfig.RegistryMirrors = []string{"http://" + r.SharedMirror}
+	}
 	daemonConfigBytes, err := json.Marshal(daemonConfig)
 	if err != nil {
 		return err
@@ -0,0 +1,375 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package daemon serves the control API of minikube daemon: a REST API on a local socket,
+// which GUIs and IDE plugins manage clusters with, instead of running the minikube binary for every operation.
+package daemon
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/libminikube"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/node"
+)
+
+// statusWatchInterval is how often the status of a watched cluster is checked for changes
+const statusWatchInterval = 5 * time.Second
+
+// Server serves the control API
+type Server struct {
+	client *libminikube.Client
+	token  string
+	// binary is the minikube binary that runs the tunnels and mounts
+	binary string
+
+	mu          sync.Mutex
+	subscribers map[chan libminikube.Event]struct{}
+	processes   map[string]*Process
+}
+
+// NewServer returns a server whose clients authenticate with token, and whose tunnels and mounts are run by binary
+func NewServer(token, binary string) *Server {
+	events := make(chan libminikube.Event)
+	s := &Server{
+		client:      libminikube.New(libminikube.Options{Events: events}),
+		token:       token,
+		binary:      binary,
+		subscribers: map[chan libminikube.Event]struct{}{},
+		processes:   map[string]*Process{},
+	}
+	go s.broadcast(events)
+	return s
+}
+
+// WriteToken writes a new random token to path, which only the user can read, and returns it
+func WriteToken(path string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", pkgerrors.Wrap(err, "random")
+	}
+	token := hex.EncodeToString(b)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", pkgerrors.Wrap(err, "mkdir")
+	}
+	return token, os.WriteFile(path, []byte(token), 0o600)
+}
+
+// Serve serves the API of s on the unix socket at path, which only the user can connect to, until ctx is done.
+// The tunnels and mounts are stopped on return.
+func Serve(ctx context.Context, path string, s *Server) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return pkgerrors.Wrap(err, "mkdir")
+	}
+	// left over by a daemon that did not exit cleanly
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return pkgerrors.Wrap(err, "remove socket")
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return pkgerrors.Wrap(err, "listen")
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		ln.Close()
+		return pkgerrors.Wrap(err, "chmod socket")
+	}
+	defer s.stopProcesses()
+
+	srv := &http.Server{Handler: s.Handler(), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		sctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(sctx); err != nil {
+			klog.Warningf("shutting down the daemon: %v", err)
+		}
+	}()
+	if err := srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Handler returns the handler of the API, which requires the token of s as a bearer token
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/clusters", s.listClusters)
+	mux.HandleFunc("POST /v1/clusters", s.createCluster)
+	mux.HandleFunc("DELETE /v1/clusters/{name}", s.deleteCluster)
+	mux.HandleFunc("POST /v1/clusters/{name}/start", s.clusterOperation(s.client.StartCluster))
+	mux.HandleFunc("POST /v1/clusters/{name}/stop", s.clusterOperation(s.client.StopCluster))
+	mux.HandleFunc("GET /v1/clusters/{name}/status", s.status)
+	mux.HandleFunc("POST /v1/clusters/{name}/nodes", s.addNode)
+	mux.HandleFunc("DELETE /v1/clusters/{name}/nodes/{node}", s.nodeOperation(s.client.DeleteNode))
+	mux.HandleFunc("POST /v1/clusters/{name}/nodes/{node}/start", s.nodeOperation(s.client.StartNode))
+	mux.HandleFunc("POST /v1/clusters/{name}/nodes/{node}/stop", s.nodeOperation(s.client.StopNode))
+	mux.HandleFunc("GET /v1/clusters/{name}/processes", s.listProcesses)
+	mux.HandleFunc("POST /v1/clusters/{name}/tunnel", s.startTunnel)
+	mux.HandleFunc("POST /v1/clusters/{name}/mounts", s.startMount)
+	mux.HandleFunc("DELETE /v1/clusters/{name}/processes/{id}", s.stopProcess)
+	mux.HandleFunc("GET /v1/events", s.events)
+
+	want := []byte("Bearer " + s.token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			writeError(w, http.StatusUnauthorized, errors.New("the bearer token of "+localpath.DaemonToken()+" is required"))
+			return
+		}
+		klog.Infof("%s %s", r.Method, r.URL)
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// apiError is the body of the failed requests
+type apiError struct {
+	Error string
+	// ID and ExitCode are set for the failures that the minikube binary would have exited on
+	ID       string `json:",omitempty"`
+	ExitCode int    `json:",omitempty"`
+	Advice   string `json:",omitempty"`
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	body := apiError{Error: err.Error()}
+	var e *libminikube.Error
+	if errors.As(err, &e) {
+		body = apiError{Error: e.Message, ID: e.ID, ExitCode: e.ExitCode, Advice: e.Advice}
+	}
+	writeJSON(w, code, body)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.Warningf("writing response: %v", err)
+	}
+}
+
+// cluster is an entry of GET /v1/clusters
+type cluster struct {
+	Name              string
+	Driver            string
+	ContainerRuntime  string
+	KubernetesVersion string
+	Nodes             int
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, _ *http.Request) {
+	valid, err := config.ListValidProfiles()
+	// there is no profiles directory before the first cluster is created
+	if err != nil && !os.IsNotExist(err) {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	clusters := []cluster{}
+	for _, p := range valid {
+		clusters = append(clusters, cluster{
+			Name:              p.Name,
+			Driver:            p.Config.Driver,
+			ContainerRuntime:  p.Config.KubernetesConfig.ContainerRuntime,
+			KubernetesVersion: p.Config.KubernetesConfig.KubernetesVersion,
+			Nodes:             len(p.Config.Nodes),
+		})
+	}
+	writeJSON(w, http.StatusOK, clusters)
+}
+
+// createCluster creates the cluster of the libminikube.ClusterOptions of the body, and returns once it is started.
+// Its progress is sent to GET /v1/events.
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	var o libminikube.ClusterOptions
+	if err := json.NewDecoder(r.Body).Decode(&o); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := s.client.CreateCluster(o); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, cluster{Name: o.Name})
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	s.stopClusterProcesses(name)
+	if err := s.client.DeleteCluster(name); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// clusterOperation returns a handler that runs op on a cluster, and returns once it is done.
+// Its progress is sent to GET /v1/events.
+func (s *Server) clusterOperation(op func(cluster string) error) http.HandlerFunc {
+	return s.nodeOperation(func(cluster, _ string) error {
+		return op(cluster)
+	})
+}
+
+// nodeOperation returns a handler that runs op on a node of a cluster, and returns once it is done.
+// Its progress is sent to GET /v1/events.
+func (s *Server) nodeOperation(op func(cluster, node string) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		if !config.ProfileExists(name) {
+			writeError(w, http.StatusNotFound, errors.New("cluster "+name+" not found"))
+			return
+		}
+		if err := op(name, r.PathValue("node")); err != nil {
+			code := http.StatusInternalServerError
+			var qe *node.QuorumError
+			if errors.As(err, &qe) {
+				code = http.StatusConflict
+			}
+			writeError(w, code, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// status returns the status of each node of a cluster, or with ?watch=true, streams it as JSON lines every time it changes
+func (s *Server) status(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if !config.ProfileExists(name) {
+		writeError(w, http.StatusNotFound, errors.New("cluster "+name+" not found"))
+		return
+	}
+	st, err := s.client.Status(name)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if r.URL.Query().Get("watch") != "true" {
+		writeJSON(w, http.StatusOK, st)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+	var last []libminikube.NodeStatus
+	ticker := time.NewTicker(statusWatchInterval)
+	defer ticker.Stop()
+	for {
+		if !reflect.DeepEqual(st, last) {
+			if err := enc.Encode(st); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			last = st
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+		// a failed check is retried, eg: while the cluster is being changed
+		if next, err := s.client.Status(name); err == nil {
+			st = next
+		} else {
+			klog.Warningf("status of %s: %v", name, err)
+		}
+	}
+}
+
+// addNode adds the node of the libminikube.NodeOptions of the body to a cluster
+func (s *Server) addNode(w http.ResponseWriter, r *http.Request) {
+	var o libminikube.NodeOptions
+	if err := json.NewDecoder(r.Body).Decode(&o); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	name, err := s.client.AddNode(r.PathValue("name"), o)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"Name": name})
+}
+
+// events streams the events of the operations of the daemon as JSON lines, of every cluster or of ?cluster=NAME
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("cluster")
+	ch := make(chan libminikube.Event, 100)
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	enc := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e := <-ch:
+			if name != "" && e.Cluster != name {
+				continue
+			}
+			if err := enc.Encode(e); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// broadcast sends the events of the client to the subscribers, skipping the ones that do not keep up
+func (s *Server) broadcast(events <-chan libminikube.Event) {
+	for e := range events {
+		s.mu.Lock()
+		for ch := range s.subscribers {
+			select {
+			case ch <- e:
+			default:
+				klog.Warningf("dropping event %q of a slow subscriber", e.Message)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
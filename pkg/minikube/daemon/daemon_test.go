@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func request(t *testing.T, h http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestHandler(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	if err := config.SaveProfile("p1", &config.ClusterConfig{Name: "p1", Driver: "docker", Nodes: []config.Node{{ControlPlane: true, KubernetesVersion: "v1.30.1"}}}); err != nil {
+		t.Fatal(err)
+	}
+	h := NewServer("secret", "minikube").Handler()
+
+	tests := []struct {
+		description string
+		method      string
+		path        string
+		token       string
+		body        string
+		code        int
+	}{
+		{"no token", "GET", "/v1/clusters", "", "", http.StatusUnauthorized},
+		{"wrong token", "GET", "/v1/clusters", "guess", "", http.StatusUnauthorized},
+		{"list clusters", "GET", "/v1/clusters", "secret", "", http.StatusOK},
+		{"unknown cluster", "GET", "/v1/clusters/p2/status", "secret", "", http.StatusNotFound},
+		{"invalid cluster options", "POST", "/v1/clusters", "secret", "{", http.StatusBadRequest},
+		{"mount without target", "POST", "/v1/clusters/p1/mounts", "secret", `{"Source": "/tmp"}`, http.StatusBadRequest},
+		{"tunnel of an unknown cluster", "POST", "/v1/clusters/p2/tunnel", "secret", "", http.StatusNotFound},
+		{"unknown process", "DELETE", "/v1/clusters/p1/processes/mount-1", "secret", "", http.StatusNotFound},
+		{"start an unknown cluster", "POST", "/v1/clusters/p2/start", "secret", "", http.StatusNotFound},
+		{"stop a node of an unknown cluster", "POST", "/v1/clusters/p2/nodes/m02/stop", "secret", "", http.StatusNotFound},
+		{"delete an unknown node", "DELETE", "/v1/clusters/p1/nodes/m05", "secret", "", http.StatusNoContent},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			w := request(t, h, tc.method, tc.path, tc.token, tc.body)
+			if w.Code != tc.code {
+				t.Errorf("%s %s = %d %s, want %d", tc.method, tc.path, w.Code, w.Body, tc.code)
+			}
+		})
+	}
+
+	w := request(t, h, "GET", "/v1/clusters", "secret", "")
+	var clusters []cluster
+	if err := json.Unmarshal(w.Body.Bytes(), &clusters); err != nil {
+		t.Fatal(err)
+	}
+	if len(clusters) != 1 || clusters[0].Name != "p1" || clusters[0].Driver != "docker" || clusters[0].Nodes != 1 {
+		t.Errorf("GET /v1/clusters = %+v, want p1", clusters)
+	}
+}
+
+func TestProcesses(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake minikube binary is a shell script")
+	}
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	if err := config.SaveProfile("p1", &config.ClusterConfig{Name: "p1"}); err != nil {
+		t.Fatal(err)
+	}
+	binary := filepath.Join(t.TempDir(), "minikube")
+	if err := os.WriteFile(binary, []byte("#!/bin/sh\nexec sleep 60\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	h := NewServer("secret", binary).Handler()
+
+	w := request(t, h, "POST", "/v1/clusters/p1/mounts", "secret", `{"Source": "/src", "Target": "/dst"}`)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("POST mounts = %d %s", w.Code, w.Body)
+	}
+	var p Process
+	if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"mount", "--profile", "p1", "/src:/dst"}; strings.Join(p.Args, " ") != strings.Join(want, " ") || p.Kind != "mount" {
+		t.Errorf("POST mounts = %+v, want the args %v", p, want)
+	}
+
+	var ps []Process
+	if err := json.Unmarshal(request(t, h, "GET", "/v1/clusters/p1/processes", "secret", "").Body.Bytes(), &ps); err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 1 || ps[0].ID != p.ID {
+		t.Errorf("GET processes = %+v, want %s", ps, p.ID)
+	}
+
+	if w := request(t, h, "DELETE", "/v1/clusters/p1/processes/"+p.ID, "secret", ""); w.Code != http.StatusNoContent {
+		t.Errorf("DELETE %s = %d %s", p.ID, w.Code, w.Body)
+	}
+	if err := json.Unmarshal(request(t, h, "GET", "/v1/clusters/p1/processes", "secret", "").Body.Bytes(), &ps); err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 0 {
+		t.Errorf("GET processes after DELETE = %+v, want none", ps)
+	}
+}
+
+func TestServe(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets")
+	}
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	// the path of a unix socket is limited to about a hundred characters
+	dir, err := os.MkdirTemp("", "daemon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "d.sock")
+	token, err := WriteToken(filepath.Join(dir, "token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() { errs <- Serve(ctx, socket, NewServer(token, "minikube")) }()
+
+	c := http.Client{Transport: &http.Transport{DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", socket)
+	}}}
+	var resp *http.Response
+	for i := 0; i < 50; i++ {
+		req, _ := http.NewRequest("GET", "http://minikube/v1/clusters", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if resp, err = c.Do(req); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("GET /v1/clusters: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /v1/clusters = %d, want 200", resp.StatusCode)
+	}
+	if fi, err := os.Stat(socket); err != nil || fi.Mode().Perm() != 0o600 {
+		t.Errorf("socket mode = %v (%v), want 0600", fi.Mode(), err)
+	}
+
+	cancel()
+	if err := <-errs; err != nil {
+		t.Errorf("Serve() = %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+)
+
+// Process is a tunnel or a mount of a cluster, run by the minikube binary until it is stopped
+type Process struct {
+	ID      string
+	Cluster string
+	// Kind is tunnel or mount
+	Kind    string
+	Args    []string
+	PID     int
+	Started time.Time
+
+	cmd  *exec.Cmd
+	done chan struct{}
+}
+
+// mountRequest is the body of POST /v1/clusters/{name}/mounts
+type mountRequest struct {
+	// Source is the directory of the host, and Target where it is mounted in the nodes
+	Source string
+	Target string
+}
+
+func (s *Server) startTunnel(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	s.mu.Lock()
+	for _, p := range s.processes {
+		if p.Cluster == name && p.Kind == "tunnel" {
+			s.mu.Unlock()
+			writeJSON(w, http.StatusOK, p)
+			return
+		}
+	}
+	s.mu.Unlock()
+	s.start(w, name, "tunnel", []string{"tunnel", "--profile", name})
+}
+
+func (s *Server) startMount(w http.ResponseWriter, r *http.Request) {
+	var m mountRequest
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if m.Source == "" || m.Target == "" {
+		writeError(w, http.StatusBadRequest, errors.New("the Source and Target of the mount are required"))
+		return
+	}
+	name := r.PathValue("name")
+	s.start(w, name, "mount", []string{"mount", "--profile", name, m.Source + ":" + m.Target})
+}
+
+// start runs the minikube binary with args for the cluster name, and writes the Process
+func (s *Server) start(w http.ResponseWriter, name, kind string, args []string) {
+	if !config.ProfileExists(name) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("cluster %s not found", name))
+		return
+	}
+	c := exec.Command(s.binary, args...)
+	c.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
+	if err := c.Start(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	p := &Process{
+		ID:      fmt.Sprintf("%s-%d", kind, c.Process.Pid),
+		Cluster: name,
+		Kind:    kind,
+		Args:    args,
+		PID:     c.Process.Pid,
+		Started: time.Now(),
+		cmd:     c,
+		done:    make(chan struct{}),
+	}
+	s.mu.Lock()
+	s.processes[p.ID] = p
+	s.mu.Unlock()
+	klog.Infof("started %s %s, pid %d", s.binary, strings.Join(args, " "), p.PID)
+
+	go func() {
+		err := c.Wait()
+		klog.Infof("%s exited: %v", p.ID, err)
+		s.mu.Lock()
+		delete(s.processes, p.ID)
+		s.mu.Unlock()
+		close(p.done)
+	}()
+	writeJSON(w, http.StatusCreated, p)
+}
+
+func (s *Server) listProcesses(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	s.mu.Lock()
+	ps := []*Process{}
+	for _, p := range s.processes {
+		if p.Cluster == name {
+			ps = append(ps, p)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(ps, func(i, j int) bool { return ps[i].Started.Before(ps[j].Started) })
+	writeJSON(w, http.StatusOK, ps)
+}
+
+func (s *Server) stopProcess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	p, ok := s.processes[r.PathValue("id")]
+	s.mu.Unlock()
+	if !ok || p.Cluster != r.PathValue("name") {
+		writeError(w, http.StatusNotFound, fmt.Errorf("process %s not found", r.PathValue("id")))
+		return
+	}
+	stop(p)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// stop interrupts p, so that a tunnel removes its routes and a mount unmounts, and kills it if it does not exit in time
+func stop(p *Process) {
+	// processes cannot be interrupted on windows
+	if runtime.GOOS == "windows" || p.cmd.Process.Signal(os.Interrupt) != nil {
+		_ = p.cmd.Process.Kill()
+	}
+	select {
+	case <-p.done:
+	case <-time.After(30 * time.Second):
+		klog.Warningf("killing %s, which did not exit on interrupt", p.ID)
+		_ = p.cmd.Process.Kill()
+		<-p.done
+	}
+}
+
+// stopClusterProcesses stops the tunnels and mounts of the cluster name
+func (s *Server) stopClusterProcesses(name string) {
+	s.mu.Lock()
+	var ps []*Process
+	for _, p := range s.processes {
+		if p.Cluster == name {
+			ps = append(ps, p)
+		}
+	}
+	s.mu.Unlock()
+	for _, p := range ps {
+		stop(p)
+	}
+}
+
+// stopProcesses stops every tunnel and mount
+func (s *Server) stopProcesses() {
+	s.mu.Lock()
+	var ps []*Process
+	for _, p := range s.processes {
+		ps = append(ps, p)
+	}
+	s.mu.Unlock()
+	for _, p := range ps {
+		stop(p)
+	}
+}
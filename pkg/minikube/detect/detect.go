@@ -122,7 +122,12 @@ func GithubActionRunner() bool {
 
 // ImageCacheDir returns the path in the minikube home directory to the container image cache for the current architecture
 func ImageCacheDir() string {
-	return filepath.Join(localpath.MakeMiniPath("cache", "images"), runtime.GOARCH)
+	return ArchImageCacheDir(runtime.GOARCH)
+}
+
+// ArchImageCacheDir returns the path in the minikube home directory to the container image cache for the architecture arch
+func ArchImageCacheDir(arch string) string {
+	return filepath.Join(localpath.MakeMiniPath("cache", "images"), arch)
 }
 
 // KICCacheDir returns the path in the minikube home directory to the container node cache for the current architecture
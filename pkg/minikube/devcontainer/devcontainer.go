@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package devcontainer generates the settings of devcontainer.json that expose a cluster to a dev container,
+// so that docker and kubectl in the container target the cluster.
+package devcontainer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/minikube/constants"
+)
+
+// Dir is where the files of the cluster are mounted in the dev container
+const Dir = "/minikube"
+
+// Options are how the dev container reaches the cluster
+type Options struct {
+	// Network is the container network of the nodes, which the dev container joins, for the docker and podman drivers
+	Network string
+	// Kubeconfig is the path of a kubeconfig on the host that reaches the cluster from the dev container
+	Kubeconfig string
+	// DockerHost is the docker daemon of the cluster, as reached from the dev container, eg: tcp://192.168.49.2:2376.
+	// It is only set for the docker container runtime.
+	DockerHost string
+	// CertsDir is the path on the host of the client certificates of the docker daemon
+	CertsDir string
+}
+
+// Config is the part of devcontainer.json that exposes a cluster to a dev container
+type Config struct {
+	RunArgs      []string          `json:"runArgs,omitempty"`
+	ContainerEnv map[string]string `json:"containerEnv"`
+	Mounts       []string          `json:"mounts"`
+}
+
+// Generate returns the settings of devcontainer.json of o
+func Generate(o Options) Config {
+	c := Config{
+		ContainerEnv: map[string]string{constants.KubeconfigEnvVar: Dir + "/kubeconfig"},
+		Mounts:       []string{mount(o.Kubeconfig, Dir+"/kubeconfig")},
+	}
+	if o.Network != "" {
+		c.RunArgs = []string{"--network=" + o.Network}
+	}
+	if o.DockerHost != "" {
+		c.ContainerEnv[constants.DockerHostEnv] = o.DockerHost
+		c.ContainerEnv[constants.DockerTLSVerifyEnv] = "1"
+		c.ContainerEnv[constants.DockerCertPathEnv] = Dir + "/certs"
+		c.Mounts = append(c.Mounts, mount(o.CertsDir, Dir+"/certs"))
+	}
+	return c
+}
+
+// mount returns a read-only bind mount of source at target, in the syntax of devcontainer.json
+func mount(source, target string) string {
+	return fmt.Sprintf("source=%s,target=%s,type=bind,readonly", source, target)
+}
+
+// Merge merges c into the devcontainer.json at path, which is created if it does not exist.
+// The settings of a previous Merge are replaced: the --network run argument, the variables and the mounts under Dir.
+// devcontainer.json files with comments cannot be merged, as they are not plain JSON.
+func Merge(path string, c Config) error {
+	doc := map[string]interface{}{}
+	b, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		if err := json.Unmarshal(b, &doc); err != nil {
+			return errors.Wrapf(err, "%s is not plain JSON, eg: it has comments, so the settings must be merged by hand", path)
+		}
+	}
+
+	var runArgs []interface{}
+	if l, ok := doc["runArgs"].([]interface{}); ok {
+		for i := 0; i < len(l); i++ {
+			switch a, _ := l[i].(string); {
+			case a == "--network":
+				// and its value
+				i++
+			case strings.HasPrefix(a, "--network="):
+			default:
+				runArgs = append(runArgs, l[i])
+			}
+		}
+	}
+	for _, a := range c.RunArgs {
+		runArgs = append(runArgs, a)
+	}
+	doc["runArgs"] = runArgs
+	if len(runArgs) == 0 {
+		delete(doc, "runArgs")
+	}
+
+	env, _ := doc["containerEnv"].(map[string]interface{})
+	if env == nil {
+		env = map[string]interface{}{}
+	}
+	// the docker daemon of a previous Merge, which is not exposed anymore for another container runtime
+	if env[constants.DockerCertPathEnv] == Dir+"/certs" {
+		for _, k := range constants.DockerDaemonEnvs {
+			delete(env, k)
+		}
+	}
+	for k, v := range c.ContainerEnv {
+		env[k] = v
+	}
+	doc["containerEnv"] = env
+
+	var mounts []interface{}
+	if l, ok := doc["mounts"].([]interface{}); ok {
+		for _, m := range l {
+			if s, ok := m.(string); ok && strings.Contains(s, "target="+Dir+"/") {
+				continue
+			}
+			mounts = append(mounts, m)
+		}
+	}
+	for _, m := range c.Mounts {
+		mounts = append(mounts, m)
+	}
+	doc["mounts"] = mounts
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrap(err, "mkdir")
+	}
+	return os.WriteFile(path, append(out, '\n'), 0o644)
+}
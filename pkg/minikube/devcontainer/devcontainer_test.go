@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package devcontainer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	got := Generate(Options{
+		Network:    "minikube",
+		Kubeconfig: "/home/me/.minikube/profiles/minikube/devcontainer-kubeconfig",
+		DockerHost: "tcp://192.168.49.2:2376",
+		CertsDir:   "/home/me/.minikube/certs",
+	})
+	want := Config{
+		RunArgs: []string{"--network=minikube"},
+		ContainerEnv: map[string]string{
+			"KUBECONFIG":        "/minikube/kubeconfig",
+			"DOCKER_HOST":       "tcp://192.168.49.2:2376",
+			"DOCKER_TLS_VERIFY": "1",
+			"DOCKER_CERT_PATH":  "/minikube/certs",
+		},
+		Mounts: []string{
+			"source=/home/me/.minikube/profiles/minikube/devcontainer-kubeconfig,target=/minikube/kubeconfig,type=bind,readonly",
+			"source=/home/me/.minikube/certs,target=/minikube/certs,type=bind,readonly",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Generate() = %+v, want %+v", got, want)
+	}
+
+	// a VM with the containerd runtime
+	got = Generate(Options{Kubeconfig: "/kc"})
+	if got.RunArgs != nil || len(got.Mounts) != 1 || len(got.ContainerEnv) != 1 {
+		t.Errorf("Generate() = %+v, want the kubeconfig only", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".devcontainer", "devcontainer.json")
+	existing := `{
+  "image": "mcr.microsoft.com/devcontainers/go:1",
+  "runArgs": ["--network", "old", "--cap-add=SYS_PTRACE"],
+  "containerEnv": {"GOFLAGS": "-mod=mod"},
+  "mounts": ["source=/cache,target=/cache,type=bind", "source=/old,target=/minikube/certs,type=bind,readonly"]
+}`
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(existing), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	docker := Generate(Options{Network: "minikube", Kubeconfig: "/kc", DockerHost: "tcp://192.168.49.2:2376", CertsDir: "/certs"})
+	if err := Merge(path, docker); err != nil {
+		t.Fatalf("Merge() = %v", err)
+	}
+	// merging again replaces the settings of the previous merge
+	if err := Merge(path, Generate(Options{Network: "minikube", Kubeconfig: "/kc"})); err != nil {
+		t.Fatalf("Merge() = %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"image":        "mcr.microsoft.com/devcontainers/go:1",
+		"runArgs":      []interface{}{"--cap-add=SYS_PTRACE", "--network=minikube"},
+		"containerEnv": map[string]interface{}{"GOFLAGS": "-mod=mod", "KUBECONFIG": "/minikube/kubeconfig"},
+		"mounts":       []interface{}{"source=/cache,target=/cache,type=bind", "source=/kc,target=/minikube/kubeconfig,type=bind,readonly"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() wrote %s, want %v", b, want)
+	}
+
+	if err := os.WriteFile(path, []byte("// comments\n{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Merge(path, docker); err == nil {
+		t.Errorf("Merge() of a file with comments = nil, want an error")
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/docker/machine/libmachine/cert"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// RemoteOptions configures the exposure of the Docker daemon of a cluster to other machines
+type RemoteOptions struct {
+	// Host is the address of the host interface that the other machines connect to
+	Host string
+	// Port is the port that the other machines connect to
+	Port int
+	// Upstream is the address of the Docker daemon of the cluster, as reached from the host
+	Upstream string
+	// CertsDir holds the CA that signs the certs of the Docker daemon, ca.pem and ca-key.pem, and the client cert of the host, cert.pem and key.pem
+	CertsDir string
+	// RemoteDir is where the certs of the other machines, and of the proxy that they connect to, are written
+	RemoteDir string
+}
+
+// ClientCertsDir returns the directory of the certs to copy to the other machines, as their DOCKER_CERT_PATH
+func (o RemoteOptions) ClientCertsDir() string {
+	return filepath.Join(o.RemoteDir, "client")
+}
+
+func (o RemoteOptions) serverCertsDir() string {
+	return filepath.Join(o.RemoteDir, "server")
+}
+
+// GenerateRemoteCerts writes the certs of the other machines, which are kept so that they remain valid once copied,
+// and the server cert of the proxy, which is issued for o.Host every time. Both are signed by the CA of the Docker daemon.
+func GenerateRemoteCerts(o RemoteOptions) error {
+	caCert := filepath.Join(o.CertsDir, "ca.pem")
+	caKey := filepath.Join(o.CertsDir, "ca-key.pem")
+	client := o.ClientCertsDir()
+	server := o.serverCertsDir()
+	for _, dir := range []string{client, server} {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return errors.Wrapf(err, "mkdir %s", dir)
+		}
+	}
+
+	ca, err := os.ReadFile(caCert)
+	if err != nil {
+		return errors.Wrap(err, "read CA")
+	}
+	if err := os.WriteFile(filepath.Join(client, "ca.pem"), ca, 0o644); err != nil {
+		return errors.Wrap(err, "write CA")
+	}
+
+	if _, err := os.Stat(filepath.Join(client, "cert.pem")); os.IsNotExist(err) {
+		klog.Infof("generating remote docker client cert in %s", client)
+		// an empty host makes a client cert
+		if err := cert.GenerateCert(&cert.Options{
+			Hosts:     []string{""},
+			CertFile:  filepath.Join(client, "cert.pem"),
+			KeyFile:   filepath.Join(client, "key.pem"),
+			CAFile:    caCert,
+			CAKeyFile: caKey,
+			Org:       "minikube-remote",
+			Bits:      2048,
+		}); err != nil {
+			return errors.Wrap(err, "generate client cert")
+		}
+	}
+
+	klog.Infof("generating remote docker server cert for %s in %s", o.Host, server)
+	if err := cert.GenerateCert(&cert.Options{
+		Hosts:     []string{o.Host},
+		CertFile:  filepath.Join(server, "server.pem"),
+		KeyFile:   filepath.Join(server, "server-key.pem"),
+		CAFile:    caCert,
+		CAKeyFile: caKey,
+		Org:       "minikube-remote",
+		Bits:      2048,
+	}); err != nil {
+		return errors.Wrap(err, "generate server cert")
+	}
+	return nil
+}
+
+// ServeRemote listens on o.Host and o.Port, over TLS, for the other machines that present a client cert signed by the CA of the Docker daemon,
+// and forwards their connections to the Docker daemon of the cluster, with the client cert of the host, until ctx is done
+func ServeRemote(ctx context.Context, o RemoteOptions) error {
+	ca, err := os.ReadFile(filepath.Join(o.CertsDir, "ca.pem"))
+	if err != nil {
+		return errors.Wrap(err, "read CA")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return fmt.Errorf("no certificates in %s", filepath.Join(o.CertsDir, "ca.pem"))
+	}
+	serverCert, err := tls.LoadX509KeyPair(filepath.Join(o.serverCertsDir(), "server.pem"), filepath.Join(o.serverCertsDir(), "server-key.pem"))
+	if err != nil {
+		return errors.Wrap(err, "load server cert")
+	}
+	clientCert, err := tls.LoadX509KeyPair(filepath.Join(o.CertsDir, "cert.pem"), filepath.Join(o.CertsDir, "key.pem"))
+	if err != nil {
+		return errors.Wrap(err, "load client cert")
+	}
+
+	ln, err := tls.Listen("tcp", net.JoinHostPort(o.Host, strconv.Itoa(o.Port)), &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})
+	if err != nil {
+		return errors.Wrap(err, "listen")
+	}
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	upstream := &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		c, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "accept")
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forward(ctx, c, o.Upstream, upstream)
+		}()
+	}
+}
+
+// forward copies the traffic of c to the Docker daemon at addr, and back, until either side closes its connection
+func forward(ctx context.Context, c net.Conn, addr string, upstream *tls.Config) {
+	defer c.Close()
+	d := tls.Dialer{Config: upstream}
+	u, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		klog.Warningf("connecting %s to the docker daemon: %v", c.RemoteAddr(), err)
+		return
+	}
+	defer u.Close()
+	klog.Infof("forwarding %s to %s", c.RemoteAddr(), addr)
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(u, c)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(c, u)
+		done <- struct{}{}
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/docker/machine/libmachine/cert"
+)
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func TestServeRemote(t *testing.T) {
+	certs := t.TempDir()
+	ca := filepath.Join(certs, "ca.pem")
+	caKey := filepath.Join(certs, "ca-key.pem")
+	if err := cert.GenerateCACertificate(ca, caKey, "minikube", 2048); err != nil {
+		t.Fatalf("generate CA: %v", err)
+	}
+	gen := func(host, certFile, keyFile string) {
+		if err := cert.GenerateCert(&cert.Options{Hosts: []string{host}, CertFile: certFile, KeyFile: keyFile, CAFile: ca, CAKeyFile: caKey, Org: "minikube", Bits: 2048}); err != nil {
+			t.Fatalf("generate cert: %v", err)
+		}
+	}
+	gen("", filepath.Join(certs, "cert.pem"), filepath.Join(certs, "key.pem"))
+	gen("127.0.0.1", filepath.Join(certs, "server.pem"), filepath.Join(certs, "server-key.pem"))
+
+	caPEM, err := os.ReadFile(ca)
+	if err != nil {
+		t.Fatalf("read CA: %v", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(caPEM)
+
+	// the docker daemon of the cluster echoes what it reads
+	daemonCert, err := tls.LoadX509KeyPair(filepath.Join(certs, "server.pem"), filepath.Join(certs, "server-key.pem"))
+	if err != nil {
+		t.Fatalf("load daemon cert: %v", err)
+	}
+	daemon, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{daemonCert}, ClientCAs: pool, ClientAuth: tls.RequireAndVerifyClientCert})
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer daemon.Close()
+	go func() {
+		for {
+			c, err := daemon.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				_, _ = io.Copy(c, c)
+			}()
+		}
+	}()
+
+	o := RemoteOptions{Host: "127.0.0.1", Port: freePort(t), Upstream: daemon.Addr().String(), CertsDir: certs, RemoteDir: t.TempDir()}
+	if err := GenerateRemoteCerts(o); err != nil {
+		t.Fatalf("GenerateRemoteCerts: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error)
+	go func() { served <- ServeRemote(ctx, o) }()
+	addr := net.JoinHostPort(o.Host, strconv.Itoa(o.Port))
+
+	clientCert, err := tls.LoadX509KeyPair(filepath.Join(o.ClientCertsDir(), "cert.pem"), filepath.Join(o.ClientCertsDir(), "key.pem"))
+	if err != nil {
+		t.Fatalf("load remote client cert: %v", err)
+	}
+	dial := func(certs []tls.Certificate) (*tls.Conn, error) {
+		var c *tls.Conn
+		var err error
+		for i := 0; i < 50; i++ {
+			c, err = tls.Dial("tcp", addr, &tls.Config{Certificates: certs, RootCAs: pool})
+			if err == nil {
+				return c, c.Handshake()
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		return nil, err
+	}
+
+	c, err := dial([]tls.Certificate{clientCert})
+	if err != nil {
+		t.Fatalf("dial with the remote client cert: %v", err)
+	}
+	if _, err := c.Write([]byte("ping")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got := make([]byte, 4)
+	if _, err := io.ReadFull(c, got); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(got) != "ping" {
+		t.Errorf("read %q, want %q", got, "ping")
+	}
+	c.Close()
+
+	// without a client cert, the proxy rejects the connection
+	if c, err := dial(nil); err == nil {
+		_ = c.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if _, err := c.Read(make([]byte, 1)); err == nil {
+			t.Errorf("a connection without a client cert was accepted")
+		}
+		c.Close()
+	}
+
+	cancel()
+	if err := <-served; err != nil {
+		t.Errorf("ServeRemote: %v", err)
+	}
+}
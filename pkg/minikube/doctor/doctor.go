@@ -0,0 +1,311 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package doctor checks that the host can run the nodes of a cluster, before minikube start or minikube node add,
+// and reports how to fix the problems that it finds.
+package doctor
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/registry"
+	"k8s.io/minikube/pkg/util"
+)
+
+const (
+	// minMemory is the memory of a node in MB under which kubeadm does not start
+	minMemory = 1800
+	// osMemory is the memory in MB that the host keeps for itself
+	osMemory = 1024
+	// minFreeDisk is the free space in MB of the minikube home that the ISO, the preload and the images need
+	minFreeDisk = 5000
+
+	vpnDoc = "https://minikube.sigs.k8s.io/docs/handbook/vpn_and_proxy/"
+)
+
+// Status is the result of a Check
+type Status string
+
+const (
+	// OK is a check that passed
+	OK Status = "ok"
+	// Warning is a problem that the cluster may run with, eg: with degraded performance
+	Warning Status = "warning"
+	// Failure is a problem that the cluster will not run with
+	Failure Status = "failure"
+)
+
+// Check is the result of one check of the host
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+	// Reason is the ID of the reason.Kind that minikube start fails or warns with for the same problem, if any
+	Reason string `json:"reason,omitempty"`
+	Fix    string `json:"fix,omitempty"`
+	Doc    string `json:"doc,omitempty"`
+}
+
+// Report is the result of the checks of the host, in a deterministic order
+type Report struct {
+	Driver string  `json:"driver"`
+	Checks []Check `json:"checks"`
+}
+
+// Failed returns whether any check of r failed
+func (r Report) Failed() bool {
+	for _, c := range r.Checks {
+		if c.Status == Failure {
+			return true
+		}
+	}
+	return false
+}
+
+// Request is the nodes that the host is checked for
+type Request struct {
+	Driver string
+	// Memory and DiskSize are per node, in MB
+	Memory   int
+	DiskSize int
+	Nodes    int
+}
+
+// Host is what the checks know of the host
+type Host struct {
+	OS          string
+	DriverState registry.State
+	// Memory is the total memory of the host, and ContainerMemory the one of the docker or podman daemon, in MB
+	Memory          int
+	ContainerMemory int
+	// FreeDisk is the free space of the minikube home in MB, and FreeDiskPath its path
+	FreeDisk     int
+	FreeDiskPath string
+	// CPUFlags are the flags of the CPU, eg: vmx, only known on Linux
+	CPUFlags []string
+	// Interfaces are the names of the network interfaces that are up
+	Interfaces []string
+}
+
+// Inspect returns what the checks need to know of the host, for the driver of req
+func Inspect(req Request) Host {
+	h := Host{OS: runtime.GOOS, DriverState: driver.Status(req.Driver).State}
+	if v, err := mem.VirtualMemory(); err == nil {
+		h.Memory = int(util.ConvertUnsignedBytesToMB(v.Total))
+	} else {
+		klog.Warningf("unable to get the memory of the host: %v", err)
+	}
+	if driver.IsKIC(req.Driver) && h.DriverState.Healthy {
+		if info, err := oci.CachedDaemonInfo(req.Driver); err == nil {
+			h.ContainerMemory = util.ConvertBytesToMB(info.TotalMemory)
+		} else {
+			klog.Warningf("unable to get the memory of %s: %v", req.Driver, err)
+		}
+	}
+	// the minikube home is only created by the first minikube start
+	h.FreeDiskPath = localpath.MiniPath()
+	for {
+		if _, err := os.Stat(h.FreeDiskPath); !os.IsNotExist(err) || filepath.Dir(h.FreeDiskPath) == h.FreeDiskPath {
+			break
+		}
+		h.FreeDiskPath = filepath.Dir(h.FreeDiskPath)
+	}
+	if d, err := disk.Usage(h.FreeDiskPath); err == nil {
+		h.FreeDisk = int(util.ConvertUnsignedBytesToMB(d.Free))
+	} else {
+		klog.Warningf("unable to get the free space of %s: %v", h.FreeDiskPath, err)
+	}
+	if h.OS == "linux" {
+		if infos, err := cpu.Info(); err == nil && len(infos) > 0 {
+			h.CPUFlags = infos[0].Flags
+		} else {
+			klog.Warningf("unable to get the CPU flags: %v", err)
+		}
+	}
+	if ifaces, err := net.Interfaces(); err == nil {
+		for _, i := range ifaces {
+			if i.Flags&net.FlagUp != 0 {
+				h.Interfaces = append(h.Interfaces, i.Name)
+			}
+		}
+	} else {
+		klog.Warningf("unable to list the network interfaces: %v", err)
+	}
+	return h
+}
+
+// Run checks that h can run the nodes of req
+func Run(req Request, h Host) Report {
+	r := Report{Driver: req.Driver, Checks: []Check{checkDriver(req, h)}}
+	if c, ok := checkVirtualization(req, h); ok {
+		r.Checks = append(r.Checks, c)
+	}
+	r.Checks = append(r.Checks, checkMemory(req, h), checkDisk(req, h), checkNetwork(h))
+	return r
+}
+
+// checkDriver reports the status of the driver, eg: that Hyper-V is not enabled, or that the user is not an Administrator
+func checkDriver(req Request, h Host) Check {
+	c := Check{Name: "driver", Status: OK, Reason: h.DriverState.Reason, Fix: h.DriverState.Fix, Doc: h.DriverState.Doc}
+	st := h.DriverState
+	switch {
+	case !st.Installed:
+		c.Status = Failure
+		c.Message = fmt.Sprintf("%s is not installed", req.Driver)
+		if st.Error != nil {
+			c.Message += ": " + st.Error.Error()
+		}
+	case st.Error != nil:
+		c.Status = Failure
+		c.Message = st.Error.Error()
+	case !st.Healthy:
+		c.Status = Failure
+		c.Message = fmt.Sprintf("%s is not healthy", req.Driver)
+	case st.NeedsImprovement:
+		c.Status = Warning
+		c.Message = fmt.Sprintf("%s works, but could be improved", req.Driver)
+	default:
+		c.Message = fmt.Sprintf("%s is installed and healthy", req.Driver)
+		if st.Version != "" {
+			c.Message = fmt.Sprintf("%s %s is installed and healthy", req.Driver, st.Version)
+		}
+	}
+	return c
+}
+
+// checkVirtualization reports whether the CPU can run VMs, eg: in a VM without nested virtualization.
+// It is only known on Linux, the drivers of the other OSes check it themselves.
+func checkVirtualization(req Request, h Host) (Check, bool) {
+	if h.OS != "linux" || !driver.IsVM(req.Driver) || driver.IsSSH(req.Driver) || len(h.CPUFlags) == 0 {
+		return Check{}, false
+	}
+	c := Check{Name: "virtualization", Status: OK, Message: "the CPU supports hardware virtualization"}
+	if slices.Contains(h.CPUFlags, "vmx") || slices.Contains(h.CPUFlags, "svm") {
+		return c, true
+	}
+	c.Status = Failure
+	c.Reason = "PR_KVM_CAPABILITIES"
+	if slices.Contains(h.CPUFlags, "hypervisor") {
+		c.Message = "the host is a VM without nested virtualization"
+		c.Fix = "Enable nested virtualization in the hypervisor of the host, or use --driver=docker"
+		return c, true
+	}
+	c.Message = "the CPU does not support hardware virtualization, or it is disabled"
+	c.Fix = "Enable virtualization (VT-x or AMD-V) in the BIOS, or use --driver=docker"
+	return c, true
+}
+
+// checkMemory reports whether the memory of the host, or of the docker or podman daemon, fits the nodes
+func checkMemory(req Request, h Host) Check {
+	c := Check{Name: "memory", Status: OK}
+	need := req.Memory * req.Nodes
+	available, of := h.Memory, "the host"
+	if h.ContainerMemory > 0 {
+		available, of = h.ContainerMemory, req.Driver
+	}
+	switch {
+	case available == 0:
+		c.Status = Warning
+		c.Message = "unable to get the memory of " + of
+	case req.Memory < minMemory:
+		c.Status = Failure
+		c.Reason = reason.RsrcInsufficientReqMemory.ID
+		c.Message = fmt.Sprintf("%dMB per node is less than the usable minimum of %dMB", req.Memory, minMemory)
+		c.Fix = fmt.Sprintf("Use --memory=%dmb or more", minMemory)
+	case need > available:
+		c.Status = Failure
+		c.Reason = "RSRC_OVER_ALLOC_MEM"
+		c.Message = fmt.Sprintf("%d nodes of %dMB need %dMB, more than the %dMB of %s", req.Nodes, req.Memory, need, available, of)
+		c.Fix = "Use fewer nodes"
+		if available/req.Nodes >= minMemory {
+			c.Fix = fmt.Sprintf("Use at most --memory=%dmb with %d nodes", available/req.Nodes, req.Nodes)
+		}
+	case h.ContainerMemory == 0 && need > available-osMemory:
+		c.Status = Warning
+		c.Reason = "RSRC_OVER_ALLOC_MEM"
+		c.Message = fmt.Sprintf("%d nodes of %dMB leave less than %dMB of the %dMB of the host to the host itself", req.Nodes, req.Memory, osMemory, available)
+		c.Fix = "Use less memory or fewer nodes"
+	default:
+		c.Message = fmt.Sprintf("%d nodes of %dMB fit in the %dMB of %s", req.Nodes, req.Memory, available, of)
+	}
+	return c
+}
+
+// checkDisk reports whether the minikube home has the space for the caches, and, with the VM drivers, for the disks of the nodes
+func checkDisk(req Request, h Host) Check {
+	c := Check{Name: "disk", Status: OK}
+	need := minFreeDisk
+	if driver.IsVM(req.Driver) && !driver.IsSSH(req.Driver) {
+		need += req.DiskSize * req.Nodes
+	}
+	switch {
+	case h.FreeDisk == 0:
+		c.Status = Warning
+		c.Message = "unable to get the free space of " + h.FreeDiskPath
+	case h.FreeDisk < minFreeDisk:
+		c.Status = Failure
+		c.Reason = reason.RsrcInsufficientStorage.ID
+		c.Message = fmt.Sprintf("%s only has %dMB free, less than the %dMB of the caches of minikube", h.FreeDiskPath, h.FreeDisk, minFreeDisk)
+		c.Fix = "Free some space, or set MINIKUBE_HOME to a directory of another disk"
+	case h.FreeDisk < need:
+		// the disks of the VMs grow as they are written to
+		c.Status = Warning
+		c.Reason = reason.RsrcInsufficientStorage.ID
+		c.Message = fmt.Sprintf("the disks of %d nodes of %dMB may grow beyond the %dMB free in %s", req.Nodes, req.DiskSize, h.FreeDisk, h.FreeDiskPath)
+		c.Fix = "Use a smaller --disk-size, free some space, or set MINIKUBE_HOME to a directory of another disk"
+	default:
+		c.Message = fmt.Sprintf("%s has %dMB free", h.FreeDiskPath, h.FreeDisk)
+	}
+	return c
+}
+
+// vpnInterfaces are the prefixes of the names of the network interfaces of VPNs, in lower case.
+// The utun interfaces of macOS are not among them, as they are also the ones of its own services.
+var vpnInterfaces = []string{"tun", "tap", "ppp", "wg", "ipsec", "gpd", "cscotun", "nordlynx", "tailscale", "zt"}
+
+// checkNetwork reports the network interfaces of VPNs, which may route the traffic to the nodes away from them
+func checkNetwork(h Host) Check {
+	c := Check{Name: "network", Status: OK, Message: "no VPN network interface is up"}
+	var vpns []string
+	for _, name := range h.Interfaces {
+		lower := strings.ToLower(name)
+		if strings.Contains(lower, "vpn") || slices.ContainsFunc(vpnInterfaces, func(prefix string) bool { return strings.HasPrefix(lower, prefix) }) {
+			vpns = append(vpns, name)
+		}
+	}
+	if len(vpns) > 0 {
+		c.Status = Warning
+		c.Message = fmt.Sprintf("the network interfaces %s may be the ones of a VPN, which can route the traffic to the nodes away from them", strings.Join(vpns, ", "))
+		c.Fix = "If the cluster is unreachable, disconnect the VPN, or exclude the network of minikube from it"
+		c.Doc = vpnDoc
+	}
+	return c
+}
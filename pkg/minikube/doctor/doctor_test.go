@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"errors"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/registry"
+)
+
+func TestRun(t *testing.T) {
+	healthy := registry.State{Installed: true, Healthy: true}
+	host := Host{OS: "linux", DriverState: healthy, Memory: 16000, FreeDisk: 100000, FreeDiskPath: "/home/me/.minikube", CPUFlags: []string{"vmx"}, Interfaces: []string{"lo", "eth0"}}
+
+	tests := []struct {
+		description string
+		req         Request
+		host        func(h *Host)
+		// want is the status of each check, in order
+		want   []Status
+		reason string
+	}{
+		{"docker", Request{Driver: "docker", Memory: 4000, DiskSize: 20000, Nodes: 1}, nil, []Status{OK, OK, OK, OK}, ""},
+		{"kvm2", Request{Driver: "kvm2", Memory: 4000, DiskSize: 20000, Nodes: 1}, nil, []Status{OK, OK, OK, OK, OK}, ""},
+		{"not administrator", Request{Driver: "docker", Memory: 4000, DiskSize: 20000, Nodes: 1}, func(h *Host) {
+			h.DriverState = registry.State{Installed: true, Error: errors.New("Hyper-V requires Administrator privileges"), Fix: "Run as Administrator"}
+		}, []Status{Failure, OK, OK, OK}, ""},
+		{"no nested virtualization", Request{Driver: "kvm2", Memory: 4000, DiskSize: 20000, Nodes: 1}, func(h *Host) {
+			h.CPUFlags = []string{"hypervisor"}
+		}, []Status{OK, Failure, OK, OK, OK}, "PR_KVM_CAPABILITIES"},
+		{"too little memory per node", Request{Driver: "docker", Memory: 1000, DiskSize: 20000, Nodes: 1}, nil, []Status{OK, Failure, OK, OK}, "RSRC_INSUFFICIENT_REQ_MEMORY"},
+		{"too many nodes", Request{Driver: "docker", Memory: 4000, DiskSize: 20000, Nodes: 5}, nil, []Status{OK, Failure, OK, OK}, "RSRC_OVER_ALLOC_MEM"},
+		{"docker daemon memory", Request{Driver: "docker", Memory: 4000, DiskSize: 20000, Nodes: 2}, func(h *Host) {
+			h.ContainerMemory = 6000
+		}, []Status{OK, Failure, OK, OK}, "RSRC_OVER_ALLOC_MEM"},
+		{"little memory left to the host", Request{Driver: "docker", Memory: 5000, DiskSize: 20000, Nodes: 3}, nil, []Status{OK, Warning, OK, OK}, "RSRC_OVER_ALLOC_MEM"},
+		{"no space for the caches", Request{Driver: "docker", Memory: 4000, DiskSize: 20000, Nodes: 1}, func(h *Host) {
+			h.FreeDisk = 2000
+		}, []Status{OK, OK, Failure, OK}, "RSRC_INSUFFICIENT_STORAGE"},
+		{"no space for the disks of the VMs", Request{Driver: "kvm2", Memory: 4000, DiskSize: 20000, Nodes: 1}, func(h *Host) {
+			h.FreeDisk = 10000
+		}, []Status{OK, OK, OK, Warning, OK}, "RSRC_INSUFFICIENT_STORAGE"},
+		{"vpn", Request{Driver: "docker", Memory: 4000, DiskSize: 20000, Nodes: 1}, func(h *Host) {
+			h.Interfaces = append(h.Interfaces, "tun0")
+		}, []Status{OK, OK, OK, Warning}, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			h := host
+			if tc.host != nil {
+				tc.host(&h)
+			}
+			r := Run(tc.req, h)
+			if len(r.Checks) != len(tc.want) {
+				t.Fatalf("Run() = %+v, want %d checks", r.Checks, len(tc.want))
+			}
+			failed := false
+			for i, c := range r.Checks {
+				if c.Status != tc.want[i] {
+					t.Errorf("check %s: status = %s, want %s: %s", c.Name, c.Status, tc.want[i], c.Message)
+				}
+				if c.Status == OK {
+					continue
+				}
+				failed = failed || c.Status == Failure
+				if c.Reason != tc.reason {
+					t.Errorf("check %s: reason = %q, want %q", c.Name, c.Reason, tc.reason)
+				}
+				if c.Fix == "" {
+					t.Errorf("check %s: no fix for %s", c.Name, c.Message)
+				}
+			}
+			if r.Failed() != failed {
+				t.Errorf("Failed() = %v, want %v", r.Failed(), failed)
+			}
+		})
+	}
+}
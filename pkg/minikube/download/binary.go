@@ -53,7 +53,8 @@ func binaryWithChecksumURL(binaryName, version, osName, archName, binaryURL stri
 	return fmt.Sprintf("%s?checksum=file:%s.sha1", base, base), nil
 }
 
-// Binary will download a binary onto the host
+// Binary will download a binary onto the host, into a cache shared by all profiles.
+// When binaryURL is a mirror that fails, the binary is downloaded from the default release host instead.
 func Binary(binary, version, osName, archName, binaryURL string) (string, error) {
 	targetDir := localpath.MakeMiniPath("cache", osName, archName, version)
 	targetFilepath := path.Join(targetDir, binary)
@@ -78,7 +79,17 @@ func Binary(binary, version, osName, archName, binaryURL string) (string, error)
 	}
 
 	if err := download(url, targetFilepath); err != nil {
-		return "", errors.Wrapf(err, "download failed: %s", url)
+		if binaryURL == "" || binaryURL == DefaultKubeBinariesURL() {
+			return "", errors.Wrapf(err, "download failed: %s", url)
+		}
+		klog.Warningf("download from mirror failed, falling back to %s: %v", DefaultKubeBinariesURL(), err)
+		fallback, ferr := binaryWithChecksumURL(binary, version, osName, archName, "")
+		if ferr != nil {
+			return "", ferr
+		}
+		if ferr := download(fallback, targetFilepath); ferr != nil {
+			return "", errors.Wrapf(ferr, "download failed: %s (mirror %s: %v)", fallback, url, err)
+		}
 	}
 
 	if osName == runtime.GOOS && archName == detect.EffectiveArch() {
@@ -67,7 +67,7 @@ func CreateDstDownloadMock(_, dst string) error {
 // download is a well-configured atomic download function
 func download(src, dst string) error {
 	var clientOptions []getter.ClientOption
-	if out.IsTerminal(os.Stdout) && !detect.GithubActionRunner() {
+	if out.IsTerminal(os.Stdout) && !detect.GithubActionRunner() && !out.Plain {
 		progress := getter.WithProgress(DefaultProgressBar)
 		if out.JSON {
 			progress = getter.WithProgress(DefaultJSONOutput)
@@ -132,7 +132,7 @@ func lockDownload(file string) (mutex.Releaser, error) {
 	go func() {
 		spec := lock.PathMutexSpec(file)
 		spec.Timeout = 5 * time.Minute
-		releaser, err := mutex.Acquire(spec)
+		releaser, err := lock.Acquire(spec)
 		if err != nil {
 			lockChannel <- retPair{nil, errors.Wrapf(err, "failed to acquire lock \"%s\": %+v", file, spec)}
 			return
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -30,12 +31,14 @@ import (
 // Force download tests to run in serial.
 func TestDownload(t *testing.T) {
 	t.Run("BinaryDownloadPreventsMultipleDownload", testBinaryDownloadPreventsMultipleDownload)
+	t.Run("BinaryMirrorFallback", testBinaryMirrorFallback)
 	t.Run("PreloadDownloadPreventsMultipleDownload", testPreloadDownloadPreventsMultipleDownload)
 	t.Run("ImageToCache", testImageToCache)
 	t.Run("PreloadNotExists", testPreloadNotExists)
 	t.Run("PreloadChecksumMismatch", testPreloadChecksumMismatch)
 	t.Run("PreloadExistsCaching", testPreloadExistsCaching)
 	t.Run("PreloadWithCachedSizeZero", testPreloadWithCachedSizeZero)
+	t.Run("PreloadCached", testPreloadCached)
 }
 
 // Returns a mock function that sleeps before incrementing `downloadsCounter` and creates the requested file.
@@ -78,6 +81,25 @@ func testBinaryDownloadPreventsMultipleDownload(t *testing.T) {
 	}
 }
 
+func testBinaryMirrorFallback(t *testing.T) {
+	var srcs []string
+	DownloadMock = func(src, dst string) error {
+		srcs = append(srcs, src)
+		if strings.HasPrefix(src, "https://mirror.example.com/") {
+			return fmt.Errorf("mirror unavailable")
+		}
+		return CreateDstDownloadMock(src, dst)
+	}
+	checkCache = func(_ string) (fs.FileInfo, error) { return nil, fmt.Errorf("not cached") }
+
+	if _, err := Binary("kubeadm", "v1.20.2", "linux", "amd64", "https://mirror.example.com/release"); err != nil {
+		t.Fatalf("Failed to download binary: %+v", err)
+	}
+	if len(srcs) != 2 || !strings.HasPrefix(srcs[1], DefaultKubeBinariesURL()) {
+		t.Errorf("Expected a download from the mirror, then from %s, but got %v", DefaultKubeBinariesURL(), srcs)
+	}
+}
+
 func testPreloadDownloadPreventsMultipleDownload(t *testing.T) {
 	downloadNum := 0
 	DownloadMock = mockSleepDownload(&downloadNum)
@@ -242,3 +264,28 @@ func testPreloadWithCachedSizeZero(t *testing.T) {
 		t.Errorf("Expected only 1 download attempt but got %v!", downloadNum)
 	}
 }
+
+func testPreloadCached(t *testing.T) {
+	f, err := os.CreateTemp("", "preload")
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(f.Name())
+
+	checkCache = func(_ string) (fs.FileInfo, error) { return os.Stat(f.Name()) }
+	if PreloadCached(constants.DefaultKubernetesVersion, constants.Docker) {
+		t.Errorf("Expected a cached preload of size zero not to count")
+	}
+
+	if _, err := f.Write([]byte("data")); err != nil {
+		t.Fatalf("failed to write to temp file: %v", err)
+	}
+	if !PreloadCached(constants.DefaultKubernetesVersion, constants.Docker) {
+		t.Errorf("Expected the preload to be cached")
+	}
+
+	checkCache = func(_ string) (fs.FileInfo, error) { return nil, fmt.Errorf("not found") }
+	if PreloadCached(constants.DefaultKubernetesVersion, constants.Docker) {
+		t.Errorf("Expected the preload not to be cached")
+	}
+}
@@ -144,7 +144,7 @@ func ImageToCache(img string) error {
 	var p *pb.ProgressBar
 	if out.JSON {
 		register.PrintDownloadProgress(img, "0")
-	} else {
+	} else if !out.Plain {
 		// if we need to print progress bar to stdout
 		p = pb.Full.Start64(0)
 		fn := image.Tag(ref.Name())
@@ -175,7 +175,7 @@ func ImageToCache(img string) error {
 					register.PrintDownloadProgress(img, fmt.Sprintf("%f", float64(update.Complete)/float64(update.Total)))
 					previousTime = now
 				}
-			} else {
+			} else if p != nil {
 				p.SetCurrent(update.Complete)
 				p.SetTotal(update.Total)
 			}
@@ -183,7 +183,7 @@ func ImageToCache(img string) error {
 		case err = <-errchan:
 			if out.JSON {
 				register.PrintDownloadProgress(img, "1")
-			} else {
+			} else if p != nil {
 				p.Finish()
 			}
 			if err != nil {
@@ -26,7 +26,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/juju/mutex/v2"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/minikube/detect"
@@ -40,13 +39,19 @@ const fileScheme = "file"
 
 // DefaultISOURLs returns a list of ISO URL's to consult by default, in priority order
 func DefaultISOURLs() []string {
+	return ISOURLs(runtime.GOARCH)
+}
+
+// ISOURLs returns the ISO URL's of the CPU architecture arch, eg: for a node of another arch than the host, in priority order.
+// They all have the same file name, so the ISO is cached at the same path whichever one it is downloaded from.
+func ISOURLs(arch string) []string {
 	v := version.GetISOVersion()
 	isoBucket := "minikube-builds/iso/19038"
 
 	return []string{
-		fmt.Sprintf("https://storage.googleapis.com/%s/minikube-%s-%s.iso", isoBucket, v, runtime.GOARCH),
-		fmt.Sprintf("https://github.com/kubernetes/minikube/releases/download/%s/minikube-%s-%s.iso", v, v, runtime.GOARCH),
-		fmt.Sprintf("https://kubernetes.oss-cn-hangzhou.aliyuncs.com/minikube/iso/minikube-%s-%s.iso", v, runtime.GOARCH),
+		fmt.Sprintf("https://storage.googleapis.com/%s/minikube-%s-%s.iso", isoBucket, v, arch),
+		fmt.Sprintf("https://github.com/kubernetes/minikube/releases/download/%s/minikube-%s-%s.iso", v, v, arch),
+		fmt.Sprintf("https://kubernetes.oss-cn-hangzhou.aliyuncs.com/minikube/iso/minikube-%s-%s.iso", v, arch),
 	}
 }
 
@@ -123,7 +128,7 @@ func downloadISO(isoURL string, skipChecksum bool) error {
 	spec := lock.PathMutexSpec(dst)
 	spec.Timeout = 10 * time.Minute
 	klog.Infof("acquiring lock: %+v", spec)
-	releaser, err := mutex.Acquire(spec)
+	releaser, err := lock.Acquire(spec)
 	if err != nil {
 		return errors.Wrapf(err, "unable to acquire lock for %+v", spec)
 	}
@@ -156,6 +156,17 @@ func PreloadExists(k8sVersion, containerRuntime, driverName string, forcePreload
 
 var checkPreloadExists = PreloadExists
 
+// PreloadCached returns true if the preloaded tarball is in the cache already
+func PreloadCached(k8sVersion, containerRuntime string) bool {
+	f, err := checkCache(TarballPath(k8sVersion, containerRuntime))
+	return err == nil && f.Size() != 0
+}
+
+// SkipPreload makes PreloadExists return false, so that the images of the version are cached and loaded one by one instead
+func SkipPreload(k8sVersion, containerRuntime string) {
+	setPreloadState(k8sVersion, containerRuntime, false)
+}
+
 // Preload caches the preloaded images tarball on the host machine
 func Preload(k8sVersion, containerRuntime, driverName string) error {
 	targetPath := TarballPath(k8sVersion, containerRuntime)
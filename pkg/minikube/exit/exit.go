@@ -30,6 +30,9 @@ import (
 
 var (
 	shell bool
+
+	// osExit exits the process, unless it is replaced by SetExitFunc
+	osExit = os.Exit
 )
 
 // SetShell configures if we are doing a shell configuration or not
@@ -37,6 +40,17 @@ func SetShell(s bool) {
 	shell = s
 }
 
+// SetExitFunc replaces how minikube exits, eg: by libminikube, which must not exit the program that embeds it.
+// f must not return, and a nil f restores os.Exit. It returns the previous func, to be restored with SetExitFunc.
+func SetExitFunc(f func(code int)) func(code int) {
+	if f == nil {
+		f = os.Exit
+	}
+	prev := osExit
+	osExit = f
+	return prev
+}
+
 // Message outputs a templated message and exits without interpretation
 func Message(r reason.Kind, format string, args ...out.V) {
 	if r.ID == "" {
@@ -72,7 +86,7 @@ func Code(code int) {
 	if shell {
 		out.Output(os.Stdout, fmt.Sprintf("false exit code %d\n", code))
 	}
-	os.Exit(code)
+	osExit(code)
 }
 
 // Advice is syntactic sugar to output a message with dynamically generated advice
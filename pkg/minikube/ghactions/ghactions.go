@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ghactions writes the outputs and environment variables of a step of a GitHub Actions workflow,
+// which the subsequent steps consume without parsing the output of minikube.
+package ghactions
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// OutputEnv is the file of the outputs of the step, eg: steps.minikube.outputs.kubeconfig
+	OutputEnv = "GITHUB_OUTPUT"
+	// EnvEnv is the file of the environment variables of the subsequent steps
+	EnvEnv = "GITHUB_ENV"
+)
+
+// Enabled returns whether the files of the outputs and environment variables of the step are set
+func Enabled() bool {
+	return os.Getenv(OutputEnv) != "" && os.Getenv(EnvEnv) != ""
+}
+
+// WriteOutputs appends outputs to the outputs of the step
+func WriteOutputs(outputs map[string]string) error {
+	return write(OutputEnv, outputs)
+}
+
+// WriteEnv appends env to the environment variables of the subsequent steps
+func WriteEnv(env map[string]string) error {
+	return write(EnvEnv, env)
+}
+
+// write appends the name=value lines of values, sorted by name, to the file named by the variable fileEnv
+func write(fileEnv string, values map[string]string) error {
+	path := os.Getenv(fileEnv)
+	if path == "" {
+		return fmt.Errorf("%s is not set, which GitHub Actions sets for each step", fileEnv)
+	}
+	var names []string
+	for name, v := range values {
+		if strings.ContainsAny(name+v, "\r\n") || strings.Contains(name, "=") {
+			return fmt.Errorf("invalid %s line %s=%q", fileEnv, name, v)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s=%s\n", name, values[name])
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrapf(err, "open %s", fileEnv)
+	}
+	if _, err := f.WriteString(b.String()); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "write %s", fileEnv)
+	}
+	return f.Close()
+}
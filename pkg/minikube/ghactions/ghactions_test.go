@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ghactions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	outputs := filepath.Join(dir, "output")
+	t.Setenv(OutputEnv, outputs)
+	t.Setenv(EnvEnv, filepath.Join(dir, "env"))
+	if !Enabled() {
+		t.Errorf("Enabled() = false")
+	}
+	if err := os.WriteFile(outputs, []byte("previous=step\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteOutputs(map[string]string{"node-ips": "192.168.49.2,192.168.49.3", "cluster": "minikube"}); err != nil {
+		t.Fatalf("WriteOutputs() = %v", err)
+	}
+	b, err := os.ReadFile(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "previous=step\ncluster=minikube\nnode-ips=192.168.49.2,192.168.49.3\n"; string(b) != want {
+		t.Errorf("%s = %q, want %q", OutputEnv, b, want)
+	}
+
+	if err := WriteEnv(map[string]string{"KUBECONFIG": "a\nb"}); err == nil {
+		t.Errorf("WriteEnv() of a multiline value = nil, want an error")
+	}
+	t.Setenv(EnvEnv, "")
+	if err := WriteEnv(map[string]string{"KUBECONFIG": "/home/runner/.kube/config"}); err == nil {
+		t.Errorf("WriteEnv() without %s = nil, want an error", EnvEnv)
+	}
+	if Enabled() {
+		t.Errorf("Enabled() = true without %s", EnvEnv)
+	}
+}
@@ -17,17 +17,21 @@ limitations under the License.
 package image
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
-	"github.com/juju/mutex/v2"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/detect"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/out"
@@ -45,7 +49,7 @@ func (f *cacheError) Error() string {
 // errCacheImageDoesntExist is thrown when image that user is trying to add does not exist
 var errCacheImageDoesntExist = &cacheError{errors.New("the image you are trying to add does not exist")}
 
-// DeleteFromCacheDir deletes tar files stored in cache dir
+// DeleteFromCacheDir deletes tar files stored in cache dir, with the variants of the other architectures
 func DeleteFromCacheDir(images []string) error {
 	for _, image := range images {
 		path := filepath.Join(detect.ImageCacheDir(), image)
@@ -54,23 +58,120 @@ func DeleteFromCacheDir(images []string) error {
 		if err := os.Remove(path); err != nil {
 			return err
 		}
+		for _, arch := range CachedArchs(image) {
+			path := localpath.SanitizeCacheDir(filepath.Join(detect.ArchImageCacheDir(arch), image))
+			klog.Infoln("Deleting image in cache at ", path)
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
 	}
 	return cleanImageCacheDir()
 }
 
+// CachedArchs returns the architectures that the image img is cached for
+func CachedArchs(img string) []string {
+	var archs []string
+	for _, arch := range constants.SupportedArchitectures {
+		path := localpath.SanitizeCacheDir(filepath.Join(detect.ArchImageCacheDir(arch), img))
+		if _, err := os.Stat(path); err == nil {
+			archs = append(archs, arch)
+		}
+	}
+	return archs
+}
+
+// ParsePlatform returns the architecture of the platform p to cache images for, eg: linux/arm64.
+// Only linux platforms are supported, as all the nodes run linux.
+func ParsePlatform(p string) (string, error) {
+	pl, err := v1.ParsePlatform(p)
+	if err != nil {
+		return "", err
+	}
+	if pl.OS != "linux" {
+		return "", fmt.Errorf("the platform %s is not supported, minikube only runs linux nodes", p)
+	}
+	for _, arch := range constants.SupportedArchitectures {
+		if pl.Architecture == arch {
+			return arch, nil
+		}
+	}
+	return "", fmt.Errorf("the architecture %s is not supported, supported architectures are %s", pl.Architecture, strings.Join(constants.SupportedArchitectures[:], ", "))
+}
+
+// RemoteArchs returns the supported linux architectures that the image img has variants for in its registry
+func RemoteArchs(img string) ([]string, error) {
+	ref, err := name.ParseReference(normalizeTagName(img), name.WeakValidation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing image ref name for %s", img)
+	}
+	desc, err := remote.Get(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, errors.Wrapf(err, "get %s", img)
+	}
+	var platforms []v1.Platform
+	if desc.MediaType.IsIndex() {
+		idx, err := desc.ImageIndex()
+		if err != nil {
+			return nil, errors.Wrap(err, "index")
+		}
+		m, err := idx.IndexManifest()
+		if err != nil {
+			return nil, errors.Wrap(err, "index manifest")
+		}
+		for _, d := range m.Manifests {
+			if d.Platform != nil {
+				platforms = append(platforms, *d.Platform)
+			}
+		}
+	} else {
+		i, err := desc.Image()
+		if err != nil {
+			return nil, errors.Wrap(err, "image")
+		}
+		cfg, err := i.ConfigFile()
+		if err != nil {
+			return nil, errors.Wrap(err, "config")
+		}
+		platforms = append(platforms, v1.Platform{OS: cfg.OS, Architecture: cfg.Architecture})
+	}
+	return linuxArchs(platforms), nil
+}
+
+// linuxArchs returns the supported architectures of the linux platforms of platforms, once each
+func linuxArchs(platforms []v1.Platform) []string {
+	var archs []string
+	for _, arch := range constants.SupportedArchitectures {
+		for _, p := range platforms {
+			if p.OS == "linux" && p.Architecture == arch {
+				archs = append(archs, arch)
+				break
+			}
+		}
+	}
+	return archs
+}
+
 // SaveToDir will cache images on the host
 //
 // The cache directory currently caches images using the imagename_tag
 // For example, registry.k8s.io/kube-addon-manager:v6.5 would be
 // stored at $CACHE_DIR/registry.k8s.io/kube-addon-manager_v6.5
 func SaveToDir(images []string, cacheDir string, overwrite bool) error {
+	return SaveArchToDir(images, cacheDir, defaultPlatform.Architecture, overwrite)
+}
+
+// SaveArchToDir caches the linux variants of the architecture arch of images on the host, like SaveToDir.
+// The variants of the architectures other than the one of the host are retrieved from their registry.
+func SaveArchToDir(images []string, cacheDir string, arch string, overwrite bool) error {
+	p := v1.Platform{OS: "linux", Architecture: arch}
 	var g errgroup.Group
 	for _, image := range images {
 		image := image
 		g.Go(func() error {
 			dst := filepath.Join(cacheDir, image)
 			dst = localpath.SanitizeCacheDir(dst)
-			if err := saveToTarFile(image, dst, overwrite); err != nil {
+			if err := saveToTarFile(image, dst, p, overwrite); err != nil {
 				if err == errCacheImageDoesntExist {
 					out.WarningT("The image '{{.imageName}}' was not found; unable to add it to cache.", out.V{"imageName": image})
 					return nil
@@ -88,8 +189,8 @@ func SaveToDir(images []string, cacheDir string, overwrite bool) error {
 	return nil
 }
 
-// saveToTarFile caches an image
-func saveToTarFile(iname, rawDest string, overwrite bool) error {
+// saveToTarFile caches the variant of the platform p of an image
+func saveToTarFile(iname, rawDest string, p v1.Platform, overwrite bool) error {
 	iname = normalizeTagName(iname)
 	start := time.Now()
 	defer func() {
@@ -105,7 +206,7 @@ func saveToTarFile(iname, rawDest string, overwrite bool) error {
 	spec := lock.PathMutexSpec(dst)
 	spec.Timeout = 10 * time.Minute
 	klog.Infof("acquiring lock: %+v", spec)
-	releaser, err := mutex.Acquire(spec)
+	releaser, err := lock.Acquire(spec)
 	if err != nil {
 		return errors.Wrapf(err, "unable to acquire lock for %+v", spec)
 	}
@@ -129,7 +230,7 @@ func saveToTarFile(iname, rawDest string, overwrite bool) error {
 		return errors.Wrapf(err, "nil reference for %s", iname)
 	}
 
-	img, cname, err := retrieveImage(ref, iname)
+	img, cname, err := retrieveImage(ref, iname, p)
 	if err != nil {
 		klog.V(2).ErrorS(err, "an error while retrieving the image")
 		return errCacheImageDoesntExist
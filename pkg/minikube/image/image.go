@@ -88,7 +88,7 @@ func DigestByGoLib(imgName string) string {
 		return ""
 	}
 
-	img, _, err := retrieveImage(ref, imgName)
+	img, _, err := retrieveImage(ref, imgName, defaultPlatform)
 	if err != nil {
 		klog.Infof("error retrieve Image %s ref %v ", imgName, err)
 		return ""
@@ -123,16 +123,19 @@ func canonicalName(ref name.Reference) string {
 	return cname
 }
 
-func retrieveImage(ref name.Reference, imgName string) (v1.Image, string, error) {
+// retrieveImage retrieves the variant of the platform p of the image ref, only from the remote registry for the platforms other than the one of the host
+func retrieveImage(ref name.Reference, imgName string, p v1.Platform) (v1.Image, string, error) {
 	var err error
 	var img v1.Image
 
-	if !useDaemon && !useRemote {
+	// the daemon has the images of the platform of the host
+	daemonPlatform := p.OS == defaultPlatform.OS && p.Architecture == defaultPlatform.Architecture
+	if !(useDaemon && daemonPlatform) && !useRemote {
 		return nil, "", fmt.Errorf("neither daemon nor remote")
 	}
 
-	klog.Infof("retrieving image: %+v", ref)
-	if useDaemon {
+	klog.Infof("retrieving image: %+v (%s/%s)", ref, p.OS, p.Architecture)
+	if useDaemon && daemonPlatform {
 		local := strings.HasPrefix(imgName, "localhost/")
 		canonical := imgName == canonicalName(ref)
 		// lookup unqualified short names
@@ -155,9 +158,9 @@ func retrieveImage(ref name.Reference, imgName string) (v1.Image, string, error)
 	}
 	if useRemote {
 		cname := canonicalName(ref)
-		img, err = retrieveRemote(ref, defaultPlatform)
+		img, err = retrieveRemote(ref, p)
 		if err == nil {
-			img, err = fixPlatform(ref, img, defaultPlatform)
+			img, err = fixPlatform(ref, img, p)
 			if err == nil {
 				return img, cname, nil
 			}
@@ -16,7 +16,12 @@ limitations under the License.
 
 package image
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+)
 
 func TestTag(t *testing.T) {
 	tcs := []struct {
@@ -91,3 +96,44 @@ func TestNormalizeImageName(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePlatform(t *testing.T) {
+	tcs := []struct {
+		platform string
+		expected string
+		err      bool
+	}{
+		{platform: "linux/amd64", expected: "amd64"},
+		{platform: "linux/arm64", expected: "arm64"},
+		{platform: "linux/arm/v7", expected: "arm"},
+		{platform: "windows/amd64", err: true},
+		{platform: "linux/riscv64", err: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.platform, func(t *testing.T) {
+			actual, err := ParsePlatform(tc.platform)
+			if (err != nil) != tc.err {
+				t.Fatalf("ParsePlatform(%q) error = %v, expected error: %v", tc.platform, err, tc.err)
+			}
+			if actual != tc.expected {
+				t.Errorf("ParsePlatform(%q) = %q, expected %q", tc.platform, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestLinuxArchs(t *testing.T) {
+	platforms := []v1.Platform{
+		{OS: "linux", Architecture: "arm64", Variant: "v8"},
+		{OS: "windows", Architecture: "amd64"},
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm", Variant: "v6"},
+		{OS: "linux", Architecture: "arm", Variant: "v7"},
+		{OS: "linux", Architecture: "riscv64"},
+		{OS: "unknown", Architecture: "unknown"},
+	}
+	expected := []string{"amd64", "arm", "arm64"}
+	if actual := linuxArchs(platforms); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("linuxArchs() = %v, expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keychain encrypts the secrets stored in profiles, with a key kept by the operating system:
+// the login keychain on macOS, the Secret Service on Linux and DPAPI on Windows.
+package keychain
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// prefix marks sealed values, so that they can be told apart from plaintext ones
+const prefix = "minikube-secret:v1:"
+
+const (
+	service = "minikube"
+	account = "profile-secrets"
+	keySize = 32
+)
+
+// ErrUnsupported is returned when the host has no key store minikube can use
+var ErrUnsupported = errors.New("no supported key store")
+
+// store keeps the key that seals the secrets
+type store interface {
+	// get returns the key, or nil if none was stored yet
+	get() ([]byte, error)
+	set(key []byte) error
+}
+
+var (
+	keyStore store = osStore{}
+
+	keyMu sync.Mutex
+	key   []byte
+)
+
+// loadKey returns the key of the key store, creating it on first use if create is set
+func loadKey(create bool) ([]byte, error) {
+	keyMu.Lock()
+	defer keyMu.Unlock()
+	if key != nil {
+		return key, nil
+	}
+	k, err := keyStore.get()
+	if err != nil {
+		return nil, err
+	}
+	if k == nil {
+		if !create {
+			return nil, errors.New("no key was stored")
+		}
+		k = make([]byte, keySize)
+		if _, err := rand.Read(k); err != nil {
+			return nil, err
+		}
+		if err := keyStore.set(k); err != nil {
+			return nil, errors.Wrap(err, "store key")
+		}
+	}
+	if len(k) != keySize {
+		return nil, fmt.Errorf("stored key is %d bytes long, want %d", len(k), keySize)
+	}
+	key = k
+	return key, nil
+}
+
+// IsSealed returns true if s was returned by Seal
+func IsSealed(s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+// Seal encrypts s. It returns ErrUnsupported if the host has no key store.
+func Seal(s string) (string, error) {
+	k, err := loadKey(true)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(k)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return prefix + base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(s), nil)), nil
+}
+
+// Unseal decrypts a value returned by Seal. Values that are not sealed are returned as is.
+func Unseal(s string) (string, error) {
+	if !IsSealed(s) {
+		return s, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, prefix))
+	if err != nil {
+		return "", errors.Wrap(err, "decode")
+	}
+	k, err := loadKey(false)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(k)
+	if err != nil {
+		return "", err
+	}
+	if len(b) < gcm.NonceSize() {
+		return "", errors.New("sealed value is too short")
+	}
+	p, err := gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+	if err != nil {
+		// most likely sealed on another host, or with a key that was since removed
+		return "", errors.Wrap(err, "decrypt")
+	}
+	return string(p), nil
+}
+
+func newGCM(k []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,50 @@
+//go:build darwin
+
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keychain
+
+import (
+	"encoding/hex"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// osStore keeps the key in the login keychain
+type osStore struct{}
+
+func (osStore) get() ([]byte, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		// 44 is errSecItemNotFound
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 44 {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "security find-generic-password")
+	}
+	return hex.DecodeString(strings.TrimSpace(string(out)))
+}
+
+func (osStore) set(key []byte) error {
+	if err := exec.Command("security", "add-generic-password", "-U", "-s", service, "-a", account, "-w", hex.EncodeToString(key)).Run(); err != nil {
+		return errors.Wrap(err, "security add-generic-password")
+	}
+	return nil
+}
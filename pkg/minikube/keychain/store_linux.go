@@ -0,0 +1,55 @@
+//go:build linux
+
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keychain
+
+import (
+	"encoding/hex"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// osStore keeps the key in the Secret Service, eg: GNOME Keyring or KWallet, through secret-tool
+type osStore struct{}
+
+func (osStore) get() ([]byte, error) {
+	if _, err := exec.LookPath("secret-tool"); err != nil {
+		return nil, ErrUnsupported
+	}
+	out, err := exec.Command("secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		// secret-tool exits with 1 and no output when nothing matches
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) == 0 {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "secret-tool lookup")
+	}
+	return hex.DecodeString(strings.TrimSpace(string(out)))
+}
+
+func (osStore) set(key []byte) error {
+	cmd := exec.Command("secret-tool", "store", "--label=minikube profile secrets", "service", service, "account", account)
+	cmd.Stdin = strings.NewReader(hex.EncodeToString(key))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "secret-tool store: %s", out)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+//go:build windows
+
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keychain
+
+import (
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/windows"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// osStore keeps the key in a file of the minikube home, encrypted with DPAPI for the current user
+type osStore struct{}
+
+func keyFile() string {
+	return filepath.Join(localpath.MiniPath(), "profile-secrets.key")
+}
+
+func (osStore) get() ([]byte, error) {
+	b, err := os.ReadFile(keyFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out windows.DataBlob
+	if err := windows.CryptUnprotectData(blob(b), nil, nil, 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out); err != nil {
+		return nil, errors.Wrap(err, "CryptUnprotectData")
+	}
+	return freeBlob(&out), nil
+}
+
+func (osStore) set(key []byte) error {
+	var out windows.DataBlob
+	if err := windows.CryptProtectData(blob(key), nil, nil, 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out); err != nil {
+		return errors.Wrap(err, "CryptProtectData")
+	}
+	return os.WriteFile(keyFile(), freeBlob(&out), 0600)
+}
+
+func blob(b []byte) *windows.DataBlob {
+	return &windows.DataBlob{Size: uint32(len(b)), Data: &b[0]}
+}
+
+// freeBlob copies a blob allocated by DPAPI, and frees it
+func freeBlob(b *windows.DataBlob) []byte {
+	defer windows.LocalFree(windows.Handle(unsafe.Pointer(b.Data))) // nolint:errcheck
+	return append([]byte{}, unsafe.Slice(b.Data, b.Size)...)
+}
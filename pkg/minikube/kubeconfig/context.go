@@ -17,9 +17,12 @@ limitations under the License.
 package kubeconfig
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 	"k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/localpath"
 )
 
 // UnsetCurrentContext unsets the current-context from minikube to "" on minikube stop
@@ -102,3 +105,45 @@ func DeleteContext(machineName string, configPath ...string) error {
 	}
 	return nil
 }
+
+// RenameContext renames the cluster, user and context of a profile, pointing its certificates to the renamed profile directory
+func RenameContext(oldName string, newName string, configPath ...string) error {
+	fPath := PathFromEnv()
+	if configPath != nil {
+		fPath = configPath[0]
+	}
+	kcfg, err := readOrNew(fPath)
+	if err != nil {
+		return errors.Wrap(err, "Error getting kubeconfig status")
+	}
+
+	oldDir, newDir := localpath.Profile(oldName), localpath.Profile(newName)
+	if c, ok := kcfg.Clusters[oldName]; ok {
+		delete(kcfg.Clusters, oldName)
+		kcfg.Clusters[newName] = c
+	}
+	if u, ok := kcfg.AuthInfos[oldName]; ok {
+		delete(kcfg.AuthInfos, oldName)
+		u.ClientCertificate = strings.Replace(u.ClientCertificate, oldDir, newDir, 1)
+		u.ClientKey = strings.Replace(u.ClientKey, oldDir, newDir, 1)
+		kcfg.AuthInfos[newName] = u
+	}
+	if c, ok := kcfg.Contexts[oldName]; ok {
+		delete(kcfg.Contexts, oldName)
+		if c.Cluster == oldName {
+			c.Cluster = newName
+		}
+		if c.AuthInfo == oldName {
+			c.AuthInfo = newName
+		}
+		kcfg.Contexts[newName] = c
+	}
+	if kcfg.CurrentContext == oldName {
+		kcfg.CurrentContext = newName
+	}
+
+	if err := writeToFile(kcfg, fPath); err != nil {
+		return errors.Wrap(err, "writing kubeconfig")
+	}
+	return nil
+}
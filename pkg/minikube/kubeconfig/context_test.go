@@ -146,3 +146,32 @@ func TestUnsetCurrentContextOnlyChangesIfProfileIsTheCurrentContext(t *testing.T
 		t.Errorf("Expected context name %s but got %s", contextName, cfg.CurrentContext)
 	}
 }
+
+func TestRenameContext(t *testing.T) {
+	fn := tempFile(t, kubeConfigWithoutHTTPS)
+	defer os.Remove(fn)
+	if err := RenameContext("la-croix", "perrier", fn); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := readOrNew(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cfg.Clusters["perrier"]; !ok || len(cfg.Clusters) != 1 {
+		t.Errorf("clusters = %v, want only perrier", cfg.Clusters)
+	}
+	if _, ok := cfg.AuthInfos["perrier"]; !ok || len(cfg.AuthInfos) != 1 {
+		t.Errorf("users = %v, want only perrier", cfg.AuthInfos)
+	}
+	c, ok := cfg.Contexts["perrier"]
+	if !ok || len(cfg.Contexts) != 1 {
+		t.Fatalf("contexts = %v, want only perrier", cfg.Contexts)
+	}
+	if c.Cluster != "perrier" || c.AuthInfo != "perrier" {
+		t.Errorf("context = %+v, want cluster and user perrier", c)
+	}
+	if cfg.CurrentContext != "perrier" {
+		t.Errorf("current-context = %q, want perrier", cfg.CurrentContext)
+	}
+}
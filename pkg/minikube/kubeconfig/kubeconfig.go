@@ -36,9 +36,9 @@ import (
 )
 
 // UpdateEndpoint overwrites the IP stored in kubeconfig with the provided IP.
-// It will also fix missing cluster or context in kubeconfig, if needed.
+// It will also fix missing cluster or context in kubeconfig, if needed, switching the current context to it unless keepContext is set.
 // Returns if the change was made and any error occurred.
-func UpdateEndpoint(contextName string, host string, port int, configPath string, ext *Extension) (bool, error) {
+func UpdateEndpoint(contextName string, host string, port int, configPath string, ext *Extension, keepContext bool) (bool, error) {
 	if host == "" {
 		return false, fmt.Errorf("empty host")
 	}
@@ -64,7 +64,7 @@ func UpdateEndpoint(contextName string, host string, port int, configPath string
 	kcs := &Settings{
 		ClusterName:          contextName,
 		ClusterServerAddress: address,
-		KeepContext:          false,
+		KeepContext:          keepContext,
 	}
 
 	populateCerts(kcs, *cfg, contextName)
@@ -263,6 +263,21 @@ func writeToFile(config runtime.Object, configPath string) error {
 	return nil
 }
 
+const (
+	// ModeShared adds the context of every profile to the kubeconfig from the environment
+	ModeShared = "shared"
+	// ModeSeparate writes the context of each profile to its own kubeconfig file in the profile directory
+	ModeSeparate = "separate"
+)
+
+// PathForProfile gets the path to the kubeconfig of a profile, depending on its kubeconfig mode
+func PathForProfile(profile string, mode string) string {
+	if mode == ModeSeparate {
+		return localpath.Kubeconfig(profile)
+	}
+	return PathFromEnv()
+}
+
 // PathFromEnv gets the path to the first kubeconfig
 func PathFromEnv() string {
 	kubeConfigEnv := os.Getenv(constants.KubeconfigEnvVar)
@@ -278,3 +293,26 @@ func PathFromEnv() string {
 	}
 	return constants.KubeconfigPath
 }
+
+// Standalone returns a kubeconfig with the context name of the kubeconfig at configPath only, and its certificates embedded,
+// so that it can be used on another machine or in a container. The server is replaced with server, unless it is empty.
+func Standalone(name string, configPath string, server string) ([]byte, error) {
+	kcfg, err := readOrNew(configPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "read kubeconfig")
+	}
+	if _, ok := kcfg.Contexts[name]; !ok {
+		return nil, errors.Errorf("%q does not appear in %s", name, configPath)
+	}
+	kcfg.CurrentContext = name
+	if err := api.MinifyConfig(kcfg); err != nil {
+		return nil, errors.Wrap(err, "minify")
+	}
+	if err := api.FlattenConfig(kcfg); err != nil {
+		return nil, errors.Wrap(err, "embed certificates")
+	}
+	if server != "" {
+		kcfg.Clusters[kcfg.Contexts[name].Cluster].Server = server
+	}
+	return runtime.Encode(latest.Codec, kcfg)
+}
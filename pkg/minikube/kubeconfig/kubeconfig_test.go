@@ -19,6 +19,7 @@ package kubeconfig
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -464,7 +465,7 @@ func TestUpdateIP(t *testing.T) {
 			t.Parallel()
 			configFilename := tempFile(t, test.existing)
 			defer os.Remove(configFilename)
-			statusActual, err := UpdateEndpoint("minikube", test.hostname, test.port, configFilename, nil)
+			statusActual, err := UpdateEndpoint("minikube", test.hostname, test.port, configFilename, nil, false)
 			if err != nil && !test.err {
 				t.Errorf("Got unexpected error: %v", err)
 			}
@@ -495,7 +496,7 @@ func TestMissingContext(t *testing.T) {
 	t.Setenv(localpath.MinikubeHome, "/home/la-croix")
 	configFilename := tempFile(t, kubeConfigMissingContext)
 	defer os.Remove(configFilename)
-	if _, err := UpdateEndpoint("minikube", "192.168.10.100", 8080, configFilename, nil); err != nil {
+	if _, err := UpdateEndpoint("minikube", "192.168.10.100", 8080, configFilename, nil, false); err != nil {
 		t.Fatal(err)
 	}
 	actual, err := readOrNew(configFilename)
@@ -801,3 +802,92 @@ func TestGetKubeConfigPath(t *testing.T) {
 		}
 	}
 }
+
+func TestPathForProfile(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+	t.Setenv(constants.KubeconfigEnvVar, "/tmp/shared-kubeconfig")
+
+	if got := PathForProfile("dev", ModeShared); got != "/tmp/shared-kubeconfig" {
+		t.Errorf("PathForProfile(dev, shared) = %q, want the kubeconfig from the environment", got)
+	}
+	if got := PathForProfile("dev", ""); got != "/tmp/shared-kubeconfig" {
+		t.Errorf("PathForProfile(dev, \"\") = %q, want the kubeconfig from the environment", got)
+	}
+	if got, want := PathForProfile("dev", ModeSeparate), localpath.Kubeconfig("dev"); got != want {
+		t.Errorf("PathForProfile(dev, separate) = %q, want %q", got, want)
+	}
+}
+
+func TestWriteOIDC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "oidc-kubeconfig")
+	kcs := &Settings{
+		ClusterName:          "minikube",
+		ClusterServerAddress: "https://192.168.49.2:8443",
+		CertificateAuthority: "/home/la-croix/.minikube/ca.crt",
+		ClientCertificate:    "/home/la-croix/.minikube/profiles/minikube/client.crt",
+		ClientKey:            "/home/la-croix/.minikube/profiles/minikube/client.key",
+	}
+	o := OIDCSettings{IssuerURL: "https://192.168.49.2:32000/dex", ClientID: "minikube", CertificateAuthority: "/home/la-croix/.minikube/ca.crt"}
+	if err := WriteOIDC(path, kcs, o); err != nil {
+		t.Fatalf("WriteOIDC: %v", err)
+	}
+
+	cfg, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("load %s: %v", path, err)
+	}
+	user := cfg.Contexts[cfg.CurrentContext].AuthInfo
+	if user != "minikube-oidc" {
+		t.Fatalf("user of the current context = %q, want %q", user, "minikube-oidc")
+	}
+	auth := cfg.AuthInfos[user]
+	if auth.ClientCertificate != "" || auth.Exec == nil {
+		t.Fatalf("user %s = %+v, want an exec plugin and no client cert", user, auth)
+	}
+	want := []string{"oidc-login", "get-token", "--oidc-issuer-url=https://192.168.49.2:32000/dex", "--oidc-client-id=minikube",
+		"--oidc-extra-scope=email", "--oidc-extra-scope=groups", "--certificate-authority=/home/la-croix/.minikube/ca.crt"}
+	if !reflect.DeepEqual(auth.Exec.Args, want) {
+		t.Errorf("exec args = %v, want %v", auth.Exec.Args, want)
+	}
+}
+
+func TestStandalone(t *testing.T) {
+	dir := t.TempDir()
+	ca := filepath.Join(dir, "ca.crt")
+	if err := os.WriteFile(ca, []byte("fake ca"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config")
+	for _, name := range []string{"dev", "other"} {
+		kcs := &Settings{
+			ClusterName:          name,
+			ClusterServerAddress: "https://127.0.0.1:32771",
+			CertificateAuthority: ca,
+			ClientCertificate:    ca,
+			ClientKey:            ca,
+		}
+		kcs.SetPath(path)
+		if err := Update(kcs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := Standalone("dev", path, "https://192.168.49.2:8443")
+	if err != nil {
+		t.Fatalf("Standalone() = %v", err)
+	}
+	cfg, err := clientcmd.Load(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Contexts) != 1 || cfg.CurrentContext != "dev" {
+		t.Errorf("contexts = %v, want dev only", cfg.Contexts)
+	}
+	c := cfg.Clusters["dev"]
+	if c == nil || c.Server != "https://192.168.49.2:8443" || string(c.CertificateAuthorityData) != "fake ca" || c.CertificateAuthority != "" {
+		t.Errorf("cluster dev = %+v, want the new server and the embedded CA", c)
+	}
+	if _, err := Standalone("missing", path, ""); err == nil {
+		t.Errorf("Standalone(missing) = nil, want an error")
+	}
+}
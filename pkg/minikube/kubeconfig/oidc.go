@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// OIDCSettings is the OpenID Connect issuer that a kubeconfig written by WriteOIDC authenticates with
+type OIDCSettings struct {
+	IssuerURL string
+	ClientID  string
+	// CertificateAuthority is the path to the CA of the issuer, if it is not trusted by the system
+	CertificateAuthority string
+}
+
+// WriteOIDC writes a kubeconfig to path, for the cluster of kcs, whose user logs in to the issuer of o with kubelogin,
+// the 'kubectl oidc-login' plugin, instead of using the client cert of kcs
+func WriteOIDC(path string, kcs *Settings, o OIDCSettings) error {
+	cfg := api.NewConfig()
+	if err := PopulateFromSettings(kcs, cfg); err != nil {
+		return err
+	}
+
+	user := kcs.ClusterName + "-oidc"
+	args := []string{
+		"oidc-login", "get-token",
+		"--oidc-issuer-url=" + o.IssuerURL,
+		"--oidc-client-id=" + o.ClientID,
+		"--oidc-extra-scope=email",
+		"--oidc-extra-scope=groups",
+	}
+	if o.CertificateAuthority != "" {
+		args = append(args, "--certificate-authority="+o.CertificateAuthority)
+	}
+	cfg.AuthInfos = map[string]*api.AuthInfo{
+		user: {
+			Exec: &api.ExecConfig{
+				APIVersion:      "client.authentication.k8s.io/v1",
+				Command:         "kubectl",
+				Args:            args,
+				InteractiveMode: api.IfAvailableExecInteractiveMode,
+			},
+		},
+	}
+	cfg.Contexts[kcs.ClusterName].AuthInfo = user
+	cfg.CurrentContext = kcs.ClusterName
+	return writeToFile(cfg, path)
+}
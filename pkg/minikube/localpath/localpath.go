@@ -38,6 +38,11 @@ func ConfigFile() string {
 	return MakeMiniPath("config", "config.json")
 }
 
+// DefaultsFile is the path of the global defaults file, whose settings are inherited by new profiles
+func DefaultsFile() string {
+	return MakeMiniPath("defaults.yaml")
+}
+
 // MiniPath returns the path to the user's minikube dir
 func MiniPath() string {
 	minikubeHomeEnv := os.Getenv(MinikubeHome)
@@ -68,12 +73,45 @@ func EventLog(name string) string {
 	return filepath.Join(Profile(name), "events.json")
 }
 
+// PromptCache returns the path to the cached status used by `minikube prompt`
+func PromptCache(name string) string {
+	return filepath.Join(Profile(name), "prompt.json")
+}
+
+// EtcdSnapshots returns the directory of the etcd snapshots of `minikube etcd backup`
+func EtcdSnapshots(name string) string {
+	return filepath.Join(Profile(name), "etcd-snapshots")
+}
+
+// ProfileLock returns the path describing the minikube process holding the lock of a profile.
+// It is kept outside of the profile directory, which may not exist yet when the lock is taken.
+func ProfileLock(name string) string {
+	return filepath.Join(MiniPath(), "locks", name+".json")
+}
+
+// DaemonSocket returns the path of the socket that `minikube daemon` serves its control API on
+func DaemonSocket() string {
+	return filepath.Join(MiniPath(), "daemon", "daemon.sock")
+}
+
+// DaemonToken returns the path of the bearer token that the clients of `minikube daemon` authenticate with.
+// It is only readable by the user, and changes every time the daemon starts.
+func DaemonToken() string {
+	return filepath.Join(MiniPath(), "daemon", "token")
+}
+
 // AuditLog returns the path to the audit log.
 // This log contains a history of commands run, by who, when, and what arguments.
 func AuditLog() string {
 	return filepath.Join(MiniPath(), "logs", "audit.json")
 }
 
+// TimingLog returns the path to the command timing log.
+// This log contains how long each command run against a machine took, and is summarized by `minikube logs --perf`.
+func TimingLog() string {
+	return filepath.Join(MiniPath(), "logs", "timings.json")
+}
+
 // LastStartLog returns the path to the last start log.
 func LastStartLog() string {
 	return filepath.Join(MiniPath(), "logs", "lastStart.txt")
@@ -99,6 +137,21 @@ func ClientCert(name string) string {
 	return newCert
 }
 
+// Kubeconfig returns the path to the kubeconfig of a profile using its own kubeconfig file
+func Kubeconfig(profile string) string {
+	return filepath.Join(Profile(profile), "kubeconfig")
+}
+
+// OIDCKubeconfig returns the path to the kubeconfig of a profile that authenticates with its OIDC issuer
+func OIDCKubeconfig(profile string) string {
+	return filepath.Join(Profile(profile), "oidc-kubeconfig")
+}
+
+// DevcontainerKubeconfig returns the path to the kubeconfig of a profile that reaches it from a dev container
+func DevcontainerKubeconfig(profile string) string {
+	return filepath.Join(Profile(profile), "devcontainer-kubeconfig")
+}
+
 // PID returns the path to the pid file used by profile for scheduled stop
 func PID(profile string) string {
 	return path.Join(Profile(profile), "pid")
@@ -129,6 +182,15 @@ func CACert() string {
 	return filepath.Join(MiniPath(), "ca.crt")
 }
 
+// ClusterCACert returns the path to the CA of a cluster: the one supplied with 'minikube start --certs-dir', or the minikube CA
+func ClusterCACert(name string) string {
+	ca := filepath.Join(Profile(name), "ca.crt")
+	if _, err := os.Stat(ca); err == nil {
+		return ca
+	}
+	return CACert()
+}
+
 // MachinePath returns the minikube machine path of a machine
 func MachinePath(machine string, miniHome ...string) string {
 	miniPath := MiniPath()
@@ -18,6 +18,7 @@ package localpath
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -145,3 +146,54 @@ func TestPropertyWithoutNameArg(t *testing.T) {
 		})
 	}
 }
+
+func TestPerm(t *testing.T) {
+	tests := []struct {
+		shared string
+		perm   os.FileMode
+		want   os.FileMode
+	}{
+		{"", 0600, 0600},
+		{"false", 0700, 0700},
+		{"true", 0600, 0660},
+		{"true", 0644, 0664},
+		{"true", 0700, 0770},
+	}
+	for _, tc := range tests {
+		t.Setenv(SharedHomeEnv, tc.shared)
+		if got := Perm(tc.perm); got != tc.want {
+			t.Errorf("Perm(%o) with %s=%q = %o, want %o", tc.perm, SharedHomeEnv, tc.shared, got, tc.want)
+		}
+	}
+}
+
+func TestShare(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not supported on windows")
+	}
+	dir := t.TempDir()
+	key := filepath.Join(dir, "machines", "m1", "id_rsa")
+	if err := os.MkdirAll(filepath.Dir(key), 0700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(key, []byte("key"), 0600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	t.Setenv(SharedHomeEnv, "true")
+	if err := Share(filepath.Join(dir, "machines")); err != nil {
+		t.Fatalf("Share() error: %v", err)
+	}
+	for p, want := range map[string]os.FileMode{key: 0660, filepath.Dir(key): 0770} {
+		fi, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("stat: %v", err)
+		}
+		if fi.Mode().Perm() != want {
+			t.Errorf("%s mode = %o, want %o", p, fi.Mode().Perm(), want)
+		}
+	}
+	if err := Share(filepath.Join(dir, "missing")); err != nil {
+		t.Errorf("Share() of a missing path error: %v", err)
+	}
+}
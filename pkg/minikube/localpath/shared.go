@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localpath
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// SharedHomeEnv is the environment variable enabling a minikube home shared between the members of a group
+const SharedHomeEnv = "MINIKUBE_SHARED_HOME"
+
+// SharedHome returns whether the minikube home is shared between OS users, eg: a CI service account and an admin.
+// Every user is expected to be in the group owning the minikube home, which should have the setgid bit set.
+func SharedHome() bool {
+	shared, err := strconv.ParseBool(os.Getenv(SharedHomeEnv))
+	return err == nil && shared
+}
+
+// Perm returns the permissions of a file in the minikube home.
+// With a shared home, the group is given the same permissions as the owner.
+func Perm(perm os.FileMode) os.FileMode {
+	if !SharedHome() {
+		return perm
+	}
+	return perm | (perm&0o700)>>3
+}
+
+// Share gives the group of a shared minikube home access to path and everything below it.
+// Files owned by other users are skipped, as only their owner may change them.
+func Share(path string) error {
+	if !SharedHome() {
+		return nil
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.Walk(path, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.Mode()&os.ModeSymlink != 0 || fi.Mode().Perm() == Perm(fi.Mode().Perm()) {
+			return nil
+		}
+		if err := os.Chmod(p, Perm(fi.Mode().Perm())|fi.Mode()&(os.ModeSetgid|os.ModeSticky)); err != nil {
+			if os.IsPermission(err) {
+				klog.Infof("skipping %s owned by another user: %v", p, err)
+				return nil
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// ApplySharedHome makes sure that files created by minikube and libmachine in a shared home stay writable by the group,
+// and that the file locks live in the shared home, where every member of the group may open them
+func ApplySharedHome() {
+	if SharedHome() {
+		setUmask(0o007)
+		lock.SetDir(MakeMiniPath("locks", "mutex"), Perm(0o600))
+	}
+}
@@ -26,8 +26,11 @@ import (
 	"os/exec"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/olekukonko/tablewriter"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/minikube/assets"
@@ -218,6 +221,74 @@ func OutputAudit(lines int) error {
 	return nil
 }
 
+// OutputAuditK8s displays the last lines of the audit log of the API server on the node of cr, and continuously prints the new ones if follow is true
+func OutputAuditK8s(cr logRunner, node string, lines int, follow bool, logOutput io.Writer) error {
+	args := []string{"sudo", "tail", "-n", strconv.Itoa(lines)}
+	if follow {
+		// -F follows the log once it is rotated
+		args = append(args, "-F")
+	}
+	args = append(args, bootstrapper.AuditLogPath())
+	if !follow {
+		fmt.Fprintf(logOutput, "==> %s: %s <==\n", node, bootstrapper.AuditLogPath())
+	}
+	c := exec.Command(args[0], args[1:]...)
+	c.Stdout = logOutput
+	c.Stderr = logOutput
+	if _, err := cr.RunCmd(c); err != nil {
+		return errors.Wrapf(err, "audit log of %s", node)
+	}
+	return nil
+}
+
+// OutputPerf displays the recorded command timings, aggregated by command.
+func OutputPerf() error {
+	out.Styled(style.None, "")
+	out.Styled(style.None, "==> Command Timings <==")
+	fp := localpath.TimingLog()
+	ts, err := command.ReadTimings(fp)
+	if os.IsNotExist(err) {
+		out.Styled(style.None, fmt.Sprintf("Command timing log not found at %s", fp))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read timing log %s: %v", fp, err)
+	}
+	out.Styled(style.None, perfTable(command.SummarizeTimings(ts)))
+	return nil
+}
+
+// perfTable renders timing summaries as an ASCII table
+func perfTable(sums []command.TimingSummary) string {
+	var total time.Duration
+	for _, s := range sums {
+		total += s.Total
+	}
+	b := new(bytes.Buffer)
+	t := tablewriter.NewWriter(b)
+	t.SetHeader([]string{"Runner", "Command", "Count", "Total", "Average", "Max", "Share"})
+	t.SetAutoFormatHeaders(false)
+	t.SetBorders(tablewriter.Border{Left: true, Top: true, Right: true, Bottom: true})
+	t.SetCenterSeparator("|")
+	for _, s := range sums {
+		share := 0.0
+		if total > 0 {
+			share = float64(s.Total) / float64(total) * 100
+		}
+		t.Append([]string{
+			s.Runner,
+			s.Key,
+			strconv.Itoa(s.Count),
+			s.Total.Round(time.Millisecond).String(),
+			s.Average().Round(time.Millisecond).String(),
+			s.Max.Round(time.Millisecond).String(),
+			fmt.Sprintf("%.1f%%", share),
+		})
+	}
+	t.Render()
+	return b.String()
+}
+
 // outputLastStart outputs the last start logs.
 func OutputLastStart() error {
 	out.Styled(style.None, "")
@@ -17,10 +17,15 @@ limitations under the License.
 package machine
 
 import (
+	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
 	"k8s.io/minikube/pkg/minikube/cruntime"
 )
 
@@ -257,3 +262,35 @@ func TestMergeImageLists(t *testing.T) {
 		}
 	}
 }
+
+func TestTarballImages(t *testing.T) {
+	img, err := random.Image(1024, 1)
+	if err != nil {
+		t.Fatalf("random image: %v", err)
+	}
+	id, err := img.ConfigName()
+	if err != nil {
+		t.Fatalf("config name: %v", err)
+	}
+	tag, err := name.NewTag("registry.k8s.io/pause:3.9")
+	if err != nil {
+		t.Fatalf("tag: %v", err)
+	}
+	src := filepath.Join(t.TempDir(), "pause.tar")
+	if err := tarball.WriteToFile(src, tag, img); err != nil {
+		t.Fatalf("write tarball: %v", err)
+	}
+
+	got, err := tarballImages(src)
+	if err != nil {
+		t.Fatalf("tarballImages: %v", err)
+	}
+	expected := map[string]string{"registry.k8s.io/pause:3.9": strings.TrimPrefix(id.String(), "sha256:")}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("tarballImages() = %v, expected %v", got, expected)
+	}
+
+	if _, err := tarballImages(filepath.Join(t.TempDir(), "missing.tar")); err == nil {
+		t.Errorf("tarballImages() of a missing file succeeded")
+	}
+}
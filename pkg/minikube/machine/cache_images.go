@@ -19,6 +19,7 @@ package machine
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path"
@@ -32,6 +33,7 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/docker/go-units"
 	"github.com/docker/machine/libmachine/state"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
 	"github.com/olekukonko/tablewriter"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
@@ -52,8 +54,11 @@ import (
 // loadRoot is where images should be loaded from within the guest VM
 var loadRoot = path.Join(vmpath.GuestPersistentDir, "images")
 
-// loadImageLock is used to serialize image loads to avoid overloading the guest VM
-var loadImageLock sync.Mutex
+// loadImageLocks serialize the image loads into each node, by its machine name, to avoid overloading the guest VM
+var (
+	loadImageLocks   = map[string]*sync.Mutex{}
+	loadImageLocksMu sync.Mutex
+)
 
 // saveRoot is where images should be saved from within the guest VM
 var saveRoot = path.Join(vmpath.GuestPersistentDir, "images")
@@ -72,8 +77,13 @@ func CacheImagesForBootstrapper(imageRepository, version string) error {
 	return nil
 }
 
-// LoadCachedImages loads previously cached images into the container runtime
-func LoadCachedImages(cc *config.ClusterConfig, runner command.Runner, images []string, cacheDir string, overwrite bool) error {
+// LoadCachedImages loads previously cached images into the container runtime of the machine whose command runner is runner
+func LoadCachedImages(cc *config.ClusterConfig, machineName string, runner command.Runner, images []string, cacheDir string, overwrite bool) error {
+	return loadCachedImages(cc, machineName, runner, images, cacheDir, overwrite, func(string) {})
+}
+
+// loadCachedImages loads previously cached images into the container runtime, calling loaded with each image that is in it
+func loadCachedImages(cc *config.ClusterConfig, machineName string, runner command.Runner, images []string, cacheDir string, overwrite bool, loaded func(img string)) error {
 	cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: runner})
 	if err != nil {
 		return errors.Wrap(err, "runtime")
@@ -82,6 +92,9 @@ func LoadCachedImages(cc *config.ClusterConfig, runner command.Runner, images []
 	// Skip loading images if images already exist
 	if !overwrite && cr.ImagesPreloaded(images) {
 		klog.Infof("Images are preloaded, skipping loading")
+		for _, img := range images {
+			loaded(img)
+		}
 		return nil
 	}
 
@@ -111,10 +124,15 @@ func LoadCachedImages(cc *config.ClusterConfig, runner command.Runner, images []
 			// waiting for i/o timeout.
 			err := timedNeedsTransfer(imgClient, image, cr, 10*time.Second)
 			if err == nil {
+				loaded(image)
 				return nil
 			}
 			klog.Infof("%q needs transfer: %v", image, err)
-			return transferAndLoadCachedImage(runner, cc.KubernetesConfig, image, cacheDir)
+			if err := transferAndLoadCachedImage(machineName, runner, cc.KubernetesConfig, image, cacheDir); err != nil {
+				return err
+			}
+			loaded(image)
+			return nil
 		})
 	}
 	if err := g.Wait(); err != nil {
@@ -169,13 +187,32 @@ func needsTransfer(imgClient *client.Client, imgName string, cr cruntime.Manager
 	return nil
 }
 
-// LoadLocalImages loads images into the container runtime
-func LoadLocalImages(cc *config.ClusterConfig, runner command.Runner, images []string) error {
+// LoadLocalImages loads images into the container runtime of the machine whose command runner is runner
+func LoadLocalImages(cc *config.ClusterConfig, machineName string, runner command.Runner, images []string) error {
+	return loadLocalImages(cc, machineName, runner, images, func(string) {})
+}
+
+// loadLocalImages loads image files into the container runtime, unless their images are in it already,
+// calling loaded with each image file that is in it
+func loadLocalImages(cc *config.ClusterConfig, machineName string, runner command.Runner, images []string, loaded func(img string)) error {
+	cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: runner})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
 	var g errgroup.Group
 	for _, image := range images {
 		image := image
 		g.Go(func() error {
-			return transferAndLoadImage(runner, cc.KubernetesConfig, image, image)
+			if tarballPresent(cr, image) {
+				klog.Infof("the images of %s are in the container runtime already, skipping loading", image)
+				loaded(image)
+				return nil
+			}
+			if err := transferAndLoadImage(machineName, runner, cc.KubernetesConfig, image, image); err != nil {
+				return err
+			}
+			loaded(image)
+			return nil
 		})
 	}
 	if err := g.Wait(); err != nil {
@@ -185,6 +222,42 @@ func LoadLocalImages(cc *config.ClusterConfig, runner command.Runner, images []s
 	return nil
 }
 
+// tarballImages returns the IDs of the images in the tarball src, by their tags
+func tarballImages(src string) (map[string]string, error) {
+	m, err := tarball.LoadManifest(func() (io.ReadCloser, error) { return os.Open(src) })
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]string{}
+	for _, d := range m {
+		// the config of an image is named after its ID, eg: sha256:<id>, <id>.json or blobs/sha256/<id>
+		id := strings.TrimPrefix(strings.TrimSuffix(path.Base(d.Config), ".json"), "sha256:")
+		for _, t := range d.RepoTags {
+			ids[t] = id
+		}
+	}
+	return ids, nil
+}
+
+// tarballPresent returns whether all the images of the tarball src are in the container runtime already, at the same ID.
+// Images without a tag are never found, as they can only be told apart by loading them.
+func tarballPresent(cr cruntime.Manager, src string) bool {
+	ids, err := tarballImages(src)
+	if err != nil {
+		klog.Infof("unable to read the images of %s: %v", src, err)
+		return false
+	}
+	if len(ids) == 0 {
+		return false
+	}
+	for tag, id := range ids {
+		if !cr.ImageExists(tag, id) {
+			return false
+		}
+	}
+	return true
+}
+
 // CacheAndLoadImages caches and loads images to all profiles
 func CacheAndLoadImages(images []string, profiles []*config.Profile, overwrite bool) error {
 	if len(images) == 0 {
@@ -195,11 +268,39 @@ func CacheAndLoadImages(images []string, profiles []*config.Profile, overwrite b
 	if err := image.SaveToDir(images, detect.ImageCacheDir(), overwrite); err != nil {
 		return errors.Wrap(err, "save to dir")
 	}
+	// the nodes of another arch load its variant of the images
+	for _, arch := range nodeArchs(profiles) {
+		if err := image.SaveArchToDir(images, detect.ArchImageCacheDir(arch), arch, overwrite); err != nil {
+			return errors.Wrapf(err, "save %s to dir", arch)
+		}
+	}
 
 	return DoLoadImages(images, profiles, detect.ImageCacheDir(), overwrite)
 }
 
-// DoLoadImages loads images to all profiles
+// nodeArchs returns the architectures of the nodes of profiles, other than the one of the host
+func nodeArchs(profiles []*config.Profile) []string {
+	var archs []string
+	seen := map[string]bool{}
+	for _, p := range profiles {
+		c, err := config.Load(p.Name)
+		if err != nil {
+			klog.Warningf("Failed to load profile %q: %v", p.Name, err)
+			continue
+		}
+		for _, n := range c.Nodes {
+			arch := config.NodeArch(n)
+			if arch == detect.EffectiveArch() || seen[arch] {
+				continue
+			}
+			seen[arch] = true
+			archs = append(archs, arch)
+		}
+	}
+	return archs
+}
+
+// DoLoadImages loads images to all profiles, into all their running nodes at once
 func DoLoadImages(images []string, profiles []*config.Profile, cacheDir string, overwrite bool) error {
 	api, err := NewAPIClient()
 	if err != nil {
@@ -207,6 +308,14 @@ func DoLoadImages(images []string, profiles []*config.Profile, cacheDir string,
 	}
 	defer api.Close()
 
+	// loadTarget is a running node that the images are loaded into, from cacheDir
+	type loadTarget struct {
+		cc       *config.ClusterConfig
+		machine  string
+		runner   command.Runner
+		cacheDir string
+	}
+	var targets []loadTarget
 	succeeded := []string{}
 	failed := []string{}
 
@@ -223,6 +332,15 @@ func DoLoadImages(images []string, profiles []*config.Profile, cacheDir string,
 
 		for _, n := range c.Nodes {
 			m := config.MachineName(*c, n)
+			nodeCacheDir := cacheDir
+			if arch := config.NodeArch(n); arch != detect.EffectiveArch() {
+				// only the image cache has the variants of the other archs
+				if cacheDir != detect.ImageCacheDir() {
+					klog.Infof("skipping %s, as its arch %s is not the one of the images", m, arch)
+					continue
+				}
+				nodeCacheDir = detect.ArchImageCacheDir(arch)
+			}
 
 			status, err := Status(api, m)
 			if err != nil {
@@ -242,38 +360,88 @@ func DoLoadImages(images []string, profiles []*config.Profile, cacheDir string,
 				if err != nil {
 					return err
 				}
-				if cacheDir != "" {
-					// loading image names, from cache
-					err = LoadCachedImages(c, cr, images, cacheDir, overwrite)
-				} else {
-					// loading image files
-					err = LoadLocalImages(c, cr, images)
-				}
-				if err != nil {
-					failed = append(failed, m)
-					klog.Warningf("Failed to load cached images for profile %s. make sure the profile is running. %v", pName, err)
-					continue
-				}
-				succeeded = append(succeeded, m)
+				targets = append(targets, loadTarget{cc: c, machine: m, runner: cr, cacheDir: nodeCacheDir})
 			}
 		}
 	}
 
+	var machines []string
+	for _, t := range targets {
+		machines = append(machines, t.machine)
+	}
+	progress := newLoadProgress(machines, imageSizes(images, cacheDir))
+	var mu sync.Mutex
+	var g errgroup.Group
+	for _, t := range targets {
+		t := t
+		g.Go(func() error {
+			loaded := func(img string) { progress.imageLoaded(t.machine, img) }
+			var err error
+			if t.cacheDir != "" {
+				// loading image names, from cache
+				err = loadCachedImages(t.cc, t.machine, t.runner, images, t.cacheDir, overwrite, loaded)
+			} else {
+				// loading image files
+				err = loadLocalImages(t.cc, t.machine, t.runner, images, loaded)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed = append(failed, t.machine)
+				klog.Warningf("Failed to load cached images for profile %s. make sure the profile is running. %v", t.cc.Name, err)
+				return nil
+			}
+			succeeded = append(succeeded, t.machine)
+			return nil
+		})
+	}
+	_ = g.Wait()
+	progress.stop()
+
 	klog.Infof("succeeded pushing to: %s", strings.Join(succeeded, " "))
 	klog.Infof("failed pushing to: %s", strings.Join(failed, " "))
 	// Live pushes are not considered a failure
 	return nil
 }
 
+// imageSizes returns the size of the file of each image, from cacheDir, or the image itself if it is a file.
+// The images without a file count as a byte, for their progress to show.
+func imageSizes(images []string, cacheDir string) map[string]int64 {
+	sizes := map[string]int64{}
+	for _, img := range images {
+		src := img
+		if cacheDir != "" {
+			src = localpath.SanitizeCacheDir(filepath.Join(cacheDir, img))
+		}
+		sizes[img] = 1
+		if fi, err := os.Stat(src); err == nil && fi.Size() > 0 {
+			sizes[img] = fi.Size()
+		}
+	}
+	return sizes
+}
+
+// loadImageLock returns the lock of the image loads into the machine machineName
+func loadImageLock(machineName string) *sync.Mutex {
+	loadImageLocksMu.Lock()
+	defer loadImageLocksMu.Unlock()
+	l, ok := loadImageLocks[machineName]
+	if !ok {
+		l = &sync.Mutex{}
+		loadImageLocks[machineName] = l
+	}
+	return l
+}
+
 // transferAndLoadCachedImage transfers and loads a single image from the cache
-func transferAndLoadCachedImage(cr command.Runner, k8s config.KubernetesConfig, imgName string, cacheDir string) error {
+func transferAndLoadCachedImage(machineName string, cr command.Runner, k8s config.KubernetesConfig, imgName string, cacheDir string) error {
 	src := filepath.Join(cacheDir, imgName)
 	src = localpath.SanitizeCacheDir(src)
-	return transferAndLoadImage(cr, k8s, src, imgName)
+	return transferAndLoadImage(machineName, cr, k8s, src, imgName)
 }
 
-// transferAndLoadImage transfers and loads a single image
-func transferAndLoadImage(cr command.Runner, k8s config.KubernetesConfig, src string, imgName string) error {
+// transferAndLoadImage transfers and loads a single image into the machine machineName
+func transferAndLoadImage(machineName string, cr command.Runner, k8s config.KubernetesConfig, src string, imgName string) error {
 	r, err := cruntime.New(cruntime.Config{Type: k8s.ContainerRuntime, Runner: cr})
 	if err != nil {
 		return errors.Wrap(err, "runtime")
@@ -304,8 +472,9 @@ func transferAndLoadImage(cr command.Runner, k8s config.KubernetesConfig, src st
 		return errors.Wrap(err, "transferring cached image")
 	}
 
-	loadImageLock.Lock()
-	defer loadImageLock.Unlock()
+	l := loadImageLock(machineName)
+	l.Lock()
+	defer l.Unlock()
 
 	err = r.LoadImage(dst)
 	if err != nil {
@@ -34,6 +34,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/out/register"
+	"k8s.io/minikube/pkg/minikube/sshutil"
 	"k8s.io/minikube/pkg/minikube/style"
 )
 
@@ -149,6 +150,13 @@ func recreateIfNeeded(api libmachine.API, cc *config.ClusterConfig, n *config.No
 	if !recreated {
 		out.Step(style.Restarting, `Restarting existing {{.driver_name}} {{.machine_type}} for "{{.cluster}}" ...`, out.V{"driver_name": cc.Driver, "cluster": machineName, "machine_type": machineType})
 	}
+	// the guests of the ISO create new host keys on every boot, as /etc/ssh is not persisted, so the one presented after this boot is recorded.
+	// The kic containers keep theirs, and the machines of the ssh driver are not booted by minikube.
+	if !driver.IsKIC(h.DriverName) && h.DriverName != driver.SSH && !driver.BareMetal(h.DriverName) {
+		if err := sshutil.ResetHostKey(h.Name); err != nil {
+			return h, errors.Wrap(err, "reset host key")
+		}
+	}
 	if err := h.Driver.Start(); err != nil {
 		MaybeDisplayAdvice(err, h.DriverName)
 		return h, errors.Wrap(err, "driver start")
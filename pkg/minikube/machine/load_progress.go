@@ -0,0 +1,111 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cheggaaa/pb/v3"
+	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/out/register"
+)
+
+// showLoadProgress is whether DoLoadImages reports the progress of each node, eg: for 'minikube image load'
+var showLoadProgress bool
+
+// ShowLoadProgress sets whether DoLoadImages reports the progress of the images loaded into each node
+func ShowLoadProgress(show bool) {
+	showLoadProgress = show
+}
+
+// loadProgress reports the images loaded into each node, by their size: as a progress bar per node,
+// or as JSON events with --output=json. An image counts once it is loaded, or found in the node already.
+type loadProgress struct {
+	mu     sync.Mutex
+	sizes  map[string]int64
+	total  int64
+	loaded map[string]int64
+	bars   map[string]*pb.ProgressBar
+	pool   *pb.Pool
+}
+
+// newLoadProgress returns the progress of loading the image files of sizes into nodes, nil if it is not shown
+func newLoadProgress(nodes []string, sizes map[string]int64) *loadProgress {
+	if !showLoadProgress || len(nodes) == 0 {
+		return nil
+	}
+	p := &loadProgress{sizes: sizes, loaded: map[string]int64{}, bars: map[string]*pb.ProgressBar{}}
+	for _, s := range sizes {
+		p.total += s
+	}
+	if out.JSON {
+		for _, n := range nodes {
+			register.PrintImageLoadProgress(n, "0")
+		}
+		return p
+	}
+	if out.Plain || !out.IsTerminal(os.Stdout) {
+		return p
+	}
+	var bars []*pb.ProgressBar
+	for _, n := range nodes {
+		b := pb.Full.New(0).SetTotal(p.total)
+		b.Set("prefix", "    > "+n+": ")
+		b.Set(pb.Bytes, true)
+		// Just a hair less than 80 (standard terminal width) for aesthetics & pasting into docs
+		b.SetWidth(79)
+		p.bars[n] = b
+		bars = append(bars, b)
+	}
+	pool, err := pb.StartPool(bars...)
+	if err != nil {
+		klog.Warningf("unable to show the progress of loading images: %v", err)
+		p.bars = map[string]*pb.ProgressBar{}
+		return p
+	}
+	p.pool = pool
+	return p
+}
+
+// imageLoaded records that img is in node
+func (p *loadProgress) imageLoaded(node, img string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.loaded[node] += p.sizes[img]
+	if b, ok := p.bars[node]; ok {
+		b.SetCurrent(p.loaded[node])
+	}
+	if out.JSON && p.total > 0 {
+		register.PrintImageLoadProgress(node, fmt.Sprintf("%f", float64(p.loaded[node])/float64(p.total)))
+	}
+}
+
+// stop stops the progress bars, once all the nodes are done
+func (p *loadProgress) stop() {
+	if p == nil || p.pool == nil {
+		return
+	}
+	if err := p.pool.Stop(); err != nil {
+		klog.Warningf("unable to stop the progress of loading images: %v", err)
+	}
+}
@@ -28,8 +28,11 @@ import (
 	libprovision "github.com/docker/machine/libmachine/provision"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/vmpath"
 	"k8s.io/minikube/pkg/provision"
 	"k8s.io/minikube/pkg/util/retry"
@@ -119,6 +122,67 @@ func provisionDockerMachine(h *host.Host) error {
 	return p.Provision(*h.HostOptions.SwarmOptions, *h.HostOptions.AuthOptions, *h.HostOptions.EngineOptions)
 }
 
+// ProvisionEngine provisions the container engine of a running host again with the engine options of cc,
+// eg: to apply changed registry mirrors or proxy settings without recreating the host
+func ProvisionEngine(api libmachine.API, h *host.Host, cc config.ClusterConfig) error {
+	if driver.BareMetal(h.DriverName) {
+		return errors.Errorf("the %s driver does not provision a container engine", h.DriverName)
+	}
+	h.HostOptions.EngineOptions = engineOptions(cc)
+	if err := provisionDockerMachine(h); err != nil {
+		return errors.Wrap(err, "provision")
+	}
+	return api.Save(h)
+}
+
+// RefreshRuntimeConfig renders the insecure registries and registry mirrors of cc into the container runtime of a running host again:
+// the engine options of docker and cri-o, and the registry configuration of containerd and cri-o
+func RefreshRuntimeConfig(api libmachine.API, h *host.Host, cc config.ClusterConfig) error {
+	if err := ProvisionEngine(api, h, cc); err != nil {
+		return err
+	}
+	r, err := CommandRunner(h)
+	if err != nil {
+		return errors.Wrap(err, "command runner")
+	}
+	return cruntime.ConfigureRegistries(cruntime.Config{
+		Type:             cc.KubernetesConfig.ContainerRuntime,
+		Runner:           r,
+		InsecureRegistry: cc.InsecureRegistry,
+		RegistryMirror:   cc.RegistryMirror,
+		SharedMirror:     SharedMirror(cc),
+	})
+}
+
+// KICNetwork returns the oci binary of the docker or podman driver of cc, and the network of its nodes
+func KICNetwork(cc config.ClusterConfig) (string, string) {
+	ociBin := oci.Docker
+	if cc.Driver == driver.Podman {
+		ociBin = oci.Podman
+	}
+	network := cc.Network
+	if network == "" {
+		network = cc.Name
+	}
+	return ociBin, network
+}
+
+// SharedMirror runs the registry mirror shared by the nodes of cc with --shared-image-cache, and returns its address.
+// Without it, eg: if it could not be started, each node pulls its images itself.
+func SharedMirror(cc config.ClusterConfig) string {
+	if !cc.SharedImageCache || !driver.IsKIC(cc.Driver) {
+		return ""
+	}
+	ociBin, network := KICNetwork(cc)
+	addr, err := oci.EnsureRegistryMirror(ociBin, cc.Name, network)
+	if err != nil {
+		klog.Warningf("unable to start the shared registry mirror: %v", err)
+		out.WarningT("Unable to start the shared image cache, each node will pull its own images: {{.error}}", out.V{"error": err})
+		return ""
+	}
+	return addr
+}
+
 // fastDetectProvisioner provides a shortcut for provisioner detection
 func fastDetectProvisioner(h *host.Host) (libprovision.Provisioner, error) {
 	d := h.Driver.DriverName()
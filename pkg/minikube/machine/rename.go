@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/state"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// CanRename returns an error if the machines of cc can not be renamed, either because of their driver or because they are running
+func CanRename(api libmachine.API, cc config.ClusterConfig) error {
+	// VM names are owned by the hypervisor, and each of them has its own way (if any) to change them
+	if !driver.IsKIC(cc.Driver) && !driver.BareMetal(cc.Driver) && !driver.IsSSH(cc.Driver) {
+		return fmt.Errorf("renaming is not supported by the %s driver", cc.Driver)
+	}
+	for _, n := range cc.Nodes {
+		m := config.MachineName(cc, n)
+		st, err := Status(api, m)
+		if err != nil {
+			return errors.Wrapf(err, "status of %s", m)
+		}
+		if st != state.Stopped.String() && st != state.None.String() {
+			return fmt.Errorf("machine %s is %s, it must be stopped first", m, st)
+		}
+	}
+	return nil
+}
+
+// RenameProfile renames the stopped cluster cc to newName: its profile directory, machines and container network.
+// The certificates signed for the old machine names are removed, so that the next start generates them again.
+// cc is updated and saved under the new name. On error, the steps already done are undone.
+//
+// Containers keep their name label and hostname, so the ones of the kic drivers are not renamed: their volumes are copied
+// to the ones of the new machines, and the next start creates the containers again on them.
+func RenameProfile(cc *config.ClusterConfig, newName string) (err error) {
+	oldName := cc.Name
+	renamed := *cc
+	renamed.Name = newName
+
+	var undo []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := len(undo) - 1; i >= 0; i-- {
+			if uerr := undo[i](); uerr != nil {
+				klog.Warningf("failed to undo the rename of profile %s: %v", oldName, uerr)
+			}
+		}
+	}()
+
+	for _, n := range cc.Nodes {
+		oldMachine, newMachine := config.MachineName(*cc, n), config.MachineName(renamed, n)
+		if driver.IsKIC(cc.Driver) {
+			if err := oci.CopyVolume(cc.Driver, cc.KicBaseImage, oldMachine, newMachine); err != nil {
+				return errors.Wrapf(err, "copy the volume of %s", oldMachine)
+			}
+			undo = append(undo, func() error { return oci.RemoveVolume(cc.Driver, newMachine) })
+			continue
+		}
+		if err := renameMachineDir(oldName, newName, oldMachine, newMachine); err != nil {
+			return errors.Wrapf(err, "rename machine %s", oldMachine)
+		}
+		undo = append(undo, func() error { return renameMachineDir(newName, oldName, newMachine, oldMachine) })
+	}
+
+	if err := os.Rename(localpath.Profile(oldName), localpath.Profile(newName)); err != nil {
+		return errors.Wrap(err, "rename profile directory")
+	}
+	undo = append(undo, func() error { return os.Rename(localpath.Profile(newName), localpath.Profile(oldName)) })
+	if err := removeMachineCerts(newName); err != nil {
+		return errors.Wrap(err, "remove certificates")
+	}
+
+	if err := config.SaveProfile(newName, &renamed); err != nil {
+		return errors.Wrap(err, "save profile")
+	}
+	if driver.IsKIC(cc.Driver) {
+		removeContainers(*cc, newName)
+	}
+	*cc = renamed
+	return nil
+}
+
+// removeContainers removes the containers, volumes and machines of the kic cluster cc once it is renamed to newName,
+// and renames its network, unless the user picked it. Failures are only logged, as the cluster is saved under newName already.
+func removeContainers(cc config.ClusterConfig, newName string) {
+	ctx := context.Background()
+	var containers []string
+	for _, n := range cc.Nodes {
+		m := config.MachineName(cc, n)
+		containers = append(containers, m)
+		if err := os.RemoveAll(localpath.MachinePath(m)); err != nil {
+			klog.Warningf("failed to remove machine %s: %v", m, err)
+		}
+	}
+	// the registry mirror only holds a cache, the next start creates it again
+	containers = append(containers, oci.RegistryMirrorName(cc.Name))
+	for _, c := range containers {
+		if exists, err := oci.ContainerExists(cc.Driver, c); err == nil && exists {
+			if err := oci.DeleteContainer(ctx, cc.Driver, c); err != nil {
+				klog.Warningf("failed to remove container %s: %v", c, err)
+			}
+		}
+		if err := oci.RemoveVolume(cc.Driver, c); err != nil {
+			klog.Warningf("failed to remove volume %s: %v", c, err)
+		}
+	}
+	if cc.Network != "" {
+		return
+	}
+	if err := oci.RenameNetwork(cc.Driver, cc.Name, newName); err != nil {
+		klog.Warningf("failed to rename network %s: %v", cc.Name, err)
+	}
+}
+
+// renameMachineDir moves the libmachine directory of a machine, and updates the names and paths in its config
+func renameMachineDir(oldProfile, newProfile, oldMachine, newMachine string) (err error) {
+	oldDir, newDir := localpath.MachinePath(oldMachine), localpath.MachinePath(newMachine)
+	if _, err := os.Stat(oldDir); os.IsNotExist(err) {
+		klog.Infof("%s does not exist, nothing to rename", oldDir)
+		return nil
+	}
+	if err := os.Rename(oldDir, newDir); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rerr := os.Rename(newDir, oldDir); rerr != nil {
+				klog.Warningf("failed to move %s back to %s: %v", newDir, oldDir, rerr)
+			}
+		}
+	}()
+
+	p := filepath.Join(newDir, "config.json")
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	// keep integers as they are, instead of turning them into floats
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return errors.Wrapf(err, "decode %s", p)
+	}
+
+	v = renameValues("", v, func(key, s string) string {
+		switch {
+		case (key == "Name" || key == "MachineName") && s == oldMachine:
+			return newMachine
+		case key == "ClusterName" && s == oldProfile:
+			return newProfile
+		case s == oldDir || strings.HasPrefix(s, oldDir+string(filepath.Separator)):
+			return newDir + strings.TrimPrefix(s, oldDir)
+		}
+		return s
+	})
+
+	b, err = json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, b, 0600)
+}
+
+// renameValues applies rename to every string in v, along with the key it is stored under
+func renameValues(key string, v interface{}, rename func(key, s string) string) interface{} {
+	switch t := v.(type) {
+	case string:
+		return rename(key, t)
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = renameValues(k, e, rename)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = renameValues(key, e, rename)
+		}
+	}
+	return v
+}
+
+// removeMachineCerts removes the certificates of a profile whose SANs include its machine names
+func removeMachineCerts(profile string) error {
+	dir := localpath.Profile(profile)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "apiserver.") {
+			if err := os.Remove(filepath.Join(dir, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+func TestRenameProfile(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+
+	cc := &config.ClusterConfig{
+		Name:   "old",
+		Driver: "ssh",
+		Nodes:  []config.Node{{Name: "", IP: "192.168.49.2", ControlPlane: true, Worker: true}, {Name: "m02", IP: "192.168.49.3", Worker: true}},
+	}
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		t.Fatalf("save profile: %v", err)
+	}
+	for _, f := range []string{"apiserver.crt", "apiserver.key", "client.crt"} {
+		if err := os.WriteFile(filepath.Join(localpath.Profile("old"), f), nil, 0600); err != nil {
+			t.Fatalf("write %s: %v", f, err)
+		}
+	}
+	for _, m := range []string{"old", "old-m02"} {
+		dir := localpath.MachinePath(m)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		data := `{"ConfigVersion": 3, "Driver": {"MachineName": "` + m + `", "StorePath": "` + localpath.MiniPath() + `", "SSHKeyPath": "` + filepath.Join(dir, "id_rsa") + `", "SSHPort": 22}, "Name": "` + m + `"}`
+		if err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(data), 0600); err != nil {
+			t.Fatalf("write config: %v", err)
+		}
+	}
+
+	if err := RenameProfile(cc, "new"); err != nil {
+		t.Fatalf("RenameProfile() error: %v", err)
+	}
+
+	if cc.Name != "new" {
+		t.Errorf("Name = %q, want new", cc.Name)
+	}
+	if config.ProfileExists("old") || !config.ProfileExists("new") {
+		t.Errorf("want profile old renamed to new")
+	}
+	if _, err := os.Stat(filepath.Join(localpath.Profile("new"), "apiserver.crt")); !os.IsNotExist(err) {
+		t.Errorf("apiserver.crt should have been removed, got: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(localpath.Profile("new"), "client.crt")); err != nil {
+		t.Errorf("client.crt should have been kept: %v", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(localpath.MachinePath("new-m02"), "config.json"))
+	if err != nil {
+		t.Fatalf("read machine config: %v", err)
+	}
+	var got struct {
+		Driver struct {
+			MachineName string
+			StorePath   string
+			SSHKeyPath  string
+			SSHPort     int
+		}
+		Name string
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.Name != "new-m02" || got.Driver.MachineName != "new-m02" {
+		t.Errorf("machine names = %q, %q, want new-m02", got.Name, got.Driver.MachineName)
+	}
+	if want := filepath.Join(localpath.MachinePath("new-m02"), "id_rsa"); got.Driver.SSHKeyPath != want {
+		t.Errorf("SSHKeyPath = %q, want %q", got.Driver.SSHKeyPath, want)
+	}
+	if got.Driver.StorePath != localpath.MiniPath() || got.Driver.SSHPort != 22 {
+		t.Errorf("unrelated values changed: %+v", got.Driver)
+	}
+}
+
+func TestRenameProfileUndo(t *testing.T) {
+	t.Setenv(localpath.MinikubeHome, t.TempDir())
+
+	cc := &config.ClusterConfig{Name: "old", Driver: "ssh", Nodes: []config.Node{{Name: "", ControlPlane: true, Worker: true}}}
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		t.Fatalf("save profile: %v", err)
+	}
+	dir := localpath.MachinePath("old")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(`{"Name": "old"}`), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	// a leftover directory of the new profile makes renaming the profile directory fail
+	if err := os.MkdirAll(filepath.Join(localpath.Profile("new"), "leftover"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	if err := RenameProfile(cc, "new"); err == nil {
+		t.Fatalf("RenameProfile() should have failed")
+	}
+
+	if cc.Name != "old" {
+		t.Errorf("Name = %q, want old", cc.Name)
+	}
+	if !config.ProfileExists("old") {
+		t.Errorf("profile old should have been kept")
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "config.json"))
+	if err != nil {
+		t.Fatalf("machine old should have been moved back: %v", err)
+	}
+	if !strings.Contains(string(b), `"old"`) {
+		t.Errorf("machine config should name old again: %s", b)
+	}
+	if _, err := os.Stat(localpath.MachinePath("new")); !os.IsNotExist(err) {
+		t.Errorf("machine new should not exist, got: %v", err)
+	}
+}
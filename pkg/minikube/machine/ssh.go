@@ -26,6 +26,7 @@ import (
 	"github.com/docker/machine/libmachine/state"
 	"github.com/pkg/errors"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/localpath"
 )
 
 // GetHost find node's host information by name in the given cluster.
@@ -55,7 +56,8 @@ func CreateSSHShell(api libmachine.API, cc config.ClusterConfig, n config.Node,
 		return err
 	}
 
-	if native {
+	// ssh refuses private keys readable by the group, which they are in a shared minikube home
+	if native || localpath.SharedHome() {
 		ssh.SetDefaultClient(ssh.Native)
 	} else {
 		ssh.SetDefaultClient(ssh.External)
@@ -49,6 +49,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/proxy"
 	"k8s.io/minikube/pkg/minikube/reason"
 	"k8s.io/minikube/pkg/minikube/registry"
+	"k8s.io/minikube/pkg/minikube/sshutil"
 	"k8s.io/minikube/pkg/minikube/style"
 	"k8s.io/minikube/pkg/minikube/vmpath"
 	"k8s.io/minikube/pkg/util"
@@ -107,7 +108,7 @@ func engineOptions(cfg config.ClusterConfig) *engine.Options {
 	// get docker env from user's proxy settings
 	dockerEnv := proxy.SetDockerEnv()
 	// get docker env from user specifiec config
-	dockerEnv = append(dockerEnv, cfg.DockerEnv...)
+	dockerEnv = append(dockerEnv, config.WithoutSealed(cfg.DockerEnv)...)
 
 	uniqueEnvs := util.RemoveDuplicateStrings(dockerEnv)
 
@@ -128,15 +129,17 @@ func createHost(api libmachine.API, cfg *config.ClusterConfig, n *config.Node) (
 		klog.Infof("duration metric: took %s to createHost", time.Since(start))
 	}()
 
+	// the node may have resources of its own, see minikube node add --memory
+	nc := config.NodeResources(*cfg, *n)
 	if cfg.Driver != driver.SSH {
-		showHostInfo(nil, *cfg)
+		showHostInfo(nil, nc)
 	}
 
 	def := registry.Driver(cfg.Driver)
 	if def.Empty() {
 		return nil, fmt.Errorf("unsupported/missing driver: %s", cfg.Driver)
 	}
-	dd, err := def.Config(*cfg, *n)
+	dd, err := def.Config(nc, *n)
 	if err != nil {
 		return nil, errors.Wrap(err, "config")
 	}
@@ -161,6 +164,10 @@ func createHost(api libmachine.API, cfg *config.ClusterConfig, n *config.Node) (
 	if cfg.StartHostTimeout == 0 {
 		cfg.StartHostTimeout = 6 * time.Minute
 	}
+	// a new guest has new host keys, the one it presents first is recorded
+	if err := sshutil.ResetHostKey(h.Name); err != nil {
+		return nil, errors.Wrap(err, "reset host key")
+	}
 	if err := timedCreateHost(h, api, cfg.StartHostTimeout); err != nil {
 		return nil, errors.Wrap(err, "creating host")
 	}
@@ -176,18 +183,30 @@ func createHost(api libmachine.API, cfg *config.ClusterConfig, n *config.Node) (
 	if err := saveHost(api, h, cfg, n); err != nil {
 		return h, err
 	}
+	// libmachine creates the SSH key and machine config readable by their owner only
+	if err := localpath.Share(localpath.MachinePath(h.Name)); err != nil {
+		return h, errors.Wrap(err, "share machine dir")
+	}
 	return h, nil
 }
 
 func timedCreateHost(h *host.Host, api libmachine.API, t time.Duration) error {
 	create := make(chan error, 1)
+	// a panic of the driver, eg: of exit when embedded in libminikube, is raised again in the caller
+	var panicked interface{}
 	go func() {
 		defer close(create)
+		defer func() {
+			panicked = recover()
+		}()
 		create <- api.Create(h)
 	}()
 
 	select {
-	case err := <-create:
+	case err, ok := <-create:
+		if !ok {
+			panic(panicked)
+		}
 		if err != nil {
 			// Wait for all the logs to reach the client
 			time.Sleep(2 * time.Second)
@@ -359,7 +378,7 @@ func acquireMachinesLock(name string, drv string) (mutex.Releaser, error) {
 
 	klog.Infof("acquireMachinesLock for %s: %+v", name, spec)
 	start := time.Now()
-	r, err := mutex.Acquire(spec)
+	r, err := lock.Acquire(spec)
 	if err == nil {
 		klog.Infof("duration metric: took %s to acquireMachinesLock for %q", time.Since(start), name)
 	}
@@ -46,6 +46,19 @@ func StopHost(api libmachine.API, machineName string) error {
 	return stop(h)
 }
 
+// KillHost forcibly powers off the host VM, for a host that does not stop in time
+func KillHost(api libmachine.API, machineName string) error {
+	klog.Infof("KillHost: %v", machineName)
+	h, err := api.Load(machineName)
+	if err != nil {
+		return errors.Wrapf(err, "load")
+	}
+
+	register.Reg.SetStep(register.PowerOff)
+	out.Step(style.Shutdown, `Forcing node "{{.name}}" off ...`, out.V{"name": machineName})
+	return errors.Wrap(h.Kill(), "kill")
+}
+
 // stop forcibly stops a host without needing to load
 func stop(h *host.Host) error {
 	start := time.Now()
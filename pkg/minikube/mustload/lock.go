@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustload
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/juju/mutex/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/reason"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// LockProfile acquires the lock of a profile, waiting up to timeout for other minikube processes changing it.
+// The lock is released when the process exits, or by calling Release.
+func LockProfile(name string, timeout time.Duration) mutex.Releaser {
+	r, err := config.LockProfile(name, 0)
+	if err == nil {
+		return r
+	}
+	if errors.Is(err, config.ErrProfileLocked) && timeout > 0 {
+		out.Styled(style.Waiting, "Waiting up to {{.timeout}} for {{.holder}} to finish with profile \"{{.name}}\" ...", out.V{"timeout": timeout, "holder": lockHolder(name), "name": name})
+		r, err = config.LockProfile(name, timeout)
+		if err == nil {
+			return r
+		}
+	}
+	if errors.Is(err, config.ErrProfileLocked) {
+		exit.Message(reason.HostProfileLocked, "Profile \"{{.name}}\" is being changed by {{.holder}}", out.V{"name": name, "holder": lockHolder(name)})
+	}
+	exit.Error(reason.HostProfileLocked, "Unable to lock profile", err)
+	return nil
+}
+
+// lockHolder describes the process holding the lock of a profile, as far as it is known
+func lockHolder(name string) string {
+	h := config.ProfileLockHolder(name)
+	if h == nil {
+		return "another minikube process"
+	}
+	return fmt.Sprintf("'%s' (pid %d, since %s)", h.Command, h.PID, h.Since.Format(time.Kitchen))
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-ps"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// backgroundImages is the flag that defers the non-critical image loads of start to a background process
+const backgroundImages = "background-images"
+
+// backgroundImagesFile records the progress of the background process in the profile directory
+const backgroundImagesFile = "background-images.json"
+
+// BackgroundImages is the progress of the images loaded after start, by a background process
+type BackgroundImages struct {
+	PID      int
+	Started  time.Time
+	Finished *time.Time `json:",omitempty"`
+	Total    int
+	Loaded   int
+	Failed   []string `json:",omitempty"`
+}
+
+// Status summarizes the progress, eg: "Loading (2/5)"
+func (b *BackgroundImages) Status() string {
+	progress := fmt.Sprintf("(%d/%d)", b.Loaded, b.Total)
+	switch {
+	case b.Finished == nil && processRunning(b.PID):
+		return "Loading " + progress
+	case b.Finished == nil:
+		return "Interrupted " + progress
+	case len(b.Failed) > 0:
+		return fmt.Sprintf("Failed %s: %s", progress, strings.Join(b.Failed, ", "))
+	}
+	return "Loaded " + progress
+}
+
+func processRunning(pid int) bool {
+	p, err := ps.FindProcess(pid)
+	return err == nil && p != nil
+}
+
+// ReadBackgroundImages returns the progress of the background image loads of a profile, or nil if there were none
+func ReadBackgroundImages(profile string) (*BackgroundImages, error) {
+	b, err := os.ReadFile(filepath.Join(localpath.Profile(profile), backgroundImagesFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	bi := &BackgroundImages{}
+	if err := json.Unmarshal(b, bi); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return bi, nil
+}
+
+func writeBackgroundImages(profile string, bi *BackgroundImages) error {
+	b, err := json.Marshal(bi)
+	if err != nil {
+		return err
+	}
+	return lock.WriteFile(filepath.Join(localpath.Profile(profile), backgroundImagesFile), b, 0o644)
+}
+
+// StartBackgroundImages starts a minikube process that loads the cached images and pulls the addon images of cc,
+// once the cluster is usable. Its progress is shown by 'minikube status --detailed'.
+func StartBackgroundImages(cc *config.ClusterConfig) error {
+	c := exec.Command(os.Args[0], "background-images", "--profile", cc.Name)
+	c.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
+	if err := c.Start(); err != nil {
+		return errors.Wrap(err, "start")
+	}
+	klog.Infof("loading images in the background, pid %d", c.Process.Pid)
+	// the process outlives start, which must not wait for it
+	return c.Process.Release()
+}
+
+// LoadBackgroundImages loads the cached images and pulls the addon images of cc on each of its nodes, recording the progress.
+// It is run by the process of StartBackgroundImages.
+func LoadBackgroundImages(cc *config.ClusterConfig) error {
+	cached, err := imagesInConfigFile()
+	if err != nil {
+		return errors.Wrap(err, "cached images")
+	}
+	var addonImages []string
+	for name, enabled := range cc.Addons {
+		if a, ok := assets.Addons[name]; ok && enabled {
+			addonImages = append(addonImages, assets.ImageNames(a, cc)...)
+		}
+	}
+
+	bi := &BackgroundImages{PID: os.Getpid(), Started: time.Now(), Total: len(cached) + len(addonImages)}
+	if err := writeBackgroundImages(cc.Name, bi); err != nil {
+		return err
+	}
+	done := func(img string, err error) {
+		if err != nil {
+			klog.Warningf("loading %s: %v", img, err)
+			bi.Failed = append(bi.Failed, img)
+		} else {
+			bi.Loaded++
+		}
+		if err := writeBackgroundImages(cc.Name, bi); err != nil {
+			klog.Warningf("unable to record background images: %v", err)
+		}
+	}
+
+	profiles := []*config.Profile{{Name: cc.Name, Config: cc}}
+	for _, img := range cached {
+		done(img, machine.CacheAndLoadImages([]string{img}, profiles, false))
+	}
+
+	if len(addonImages) > 0 {
+		runtimes, err := nodeRuntimes(cc)
+		if err != nil {
+			return err
+		}
+		for _, img := range addonImages {
+			var errs []string
+			for name, cr := range runtimes {
+				if cr.ImageExists(img, "") {
+					continue
+				}
+				if err := cr.PullImage(img); err != nil {
+					errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+				}
+			}
+			if len(errs) > 0 {
+				done(img, errors.New(strings.Join(errs, "; ")))
+				continue
+			}
+			done(img, nil)
+		}
+	}
+
+	now := time.Now()
+	bi.Finished = &now
+	return writeBackgroundImages(cc.Name, bi)
+}
+
+// nodeRuntimes returns the container runtime of each running node of cc, by machine name
+func nodeRuntimes(cc *config.ClusterConfig) (map[string]cruntime.Manager, error) {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "api")
+	}
+	defer api.Close()
+
+	runtimes := map[string]cruntime.Manager{}
+	for _, n := range cc.Nodes {
+		m := config.MachineName(*cc, n)
+		h, err := machine.LoadHost(api, m)
+		if err != nil {
+			klog.Warningf("skipping %s: %v", m, err)
+			continue
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			klog.Warningf("skipping %s: %v", m, err)
+			continue
+		}
+		cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+		if err != nil {
+			return nil, errors.Wrap(err, "runtime")
+		}
+		runtimes[m] = cr
+	}
+	return runtimes, nil
+}
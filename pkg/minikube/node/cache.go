@@ -21,7 +21,9 @@ import (
 	"os"
 	"path"
 	"runtime"
+	"slices"
 	"strings"
+	"sync"
 
 	"k8s.io/minikube/pkg/minikube/detect"
 
@@ -30,6 +32,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/drivers/kic"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/download"
@@ -49,8 +52,58 @@ const (
 	cacheImageConfigKey = "cache"
 )
 
+// groupsMu serializes the downloads of the nodes added in parallel, see AddParallel:
+// an errgroup.Group cannot be waited for while goroutines are added to it
+var groupsMu sync.Mutex
+
+// upgradeImages are the images that changed between the cached preload and the version a cluster is upgraded to, see PlanUpgradeDelta
+var upgradeImages []string
+
+// PlanUpgradeDelta makes the upgrade of cc from fromVersion download only the images that changed between the two versions,
+// instead of the preloaded tarball of the new version, when the tarball of fromVersion is cached but the new one is not.
+// The nodes run fromVersion already, so the images that did not change are in their container runtime, and the
+// layers shared by the changed images are not pulled again.
+func PlanUpgradeDelta(cc *config.ClusterConfig, fromVersion string) {
+	toVersion := cc.KubernetesConfig.KubernetesVersion
+	cr := cc.KubernetesConfig.ContainerRuntime
+	// TODO: remove imageRepository check once #7695 is fixed
+	if fromVersion == "" || fromVersion == toVersion || cc.KubernetesConfig.ImageRepository != "" {
+		return
+	}
+	if !viper.GetBool("preload") || !viper.GetBool(cacheImages) || !driver.AllowsPreload(cc.Driver) {
+		return
+	}
+	if !download.PreloadCached(fromVersion, cr) || download.PreloadCached(toVersion, cr) {
+		return
+	}
+
+	from, err := bootstrapper.GetCachedImageList("", fromVersion)
+	if err != nil {
+		klog.Warningf("unable to list the images of %s, will download the preload: %v", fromVersion, err)
+		return
+	}
+	to, err := bootstrapper.GetCachedImageList("", toVersion)
+	if err != nil {
+		klog.Warningf("unable to list the images of %s, will download the preload: %v", toVersion, err)
+		return
+	}
+	var changed []string
+	for _, img := range to {
+		if !slices.Contains(from, img) {
+			changed = append(changed, img)
+		}
+	}
+
+	out.Step(style.Waiting, "Downloading the {{.count}} images that changed since Kubernetes {{.version}}, instead of the preload ...", out.V{"count": len(changed), "version": fromVersion})
+	klog.Infof("images changed from %s to %s: %v", fromVersion, toVersion, changed)
+	upgradeImages = changed
+	download.SkipPreload(toVersion, cr)
+}
+
 // BeginCacheKubernetesImages caches images required for Kubernetes version in the background
 func beginCacheKubernetesImages(g *errgroup.Group, imageRepository string, k8sVersion string, cRuntime string, driverName string) {
+	groupsMu.Lock()
+	defer groupsMu.Unlock()
 	// TODO: remove imageRepository check once #7695 is fixed
 	if imageRepository == "" && download.PreloadExists(k8sVersion, cRuntime, driverName) {
 		klog.Info("Caching tarball of preloaded images")
@@ -66,6 +119,13 @@ func beginCacheKubernetesImages(g *errgroup.Group, imageRepository string, k8sVe
 		return
 	}
 
+	if upgradeImages != nil {
+		g.Go(func() error {
+			return errors.Wrap(image.SaveToDir(upgradeImages, detect.ImageCacheDir(), false), "caching upgrade images")
+		})
+		return
+	}
+
 	g.Go(func() error {
 		return machine.CacheImagesForBootstrapper(imageRepository, k8sVersion)
 	})
@@ -117,7 +177,8 @@ func doCacheBinaries(k8sVersion, containerRuntime, driverName, binariesURL strin
 
 // beginDownloadKicBaseImage downloads the kic image
 func beginDownloadKicBaseImage(g *errgroup.Group, cc *config.ClusterConfig, downloadOnly bool) {
-
+	groupsMu.Lock()
+	defer groupsMu.Unlock()
 	klog.Infof("Beginning downloading kic base image for %s with %s", cc.Driver, cc.KubernetesConfig.ContainerRuntime)
 	register.Reg.SetStep(register.PullingBaseImage)
 	out.Step(style.Pulling, "Pulling base image {{.kicVersion}} ...", out.V{"kicVersion": kic.Version})
@@ -173,6 +234,8 @@ func beginDownloadKicBaseImage(g *errgroup.Group, cc *config.ClusterConfig, down
 
 // waitDownloadKicBaseImage blocks until the base image for KIC is downloaded.
 func waitDownloadKicBaseImage(g *errgroup.Group) {
+	groupsMu.Lock()
+	defer groupsMu.Unlock()
 	if err := g.Wait(); err != nil {
 		if err != nil {
 			if errors.Is(err, image.ErrGithubNeedsLogin) {
@@ -199,6 +262,8 @@ func waitCacheRequiredImages(g *errgroup.Group) {
 	if !viper.GetBool(cacheImages) {
 		return
 	}
+	groupsMu.Lock()
+	defer groupsMu.Unlock()
 	if err := g.Wait(); err != nil {
 		klog.Errorln("Error caching images: ", err)
 	}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"github.com/docker/machine/libmachine"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+
+	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// controlPlaneComponents are the static pods that are restarted to use renewed certificates
+var controlPlaneComponents = []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler", "etcd"}
+
+// RotateCerts renews the certificates of cc on every node: the profile certs that minikube signs, and the ones kubeadm manages on the control-plane nodes,
+// whose components are then restarted. The kubeconfig of the cluster is updated with the renewed client cert.
+func RotateCerts(api libmachine.API, cc *config.ClusterConfig) error {
+	if err := bootstrapper.RemoveProfileCerts(*cc); err != nil {
+		return errors.Wrap(err, "remove profile certs")
+	}
+
+	pcp, err := config.ControlPlane(*cc)
+	if err != nil {
+		return errors.Wrap(err, "get primary control-plane node")
+	}
+	pcpHost, err := machine.LoadHost(api, config.MachineName(*cc, pcp))
+	if err != nil {
+		return errors.Wrap(err, "load primary control-plane host")
+	}
+	pcpRunner, err := machine.CommandRunner(pcpHost)
+	if err != nil {
+		return errors.Wrap(err, "primary control-plane runner")
+	}
+
+	// the primary control-plane node goes first, as the other ones copy its certs
+	nodes := []config.Node{pcp}
+	for _, n := range cc.Nodes {
+		if !config.IsPrimaryControlPlane(*cc, n) {
+			nodes = append(nodes, n)
+		}
+	}
+	for _, n := range nodes {
+		m := config.MachineName(*cc, n)
+		out.Step(style.Restarting, "Rotating the certificates of {{.name}} ...", out.V{"name": m})
+		h, err := machine.LoadHost(api, m)
+		if err != nil {
+			return errors.Wrapf(err, "load host %s", m)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			return errors.Wrapf(err, "command runner %s", m)
+		}
+		if n.ControlPlane {
+			// renewed first, so that SetupCerts replaces the apiserver cert that kubeadm renews with the one minikube signs
+			if err := bootstrapper.RenewKubeadmCerts(r, *cc); err != nil {
+				return errors.Wrapf(err, "renew kubeadm certs of %s", m)
+			}
+		}
+		bs, err := cluster.Bootstrapper(api, viper.GetString(cmdcfg.Bootstrapper), *cc, r)
+		if err != nil {
+			return errors.Wrap(err, "bootstrapper")
+		}
+		if err := bs.SetupCerts(*cc, n, pcpRunner); err != nil {
+			return errors.Wrapf(err, "setup certs of %s", m)
+		}
+		if n.ControlPlane {
+			if err := restartControlPlane(r, *cc); err != nil {
+				return errors.Wrapf(err, "restart control plane of %s", m)
+			}
+		}
+	}
+
+	kcs := setupKubeconfig(*pcpHost, *cc, pcp, cc.Name)
+	return errors.Wrap(kubeconfig.Update(kcs), "update kubeconfig")
+}
+
+// restartControlPlane stops the containers of the control-plane components, which the kubelet then starts again with the renewed certificates
+func restartControlPlane(r command.Runner, cc config.ClusterConfig) error {
+	cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
+	var ids []string
+	for _, c := range controlPlaneComponents {
+		found, err := cr.ListContainers(cruntime.ListContainersOptions{Name: c, Namespaces: []string{"kube-system"}})
+		if err != nil {
+			return errors.Wrapf(err, "list %s containers", c)
+		}
+		ids = append(ids, found...)
+	}
+	klog.Infof("restarting control-plane containers: %v", ids)
+	return cr.StopContainers(ids)
+}
+
+// warnExpiringCerts warns about the certificates of the cluster on the host, and on the node of r, that expire within bootstrapper.ExpiryWarning
+func warnExpiringCerts(r command.Runner, cc config.ClusterConfig, n config.Node) {
+	certs := bootstrapper.HostCertsExpiry(cc)
+	guest, err := bootstrapper.GuestCertsExpiry(r, n)
+	if err != nil {
+		klog.Warningf("unable to check the expiry of the certificates of %s: %v", n.Name, err)
+	}
+	certs = append(certs, guest...)
+
+	expiring := 0
+	for _, c := range certs {
+		if c.ExpiresWithin(bootstrapper.ExpiryWarning) {
+			klog.Infof("%s expires on %s", c.Path, c.NotAfter)
+			expiring++
+		}
+	}
+	if expiring > 0 {
+		out.WarningT("{{.count}} certificates of the cluster expire within 30 days. To renew them, run: 'minikube certs rotate -p {{.profile}}'", out.V{"count": expiring, "profile": cc.Name})
+	}
+}
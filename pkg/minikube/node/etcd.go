@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/style"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+)
+
+// etcdSnapshotName is the snapshot on the control-plane nodes. It is kept in the data dir of etcd, which its container mounts.
+const etcdSnapshotName = "minikube-snapshot.db"
+
+// etcdStaticPods are the static pods that are stopped while the data of etcd is replaced
+var etcdStaticPods = []string{"etcd.yaml", "kube-apiserver.yaml"}
+
+// etcdctlFlags connect etcdctl to the member of its node, with the certs that kubeadm mounts into the etcd container
+var etcdctlFlags = []string{
+	"--endpoints=https://127.0.0.1:2379",
+	"--cacert=" + path.Join(vmpath.GuestKubernetesCertsDir, "etcd", "ca.crt"),
+	"--cert=" + path.Join(vmpath.GuestKubernetesCertsDir, "etcd", "healthcheck-client.crt"),
+	"--key=" + path.Join(vmpath.GuestKubernetesCertsDir, "etcd", "healthcheck-client.key"),
+}
+
+// etcdMember is the etcd member of a control-plane node
+type etcdMember struct {
+	name    string
+	peerURL string
+	r       command.Runner
+	cr      cruntime.Manager
+}
+
+// EtcdBackup saves a snapshot of the etcd of cc, taken on its primary control-plane node, to dest on the host.
+// A snapshot of one member has the data of the whole cluster.
+func EtcdBackup(api libmachine.API, cc *config.ClusterConfig, dest string) error {
+	pcp, err := config.ControlPlane(*cc)
+	if err != nil {
+		return errors.Wrap(err, "get primary control-plane node")
+	}
+	m, err := loadEtcdMember(api, *cc, pcp)
+	if err != nil {
+		return err
+	}
+	snapshot := path.Join(bsutil.EtcdDataDir(), etcdSnapshotName)
+	defer func() {
+		if _, err := m.r.RunCmd(exec.Command("sudo", "rm", "-f", snapshot)); err != nil {
+			klog.Warningf("unable to remove %s: %v", snapshot, err)
+		}
+	}()
+	if _, err := m.etcdctl(append(etcdctlFlags, "snapshot", "save", snapshot)...); err != nil {
+		return errors.Wrap(err, "save snapshot")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return errors.Wrap(err, "mkdir")
+	}
+	// created empty, as the file asset does not truncate it
+	f, err := os.Create(dest)
+	if err != nil {
+		return errors.Wrap(err, "create")
+	}
+	f.Close()
+	fa, err := assets.NewFileAsset(dest, bsutil.EtcdDataDir(), etcdSnapshotName, "0600")
+	if err != nil {
+		return errors.Wrap(err, "file asset")
+	}
+	defer fa.Close()
+	return errors.Wrap(m.r.CopyFrom(fa), "copy snapshot")
+}
+
+// EtcdRestore restores the etcd of cc from the snapshot src on the host. Each control-plane node restores its member
+// from it, as a new cluster of the same members, then etcd and the API server are restarted on all of them together.
+// The data that is replaced is kept next to it, as member.bak.
+func EtcdRestore(api libmachine.API, cc *config.ClusterConfig, src string) error {
+	var members []etcdMember
+	var initialCluster []string
+	for _, n := range cc.Nodes {
+		if !n.ControlPlane {
+			continue
+		}
+		m, err := loadEtcdMember(api, *cc, n)
+		if err != nil {
+			return err
+		}
+		members = append(members, m)
+		initialCluster = append(initialCluster, m.name+"="+m.peerURL)
+	}
+
+	dataDir := bsutil.EtcdDataDir()
+	snapshot := path.Join(dataDir, etcdSnapshotName)
+	restored := path.Join(dataDir, "restore")
+	// restored next to the data of each member, by the etcd that is running there
+	for _, m := range members {
+		out.Step(style.Waiting, "Restoring the etcd member of {{.name}} ...", out.V{"name": m.name})
+		f, err := assets.NewFileAsset(src, dataDir, etcdSnapshotName, "0600")
+		if err != nil {
+			return errors.Wrap(err, "file asset")
+		}
+		err = m.r.Copy(f)
+		f.Close()
+		if err != nil {
+			return errors.Wrapf(err, "copy snapshot to %s", m.name)
+		}
+		if _, err := m.r.RunCmd(exec.Command("sudo", "rm", "-rf", restored)); err != nil {
+			return errors.Wrapf(err, "clean up %s", m.name)
+		}
+		args := []string{"snapshot", "restore", snapshot, "--data-dir", restored, "--name", m.name,
+			"--initial-cluster", strings.Join(initialCluster, ","), "--initial-advertise-peer-urls", m.peerURL}
+		if err := m.etcdutl(args...); err != nil {
+			return errors.Wrapf(err, "restore snapshot on %s", m.name)
+		}
+	}
+
+	// the members only form the restored cluster if none of them runs with the data it replaces
+	for _, m := range members {
+		if err := m.moveStaticPods(vmpath.GuestManifestsDir, path.Dir(vmpath.GuestManifestsDir)); err != nil {
+			return errors.Wrapf(err, "stop etcd on %s", m.name)
+		}
+	}
+	for _, m := range members {
+		if err := waitContainerStopped(m.cr, "etcd"); err != nil {
+			return errors.Wrapf(err, "stop etcd on %s", m.name)
+		}
+	}
+	for _, m := range members {
+		swap := fmt.Sprintf("cd %s && rm -rf member.bak && mv member member.bak && mv restore/member member && rm -rf restore %s", dataDir, etcdSnapshotName)
+		if _, err := m.r.RunCmd(exec.Command("sudo", "/bin/bash", "-c", swap)); err != nil {
+			return errors.Wrapf(err, "replace the data of %s", m.name)
+		}
+	}
+	for _, m := range members {
+		if err := m.moveStaticPods(path.Dir(vmpath.GuestManifestsDir), vmpath.GuestManifestsDir); err != nil {
+			return errors.Wrapf(err, "start etcd on %s", m.name)
+		}
+	}
+	return nil
+}
+
+// loadEtcdMember returns the etcd member of the control-plane node n
+func loadEtcdMember(api libmachine.API, cc config.ClusterConfig, n config.Node) (etcdMember, error) {
+	name := config.MachineName(cc, n)
+	h, err := machine.LoadHost(api, name)
+	if err != nil {
+		return etcdMember{}, errors.Wrapf(err, "load host %s", name)
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return etcdMember{}, errors.Wrapf(err, "command runner %s", name)
+	}
+	cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+	if err != nil {
+		return etcdMember{}, errors.Wrap(err, "runtime")
+	}
+	// kubeadm names the members after their node
+	return etcdMember{name: name, peerURL: "https://" + net.JoinHostPort(n.IP, "2380"), r: r, cr: cr}, nil
+}
+
+// container returns the ID of the running etcd container of m
+func (m etcdMember) container() (string, error) {
+	ids, err := m.cr.ListContainers(cruntime.ListContainersOptions{State: cruntime.Running, Name: "etcd", Namespaces: []string{"kube-system"}})
+	if err != nil {
+		return "", errors.Wrap(err, "list etcd containers")
+	}
+	if len(ids) == 0 {
+		return "", fmt.Errorf("etcd is not running on %s", m.name)
+	}
+	return ids[0], nil
+}
+
+// etcdctl runs etcdctl in the etcd container of m, and returns its output
+func (m etcdMember) etcdctl(args ...string) (string, error) {
+	id, err := m.container()
+	if err != nil {
+		return "", err
+	}
+	rr, err := m.r.RunCmd(exec.Command("sudo", append([]string{"crictl", "exec", id, "etcdctl"}, args...)...))
+	if err != nil {
+		return "", err
+	}
+	return rr.Stdout.String(), nil
+}
+
+// etcdutl runs etcdutl in the etcd container of m, or etcdctl for the versions of etcd before 3.5, which do not have it
+func (m etcdMember) etcdutl(args ...string) error {
+	id, err := m.container()
+	if err != nil {
+		return err
+	}
+	if _, err := m.r.RunCmd(exec.Command("sudo", append([]string{"crictl", "exec", id, "etcdutl"}, args...)...)); err != nil {
+		klog.Infof("etcdutl failed, trying etcdctl: %v", err)
+		_, err := m.etcdctl(args...)
+		return err
+	}
+	return nil
+}
+
+// moveStaticPods moves the manifests of etcd and the API server of m from the directory from to to, for the kubelet to stop or start them
+func (m etcdMember) moveStaticPods(from, to string) error {
+	args := []string{"mv"}
+	for _, p := range etcdStaticPods {
+		args = append(args, path.Join(from, p))
+	}
+	_, err := m.r.RunCmd(exec.Command("sudo", append(args, to)...))
+	return err
+}
+
+// removeEtcdMember removes the etcd member of the control-plane node n of cc, unless kubeadm reset did,
+// with the etcdctl of another running control-plane node
+func removeEtcdMember(api libmachine.API, cc config.ClusterConfig, n config.Node) error {
+	name := config.MachineName(cc, n)
+	var m etcdMember
+	found := false
+	for _, cp := range config.ControlPlanes(cc) {
+		if cp.Name == n.Name || !machine.IsRunning(api, config.MachineName(cc, cp)) {
+			continue
+		}
+		var err error
+		if m, err = loadEtcdMember(api, cc, cp); err != nil {
+			return err
+		}
+		found = true
+		break
+	}
+	if !found {
+		return fmt.Errorf("no other control-plane node is running")
+	}
+
+	list, err := m.etcdctl(append(etcdctlFlags, "member", "list", "--write-out=simple")...)
+	if err != nil {
+		return errors.Wrap(err, "list members")
+	}
+	id := etcdMemberID(list, name)
+	if id == "" {
+		klog.Infof("%s is not an etcd member", name)
+		return nil
+	}
+	if _, err := m.etcdctl(append(etcdctlFlags, "member", "remove", id)...); err != nil {
+		return errors.Wrapf(err, "remove member %s", id)
+	}
+	klog.Infof("removed the etcd member %s of %s", id, name)
+	return nil
+}
+
+// etcdMemberID returns the ID of the member name in the output of etcdctl member list --write-out=simple,
+// empty if it is not a member. Its lines are: ID, status, name, peer URLs, client URLs, learner.
+func etcdMemberID(list, name string) string {
+	for _, l := range strings.Split(list, "\n") {
+		f := strings.Split(l, ", ")
+		if len(f) > 2 && f[2] == name {
+			return f[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path"
+	"time"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/kapi"
+	kubevip "k8s.io/minikube/pkg/minikube/cluster/ha/kube-vip"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+	"k8s.io/minikube/pkg/util/retry"
+)
+
+// kubeVIPLease is the lease of the kube-vip leader, which holds the VIP of the API server
+const kubeVIPLease = "plndr-cp-lock"
+
+// QuorumError is returned when stopping or deleting a control-plane node would leave etcd without quorum
+type QuorumError struct {
+	Node string
+	// Running is the number of control-plane nodes that would be left running
+	Running int
+	// Members is the number of etcd members that would be left
+	Members int
+}
+
+func (e *QuorumError) Error() string {
+	return fmt.Sprintf("etcd would be left with %d running members of %d without %s, fewer than the %d of its quorum", e.Running, e.Members, e.Node, e.Members/2+1)
+}
+
+// CheckQuorum returns a *QuorumError if stopping n, or deleting it, would leave the etcd of cc without quorum.
+// Deleting a member leaves fewer members, so fewer of them have to run.
+func CheckQuorum(api libmachine.API, cc config.ClusterConfig, n config.Node, deleting bool) error {
+	cps := config.ControlPlanes(cc)
+	// the quorum of an external etcd does not depend on the nodes
+	if !n.ControlPlane || len(cps) < 2 || config.IsExternalEtcd(cc) {
+		return nil
+	}
+	members := len(cps)
+	if deleting {
+		members--
+	}
+	running := 0
+	for _, cp := range cps {
+		if cp.Name != n.Name && machine.IsRunning(api, config.MachineName(cc, cp)) {
+			running++
+		}
+	}
+	if running < members/2+1 {
+		return &QuorumError{Node: config.MachineName(cc, n), Running: running, Members: members}
+	}
+	return nil
+}
+
+// Stop stops the host of n. The kube-vip of a control-plane node of an HA cluster is stopped first, for another
+// control-plane node to take the VIP of the API server at once, rather than once its lease expires.
+func Stop(api libmachine.API, cc config.ClusterConfig, n config.Node) error {
+	m := config.MachineName(cc, n)
+	if n.ControlPlane && config.IsHA(cc) {
+		if err := releaseKubeVIP(api, cc, n); err != nil {
+			klog.Warningf("unable to hand the VIP over from %s (will continue): %v", m, err)
+		}
+	}
+	return machine.StopHost(api, m)
+}
+
+// releaseKubeVIP stops the kube-vip of the control-plane node n, and releases its lease if it was the leader.
+// minikube start writes the manifest of kube-vip again.
+func releaseKubeVIP(api libmachine.API, cc config.ClusterConfig, n config.Node) error {
+	m := config.MachineName(cc, n)
+	h, err := machine.LoadHost(api, m)
+	if err != nil {
+		return errors.Wrap(err, "load host")
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return errors.Wrap(err, "command runner")
+	}
+	if _, err := r.RunCmd(exec.Command("sudo", "rm", "-f", path.Join(vmpath.GuestManifestsDir, kubevip.Manifest))); err != nil {
+		return errors.Wrap(err, "remove manifest")
+	}
+	cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
+	if err := waitContainerStopped(cr, "kube-vip"); err != nil {
+		return err
+	}
+
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		return errors.Wrap(err, "client")
+	}
+	leases := client.CoordinationV1().Leases(meta.NamespaceSystem)
+	l, err := leases.Get(context.Background(), kubeVIPLease, meta.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "get lease")
+	}
+	if l.Spec.HolderIdentity == nil || *l.Spec.HolderIdentity != m {
+		return nil
+	}
+	klog.Infof("releasing the kube-vip lease of %s", m)
+	if err := leases.Delete(context.Background(), kubeVIPLease, meta.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "delete lease")
+	}
+	return nil
+}
+
+// waitContainerStopped waits for the kubelet to stop the container name of a static pod, once its manifest is removed
+func waitContainerStopped(cr cruntime.Manager, name string) error {
+	return retry.Local(func() error {
+		ids, err := cr.ListContainers(cruntime.ListContainersOptions{State: cruntime.Running, Name: name, Namespaces: []string{meta.NamespaceSystem}})
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			return fmt.Errorf("%s is still running", name)
+		}
+		return nil
+	}, 2*time.Minute)
+}
@@ -20,13 +20,17 @@ import (
 	"context"
 	"fmt"
 	"os/exec"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blang/semver/v4"
+	"github.com/docker/machine/libmachine/mcnerror"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -36,6 +40,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/cruntime"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/util"
 	"k8s.io/minikube/pkg/util/retry"
 	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
@@ -65,28 +70,138 @@ func Add(cc *config.ClusterConfig, n config.Node, delOnFail bool) error {
 		n.Port = cc.APIServerPort
 	}
 
+	policy, err := RetryPolicyFromConfig()
+	if err != nil {
+		return errors.Wrap(err, "retry policy")
+	}
+
+	warm := claimWarmNode(cc, &n)
 	if err := config.SaveNode(cc, &n); err != nil {
 		return errors.Wrap(err, "save node")
 	}
 
-	r, p, m, h, err := Provision(cc, &n, delOnFail)
-	if err != nil {
-		return err
+	// the machine of a node that failed to join is reused, but the node has still never joined the cluster
+	joined, provisioned := false, false
+	for attempt := 1; ; attempt++ {
+		r, p, m, h, err := Provision(cc, &n, delOnFail)
+		if err == nil {
+			if !provisioned {
+				// a warm node was booted before, but has never joined the cluster
+				joined, provisioned = p && !warm, true
+			}
+			s := Starter{
+				Runner:         r,
+				PreExists:      joined,
+				MachineAPI:     m,
+				Host:           h,
+				Cfg:            cc,
+				Node:           &n,
+				ExistingAddons: nil,
+			}
+			_, err = Start(s)
+		}
+		if err == nil {
+			return nil
+		}
+		phase, ok := policy.retries(err, attempt)
+		if !ok {
+			return err
+		}
+		wait := policy.backoff(attempt)
+		out.WarningT("Adding node {{.name}} failed in its {{.phase}} phase, trying again in {{.wait}} ({{.attempt}}/{{.attempts}}): {{.error}}",
+			out.V{"phase": phase, "name": n.Name, "wait": wait, "attempt": attempt + 1, "attempts": policy.Attempts, "error": err})
+		time.Sleep(wait)
 	}
-	s := Starter{
-		Runner:         r,
-		PreExists:      p,
-		MachineAPI:     m,
-		Host:           h,
-		Cfg:            cc,
-		Node:           &n,
-		ExistingAddons: nil,
+}
+
+// AddParallel adds the nodes ns to an existing cluster concurrently, eg: its workers once its first control plane is up.
+// done is called as each node is added, or fails to be, from the goroutine that added it.
+// Each node is added to a copy of cc, so the nodes are saved beforehand, and once more when all of them are added.
+func AddParallel(cc *config.ClusterConfig, ns []config.Node, delOnFail bool, done func(n config.Node, err error)) error {
+	for i := range ns {
+		if err := config.SaveNode(cc, &ns[i]); err != nil {
+			return errors.Wrap(err, "save node")
+		}
 	}
 
-	_, err = Start(s)
+	added := make([]config.Node, len(ns))
+	// a panic of a node, eg: of exit when embedded in libminikube, is raised again in the caller once the others are added
+	var (
+		mu       sync.Mutex
+		panicked interface{}
+	)
+	var g errgroup.Group
+	for i, n := range ns {
+		c := *cc
+		c.Nodes = slices.Clone(cc.Nodes)
+		c.WarmNodes = slices.Clone(cc.WarmNodes)
+		g.Go(func() (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					if panicked == nil {
+						panicked = r
+					}
+					mu.Unlock()
+					err = fmt.Errorf("adding node %s: %v", n.Name, r)
+				}
+			}()
+			err = Add(&c, n, delOnFail)
+			if j := slices.IndexFunc(c.Nodes, func(cn config.Node) bool { return cn.Name == n.Name }); j != -1 {
+				added[i] = c.Nodes[j]
+			}
+			done(n, err)
+			return errors.Wrapf(err, "adding node %s", n.Name)
+		})
+	}
+	err := g.Wait()
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	// the copies saved the nodes of each other as they were before
+	for i := range added {
+		if added[i].Name != "" {
+			if serr := config.SaveNode(cc, &added[i]); serr != nil && err == nil {
+				err = errors.Wrap(serr, "save node")
+			}
+		}
+	}
 	return err
 }
 
+// Drain cordons the node name of cc, and evicts its pods, as kubectl drain does, eg: before it is stopped.
+// The node is scheduled again once it is uncordoned, eg: with kubectl uncordon.
+func Drain(cc config.ClusterConfig, name string) error {
+	n, _, err := Retrieve(cc, name)
+	if err != nil {
+		return errors.Wrap(err, "retrieve node")
+	}
+	cpr := mustload.Healthy(cc.Name).CP.Runner
+	kubectl := kapi.KubectlBinaryPath(cc.KubernetesConfig.KubernetesVersion)
+	cmd := exec.Command("sudo", "KUBECONFIG=/var/lib/minikube/kubeconfig", kubectl, "drain", config.MachineName(cc, *n),
+		"--ignore-daemonsets", "--delete-emptydir-data", "--timeout=5m")
+	if _, err := cpr.RunCmd(cmd); err != nil {
+		return errors.Wrap(err, "kubectl drain")
+	}
+	return nil
+}
+
+// Uncordon schedules the pods again on the node name of cc, once it was drained.
+func Uncordon(cc config.ClusterConfig, name string) error {
+	n, _, err := Retrieve(cc, name)
+	if err != nil {
+		return errors.Wrap(err, "retrieve node")
+	}
+	cpr := mustload.Healthy(cc.Name).CP.Runner
+	kubectl := kapi.KubectlBinaryPath(cc.KubernetesConfig.KubernetesVersion)
+	cmd := exec.Command("sudo", "KUBECONFIG=/var/lib/minikube/kubeconfig", kubectl, "uncordon", config.MachineName(cc, *n))
+	if _, err := cpr.RunCmd(cmd); err != nil {
+		return errors.Wrap(err, "kubectl uncordon")
+	}
+	return nil
+}
+
 // teardown drains, then resets and finally deletes node from cluster.
 // ref: https://kubernetes.io/docs/setup/production-environment/tools/kubeadm/create-cluster-kubeadm/#tear-down
 func teardown(cc config.ClusterConfig, name string) (*config.Node, error) {
@@ -128,6 +243,13 @@ func teardown(cc config.ClusterConfig, name string) (*config.Node, error) {
 		klog.Infof("successfully drained node %q", m)
 	}
 
+	ha := n.ControlPlane && config.IsHA(cc)
+	if ha {
+		if err := releaseKubeVIP(api, cc, *n); err != nil {
+			klog.Warningf("unable to hand the VIP over from %q (will continue): %v", m, err)
+		}
+	}
+
 	// kubeadm reset node to revert any changes made by previous kubeadm init/join
 	// it's to inform cluster of the node that is about to be removed and should be unregistered (eg, from etcd quorum, that would otherwise complain)
 	// ref: https://kubernetes.io/docs/reference/setup-tools/kubeadm/kubeadm-reset/
@@ -160,6 +282,13 @@ func teardown(cc config.ClusterConfig, name string) (*config.Node, error) {
 		klog.Warningf("kubeadm reset node %q failed (will continue, but cluster might become unstable): %v", m, kerr)
 	}
 
+	// kubeadm reset removes the etcd member of the node, unless it could not reach the other members
+	if ha && !config.IsExternalEtcd(cc) {
+		if err := removeEtcdMember(api, cc, *n); err != nil {
+			klog.Warningf("unable to remove the etcd member of %q (will continue, but etcd might lose quorum): %v", m, err)
+		}
+	}
+
 	// kubectl delete node
 	client, err := kapi.Client(cc.Name)
 	if err != nil {
@@ -209,6 +338,29 @@ func Delete(cc config.ClusterConfig, name string) (*config.Node, error) {
 	return n, config.SaveProfile(viper.GetString(config.ProfileName), &cc)
 }
 
+// Remove deletes the host of the node name and removes it from cc, without removing it from Kubernetes first,
+// eg: when it failed to join the cluster. The host may not exist.
+func Remove(cc *config.ClusterConfig, name string) error {
+	n, index, err := Retrieve(*cc, name)
+	if err != nil {
+		return errors.Wrap(err, "retrieve")
+	}
+
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	err = machine.DeleteHost(api, config.MachineName(*cc, *n))
+	if _, ok := errors.Cause(err).(mcnerror.ErrHostDoesNotExist); err != nil && !ok {
+		return err
+	}
+
+	cc.Nodes = append(cc.Nodes[:index], cc.Nodes[index+1:]...)
+	return config.SaveProfile(cc.Name, cc)
+}
+
 // Retrieve finds the node by name in the given cluster
 func Retrieve(cc config.ClusterConfig, name string) (*config.Node, int, error) {
 	for i, n := range cc.Nodes {
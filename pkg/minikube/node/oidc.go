@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// setupOIDCKubeconfig writes the kubeconfig of a test user that logs in to the OIDC issuer of cc, and tells how to use it
+func setupOIDCKubeconfig(kcs *kubeconfig.Settings, cc config.ClusterConfig) {
+	o := kubeconfig.OIDCSettings{
+		IssuerURL: config.OIDCIssuerURL(cc),
+		ClientID:  cc.OIDC.ClientID,
+	}
+	if cc.OIDC.IssuerURL == constants.DexIssuer {
+		o.CertificateAuthority = localpath.ClusterCACert(cc.Name)
+	}
+	path := localpath.OIDCKubeconfig(cc.Name)
+	if err := kubeconfig.WriteOIDC(path, kcs, o); err != nil {
+		klog.Warningf("unable to write the OIDC kubeconfig: %v", err)
+		out.WarningT("Unable to write the kubeconfig of the OIDC test user: {{.error}}", out.V{"error": err})
+		return
+	}
+
+	out.Styled(style.Tip, "To use the cluster as an OIDC user, install kubelogin (kubectl krew install oidc-login) and run: kubectl --kubeconfig={{.path}} get pods", out.V{"path": path})
+	if cc.OIDC.IssuerURL == constants.DexIssuer {
+		out.Styled(style.Tip, "Log in to dex as admin@example.com, with the password 'password'. To grant the user access: kubectl create clusterrolebinding oidc-admin --clusterrole=cluster-admin --user=admin@example.com")
+	}
+}
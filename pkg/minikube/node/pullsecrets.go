@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/machine/libmachine/state"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
+)
+
+const (
+	// PullSecretName is the imagePullSecret that the process of StartPullSecrets keeps refreshed in each of the namespaces
+	PullSecretName = "minikube-pull-secret"
+	// pullSecretsFile records the pid of the process of StartPullSecrets in the profile directory
+	pullSecretsFile = "pull-secrets.pid"
+	// pullSecretsInterval is how often the tokens are minted, well before the shortest of them, gcloud's, expires after an hour
+	pullSecretsInterval = 30 * time.Minute
+	// pullSecretsRetryInterval is how soon a failed refresh is retried
+	pullSecretsRetryInterval = time.Minute
+)
+
+// PullSecretsProviders are the cloud providers that registry tokens are minted from, with their CLI on the host
+var PullSecretsProviders = []string{"gcloud", "ecr", "acr"}
+
+// PullSecretsCommand returns the command that prints a short-lived token for registry, from the credentials of provider on the host,
+// and the user name that the token goes with
+func PullSecretsCommand(provider, registry string) (args []string, user string, err error) {
+	host := strings.SplitN(registry, "/", 2)[0]
+	switch provider {
+	case "gcloud":
+		return []string{"gcloud", "auth", "print-access-token"}, "oauth2accesstoken", nil
+	case "ecr":
+		// eg: 123456789012.dkr.ecr.us-east-1.amazonaws.com
+		parts := strings.Split(host, ".")
+		if len(parts) < 6 || parts[1] != "dkr" || parts[2] != "ecr" {
+			return nil, "", fmt.Errorf("not an ECR registry: %s", registry)
+		}
+		return []string{"aws", "ecr", "get-login-password", "--region", parts[3]}, "AWS", nil
+	case "acr":
+		// eg: myregistry.azurecr.io
+		name, _, ok := strings.Cut(host, ".")
+		if !ok || !strings.HasSuffix(host, ".azurecr.io") {
+			return nil, "", fmt.Errorf("not an ACR registry: %s", registry)
+		}
+		return []string{"az", "acr", "login", "--name", name, "--expose-token", "--output", "tsv", "--query", "accessToken"}, "00000000-0000-0000-0000-000000000000", nil
+	}
+	return nil, "", fmt.Errorf("unknown provider %q, options include: %s", provider, strings.Join(PullSecretsProviders, ", "))
+}
+
+// StartPullSecrets starts a minikube process that keeps the imagePullSecrets of the namespaces of cc refreshed with short-lived registry tokens,
+// unless one is running already. The process exits once the cluster is stopped or deleted, or the provider is unset.
+func StartPullSecrets(cc *config.ClusterConfig) error {
+	pidFile := filepath.Join(localpath.Profile(cc.Name), pullSecretsFile)
+	if b, err := os.ReadFile(pidFile); err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(b))); err == nil && processRunning(pid) {
+			klog.Infof("pull secrets are refreshed by pid %d already", pid)
+			return nil
+		}
+	}
+
+	c := exec.Command(os.Args[0], "pull-secrets", "--profile", cc.Name)
+	c.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
+	if err := c.Start(); err != nil {
+		return errors.Wrap(err, "start")
+	}
+	klog.Infof("refreshing pull secrets in the background, pid %d", c.Process.Pid)
+	if err := os.WriteFile(pidFile, []byte(strconv.Itoa(c.Process.Pid)), 0o644); err != nil {
+		return errors.Wrap(err, "write pid")
+	}
+	return c.Process.Release()
+}
+
+// RefreshPullSecrets mints a registry token every 30 minutes and writes it to the imagePullSecret of each namespace of a profile.
+// It returns once the control plane is no longer running. It is run by the process of StartPullSecrets.
+func RefreshPullSecrets(profile string) error {
+	for {
+		cc, err := config.Load(profile)
+		if config.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "load profile")
+		}
+		if cc.PullSecrets.Provider == "" {
+			klog.Infof("pull secrets of %s are disabled, done", profile)
+			return nil
+		}
+		running, err := controlPlaneRunning(cc)
+		if err != nil {
+			return err
+		}
+		if !running {
+			klog.Infof("the control plane of %s is not running, done", profile)
+			return nil
+		}
+
+		wait := pullSecretsInterval
+		if err := refreshPullSecrets(cc); err != nil {
+			klog.Warningf("refreshing pull secrets: %v", err)
+			wait = pullSecretsRetryInterval
+		}
+		time.Sleep(wait)
+	}
+}
+
+// controlPlaneRunning returns whether the primary control-plane node of cc is running
+func controlPlaneRunning(cc *config.ClusterConfig) (bool, error) {
+	cp, err := config.ControlPlane(*cc)
+	if err != nil {
+		return false, err
+	}
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return false, errors.Wrap(err, "api")
+	}
+	defer api.Close()
+
+	h, err := machine.LoadHost(api, config.MachineName(*cc, cp))
+	if err != nil {
+		return false, nil
+	}
+	st, err := h.Driver.GetState()
+	return err == nil && st == state.Running, nil
+}
+
+// refreshPullSecrets mints a token for the registry of cc and writes it to the imagePullSecret of each of its namespaces,
+// which is added to the imagePullSecrets of their default service account
+func refreshPullSecrets(cc *config.ClusterConfig) error {
+	ps := cc.PullSecrets
+	args, user, err := PullSecretsCommand(ps.Provider, ps.Registry)
+	if err != nil {
+		return err
+	}
+	var stdout, stderr bytes.Buffer
+	c := exec.Command(args[0], args[1:]...)
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		return errors.Wrapf(err, "%s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return fmt.Errorf("%s printed no token", strings.Join(args, " "))
+	}
+
+	auth := base64.StdEncoding.EncodeToString([]byte(user + ":" + token))
+	dockerConfig, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			ps.Registry: map[string]string{"username": user, "password": token, "auth": auth},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		return errors.Wrap(err, "client")
+	}
+	// a namespace that is not created yet must not hold back the others
+	var errs []string
+	for _, ns := range ps.Namespaces {
+		if err := writePullSecret(client, ns, dockerConfig); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", ns, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	klog.Infof("refreshed the pull secrets of %s in %s", ps.Registry, strings.Join(ps.Namespaces, ", "))
+	return nil
+}
+
+// writePullSecret creates or updates the imagePullSecret of ns, and adds it to the default service account of ns
+func writePullSecret(client kubernetes.Interface, ns string, dockerConfig []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	secret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      PullSecretName,
+			Namespace: ns,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "minikube"},
+		},
+		Type: core.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{core.DockerConfigJsonKey: dockerConfig},
+	}
+	secrets := client.CoreV1().Secrets(ns)
+	if _, err := secrets.Update(ctx, secret, meta.UpdateOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrap(err, "update secret")
+		}
+		if _, err := secrets.Create(ctx, secret, meta.CreateOptions{}); err != nil {
+			return errors.Wrap(err, "create secret")
+		}
+	}
+
+	sa, err := client.CoreV1().ServiceAccounts(ns).Get(ctx, "default", meta.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "get default service account")
+	}
+	if slices.ContainsFunc(sa.ImagePullSecrets, func(r core.LocalObjectReference) bool { return r.Name == PullSecretName }) {
+		return nil
+	}
+	sa.ImagePullSecrets = append(sa.ImagePullSecrets, core.LocalObjectReference{Name: PullSecretName})
+	if _, err := client.CoreV1().ServiceAccounts(ns).Update(ctx, sa, meta.UpdateOptions{}); err != nil {
+		return errors.Wrap(err, "update default service account")
+	}
+	return nil
+}
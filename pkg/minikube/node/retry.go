@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/kubeadm"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// RetryPolicy is how many times, and after which failures, a node is tried to be added again
+type RetryPolicy struct {
+	// Attempts is the number of times the node is tried to be added, 1 to never try again
+	Attempts int
+	// Backoff is the wait before the second attempt, doubled before each of the next ones
+	Backoff time.Duration
+	// Phases are the phases of config.RetryPhases whose failures are tried again
+	Phases []string
+}
+
+// RetryPolicyFromConfig returns the retry policy of the provision-attempts, provision-backoff and provision-retry-phases settings
+func RetryPolicyFromConfig() (RetryPolicy, error) {
+	p := RetryPolicy{Attempts: max(viper.GetInt(config.ProvisionAttempts), 1), Backoff: viper.GetDuration(config.ProvisionBackoff)}
+	phases, err := config.ParseRetryPhases(viper.GetString(config.ProvisionRetryPhases))
+	if err != nil {
+		return p, err
+	}
+	p.Phases = phases
+	return p, nil
+}
+
+// backoff returns the wait before the attempt after attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	return p.Backoff << (attempt - 1)
+}
+
+// retries returns whether err, of the attempt attempt, is tried again, and the phase that it failed in
+func (p RetryPolicy) retries(err error, attempt int) (string, bool) {
+	ph := phaseOf(err)
+	return ph, ph != "" && attempt < p.Attempts && slices.Contains(p.Phases, ph)
+}
+
+// phaseError is the failure of a phase of adding a node
+type phaseError struct {
+	phase string
+	err   error
+}
+
+func (e *phaseError) Error() string {
+	return e.err.Error()
+}
+
+func (e *phaseError) Unwrap() error {
+	return e.err
+}
+
+// Cause returns the failure, so that errors.Cause looks through the phase
+func (e *phaseError) Cause() error {
+	return e.err
+}
+
+// phaseOf returns the phase of config.RetryPhases that err failed in, empty if it failed in another one
+func phaseOf(err error) string {
+	switch errors.Cause(err).(type) {
+	case *oci.FailFastError, *kubeadm.FailFastError:
+		return ""
+	}
+	var pe *phaseError
+	if errors.As(err, &pe) {
+		return pe.phase
+	}
+	var de *kubeadm.DownloadError
+	if errors.As(err, &de) {
+		return "download"
+	}
+	return ""
+}
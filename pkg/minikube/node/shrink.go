@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/machine/libmachine/state"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
+)
+
+const (
+	// memoryShrinkFile records the pid of the process of StartMemoryShrink in the profile directory
+	memoryShrinkFile = "memory-shrink.pid"
+	// shrinkCheckInterval is how often the activity of the guests is checked
+	shrinkCheckInterval = time.Minute
+	// idleLoad is the load average per CPU under which a guest is idle
+	idleLoad = 0.3
+	// minAvailableMemory is the fraction of available memory under which a guest is busy
+	minAvailableMemory = 0.15
+	// minShrunkMemory is the memory in MB left to an idle guest, which its control plane needs with some headroom
+	minShrunkMemory = 2048
+	// defaultKVMQemuURI is the connection URI of the kvm2 driver when --kvm-qemu-uri is not set
+	defaultKVMQemuURI = "qemu:///system"
+)
+
+// shrinkState is what the process of StartMemoryShrink knows about a guest
+type shrinkState struct {
+	idleSince time.Time
+	shrunk    bool
+}
+
+// StartMemoryShrink starts a minikube process that shrinks the memory of the guests of cc when the cluster is idle,
+// and restores it on activity, unless one is running already. The process exits once the cluster is stopped or deleted.
+func StartMemoryShrink(cc *config.ClusterConfig) error {
+	pidFile := filepath.Join(localpath.Profile(cc.Name), memoryShrinkFile)
+	if b, err := os.ReadFile(pidFile); err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(b))); err == nil && processRunning(pid) {
+			klog.Infof("memory is shrunk when idle by pid %d already", pid)
+			return nil
+		}
+	}
+
+	c := exec.Command(os.Args[0], "memory-shrink", "--profile", cc.Name)
+	c.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
+	if err := c.Start(); err != nil {
+		return errors.Wrap(err, "start")
+	}
+	klog.Infof("shrinking memory when idle in the background, pid %d", c.Process.Pid)
+	if err := os.WriteFile(pidFile, []byte(strconv.Itoa(c.Process.Pid)), 0o644); err != nil {
+		return errors.Wrap(err, "write pid")
+	}
+	return c.Process.Release()
+}
+
+// ShrinkMemory checks the activity of the guests of a profile every minute, shrinks the memory of the ones that have been idle
+// for the memory-auto-shrink duration, and restores the memory of the shrunk ones that are busy again.
+// It returns once no guest is running. It is run by the process of StartMemoryShrink.
+func ShrinkMemory(profile string) error {
+	guests := map[string]*shrinkState{}
+	for {
+		cc, err := config.Load(profile)
+		if config.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "load profile")
+		}
+
+		running, err := shrinkGuests(cc, guests)
+		if err != nil {
+			return err
+		}
+		if !running {
+			klog.Infof("no guest of %s is running, done", profile)
+			return nil
+		}
+		time.Sleep(shrinkCheckInterval)
+	}
+}
+
+// shrinkGuests shrinks or restores the memory of each running guest of cc according to its activity,
+// and returns false if none is running
+func shrinkGuests(cc *config.ClusterConfig, guests map[string]*shrinkState) (bool, error) {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return false, errors.Wrap(err, "api")
+	}
+	defer api.Close()
+
+	running := false
+	for _, n := range cc.Nodes {
+		m := config.MachineName(*cc, n)
+		h, err := machine.LoadHost(api, m)
+		if err != nil {
+			klog.Warningf("skipping %s: %v", m, err)
+			continue
+		}
+		if st, err := h.Driver.GetState(); err != nil || st != state.Running {
+			delete(guests, m)
+			continue
+		}
+		running = true
+
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			klog.Warningf("skipping %s: %v", m, err)
+			continue
+		}
+		rr, err := r.RunCmd(exec.Command("cat", "/proc/loadavg", "/proc/meminfo"))
+		if err != nil {
+			klog.Warningf("skipping %s: %v", m, err)
+			continue
+		}
+		load, available, err := guestActivity(rr.Stdout.String())
+		if err != nil {
+			klog.Warningf("skipping %s: %v", m, err)
+			continue
+		}
+
+		g, ok := guests[m]
+		if !ok {
+			g = &shrinkState{}
+			guests[m] = g
+		}
+		nc := config.NodeResources(*cc, n)
+		busy := load/float64(nc.CPUs) >= idleLoad || available < minAvailableMemory
+		switch {
+		case busy:
+			g.idleSince = time.Time{}
+			if g.shrunk {
+				klog.Infof("%s is busy (load %.2f, %.0f%% available), restoring %d MB", m, load, available*100, nc.Memory)
+				if err := setGuestMemory(cc, m, nc.Memory); err != nil {
+					return true, errors.Wrapf(err, "restore memory of %s", m)
+				}
+				g.shrunk = false
+			}
+		case g.idleSince.IsZero():
+			g.idleSince = time.Now()
+		case !g.shrunk && time.Since(g.idleSince) >= cc.MemoryAutoShrink:
+			shrunk := max(nc.Memory/2, minShrunkMemory)
+			if shrunk >= nc.Memory {
+				continue
+			}
+			klog.Infof("%s has been idle since %s, shrinking to %d MB", m, g.idleSince, shrunk)
+			if err := setGuestMemory(cc, m, shrunk); err != nil {
+				return true, errors.Wrapf(err, "shrink memory of %s", m)
+			}
+			g.shrunk = true
+		}
+	}
+	return running, nil
+}
+
+// guestActivity returns the load average over a minute, and the fraction of the memory that is available,
+// from the output of 'cat /proc/loadavg /proc/meminfo'
+func guestActivity(out string) (float64, float64, error) {
+	s := bufio.NewScanner(strings.NewReader(out))
+	if !s.Scan() {
+		return 0, 0, fmt.Errorf("no load average")
+	}
+	fields := strings.Fields(s.Text())
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("no load average")
+	}
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "load average")
+	}
+
+	var total, available float64
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			total, err = strconv.ParseFloat(fields[1], 64)
+		case "MemAvailable:":
+			available, err = strconv.ParseFloat(fields[1], 64)
+		}
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "meminfo")
+		}
+	}
+	if total == 0 {
+		return 0, 0, fmt.Errorf("no total memory")
+	}
+	return load, available / total, nil
+}
+
+// setGuestMemory sets the memory of the running guest of the kvm2 driver to mb, with its virtio balloon
+func setGuestMemory(cc *config.ClusterConfig, machineName string, mb int) error {
+	uri := cc.KVMQemuURI
+	if uri == "" {
+		uri = defaultKVMQemuURI
+	}
+	c := exec.Command("virsh", "-c", uri, "setmem", machineName, strconv.Itoa(mb*1024), "--live")
+	if out, err := c.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "%s: %s", strings.Join(c.Args, " "), out)
+	}
+	return nil
+}
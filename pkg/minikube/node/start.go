@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -45,6 +46,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/kubeadm"
 	"k8s.io/minikube/pkg/minikube/cluster"
+	kubevip "k8s.io/minikube/pkg/minikube/cluster/ha/kube-vip"
 	"k8s.io/minikube/pkg/minikube/cni"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
@@ -67,6 +69,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/vmpath"
 	"k8s.io/minikube/pkg/network"
 	"k8s.io/minikube/pkg/util"
+	"k8s.io/minikube/pkg/util/dag"
 	"k8s.io/minikube/pkg/util/retry"
 	kconst "k8s.io/minikube/third_party/kubeadm/app/constants"
 )
@@ -133,6 +136,12 @@ func Start(starter Starter) (*kubeconfig.Settings, error) { // nolint:gocyclo
 		klog.Errorf("Unable to add minikube host alias: %v", err)
 	}
 
+	// a previous start may have failed after creating the machine of the node, but before bootstrapping Kubernetes on it
+	bootstrapped := config.Bootstrapped(*starter.Node, starter.PreExists)
+	if starter.PreExists && !bootstrapped {
+		out.Step(style.Waiting, "Resuming the provisioning of node {{.name}}, which a previous start did not complete", out.V{"name": config.MachineName(*starter.Cfg, *starter.Node)})
+	}
+
 	var kcs *kubeconfig.Settings
 	var bs bootstrapper.Bootstrapper
 	if config.IsPrimaryControlPlane(*starter.Cfg, *starter.Node) {
@@ -141,8 +150,15 @@ func Start(starter Starter) (*kubeconfig.Settings, error) { // nolint:gocyclo
 		if err != nil {
 			return nil, err
 		}
+		if err := setPhase(starter.Cfg, starter.Node, config.NodeBootstrapped); err != nil {
+			return nil, err
+		}
+		warnExpiringCerts(starter.Runner, *starter.Cfg, *starter.Node)
+		if starter.Cfg.OIDC.IssuerURL != "" {
+			setupOIDCKubeconfig(kcs, *starter.Cfg)
+		}
 		// configure CoreDNS concurently from primary control-plane node only and only on first node start
-		if !starter.PreExists {
+		if !bootstrapped {
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
@@ -179,34 +195,50 @@ func Start(starter Starter) (*kubeconfig.Settings, error) { // nolint:gocyclo
 
 		// join cluster only on first node start
 		// except for vm driver in non-ha (non-multi-control plane) cluster - fallback to old behaviour
-		if !starter.PreExists || (driver.IsVM(starter.Cfg.Driver) && !config.IsHA(*starter.Cfg)) {
+		if !bootstrapped || (driver.IsVM(starter.Cfg.Driver) && !config.IsHA(*starter.Cfg)) {
 			// make sure to use the command runner for the primary control plane to generate the join token
 			pcpBs, err := cluster.ControlPlaneBootstrapper(starter.MachineAPI, starter.Cfg, viper.GetString(cmdcfg.Bootstrapper))
 			if err != nil {
 				return nil, errors.Wrap(err, "get primary control-plane bootstrapper")
 			}
 			if err := joinCluster(starter, pcpBs, bs); err != nil {
-				return nil, errors.Wrap(err, "join node to cluster")
+				return nil, &phaseError{"join", errors.Wrap(err, "join node to cluster")}
+			}
+			if err := setPhase(starter.Cfg, starter.Node, config.NodeBootstrapped); err != nil {
+				return nil, err
+			}
+		}
+
+		// balance to the API server of this secondary control-plane node too
+		if starter.Node.ControlPlane {
+			if err := haLoadBalancer(*starter.Cfg); err != nil {
+				return nil, errors.Wrap(err, "update the load balancer of the API servers")
 			}
 		}
 	}
 
 	go configureMounts(&wg, *starter.Cfg)
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		profile, err := config.LoadProfile(starter.Cfg.Name)
-		if err != nil {
-			out.FailureT("Unable to load profile: {{.error}}", out.V{"error": err})
-		}
-		if err := CacheAndLoadImagesInConfig([]*config.Profile{profile}); err != nil {
-			out.FailureT("Unable to push cached images: {{.error}}", out.V{"error": err})
-		}
-	}()
+	// with --background-images, start loads the cached images once every node is up, see StartBackgroundImages
+	if !viper.GetBool(backgroundImages) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			profile, err := config.LoadProfile(starter.Cfg.Name)
+			if err != nil {
+				out.FailureT("Unable to load profile: {{.error}}", out.V{"error": err})
+			}
+			if err := CacheAndLoadImagesInConfig([]*config.Profile{profile}); err != nil {
+				out.FailureT("Unable to push cached images: {{.error}}", out.V{"error": err})
+			}
+		}()
+	}
 
 	// enable addons, both old and new!
 	addonList := viper.GetStringSlice(config.AddonListFlag)
+	if starter.Cfg.OIDC.IssuerURL == constants.DexIssuer {
+		addonList = append(addonList, "dex")
+	}
 	enabledAddons := make(chan []string, 1)
 	if starter.ExistingAddons != nil {
 		if viper.GetBool("force") {
@@ -255,6 +287,15 @@ func Start(starter Starter) (*kubeconfig.Settings, error) { // nolint:gocyclo
 	return kcs, config.Write(viper.GetString(config.ProfileName), starter.Cfg)
 }
 
+// setPhase saves phase as the last phase of provisioning n that completed
+func setPhase(cc *config.ClusterConfig, n *config.Node, phase string) error {
+	if n.Phase == phase {
+		return nil
+	}
+	n.Phase = phase
+	return errors.Wrap(config.SaveNode(cc, n), "save node")
+}
+
 // handleNoKubernetes handles starting minikube without Kubernetes.
 func handleNoKubernetes(starter Starter) (bool, error) {
 	// Do not bootstrap cluster if --no-kubernetes.
@@ -283,8 +324,17 @@ func startPrimaryControlPlane(starter Starter, cr cruntime.Manager) (*kubeconfig
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "inspect network")
 		}
+		vip := starter.Cfg.KubernetesConfig.HAVIP
+		if vip == "" {
+			vip = n.ClientMax // last available ip from node's subnet, should've been reserved already
+		} else if _, subnet, err := net.ParseCIDR(n.CIDR); err != nil || !subnet.Contains(net.ParseIP(vip)) {
+			return nil, nil, fmt.Errorf("the virtual IP %s is not in the subnet %s of the nodes", vip, n.CIDR)
+		}
 		// update cluster config
-		starter.Cfg.KubernetesConfig.APIServerHAVIP = n.ClientMax // last available ip from node's subnet, should've been reserved already
+		starter.Cfg.KubernetesConfig.APIServerHAVIP = vip
+		if err := haLoadBalancer(*starter.Cfg); err != nil {
+			return nil, nil, errors.Wrap(err, "start the load balancer of the API servers")
+		}
 	}
 
 	// must be written before bootstrap, otherwise health checks may flake due to stale IP
@@ -384,26 +434,92 @@ func Provision(cc *config.ClusterConfig, n *config.Node, delOnFail bool) (comman
 		out.Step(style.ThumbsUp, "Starting \"{{.node}}\" {{.role}} node in \"{{.cluster}}\" cluster", out.V{"node": name, "role": role, "cluster": cc.Name})
 	}
 
+	downloadOnly := viper.GetBool("download-only")
 	if driver.IsKIC(cc.Driver) {
-		beginDownloadKicBaseImage(&kicGroup, cc, viper.GetBool("download-only"))
-	}
-
-	if !driver.BareMetal(cc.Driver) {
-		beginCacheKubernetesImages(&cacheGroup, cc.KubernetesConfig.ImageRepository, n.KubernetesVersion, cc.KubernetesConfig.ContainerRuntime, cc.Driver)
+		beginDownloadKicBaseImage(&kicGroup, cc, downloadOnly)
 	}
 
+	// The phases below run as soon as the ones they depend on are done, rather than one after the other:
+	// eg: a VM boots while the preload is downloaded, which is only needed once the VM is up.
+	var phases dag.Graph
+	phases.Add("cache kubernetes images", func() error {
+		if !driver.BareMetal(cc.Driver) {
+			beginCacheKubernetesImages(&cacheGroup, cc.KubernetesConfig.ImageRepository, n.KubernetesVersion, cc.KubernetesConfig.ContainerRuntime, cc.Driver)
+		}
+		return nil
+	})
 	// Abstraction leakage alert: startHost requires the config to be saved, to satistfy pkg/provision/buildroot.
 	// Hence, SaveProfile must be called before startHost, and again afterwards when we know the IP.
-	if err := config.SaveProfile(viper.GetString(config.ProfileName), cc); err != nil {
-		return nil, false, nil, nil, errors.Wrap(err, "Failed to save config")
+	phases.Add("save profile", func() error {
+		return errors.Wrap(config.SaveProfile(viper.GetString(config.ProfileName), cc), "Failed to save config")
+	})
+	if downloadOnly {
+		phases.Add("download only", func() error {
+			handleDownloadOnly(&cacheGroup, &kicGroup, n.KubernetesVersion, cc.KubernetesConfig.ContainerRuntime, cc.Driver)
+			return nil
+		}, "cache kubernetes images", "save profile")
+		return nil, false, nil, nil, phases.Run()
+	}
+
+	if cc.KubernetesConfig.KubernetesVersion != constants.NoKubernetesVersion {
+		phases.Add("generate shared ca certs", func() error {
+			if err := bootstrapper.GenerateSharedCACerts(); err != nil {
+				// SetupCerts retries, and reports the failure
+				klog.Warningf("unable to generate shared ca certs: %v", err)
+			}
+			return nil
+		})
 	}
-
-	handleDownloadOnly(&cacheGroup, &kicGroup, n.KubernetesVersion, cc.KubernetesConfig.ContainerRuntime, cc.Driver)
+	phases.Add("download kic base image", func() error {
+		if driver.IsKIC(cc.Driver) {
+			waitDownloadKicBaseImage(&kicGroup)
+		}
+		return nil
+	})
+	machineDeps := []string{"save profile", "download kic base image"}
 	if driver.IsKIC(cc.Driver) {
-		waitDownloadKicBaseImage(&kicGroup)
-	}
+		// the preload is extracted to the volume of the container node while it is created
+		machineDeps = append(machineDeps, "cache kubernetes images")
+	}
+	var (
+		runner     command.Runner
+		preExists  bool
+		machineAPI libmachine.API
+		h          *host.Host
+	)
+	phases.Add("start machine", func() error {
+		var err error
+		runner, preExists, machineAPI, h, err = startMachine(cc, n, delOnFail)
+		if err != nil {
+			return &phaseError{"create", err}
+		}
+		if !preExists {
+			return setPhase(cc, n, config.NodeCreated)
+		}
+		return nil
+	}, machineDeps...)
 
-	return startMachine(cc, n, delOnFail)
+	err := phases.Run()
+	return runner, preExists, machineAPI, h, err
+}
+
+// haLoadBalancer runs the haproxy that serves the virtual IP of the HA cluster cc with the docker and podman drivers and --ha-lb-mode=haproxy,
+// balancing to the control-plane nodes that have an IP yet. In the other modes, it deletes the one of a previous start, as kube-vip serves the virtual IP.
+func haLoadBalancer(cc config.ClusterConfig) error {
+	if !config.IsHA(cc) || !driver.IsKIC(cc.Driver) {
+		return nil
+	}
+	ociBin, network := machine.KICNetwork(cc)
+	if kubevip.Mode(cc) != kubevip.ModeHAProxy {
+		return oci.DeleteHAProxy(ociBin, cc.Name)
+	}
+	var backends []string
+	for _, cp := range config.ControlPlanes(cc) {
+		if cp.IP != "" {
+			backends = append(backends, net.JoinHostPort(cp.IP, strconv.Itoa(cp.Port)))
+		}
+	}
+	return oci.EnsureHAProxy(ociBin, cc.Name, network, cc.KubernetesConfig.APIServerHAVIP, cc.APIServerPort, backends)
 }
 
 // ConfigureRuntimes does what needs to happen to get a runtime going.
@@ -416,6 +532,8 @@ func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, k
 		ImageRepository:   cc.KubernetesConfig.ImageRepository,
 		KubernetesVersion: kv,
 		InsecureRegistry:  cc.InsecureRegistry,
+		RegistryMirror:    cc.RegistryMirror,
+		SharedMirror:      machine.SharedMirror(cc),
 	}
 	if cc.GPUs != "" {
 		co.GPUs = true
@@ -598,8 +716,25 @@ func setupKubeadm(mAPI libmachine.API, cfg config.ClusterConfig, n config.Node,
 
 	// Loads cached images, generates config files, download binaries
 	// update cluster and set up certs
-
-	if err := bs.UpdateCluster(cfg); err != nil {
+	var updateErr, certsErr error
+	var phases dag.Graph
+	phases.Add("update cluster", func() error {
+		updateErr = bs.UpdateCluster(cfg)
+		return updateErr
+	})
+	var certsDeps []string
+	if _, err := os.Stat(filepath.Join(localpath.Profile(cfg.KubernetesConfig.ClusterName), "apiserver.crt")); err == nil {
+		// renewing the expired certs of an existing cluster needs the kubeadm binary and config installed by UpdateCluster
+		certsDeps = append(certsDeps, "update cluster")
+	}
+	phases.Add("setup certs", func() error {
+		certsErr = bs.SetupCerts(cfg, n, r)
+		return certsErr
+	}, certsDeps...)
+	// the errors are reported below, as each phase has its own reason
+	_ = phases.Run()
+
+	if err := updateErr; err != nil {
 		if !deleteOnFailure {
 			if errors.Is(err, cruntime.ErrContainerRuntimeNotRunning) {
 				exit.Error(reason.KubernetesInstallFailedRuntimeNotRunning, "Failed to update cluster", err)
@@ -610,7 +745,7 @@ func setupKubeadm(mAPI libmachine.API, cfg config.ClusterConfig, n config.Node,
 		return nil, err
 	}
 
-	if err := bs.SetupCerts(cfg, n, r); err != nil {
+	if err := certsErr; err != nil {
 		if !deleteOnFailure {
 			exit.Error(reason.GuestCert, "Failed to setup certs", err)
 		}
@@ -643,12 +778,12 @@ func setupKubeconfig(h host.Host, cc config.ClusterConfig, n config.Node, cluste
 		ClusterServerAddress: addr,
 		ClientCertificate:    localpath.ClientCert(cc.Name),
 		ClientKey:            localpath.ClientKey(cc.Name),
-		CertificateAuthority: localpath.CACert(),
-		KeepContext:          cc.KeepContext,
+		CertificateAuthority: localpath.ClusterCACert(cc.Name),
+		KeepContext:          config.KeepsContext(cc),
 		EmbedCerts:           cc.EmbedCerts,
 	}
 
-	kcs.SetPath(kubeconfig.PathFromEnv())
+	kcs.SetPath(kubeconfig.PathForProfile(cc.Name, cc.KubeconfigMode))
 	return kcs
 }
 
@@ -672,6 +807,18 @@ func startMachine(cfg *config.ClusterConfig, node *config.Node, delOnFail bool)
 		return runner, preExists, m, host, errors.Wrap(err, "Failed to validate network")
 	}
 
+	if driver.IsSSH(host.Driver.DriverName()) {
+		if err := detectArch(runner, cfg, node); err != nil {
+			klog.Warningf("unable to detect the arch of %s: %v", config.MachineName(*cfg, *node), err)
+		}
+	}
+
+	if cfg.KubernetesConfig.DualStack {
+		if err := detectIPv6(runner, cfg, node, ip); err != nil {
+			out.WarningT("Unable to detect the IPv6 address of {{.name}}, so it only has an IPv4 one: {{.error}}", out.V{"name": config.MachineName(*cfg, *node), "error": err})
+		}
+	}
+
 	if driver.IsQEMU(host.Driver.DriverName()) && network.IsBuiltinQEMU(cfg.Network) {
 		apiServerPort, err := getPort()
 		if err != nil {
@@ -689,6 +836,63 @@ func startMachine(cfg *config.ClusterConfig, node *config.Node, delOnFail bool)
 	return runner, preExists, m, host, err
 }
 
+// detectArch saves the CPU architecture of the machine of n, which the ssh driver does not choose, when it is not the one of the host
+func detectArch(r command.Runner, cc *config.ClusterConfig, n *config.Node) error {
+	rr, err := r.RunCmd(exec.Command("uname", "-m"))
+	if err != nil {
+		return errors.Wrap(err, "uname")
+	}
+	arch := strings.TrimSpace(rr.Stdout.String())
+	switch arch {
+	case "x86_64":
+		arch = "amd64"
+	case "aarch64":
+		arch = "arm64"
+	}
+	klog.Infof("%s is an %s machine", config.MachineName(*cc, *n), arch)
+	if arch == detect.EffectiveArch() {
+		arch = ""
+	}
+	if n.Arch == arch {
+		return nil
+	}
+	n.Arch = arch
+	return config.SaveNode(cc, n)
+}
+
+// detectIPv6 saves the IPv6 address of the machine of n in dual-stack clusters, the one of the interface of its IPv4 address ip
+func detectIPv6(r command.Runner, cc *config.ClusterConfig, n *config.Node, ip string) error {
+	rr, err := r.RunCmd(exec.Command("ip", "-o", "addr", "show", "to", ip))
+	if err != nil {
+		return errors.Wrap(err, "ip addr")
+	}
+	// eg: 2: eth0    inet 192.168.49.2/24 brd 192.168.49.255 scope global eth0
+	fields := strings.Fields(rr.Stdout.String())
+	if len(fields) < 2 {
+		return errors.Errorf("no interface has the address %s", ip)
+	}
+	iface, _, _ := strings.Cut(fields[1], "@")
+	rr, err = r.RunCmd(exec.Command("ip", "-o", "-6", "addr", "show", "dev", iface, "scope", "global"))
+	if err != nil {
+		return errors.Wrap(err, "ip -6 addr")
+	}
+	// eg: 2: eth0    inet6 fd00:c0a8:3100::2/64 scope global nodad
+	fields = strings.Fields(rr.Stdout.String())
+	if len(fields) < 4 {
+		return errors.Errorf("%s has no global IPv6 address", iface)
+	}
+	ipv6, _, err := net.ParseCIDR(fields[3])
+	if err != nil {
+		return errors.Wrapf(err, "parse %s", fields[3])
+	}
+	klog.Infof("%s has the IPv6 address %s", config.MachineName(*cc, *n), ipv6)
+	if n.IPv6 == ipv6.String() {
+		return nil
+	}
+	n.IPv6 = ipv6.String()
+	return config.SaveNode(cc, n)
+}
+
 // getPort asks the kernel for a free open port that is ready to use
 func getPort() (int, error) {
 	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
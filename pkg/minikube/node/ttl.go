@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+const (
+	// ttlFile records the pid of the process of StartTTL in the profile directory
+	ttlFile = "ttl.pid"
+	// ttlInterval is how often the owner of an ephemeral cluster is checked
+	ttlInterval = 5 * time.Second
+)
+
+// StartTTL starts a process of the minikube binary that deletes the ephemeral cluster cc once it expires,
+// or its owner process exits, unless one is running already. binary is the minikube binary, eg: os.Args[0].
+func StartTTL(cc *config.ClusterConfig, binary string) error {
+	pidFile := filepath.Join(localpath.Profile(cc.Name), ttlFile)
+	if b, err := os.ReadFile(pidFile); err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(b))); err == nil && processRunning(pid) {
+			klog.Infof("the TTL of %s is watched by pid %d already", cc.Name, pid)
+			return nil
+		}
+	}
+
+	c := exec.Command(binary, "ttl", "--profile", cc.Name)
+	c.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
+	if err := c.Start(); err != nil {
+		return errors.Wrap(err, "start")
+	}
+	klog.Infof("watching the TTL of %s in the background, pid %d", cc.Name, c.Process.Pid)
+	if err := os.WriteFile(pidFile, []byte(strconv.Itoa(c.Process.Pid)), 0o644); err != nil {
+		return errors.Wrap(err, "write pid")
+	}
+	return c.Process.Release()
+}
+
+// WatchTTL returns true once the ephemeral cluster of a profile expires or its owner process exits, and false once
+// the cluster is deleted or its TTL is unset. It is run by the process of StartTTL, which then deletes the cluster.
+func WatchTTL(profile string) (bool, error) {
+	for {
+		cc, err := config.Load(profile)
+		if config.IsNotExist(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "load profile")
+		}
+		if cc.TTL.Expires.IsZero() {
+			klog.Infof("the TTL of %s is unset, done", profile)
+			return false, nil
+		}
+		left := time.Until(cc.TTL.Expires)
+		if left <= 0 {
+			klog.Infof("%s expired at %s", profile, cc.TTL.Expires)
+			return true, nil
+		}
+		if cc.TTL.OwnerPID != 0 && !processRunning(cc.TTL.OwnerPID) {
+			klog.Infof("the owner of %s, pid %d, exited", profile, cc.TTL.OwnerPID)
+			return true, nil
+		}
+		time.Sleep(min(left, ttlInterval))
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"github.com/docker/machine/libmachine"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+
+	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/style"
+)
+
+// Upgrade upgrades the Kubernetes of cc in place to version: the control-plane nodes first, the primary one leading,
+// then the workers one at a time, each drained beforehand and uncordoned afterwards.
+// Each node is saved once it is upgraded, and the cluster once all of them are, so that running it again resumes a failed upgrade.
+func Upgrade(api libmachine.API, cc *config.ClusterConfig, version string) error {
+	out.Step(style.FileDownload, "Downloading Kubernetes {{.version}} ...", out.V{"version": version})
+	if err := machine.CacheBinariesForBootstrapper(version, nil, cc.BinaryMirror); err != nil {
+		return errors.Wrap(err, "cache binaries")
+	}
+
+	upgraded := *cc
+	upgraded.KubernetesConfig.KubernetesVersion = version
+
+	pcp, err := config.ControlPlane(*cc)
+	if err != nil {
+		return errors.Wrap(err, "get primary control-plane node")
+	}
+	// kubeadm upgrades the primary control-plane node first, which upgrades the configuration of the cluster
+	nodes := []config.Node{pcp}
+	for _, n := range config.ControlPlanes(*cc) {
+		if !config.IsPrimaryControlPlane(*cc, n) {
+			nodes = append(nodes, n)
+		}
+	}
+	for _, n := range cc.Nodes {
+		if !n.ControlPlane {
+			nodes = append(nodes, n)
+		}
+	}
+
+	for _, n := range nodes {
+		m := config.MachineName(*cc, n)
+		if n.KubernetesVersion == version {
+			klog.Infof("%s already runs Kubernetes %s, skipping", m, version)
+			continue
+		}
+		if !n.ControlPlane {
+			out.Step(style.Waiting, "Draining {{.name}} ...", out.V{"name": m})
+			if err := Drain(upgraded, n.Name); err != nil {
+				return errors.Wrapf(err, "drain %s", m)
+			}
+		}
+
+		out.Step(style.Waiting, "Upgrading {{.name}} to Kubernetes {{.version}} ...", out.V{"name": m, "version": version})
+		h, err := machine.LoadHost(api, m)
+		if err != nil {
+			return errors.Wrapf(err, "load host %s", m)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			return errors.Wrapf(err, "command runner %s", m)
+		}
+		bs, err := cluster.Bootstrapper(api, viper.GetString(cmdcfg.Bootstrapper), upgraded, r)
+		if err != nil {
+			return errors.Wrap(err, "bootstrapper")
+		}
+		if err := bs.UpgradeNode(upgraded, n); err != nil {
+			return errors.Wrapf(err, "upgrade %s", m)
+		}
+
+		if !n.ControlPlane {
+			if err := Uncordon(upgraded, n.Name); err != nil {
+				return errors.Wrapf(err, "uncordon %s", m)
+			}
+		}
+		n.KubernetesVersion = version
+		if err := config.SaveNode(cc, &n); err != nil {
+			return errors.Wrapf(err, "save node %s", m)
+		}
+	}
+
+	cc.KubernetesConfig.KubernetesVersion = version
+	return errors.Wrap(config.SaveProfile(cc.Name, cc), "save profile")
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"os"
+	"os/exec"
+	"slices"
+
+	"github.com/docker/machine/libmachine/mcnerror"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"k8s.io/klog/v2"
+
+	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/util"
+)
+
+// StartWarmNodes starts a minikube process that boots or deletes warm nodes of cc, until it has as many as the warm-nodes setting.
+// The process waits for the profile lock, so it runs once the current command is done.
+func StartWarmNodes(cc *config.ClusterConfig) error {
+	c := exec.Command(os.Args[0], "warm-nodes", "--profile", cc.Name)
+	c.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
+	if err := c.Start(); err != nil {
+		return errors.Wrap(err, "start")
+	}
+	klog.Infof("warming nodes in the background, pid %d", c.Process.Pid)
+	return c.Process.Release()
+}
+
+// WarmNodes boots guests that are ready to join cc, until it has as many warm nodes as the warm-nodes setting, and deletes the extra ones.
+// Warm nodes that are not running, eg: after 'minikube stop', are started again. It is run by the process of StartWarmNodes.
+func WarmNodes(cc *config.ClusterConfig) error {
+	want := viper.GetInt(config.WarmNodes)
+	// warm nodes join a Kubernetes cluster of several machines
+	if cc.KubernetesConfig.KubernetesVersion == constants.NoKubernetesVersion || driver.BareMetal(cc.Driver) {
+		want = 0
+	}
+
+	for len(cc.WarmNodes) > want {
+		w := cc.WarmNodes[len(cc.WarmNodes)-1]
+		if err := deleteWarmNode(cc, w); err != nil {
+			return errors.Wrapf(err, "delete warm node %s", w.Name)
+		}
+		cc.WarmNodes = cc.WarmNodes[:len(cc.WarmNodes)-1]
+		if err := config.SaveProfile(cc.Name, cc); err != nil {
+			return errors.Wrap(err, "save profile")
+		}
+	}
+
+	// warm nodes are recorded before they are booted, so that 'minikube delete' can remove them
+	for len(cc.WarmNodes) < want {
+		cc.WarmNodes = append(cc.WarmNodes, config.Node{
+			Name:              NextName(cc),
+			Worker:            true,
+			KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+			ContainerRuntime:  cc.KubernetesConfig.ContainerRuntime,
+		})
+	}
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		return errors.Wrap(err, "save profile")
+	}
+
+	for i := range cc.WarmNodes {
+		if err := warmNode(cc, &cc.WarmNodes[i]); err != nil {
+			return errors.Wrapf(err, "warm node %s", cc.WarmNodes[i].Name)
+		}
+	}
+	return nil
+}
+
+// warmNode boots the guest of the warm node w, with its container runtime and kubelet configured, so that joining the cluster is all that is left
+func warmNode(cc *config.ClusterConfig, w *config.Node) error {
+	n := *w
+	r, _, api, _, err := Provision(cc, &n, false)
+	if api != nil {
+		defer api.Close()
+	}
+	// provisioning saves the node with its IP as a cluster node, which it is not yet
+	cc.Nodes = slices.DeleteFunc(cc.Nodes, func(cn config.Node) bool { return cn.Name == n.Name })
+	*w = n
+	if serr := config.SaveProfile(cc.Name, cc); serr != nil && err == nil {
+		err = errors.Wrap(serr, "save profile")
+	}
+	if err != nil {
+		return err
+	}
+
+	waitCacheRequiredImages(&cacheGroup)
+	sv, err := util.ParseKubernetesVersion(n.KubernetesVersion)
+	if err != nil {
+		return errors.Wrap(err, "parse Kubernetes version")
+	}
+	cr := configureRuntimes(r, *cc, sv)
+	bs, err := cluster.Bootstrapper(api, viper.GetString(cmdcfg.Bootstrapper), *cc, r)
+	if err != nil {
+		return errors.Wrap(err, "bootstrapper")
+	}
+	return errors.Wrap(bs.UpdateNode(*cc, n, cr), "update node")
+}
+
+// deleteWarmNode deletes the guest of the warm node w
+func deleteWarmNode(cc *config.ClusterConfig, w config.Node) error {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "api")
+	}
+	defer api.Close()
+
+	err = machine.DeleteHost(api, config.MachineName(*cc, w))
+	if _, ok := errors.Cause(err).(mcnerror.ErrHostDoesNotExist); ok {
+		return nil
+	}
+	return err
+}
+
+// claimWarmNode makes the warm node of cc named like n part of the cluster, and returns true if there was one.
+// The guest of a claimed node is running already, so it only has to join the cluster.
+func claimWarmNode(cc *config.ClusterConfig, n *config.Node) bool {
+	i := slices.IndexFunc(cc.WarmNodes, func(w config.Node) bool { return w.Name == n.Name })
+	if i == -1 {
+		return false
+	}
+	n.IP = cc.WarmNodes[i].IP
+	cc.WarmNodes = slices.Delete(cc.WarmNodes, i, i+1)
+	return true
+}
+
+// NextName returns the name of the node following the last one of cc, warm nodes included
+func NextName(cc *config.ClusterConfig) string {
+	last := 0
+	for _, n := range slices.Concat(cc.Nodes, cc.WarmNodes) {
+		if id, err := ID(n.Name); err == nil && id > last {
+			last = id
+		}
+	}
+	return Name(last + 1)
+}
+
+// NextNames returns the names of count nodes to add to cc: the warm nodes first, if they are to be workers,
+// then the ones following the last node
+func NextNames(cc *config.ClusterConfig, count int, worker bool) []string {
+	var names []string
+	if worker {
+		for _, w := range cc.WarmNodes {
+			if len(names) < count {
+				names = append(names, w.Name)
+			}
+		}
+	}
+	next, _ := ID(NextName(cc))
+	for len(names) < count {
+		names = append(names, Name(next))
+		next++
+	}
+	return names
+}
@@ -54,6 +54,10 @@ func MaybePrintUpdateTextFromAliyunMirror() {
 }
 
 func maybePrintUpdateText(latestReleasesURL string, betaReleasesURL string, lastUpdatePath string) {
+	// not even checked, eg: with --ci
+	if !viper.GetBool(config.WantUpdateNotification) {
+		return
+	}
 	latestVersion, err := latestVersionFromURL(latestReleasesURL)
 	if err != nil {
 		klog.Warning(err)
@@ -65,6 +65,12 @@ var (
 	OverrideEnv = "MINIKUBE_IN_STYLE"
 	// JSON is whether or not we should output stdout in JSON format. Set using SetJSON()
 	JSON = false
+	// Plain is whether the output is plain text, without colors, emojis, spinners or progress bars. Set using SetPlain()
+	Plain = false
+	// githubGroups is whether each step starts a collapsible group of the logs of GitHub Actions. Set using SetGitHubGroups()
+	githubGroups = false
+	// groupOpen is whether a group of the logs of GitHub Actions is open, to be ended by EndGroup()
+	groupOpen = false
 	// spin is spinner showed at starting minikube
 	spin = spinner.New(spinner.CharSets[style.SpinnerCharacter], 100*time.Millisecond)
 	// defaultBoxCfg is the default style config for cli box output
@@ -98,9 +104,40 @@ func Step(st style.Enum, format string, a ...V) {
 		return
 	}
 	register.RecordStep(outStyled)
+	if githubGroups && !silent {
+		EndGroup()
+		String("::group::%s\n", strings.TrimSpace(outStyled))
+		groupOpen = true
+		return
+	}
 	Styled(st, format, a...)
 }
 
+// EndGroup ends the open group of the logs of GitHub Actions, if any
+func EndGroup() {
+	if groupOpen && !silent {
+		groupOpen = false
+		String("::endgroup::\n")
+	}
+}
+
+// Progress writes a stylized and templated message to stdout, even while the output is silent,
+// eg: the progress of the nodes added in parallel, whose own output is silenced so that it does not interleave
+func Progress(st style.Enum, format string, a ...V) {
+	outStyled, _ := stylized(st, useColor, format, a...)
+	if JSON {
+		register.PrintStep(outStyled)
+		klog.Info(outStyled)
+		return
+	}
+	register.RecordStep(outStyled)
+	klog.Info(outStyled)
+	if outFile == nil {
+		return
+	}
+	Output(outFile, "%s", outStyled)
+}
+
 // Styled writes a stylized and templated message to stdout
 func Styled(st style.Enum, format string, a ...V) {
 	if JSON || st == style.Option {
@@ -108,7 +145,7 @@ func Styled(st style.Enum, format string, a ...V) {
 		return
 	}
 	outStyled, spinner := stylized(st, useColor, format, a...)
-	if spinner {
+	if spinner && !Plain && !silent {
 		spinnerString(outStyled)
 	} else {
 		String(outStyled)
@@ -230,6 +267,8 @@ func Err(format string, a ...interface{}) {
 		return
 	}
 	register.RecordError(format)
+	// errors are not hidden in collapsed groups
+	EndGroup()
 
 	if errFile == nil {
 		klog.Errorf("[unset errFile]: %s", fmt.Sprintf(format, a...))
@@ -280,6 +319,21 @@ func IsTerminal(w fdWriter) bool {
 	return isatty.IsTerminal(w.Fd())
 }
 
+// SetPlain configures whether the output is plain text, eg: for the logs of CI pipelines
+func SetPlain(p bool) {
+	klog.Infof("Setting plain to %v", p)
+	Plain = p
+	if p {
+		useColor = false
+	}
+}
+
+// SetGitHubGroups configures whether each step starts a collapsible group of the logs of GitHub Actions
+func SetGitHubGroups(g bool) {
+	klog.Infof("Setting GitHub groups to %v", g)
+	githubGroups = g
+}
+
 // SetSilent configures whether output is disabled or not
 func SetSilent(q bool) {
 	klog.Infof("Setting silent to %v", q)
@@ -314,6 +368,9 @@ func wantsColor(w fdWriter) bool {
 	// MINIKUBE_IN_STYLE=[0, f, false, FALSE]
 	//
 	// If unset, we try to automatically determine suitability from the environment.
+	if Plain {
+		return false
+	}
 	val := os.Getenv(OverrideEnv)
 	if val != "" {
 		klog.Infof("%s=%q\n", OverrideEnv, os.Getenv(OverrideEnv))
@@ -121,6 +121,42 @@ func TestErr(t *testing.T) {
 	}
 }
 
+func TestGitHubGroups(t *testing.T) {
+	t.Setenv(OverrideEnv, "0")
+	f := tests.NewFakeFile()
+	SetOutFile(f)
+	SetErrFile(tests.NewFakeFile())
+	SetGitHubGroups(true)
+	defer SetGitHubGroups(false)
+
+	Step(style.Happy, "Starting")
+	Infof("detail")
+	Step(style.Ready, "Done!")
+	Err("failed\n")
+	EndGroup()
+
+	want := "::group::* Starting\n  - detail\n::endgroup::\n::group::* Done!\n::endgroup::\n"
+	if got := f.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	t.Setenv(OverrideEnv, "0")
+	f := tests.NewFakeFile()
+	SetOutFile(f)
+	SetSilent(true)
+	defer SetSilent(false)
+
+	Step(style.Happy, "Creating container")
+	Progress(style.Ready, "Node {{.node}} is ready ({{.ready}}/{{.total}})", V{"node": "minikube-m02", "ready": 1, "total": 3})
+
+	want := "* Node minikube-m02 is ready (1/3)\n"
+	if got := f.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
 func createLogFile() (string, error) {
 	td := os.TempDir()
 	name := filepath.Join(td, "minikube_test_test_test.log")
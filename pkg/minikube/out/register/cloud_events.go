@@ -38,7 +38,7 @@ var (
 	// GetUUID returns the UUID function to use
 	GetUUID = randomID
 
-	eventFile *os.File
+	eventFiles []*os.File
 )
 
 // SetOutputFile sets the writer to emit all events to
@@ -48,6 +48,12 @@ func SetOutputFile(w io.Writer) {
 
 // SetEventLogPath sets the path of an event log file
 func SetEventLogPath(path string) {
+	eventFiles = nil
+	AddEventLogPath(path)
+}
+
+// AddEventLogPath adds an event log file, which the events are written to as well, eg: for the artifacts of a CI pipeline
+func AddEventLogPath(path string) {
 	if _, err := os.Stat(filepath.Dir(path)); err != nil {
 		if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
 			klog.Errorf("Error creating profile directory: %v", err)
@@ -60,7 +66,7 @@ func SetEventLogPath(path string) {
 		klog.Errorf("unable to write to %s: %v", path, err)
 		return
 	}
-	eventFile = f
+	eventFiles = append(eventFiles, f)
 }
 
 // CloudEvent creates a CloudEvent from a log object & associated data
@@ -99,21 +105,23 @@ func printAndRecordCloudEvent(log Log, data map[string]string) {
 	}
 	fmt.Fprintln(outputFile, string(bs))
 
-	if eventFile != nil {
+	if len(eventFiles) > 0 {
 		storeEvent(bs)
 	}
 }
 
 func storeEvent(bs []byte) {
-	fmt.Fprintln(eventFile, string(bs))
-	if err := eventFile.Sync(); err != nil {
-		klog.Warningf("even file flush failed: %v", err)
+	for _, f := range eventFiles {
+		fmt.Fprintln(f, string(bs))
+		if err := f.Sync(); err != nil {
+			klog.Warningf("even file flush failed: %v", err)
+		}
 	}
 }
 
 // record cloud event to disk
 func recordCloudEvent(log Log, data map[string]string) {
-	if eventFile == nil {
+	if len(eventFiles) == 0 {
 		return
 	}
 
@@ -46,6 +46,12 @@ func PrintDownloadProgress(artifact, progress string) {
 	printAsCloudEvent(s, s.data)
 }
 
+// PrintImageLoadProgress prints an ImageLoadProgress type in JSON format
+func PrintImageLoadProgress(node, progress string) {
+	s := NewImageLoadProgress(node, progress)
+	printAsCloudEvent(s, s.data)
+}
+
 // PrintError prints an Error type in JSON format
 func PrintError(err string) {
 	e := NewError(err)
@@ -117,3 +117,21 @@ func TestWarning(t *testing.T) {
 
 	tests.CompareJSON(t, actual, []byte(expected))
 }
+
+func TestImageLoadProgress(t *testing.T) {
+	expected := `{"data":{"node":"minikube-m02","progress":"0.500000"},"datacontenttype":"application/json","id":"random-id","source":"https://minikube.sigs.k8s.io/","specversion":"1.0","type":"io.k8s.sigs.minikube.image.load.progress"}`
+	expected += "\n"
+
+	buf := bytes.NewBuffer([]byte{})
+	SetOutputFile(buf)
+	defer func() { SetOutputFile(os.Stdout) }()
+
+	GetUUID = func() string {
+		return "random-id"
+	}
+
+	PrintImageLoadProgress("minikube-m02", "0.500000")
+	actual := buf.Bytes()
+
+	tests.CompareJSON(t, actual, []byte(expected))
+}
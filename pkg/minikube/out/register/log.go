@@ -22,7 +22,7 @@ import (
 )
 
 // Log represents the different types of logs that can be output as JSON
-// This includes: Step, Download, DownloadProgress, Warning, Info, Error
+// This includes: Step, Download, DownloadProgress, ImageLoadProgress, Warning, Info, Error
 type Log interface {
 	Type() string
 }
@@ -86,6 +86,24 @@ func NewDownloadProgress(artifact, progress string) *DownloadProgress {
 	}}
 }
 
+// ImageLoadProgress will be used to notify the user around the progress of the images loaded into a node
+type ImageLoadProgress struct {
+	data map[string]string
+}
+
+// Type returns the cloud events compatible type of this struct
+func (s *ImageLoadProgress) Type() string {
+	return "io.k8s.sigs.minikube.image.load.progress"
+}
+
+// NewImageLoadProgress returns a new image load progress type
+func NewImageLoadProgress(node, progress string) *ImageLoadProgress {
+	return &ImageLoadProgress{data: map[string]string{
+		"progress": progress,
+		"node":     node,
+	}}
+}
+
 // Warning will be used to notify the user of warnings
 type Warning struct {
 	data map[string]string
@@ -19,6 +19,7 @@ package register
 
 import (
 	"fmt"
+	"sync"
 
 	"k8s.io/klog/v2"
 	"k8s.io/minikube/pkg/trace"
@@ -52,6 +53,9 @@ const (
 	Deleting RegStep = "Deleting"
 	Purging  RegStep = "Puring home dir"
 
+	// DeletingNode is the first step of minikube node delete
+	DeletingNode RegStep = "Deleting Node"
+
 	Stopping  RegStep = "Stopping"
 	PowerOff  RegStep = "PowerOff"
 	Pausing   RegStep = "Pausing"
@@ -64,6 +68,8 @@ type RegStep string
 // Register holds all of the steps we could see in `minikube start`
 // and keeps track of the current step
 type Register struct {
+	// mu guards the current step, which the nodes added in parallel set
+	mu      sync.Mutex
 	steps   map[RegStep][]RegStep
 	first   RegStep
 	current RegStep
@@ -103,17 +109,23 @@ func init() {
 			Pausing:   {Pausing, Done},
 			Unpausing: {Unpausing, Done},
 			Deleting:  {Deleting, Stopping, Done, Purging},
+
+			DeletingNode: {DeletingNode, Done},
 		},
 	}
 }
 
 // totalSteps returns the total number of steps in the register
 func (r *Register) totalSteps() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	return fmt.Sprintf("%d", len(r.steps[r.first])-1)
 }
 
 // currentStep returns the current step we are on
 func (r *Register) currentStep() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	if r.first == RegStep("") {
 		return ""
 	}
@@ -136,6 +148,8 @@ func (r *Register) currentStep() string {
 
 // SetStep sets the current step
 func (r *Register) SetStep(s RegStep) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	defer trace.StartSpan(string(s))
 	if r.first == RegStep("") {
 		_, ok := r.steps[s]
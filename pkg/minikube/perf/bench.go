@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package perf
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// BenchScenarios are the scenarios of 'minikube bench', in the order they run in
+var BenchScenarios = []string{"start", "image-load", "node-add", "stop"}
+
+// BenchOptions configures a benchmark run
+type BenchOptions struct {
+	// Binary is the minikube binary to benchmark
+	Binary string
+	// Profile is the profile the scenarios run against. It is deleted before and after each run.
+	Profile string
+	// StartArgs are the extra arguments of 'minikube start', eg: --driver
+	StartArgs []string
+	// Scenarios are the scenarios to time, of BenchScenarios
+	Scenarios []string
+	// Runs is the number of times each scenario is timed
+	Runs int
+	// Image is the image loaded by the image-load scenario
+	Image string
+	// Progress is called before each run of a scenario
+	Progress func(scenario string, run int)
+}
+
+// BenchResult is the timings of a scenario over the runs, in seconds
+type BenchResult struct {
+	Scenario string    `json:"scenario"`
+	Wall     []float64 `json:"wallSeconds"`
+	CPU      []float64 `json:"cpuSeconds"`
+}
+
+// MeanWall returns the mean wall time of the runs
+func (r BenchResult) MeanWall() float64 {
+	return average(r.Wall)
+}
+
+// MeanCPU returns the mean CPU time, user and system, that the minikube process took over the runs
+func (r BenchResult) MeanCPU() float64 {
+	return average(r.CPU)
+}
+
+// Baseline is the mean wall time of each scenario, in seconds
+type Baseline map[string]float64
+
+// Regression is a scenario that took longer than its baseline allows
+type Regression struct {
+	Scenario string  `json:"scenario"`
+	Baseline float64 `json:"baselineSeconds"`
+	Measured float64 `json:"measuredSeconds"`
+}
+
+// Bench times the scenarios of o, and returns their results in the order of BenchScenarios.
+// Each run starts a cluster and stops it, whether start and stop are timed or not.
+func Bench(ctx context.Context, o BenchOptions) ([]BenchResult, error) {
+	for _, s := range o.Scenarios {
+		if !slices.Contains(BenchScenarios, s) {
+			return nil, fmt.Errorf("unknown scenario %q, options include: %v", s, BenchScenarios)
+		}
+	}
+
+	results := map[string]*BenchResult{}
+	for _, s := range o.Scenarios {
+		results[s] = &BenchResult{Scenario: s}
+	}
+	args := map[string][]string{
+		"start":      append([]string{"start", "-p", o.Profile}, o.StartArgs...),
+		"image-load": {"image", "load", o.Image, "-p", o.Profile},
+		"node-add":   {"node", "add", "-p", o.Profile},
+		"stop":       {"stop", "-p", o.Profile},
+	}
+
+	defer deleteBenchProfile(ctx, o)
+	for run := 1; run <= o.Runs; run++ {
+		deleteBenchProfile(ctx, o)
+		for _, s := range BenchScenarios {
+			r, timed := results[s]
+			// the other scenarios need a started cluster
+			if !timed && s != "start" && s != "stop" {
+				continue
+			}
+			if timed && o.Progress != nil {
+				o.Progress(s, run)
+			}
+			wall, cpu, err := timeMinikube(ctx, o.Binary, args[s])
+			if err != nil {
+				return nil, errors.Wrapf(err, "run %d of %s", run, s)
+			}
+			if timed {
+				r.Wall = append(r.Wall, wall)
+				r.CPU = append(r.CPU, cpu)
+			}
+		}
+	}
+
+	var ordered []BenchResult
+	for _, s := range BenchScenarios {
+		if r, ok := results[s]; ok {
+			ordered = append(ordered, *r)
+		}
+	}
+	return ordered, nil
+}
+
+// timeMinikube runs minikube with args, and returns the wall time and the CPU time it took, in seconds
+func timeMinikube(ctx context.Context, binary string, args []string) (float64, float64, error) {
+	c := exec.CommandContext(ctx, binary, args...)
+	var output bytes.Buffer
+	c.Stdout = &output
+	c.Stderr = &output
+
+	klog.Infof("Running: %v", c.Args)
+	start := time.Now()
+	if err := c.Run(); err != nil {
+		return 0, 0, errors.Wrapf(err, "%v: %s", c.Args, output.String())
+	}
+	wall := time.Since(start)
+	cpu := c.ProcessState.UserTime() + c.ProcessState.SystemTime()
+	return wall.Seconds(), cpu.Seconds(), nil
+}
+
+func deleteBenchProfile(ctx context.Context, o BenchOptions) {
+	c := exec.CommandContext(ctx, o.Binary, "delete", "-p", o.Profile)
+	if out, err := c.CombinedOutput(); err != nil {
+		klog.Warningf("deleting %s: %v: %s", o.Profile, err, out)
+	}
+}
+
+// NewBaseline returns the baseline of results
+func NewBaseline(results []BenchResult) Baseline {
+	b := Baseline{}
+	for _, r := range results {
+		b[r.Scenario] = r.MeanWall()
+	}
+	return b
+}
+
+// Regressions returns the results that took longer than their baseline by more than threshold, eg: 0.2 for 20%.
+// Scenarios without a baseline are not compared.
+func Regressions(results []BenchResult, baseline Baseline, threshold float64) []Regression {
+	var regressions []Regression
+	for _, r := range results {
+		b, ok := baseline[r.Scenario]
+		if !ok {
+			continue
+		}
+		if m := r.MeanWall(); m > b*(1+threshold) {
+			regressions = append(regressions, Regression{Scenario: r.Scenario, Baseline: b, Measured: m})
+		}
+	}
+	return regressions
+}
+
+// ReadBaseline reads a baseline written by WriteBaseline
+func ReadBaseline(path string) (Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b := Baseline{}
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal %s", path)
+	}
+	return b, nil
+}
+
+// WriteBaseline writes the baseline to path, as JSON
+func WriteBaseline(path string, b Baseline) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package perf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegressions(t *testing.T) {
+	results := []BenchResult{
+		{Scenario: "start", Wall: []float64{50, 70}},
+		{Scenario: "image-load", Wall: []float64{11}},
+		{Scenario: "node-add", Wall: []float64{30}},
+		{Scenario: "stop", Wall: []float64{5}},
+	}
+	baseline := Baseline{"start": 40, "image-load": 10, "stop": 10}
+
+	expected := []Regression{{Scenario: "start", Baseline: 40, Measured: 60}}
+	if diff := cmp.Diff(expected, Regressions(results, baseline, 0.2)); diff != "" {
+		t.Errorf("regressions mismatch (-want +got):\n%s", diff)
+	}
+	if got := Regressions(results, baseline, 0.5); len(got) != 0 {
+		t.Errorf("expected no regression with a 50%% threshold, got %v", got)
+	}
+}
+
+func TestBaselineRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	b := NewBaseline([]BenchResult{{Scenario: "start", Wall: []float64{1, 3}}, {Scenario: "stop", Wall: []float64{4}}})
+	if err := WriteBaseline(path, b); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	got, err := ReadBaseline(path)
+	if err != nil {
+		t.Fatalf("read baseline: %v", err)
+	}
+	if diff := cmp.Diff(Baseline{"start": 2, "stop": 4}, got); diff != "" {
+		t.Errorf("baseline mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/libminikube"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// Minikube creates and changes the clusters of the plans, like libminikube.Client does
+type Minikube interface {
+	CreateCluster(o libminikube.ClusterOptions) error
+	DeleteCluster(cluster string) error
+	AddNode(cluster string, o libminikube.NodeOptions) (string, error)
+	DeleteNode(cluster, name string) error
+	SetAddon(cluster, name string, enable bool) error
+}
+
+// Apply makes the changes of p, computed from s and the cluster cc, with mk.
+// progress is called before each change of an update, if set.
+func Apply(mk Minikube, s Spec, cc *config.ClusterConfig, p Plan, progress func(Change)) error {
+	switch p.Action {
+	case NoOp:
+		return nil
+	case Create:
+		return mk.CreateCluster(options(s, nil))
+	case Replace:
+		if err := mk.DeleteCluster(s.Name); err != nil {
+			return errors.Wrap(err, "delete cluster")
+		}
+		return mk.CreateCluster(options(s, cc))
+	}
+
+	for _, c := range p.Changes {
+		if progress != nil {
+			progress(c)
+		}
+		switch {
+		case c.Attribute == "nodes":
+			if err := scale(mk, cc, s.Nodes); err != nil {
+				return err
+			}
+		case strings.HasPrefix(c.Attribute, "addons."):
+			name := strings.TrimPrefix(c.Attribute, "addons.")
+			if err := mk.SetAddon(s.Name, name, s.Addons[name]); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%s cannot be changed in place", c.Attribute)
+		}
+	}
+	return nil
+}
+
+// options returns the options that create the cluster of s. The attributes that s leaves unset are kept from cc, if set.
+func options(s Spec, cc *config.ClusterConfig) libminikube.ClusterOptions {
+	o := libminikube.ClusterOptions{
+		Name:              s.Name,
+		Driver:            s.Driver,
+		ContainerRuntime:  s.ContainerRuntime,
+		KubernetesVersion: s.KubernetesVersion,
+		CPUs:              s.CPUs,
+		Memory:            s.Memory,
+		DiskSize:          s.DiskSize,
+		Nodes:             s.Nodes,
+	}
+	for _, name := range addonNames(s.Addons) {
+		if s.Addons[name] {
+			o.Addons = append(o.Addons, name)
+		}
+	}
+	if cc == nil {
+		return o
+	}
+	keep := func(v *string, current string) {
+		if *v == "" {
+			*v = current
+		}
+	}
+	keep(&o.Driver, cc.Driver)
+	keep(&o.ContainerRuntime, cc.KubernetesConfig.ContainerRuntime)
+	keep(&o.KubernetesVersion, cc.KubernetesConfig.KubernetesVersion)
+	if o.CPUs == 0 {
+		o.CPUs = cc.CPUs
+	}
+	if o.Memory == 0 {
+		o.Memory = cc.Memory
+	}
+	if o.DiskSize == 0 {
+		o.DiskSize = cc.DiskSize
+	}
+	if o.Nodes == 0 {
+		o.Nodes = len(cc.Nodes)
+	}
+	return o
+}
+
+// scale adds nodes to the cluster cc, or deletes its last workers, until it has want nodes
+func scale(mk Minikube, cc *config.ClusterConfig, want int) error {
+	for i := len(cc.Nodes); i < want; i++ {
+		if _, err := mk.AddNode(cc.Name, libminikube.NodeOptions{}); err != nil {
+			return errors.Wrap(err, "add node")
+		}
+	}
+	remove := len(cc.Nodes) - want
+	for i := len(cc.Nodes) - 1; i >= 0 && remove > 0; i-- {
+		n := cc.Nodes[i]
+		if n.ControlPlane {
+			continue
+		}
+		if err := mk.DeleteNode(cc.Name, n.Name); err != nil {
+			return errors.Wrapf(err, "delete node %s", n.Name)
+		}
+		remove--
+	}
+	if remove > 0 {
+		return fmt.Errorf("%s has not enough workers to remove %d more nodes, control-plane nodes are not removed", cc.Name, remove)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plan computes and applies the changes that bring a cluster to the state of its cluster file,
+// as a stable interface that infrastructure as code tools, such as Terraform providers, are built on.
+package plan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+)
+
+// FormatVersion is the version of the JSON format of Plan, which is only changed in backwards compatible ways
+const FormatVersion = 1
+
+// Spec is the state of a cluster in a cluster file.
+// The attributes left unset take the defaults of minikube start when the cluster is created, and are not compared afterwards.
+type Spec struct {
+	Name              string `yaml:"name"`
+	Driver            string `yaml:"driver,omitempty"`
+	ContainerRuntime  string `yaml:"containerRuntime,omitempty"`
+	KubernetesVersion string `yaml:"kubernetesVersion,omitempty"`
+	CPUs              int    `yaml:"cpus,omitempty"`
+	// Memory and DiskSize are in MB
+	Memory   int `yaml:"memory,omitempty"`
+	DiskSize int `yaml:"diskSize,omitempty"`
+	// Nodes is the number of nodes, including the control plane
+	Nodes int `yaml:"nodes,omitempty"`
+	// Addons are enabled or disabled, the others are left as they are
+	Addons map[string]bool `yaml:"addons,omitempty"`
+}
+
+// Action is what a plan does to a cluster, or to one of its attributes
+type Action string
+
+const (
+	// NoOp leaves the cluster as it is
+	NoOp Action = "no-op"
+	// Create creates the cluster
+	Create Action = "create"
+	// Update changes the cluster in place
+	Update Action = "update"
+	// Replace deletes the cluster and creates it again, as the attribute cannot be changed in place
+	Replace Action = "replace"
+)
+
+// Change is the change of an attribute of a cluster, eg: nodes or addons.ingress
+type Change struct {
+	Attribute string      `json:"attribute"`
+	Action    Action      `json:"action"`
+	Before    interface{} `json:"before"`
+	After     interface{} `json:"after"`
+}
+
+// Plan is the changes that bring a cluster to the state of its Spec, in a deterministic order
+type Plan struct {
+	FormatVersion int      `json:"formatVersion"`
+	Cluster       string   `json:"cluster"`
+	Action        Action   `json:"action"`
+	Changes       []Change `json:"changes"`
+}
+
+// ReadSpec reads and validates a cluster file. Unknown attributes are rejected, rather than ignored.
+func ReadSpec(path string) (Spec, error) {
+	var s Spec
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return s, err
+	}
+	if err := yaml.UnmarshalStrict(b, &s); err != nil {
+		return s, errors.Wrapf(err, "parse %s", path)
+	}
+	return s, s.validate()
+}
+
+func (s Spec) validate() error {
+	if s.Name == "" {
+		return errors.New("the name of the cluster is required")
+	}
+	if !config.ProfileNameValid(s.Name) {
+		return fmt.Errorf("invalid cluster name %q", s.Name)
+	}
+	if s.ContainerRuntime != "" && !slices.Contains([]string{constants.Docker, constants.Containerd, constants.CRIO}, s.ContainerRuntime) {
+		return fmt.Errorf("invalid container runtime %q", s.ContainerRuntime)
+	}
+	if s.CPUs < 0 || s.Memory < 0 || s.DiskSize < 0 || s.Nodes < 0 {
+		return errors.New("cpus, memory, diskSize and nodes cannot be negative")
+	}
+	for _, name := range addonNames(s.Addons) {
+		if _, ok := assets.Addons[name]; !ok {
+			return fmt.Errorf("unknown addon %q", name)
+		}
+	}
+	return nil
+}
+
+// Compute returns the plan that brings the cluster cc, nil if it does not exist, to the state of s
+func Compute(s Spec, cc *config.ClusterConfig) Plan {
+	p := Plan{FormatVersion: FormatVersion, Cluster: s.Name, Action: NoOp, Changes: []Change{}}
+	if cc == nil {
+		p.Action = Create
+		add := func(attr string, after interface{}, set bool) {
+			if set {
+				p.Changes = append(p.Changes, Change{Attribute: attr, Action: Create, After: after})
+			}
+		}
+		add("driver", s.Driver, s.Driver != "")
+		add("containerRuntime", s.ContainerRuntime, s.ContainerRuntime != "")
+		add("kubernetesVersion", s.KubernetesVersion, s.KubernetesVersion != "")
+		add("cpus", s.CPUs, s.CPUs != 0)
+		add("memory", s.Memory, s.Memory != 0)
+		add("diskSize", s.DiskSize, s.DiskSize != 0)
+		add("nodes", s.Nodes, s.Nodes != 0)
+		for _, name := range addonNames(s.Addons) {
+			add("addons."+name, s.Addons[name], true)
+		}
+		return p
+	}
+
+	diff := func(attr string, action Action, before, after interface{}, set bool) {
+		if set && before != after {
+			p.Changes = append(p.Changes, Change{Attribute: attr, Action: action, Before: before, After: after})
+		}
+	}
+	diff("driver", Replace, cc.Driver, s.Driver, s.Driver != "")
+	diff("containerRuntime", Replace, cc.KubernetesConfig.ContainerRuntime, s.ContainerRuntime, s.ContainerRuntime != "")
+	diff("kubernetesVersion", Replace, cc.KubernetesConfig.KubernetesVersion, s.KubernetesVersion, s.KubernetesVersion != "")
+	diff("cpus", Replace, cc.CPUs, s.CPUs, s.CPUs != 0)
+	diff("memory", Replace, cc.Memory, s.Memory, s.Memory != 0)
+	diff("diskSize", Replace, cc.DiskSize, s.DiskSize, s.DiskSize != 0)
+	diff("nodes", Update, len(cc.Nodes), s.Nodes, s.Nodes != 0)
+	for _, name := range addonNames(s.Addons) {
+		diff("addons."+name, Update, cc.Addons[name], s.Addons[name], true)
+	}
+
+	for _, c := range p.Changes {
+		if c.Action == Replace {
+			p.Action = Replace
+			break
+		}
+		p.Action = Update
+	}
+	return p
+}
+
+// ReadPlan reads a plan saved as JSON, eg: by minikube plan -o json
+func ReadPlan(path string) (Plan, error) {
+	var p Plan
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return p, err
+	}
+	if err := json.Unmarshal(b, &p); err != nil {
+		return p, errors.Wrapf(err, "parse %s", path)
+	}
+	if p.FormatVersion != FormatVersion {
+		return p, fmt.Errorf("unsupported plan format version %d", p.FormatVersion)
+	}
+	return p, nil
+}
+
+// Equal returns whether the plans make the same changes, once encoded as JSON
+func Equal(a, b Plan) bool {
+	ja, erra := json.Marshal(a)
+	jb, errb := json.Marshal(b)
+	return erra == nil && errb == nil && bytes.Equal(ja, jb)
+}
+
+// addonNames returns the names of addons, sorted
+func addonNames(addons map[string]bool) []string {
+	var names []string
+	for name := range addons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
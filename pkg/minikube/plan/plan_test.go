@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/minikube/pkg/libminikube"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestReadSpec(t *testing.T) {
+	tests := []struct {
+		description string
+		file        string
+		err         string
+	}{
+		{"valid", "name: dev\ndriver: docker\nnodes: 3\naddons:\n  ingress: true\n", ""},
+		{"no name", "driver: docker\n", "name of the cluster is required"},
+		{"unknown attribute", "name: dev\nnode: 3\n", "field node not found"},
+		{"invalid runtime", "name: dev\ncontainerRuntime: rkt\n", "invalid container runtime"},
+		{"unknown addon", "name: dev\naddons:\n  nope: true\n", "unknown addon"},
+		{"negative", "name: dev\ncpus: -1\n", "cannot be negative"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cluster.yaml")
+			if err := os.WriteFile(path, []byte(tc.file), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := ReadSpec(path)
+			if tc.err == "" && err != nil || tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Errorf("ReadSpec() = %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	cc := &config.ClusterConfig{
+		Name:     "dev",
+		Driver:   "docker",
+		CPUs:     2,
+		Memory:   4096,
+		DiskSize: 20000,
+		Addons:   map[string]bool{"storage-provisioner": true},
+		KubernetesConfig: config.KubernetesConfig{
+			ContainerRuntime:  "docker",
+			KubernetesVersion: "v1.30.1",
+		},
+		Nodes: []config.Node{{ControlPlane: true}},
+	}
+	tests := []struct {
+		description string
+		spec        Spec
+		cc          *config.ClusterConfig
+		want        string
+	}{
+		{"create", Spec{Name: "dev", Driver: "docker", Nodes: 2, Addons: map[string]bool{"ingress": true}}, nil,
+			`{"formatVersion":1,"cluster":"dev","action":"create","changes":[{"attribute":"driver","action":"create","before":null,"after":"docker"},{"attribute":"nodes","action":"create","before":null,"after":2},{"attribute":"addons.ingress","action":"create","before":null,"after":true}]}`},
+		{"unset attributes are not compared", Spec{Name: "dev"}, cc,
+			`{"formatVersion":1,"cluster":"dev","action":"no-op","changes":[]}`},
+		{"no changes", Spec{Name: "dev", Driver: "docker", CPUs: 2, Nodes: 1, Addons: map[string]bool{"storage-provisioner": true}}, cc,
+			`{"formatVersion":1,"cluster":"dev","action":"no-op","changes":[]}`},
+		{"update", Spec{Name: "dev", Nodes: 3, Addons: map[string]bool{"storage-provisioner": false, "ingress": true}}, cc,
+			`{"formatVersion":1,"cluster":"dev","action":"update","changes":[{"attribute":"nodes","action":"update","before":1,"after":3},{"attribute":"addons.ingress","action":"update","before":false,"after":true},{"attribute":"addons.storage-provisioner","action":"update","before":true,"after":false}]}`},
+		{"replace", Spec{Name: "dev", Memory: 8192, Nodes: 2}, cc,
+			`{"formatVersion":1,"cluster":"dev","action":"replace","changes":[{"attribute":"memory","action":"replace","before":4096,"after":8192},{"attribute":"nodes","action":"update","before":1,"after":2}]}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := json.Marshal(Compute(tc.spec, tc.cc))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tc.want {
+				t.Errorf("Compute() = %s, want %s", b, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadPlan(t *testing.T) {
+	p := Compute(Spec{Name: "dev", Nodes: 3}, &config.ClusterConfig{Name: "dev", Nodes: []config.Node{{ControlPlane: true}}})
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "plan.json")
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := ReadPlan(path)
+	if err != nil {
+		t.Fatalf("ReadPlan() = %v", err)
+	}
+	if !Equal(saved, p) {
+		t.Errorf("ReadPlan() = %+v, want %+v", saved, p)
+	}
+	if Equal(saved, Compute(Spec{Name: "dev", Nodes: 2}, &config.ClusterConfig{Name: "dev", Nodes: []config.Node{{ControlPlane: true}}})) {
+		t.Errorf("Equal() = true for plans of different nodes")
+	}
+}
+
+// fakeMinikube records the calls of Apply
+type fakeMinikube struct {
+	calls []string
+}
+
+func (f *fakeMinikube) CreateCluster(o libminikube.ClusterOptions) error {
+	f.calls = append(f.calls, fmt.Sprintf("create %s %s %d %d %v", o.Name, o.Driver, o.Memory, o.Nodes, o.Addons))
+	return nil
+}
+
+func (f *fakeMinikube) DeleteCluster(cluster string) error {
+	f.calls = append(f.calls, "delete "+cluster)
+	return nil
+}
+
+func (f *fakeMinikube) AddNode(cluster string, _ libminikube.NodeOptions) (string, error) {
+	f.calls = append(f.calls, "add node")
+	return "m0x", nil
+}
+
+func (f *fakeMinikube) DeleteNode(_, name string) error {
+	f.calls = append(f.calls, "delete node "+name)
+	return nil
+}
+
+func (f *fakeMinikube) SetAddon(_, name string, enable bool) error {
+	f.calls = append(f.calls, fmt.Sprintf("addon %s %v", name, enable))
+	return nil
+}
+
+func TestApply(t *testing.T) {
+	cc := &config.ClusterConfig{
+		Name:   "dev",
+		Driver: "docker",
+		Memory: 4096,
+		Nodes:  []config.Node{{ControlPlane: true}, {Name: "m02"}, {Name: "m03"}},
+	}
+	tests := []struct {
+		description string
+		spec        Spec
+		cc          *config.ClusterConfig
+		want        string
+		err         bool
+	}{
+		{"create", Spec{Name: "dev", Addons: map[string]bool{"ingress": true, "dashboard": false}}, nil, "create dev  0 0 [ingress]", false},
+		{"replace keeps the unset attributes", Spec{Name: "dev", Memory: 8192}, cc, "delete dev, create dev docker 8192 3 []", false},
+		{"scale up", Spec{Name: "dev", Nodes: 4, Addons: map[string]bool{"ingress": true}}, cc, "add node, addon ingress true", false},
+		{"scale down", Spec{Name: "dev", Nodes: 1}, cc, "delete node m03, delete node m02", false},
+		{"no-op", Spec{Name: "dev", Nodes: 3}, cc, "", false},
+		{"control planes are not removed", Spec{Name: "dev", Nodes: 1}, &config.ClusterConfig{Name: "dev", Nodes: []config.Node{{ControlPlane: true}, {Name: "m02", ControlPlane: true}}}, "", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			mk := &fakeMinikube{}
+			err := Apply(mk, tc.spec, tc.cc, Compute(tc.spec, tc.cc), nil)
+			if (err != nil) != tc.err {
+				t.Errorf("Apply() = %v", err)
+			}
+			if got := strings.Join(mk.calls, ", "); got != tc.want {
+				t.Errorf("Apply() called %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
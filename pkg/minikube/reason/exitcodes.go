@@ -39,6 +39,9 @@ const (
 
 	// 3-7 are reserved for crazy legacy codes returned by "minikube status"
 
+	// ExPlanChanges is returned by "minikube plan --detailed-exitcode" when the cluster differs from its cluster file
+	ExPlanChanges = 8
+
 	// How to assign new minikube exit codes:
 	//
 	// * Each error source is indexed from 10 onward, in general, it follows the dependency stack
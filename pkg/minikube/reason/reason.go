@@ -114,6 +114,16 @@ var (
 	InternalDelConfig = Kind{ID: "MK_DEL_CONFIG", ExitCode: ExProgramError}
 	// minikube failed to generate script to activate minikube docker-env
 	InternalDockerScript = Kind{ID: "MK_DOCKER_SCRIPT", ExitCode: ExProgramError}
+	// minikube bench failed to run a scenario
+	InternalBench = Kind{ID: "MK_BENCH", ExitCode: ExProgramError}
+	// minikube bench measured a scenario slower than its baseline allows
+	BenchRegression = Kind{ID: "MK_BENCH_REGRESSION", ExitCode: ExProgramError}
+	// minikube apply was given a plan that differs from the current plan of the cluster
+	StalePlan = Kind{ID: "MK_STALE_PLAN", ExitCode: ExProgramConflict, Advice: translate.T("Run 'minikube plan' again, and review its changes")}
+	// minikube apply failed to change the cluster
+	InternalApply = Kind{ID: "MK_APPLY", ExitCode: ExProgramError}
+	// minikube ui failed to run in the terminal
+	InternalUI = Kind{ID: "MK_UI", ExitCode: ExProgramError}
 	// an error occurred when viper attempted to bind flags to configuration
 	InternalBindFlags = Kind{ID: "MK_BIND_FLAGS", ExitCode: ExProgramError}
 	// minkube was passed an invalid format string in the --format flag
@@ -258,6 +268,8 @@ var (
 	HostBrowser = Kind{ID: "HOST_BROWSER", ExitCode: ExHostError}
 	// minikube failed to load cluster config from the host for the profile in use
 	HostConfigLoad = Kind{ID: "HOST_CONFIG_LOAD", ExitCode: ExHostConfig}
+	// the minikube config file contains invalid or incompatible values
+	HostConfigInvalid = Kind{ID: "HOST_CONFIG_INVALID", ExitCode: ExHostConfig}
 	// the current user has insufficient permissions to create the minikube profile directory
 	HostHomePermission = Kind{
 		ID:       "HOST_HOME_PERMISSION",
@@ -268,6 +280,14 @@ var (
 
 	// minikube failed to determine current user
 	HostCurrentUser = Kind{ID: "HOST_CURRENT_USER", ExitCode: ExHostConfig}
+	// minikube failed to serve the control API of minikube daemon
+	HostDaemon = Kind{ID: "HOST_DAEMON", ExitCode: ExHostError}
+	// minikube failed to write the settings of a dev container
+	HostDevcontainer = Kind{ID: "HOST_DEVCONTAINER", ExitCode: ExHostConfig}
+	// minikube failed to run the Cluster API provider
+	HostCAPIProvider = Kind{ID: "HOST_CAPI_PROVIDER", ExitCode: ExHostError}
+	// minikube failed to write the outputs of a GitHub Actions step
+	HostGitHubOutput = Kind{ID: "HOST_GITHUB_OUTPUT", ExitCode: ExHostError}
 	// minikube failed to delete cached images from host
 	HostDelCache = Kind{ID: "HOST_DEL_CACHE", ExitCode: ExHostError}
 	// minikube failed to kill a mount process
@@ -286,6 +306,22 @@ var (
 	HostPathStat = Kind{ID: "HOST_PATH_STAT", ExitCode: ExHostError}
 	// minikube failed to purge minikube config directories
 	HostPurge = Kind{ID: "HOST_PURGE", ExitCode: ExHostError}
+	// minikube failed to export a profile to an archive
+	HostProfileExport = Kind{ID: "HOST_PROFILE_EXPORT", ExitCode: ExHostError}
+	// minikube failed to import a profile from an archive
+	HostProfileImport = Kind{ID: "HOST_PROFILE_IMPORT", ExitCode: ExHostConfig}
+	// minikube failed to rename a profile
+	HostProfileRename = Kind{ID: "HOST_PROFILE_RENAME", ExitCode: ExHostError}
+	// another minikube process is changing the same profile
+	HostProfileLocked = Kind{
+		ID:       "HOST_PROFILE_LOCKED",
+		ExitCode: ExHostConflict,
+		Advice:   translate.T("Wait for the other minikube command to finish, or pass --lock-timeout to wait for it."),
+	}
+	// the host does not meet the prerequisites of the rootless drivers
+	HostRootlessSetup = Kind{ID: "HOST_ROOTLESS_SETUP", ExitCode: ExHostConfig}
+	// minikube failed to migrate a profile to a rootless driver
+	HostRootlessMigrate = Kind{ID: "HOST_ROOTLESS_MIGRATE", ExitCode: ExHostError}
 	// minikube failed to persist profile config
 	HostSaveProfile = Kind{ID: "HOST_SAVE_PROFILE", ExitCode: ExHostConfig}
 	// Host doesn't support 9p
@@ -331,6 +367,16 @@ var (
 	GuestCacheLoad = Kind{ID: "GUEST_CACHE_LOAD", ExitCode: ExGuestError}
 	// minikube failed to setup certificates
 	GuestCert = Kind{ID: "GUEST_CERT", ExitCode: ExGuestError}
+	// minikube failed to encrypt the secrets again with the current encryption provider
+	GuestRewrapSecrets = Kind{ID: "GUEST_REWRAP_SECRETS", ExitCode: ExGuestError}
+	// minikube failed to record the SSH host key of a guest
+	GuestHostKey = Kind{ID: "GUEST_HOST_KEY", ExitCode: ExGuestError}
+	// minikube failed to read the audit log of the API server
+	GuestAuditLog = Kind{ID: "GUEST_AUDIT_LOG", ExitCode: ExGuestError}
+	// minikube failed to save a snapshot of etcd
+	GuestEtcdBackup = Kind{ID: "GUEST_ETCD_BACKUP", ExitCode: ExGuestError}
+	// minikube failed to restore etcd from a snapshot
+	GuestEtcdRestore = Kind{ID: "GUEST_ETCD_RESTORE", ExitCode: ExGuestError}
 	// minikube failed to access the control plane
 	GuestCpConfig = Kind{ID: "GUEST_CP_CONFIG", ExitCode: ExGuestConfig}
 	// minikube failed to properly delete a resource, such as a profile
@@ -366,12 +412,24 @@ var (
 	}
 	// minkube failed to update a mount
 	GuestMountConflict = Kind{ID: "GUEST_MOUNT_CONFLICT", ExitCode: ExGuestConflict}
+	// minikube failed to shrink or restore the memory of the guests of an idle cluster
+	GuestMemoryShrink = Kind{ID: "GUEST_MEMORY_SHRINK", ExitCode: ExGuestError}
+	// minikube failed to refresh the imagePullSecrets of the cluster
+	GuestPullSecrets = Kind{ID: "GUEST_PULL_SECRETS", ExitCode: ExGuestError}
+	// minikube failed to delete an ephemeral cluster once it expired
+	GuestTTL = Kind{ID: "GUEST_TTL", ExitCode: ExGuestError}
 	// minikube failed to add a node to the cluster
 	GuestNodeAdd = Kind{ID: "GUEST_NODE_ADD", ExitCode: ExGuestError}
 	// minikube failed to remove a node from the cluster
 	GuestNodeDelete = Kind{ID: "GUEST_NODE_DELETE", ExitCode: ExGuestError}
+	// minikube failed to drain a node of the cluster
+	GuestNodeDrain = Kind{ID: "GUEST_NODE_DRAIN", ExitCode: ExGuestError}
 	// minikube failed to provision a node
 	GuestNodeProvision = Kind{ID: "GUEST_NODE_PROVISION", ExitCode: ExGuestError}
+	// minikube failed to boot the warm nodes kept ready to join a cluster
+	GuestNodeWarm = Kind{ID: "GUEST_NODE_WARM", ExitCode: ExGuestError}
+	// stopping or deleting a control-plane node would leave etcd without quorum
+	GuestNodeQuorum = Kind{ID: "GUEST_NODE_QUORUM", ExitCode: ExGuestConflict}
 	// minikube failed to retrieve information for a cluster node
 	GuestNodeRetrieve = Kind{ID: "GUEST_NODE_RETRIEVE", ExitCode: ExGuestNotFound}
 	// minikube failed to startup a cluster node
@@ -411,6 +469,8 @@ var (
 	IfSSHClient = Kind{ID: "IF_SSH_CLIENT", ExitCode: ExLocalNetworkError}
 	// minikube failed to create a dedicated network
 	IfDedicatedNetwork = Kind{ID: "IF_DEDICATED_NETWORK", ExitCode: ExLocalNetworkError}
+	// minikube failed to expose the Docker daemon of the cluster on a host interface
+	IfDockerRemote = Kind{ID: "IF_DOCKER_REMOTE", ExitCode: ExLocalNetworkError}
 	// minikube failed to populate dchpd_leases file due to bootpd being blocked by firewall
 	IfBootpdFirewall = Kind{
 		ID:       "IF_BOOTPD_FIREWALL",
@@ -505,6 +565,9 @@ var (
 		Style: style.SeeNoEvil,
 	}
 
+	// minikube failed to upgrade the Kubernetes version of a cluster in place
+	KubernetesUpgradeFailed = Kind{ID: "K8S_UPGRADE_FAILED", ExitCode: ExControlPlaneError}
+
 	NotFoundCriDockerd = Kind{
 		ID:       "NOT_FOUND_CRI_DOCKERD",
 		ExitCode: ExProgramNotFound,
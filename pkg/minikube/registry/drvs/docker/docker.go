@@ -36,6 +36,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/registry"
+	"k8s.io/minikube/pkg/minikube/rootless"
 )
 
 const (
@@ -72,6 +73,9 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 	for _, port := range cc.ExposedPorts {
 		extraArgs = append(extraArgs, "-p", port)
 	}
+	if cc.KubernetesConfig.DualStack {
+		extraArgs = append(extraArgs, "--sysctl=net.ipv6.conf.all.disable_ipv6=0", "--sysctl=net.ipv6.conf.all.forwarding=1")
+	}
 
 	return kic.NewDriver(kic.Config{
 		ClusterName:       cc.Name,
@@ -88,6 +92,7 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		ExtraArgs:         extraArgs,
 		Network:           cc.Network,
 		Subnet:            cc.Subnet,
+		IPv6:              cc.KubernetesConfig.DualStack,
 		StaticIP:          cc.StaticIP,
 		ListenAddress:     cc.ListenAddress,
 		GPUs:              cc.GPUs,
@@ -141,7 +146,14 @@ func status() (retState registry.State) {
 		return suggestFix("info", -1, serr, fmt.Errorf("docker info error: %s", serr))
 	}
 
-	// TODO: validate cgroup v2 delegation when si.Rootless is true
+	if si.Rootless && runtime.GOOS == "linux" {
+		if h, err := rootless.NewHost(); err == nil {
+			if c := h.CheckDelegation(); c.Err != nil {
+				klog.Warningf("rootless docker: %v", c.Err)
+				return registry.State{Installed: true, Healthy: true, NeedsImprovement: true, Fix: "Run 'minikube rootless setup' to delegate the cgroup controllers kubelet needs", Doc: docURL}
+			}
+		}
+	}
 
 	return checkNeedsImprovement()
 }
@@ -77,7 +77,9 @@ func configure(cfg config.ClusterConfig, n config.Node) (interface{}, error) {
 	d.CPU = cfg.CPUs
 	d.DiskSize = cfg.DiskSize
 	d.SSHUser = "docker"
-	d.DisableDynamicMemory = true // default to disable dynamic memory as minikube is unlikely to work properly with dynamic memory
+	// default to disable dynamic memory as minikube is unlikely to work properly with dynamic memory,
+	// unless the memory is shrunk when idle, which Hyper-V does by itself with dynamic memory
+	d.DisableDynamicMemory = cfg.MemoryAutoShrink == 0
 	return d, nil
 }
 
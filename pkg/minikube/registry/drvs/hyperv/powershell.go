@@ -21,10 +21,15 @@ package hyperv
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
+	"encoding/binary"
 	"os/exec"
 	"strings"
+	"time"
+	"unicode/utf16"
 
 	"k8s.io/klog/v2"
+	"k8s.io/minikube/pkg/minikube/command"
 )
 
 var powershell string
@@ -33,15 +38,18 @@ func init() {
 	powershell, _ = exec.LookPath("powershell")
 }
 
-func cmdOut(args ...string) (string, error) {
-	args = append([]string{"-NoProfile", "-NonInteractive"}, args...)
+// cmdOut runs script with -EncodedCommand, so that powershell runs it as is, whatever quotes it has
+func cmdOut(script string) (string, error) {
+	args := []string{"-NoProfile", "-NonInteractive", "-EncodedCommand", encode(script)}
 	cmd := exec.Command(powershell, args...)
-	klog.Infof("[executing ==>] : %v %v", powershell, strings.Join(args, " "))
+	klog.Infof("[executing ==>] : %v %v", powershell, script)
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
+	start := time.Now()
 	err := cmd.Run()
+	command.RecordTiming("powershell", args, start, cmd.ProcessState.ExitCode())
 	klog.Infof("[stdout =====>] : %s", stdout.String())
 	klog.Infof("[stderr =====>] : %s", stderr.String())
 	if err != nil {
@@ -50,11 +58,27 @@ func cmdOut(args ...string) (string, error) {
 	return stdout.String(), err
 }
 
-func cmd(args ...string) error {
-	_, err := cmdOut(args...)
+func cmd(script string) error {
+	_, err := cmdOut(script)
 	return err
 }
 
+// encode returns script as the Base64 of its UTF-16LE encoding, the one of -EncodedCommand
+func encode(script string) string {
+	u := utf16.Encode([]rune(script))
+	b := make([]byte, 2*len(u))
+	for i, c := range u {
+		binary.LittleEndian.PutUint16(b[2*i:], c)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// quote returns s as a single-quoted string literal of powershell, in which $ and ` are not expanded,
+// eg: for the names of adapters given by the user
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
 func parseLines(stdout string) []string {
 	var resp []string
 
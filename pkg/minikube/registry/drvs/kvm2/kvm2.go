@@ -71,6 +71,7 @@ type kvmDriver struct {
 	ConnectionURI  string
 	NUMANodeCount  int
 	ExtraDisks     int
+	IPv6           bool
 }
 
 func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
@@ -94,6 +95,7 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		ConnectionURI:  cc.KVMQemuURI,
 		NUMANodeCount:  cc.KVMNUMACount,
 		ExtraDisks:     cc.ExtraDisks,
+		IPv6:           cc.KubernetesConfig.DualStack,
 	}, nil
 }
 
@@ -81,6 +81,9 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 	for _, port := range cc.ExposedPorts {
 		extraArgs = append(extraArgs, "-p", port)
 	}
+	if cc.KubernetesConfig.DualStack {
+		extraArgs = append(extraArgs, "--sysctl=net.ipv6.conf.all.disable_ipv6=0", "--sysctl=net.ipv6.conf.all.forwarding=1")
+	}
 
 	return kic.NewDriver(kic.Config{
 		ClusterName:       cc.Name,
@@ -97,6 +100,7 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		ExtraArgs:         extraArgs,
 		ListenAddress:     cc.ListenAddress,
 		Subnet:            cc.Subnet,
+		IPv6:              cc.KubernetesConfig.DualStack,
 	}), nil
 }
 
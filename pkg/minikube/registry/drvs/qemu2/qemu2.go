@@ -57,8 +57,8 @@ func init() {
 	}
 }
 
-func qemuSystemProgram() (string, error) {
-	arch := runtime.GOARCH
+// qemuSystemProgram returns the qemu program that runs VMs of the CPU architecture arch
+func qemuSystemProgram(arch string) (string, error) {
 	switch arch {
 	case "amd64":
 		return "qemu-system-x86_64", nil
@@ -69,21 +69,26 @@ func qemuSystemProgram() (string, error) {
 	}
 }
 
-func qemuFirmwarePath(customPath string) (string, error) {
+// qemuFirmwarePath returns the firmware of the VMs of the CPU architecture arch, unless customPath is set
+func qemuFirmwarePath(customPath, arch string) (string, error) {
 	if customPath != "" {
 		return customPath, nil
 	}
 	if runtime.GOOS == "windows" {
 		return "C:\\Program Files\\qemu\\share\\edk2-x86_64-code.fd", nil
 	}
-	arch := runtime.GOARCH
 	// For macOS, find the correct brew installation path for qemu firmware
 	if runtime.GOOS == "darwin" {
+		// brew has a prefix for each arch of the host, and the firmware of every arch
+		prefix := "/usr/local"
+		if runtime.GOARCH == "arm64" {
+			prefix = "/opt/homebrew"
+		}
 		switch arch {
 		case "amd64":
-			return "/usr/local/opt/qemu/share/qemu/edk2-x86_64-code.fd", nil
+			return prefix + "/opt/qemu/share/qemu/edk2-x86_64-code.fd", nil
 		case "arm64":
-			return "/opt/homebrew/opt/qemu/share/qemu/edk2-aarch64-code.fd", nil
+			return prefix + "/opt/qemu/share/qemu/edk2-aarch64-code.fd", nil
 		default:
 			return "", fmt.Errorf("unknown arch: %s", arch)
 		}
@@ -99,12 +104,7 @@ func qemuFirmwarePath(customPath string) (string, error) {
 	}
 }
 
-func qemuVersion() (semver.Version, error) {
-	qemuSystem, err := qemuSystemProgram()
-	if err != nil {
-		return semver.Version{}, err
-	}
-
+func qemuVersion(qemuSystem string) (semver.Version, error) {
 	cmd := exec.Command(qemuSystem, "-version")
 	rr, err := cmd.Output()
 	if err != nil {
@@ -116,13 +116,19 @@ func qemuVersion() (semver.Version, error) {
 
 func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 	name := config.MachineName(cc, n)
-	qemuSystem, err := qemuSystemProgram()
+	// a node of another arch than the host is emulated, without acceleration
+	arch := runtime.GOARCH
+	if n.Arch != "" {
+		arch = n.Arch
+	}
+	emulated := arch != runtime.GOARCH
+	qemuSystem, err := qemuSystemProgram(arch)
 	if err != nil {
 		return nil, err
 	}
 	var qemuMachine string
 	var qemuCPU string
-	switch runtime.GOARCH {
+	switch arch {
 	case "amd64":
 		qemuMachine = "" // default
 		// set cpu type to max to enable higher microarchitecture levels
@@ -131,10 +137,14 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 	case "arm64":
 		qemuMachine = "virt"
 		qemuCPU = "cortex-a72"
+		if emulated {
+			// the CPU model of the host is only available with an accelerator
+			break
+		}
 		// highmem=off needed for qemu 6.2.0 and lower, see https://patchwork.kernel.org/project/qemu-devel/patch/20201126215017.41156-9-agraf@csgraf.de/#23800615 for details
 		if runtime.GOOS == "darwin" {
 			qemu7 := semver.MustParse("7.0.0")
-			v, err := qemuVersion()
+			v, err := qemuVersion(qemuSystem)
 			if err != nil {
 				return nil, err
 			}
@@ -148,9 +158,15 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 			qemuCPU = "host"
 		}
 	default:
-		return nil, fmt.Errorf("unknown arch: %s", runtime.GOARCH)
+		return nil, fmt.Errorf("unknown arch: %s", arch)
+	}
+	iso := cc.MinikubeISO
+	customFirmware := cc.CustomQemuFirmwarePath
+	if emulated {
+		iso = download.ISOURLs(arch)[0]
+		customFirmware = ""
 	}
-	qemuFirmware, err := qemuFirmwarePath(cc.CustomQemuFirmwarePath)
+	qemuFirmware, err := qemuFirmwarePath(customFirmware, arch)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +181,7 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 			StorePath:   localpath.MiniPath(),
 			SSHUser:     "docker",
 		},
-		Boot2DockerURL:        download.LocalISOResource(cc.MinikubeISO),
+		Boot2DockerURL:        download.LocalISOResource(iso),
 		DiskSize:              cc.DiskSize,
 		Memory:                cc.Memory,
 		CPU:                   cc.CPUs,
@@ -173,7 +189,7 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		FirstQuery:            true,
 		DiskPath:              filepath.Join(localpath.MiniPath(), "machines", name, fmt.Sprintf("%s.img", name)),
 		Program:               qemuSystem,
-		BIOS:                  runtime.GOARCH != "arm64",
+		BIOS:                  arch != "arm64",
 		MachineType:           qemuMachine,
 		CPUType:               qemuCPU,
 		Firmware:              qemuFirmware,
@@ -185,11 +201,12 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		SocketVMNetPath:       cc.SocketVMnetPath,
 		SocketVMNetClientPath: cc.SocketVMnetClientPath,
 		ExtraDisks:            cc.ExtraDisks,
+		Emulated:              emulated,
 	}, nil
 }
 
 func status() registry.State {
-	qemuSystem, err := qemuSystemProgram()
+	qemuSystem, err := qemuSystemProgram(runtime.GOARCH)
 	if err != nil {
 		return registry.State{Error: err, Doc: docURL}
 	}
@@ -198,7 +215,7 @@ func status() registry.State {
 		return registry.State{Error: err, Fix: "Install qemu-system", Doc: docURL}
 	}
 
-	qemuFirmware, err := qemuFirmwarePath(viper.GetString("qemu-firmware-path"))
+	qemuFirmware, err := qemuFirmwarePath(viper.GetString("qemu-firmware-path"), runtime.GOARCH)
 	if err != nil {
 		return registry.State{Error: err, Doc: docURL}
 	}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rootless checks the host prerequisites of the rootless docker and podman drivers.
+// ref: https://rootlesscontaine.rs/getting-started/common/
+package rootless
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+)
+
+// subIDCount is the number of subordinate ids a user needs, to map every uid of a container
+const subIDCount = 65536
+
+// delegatedControllers are the cgroup v2 controllers the user systemd instance must delegate
+var delegatedControllers = []string{"cpu", "cpuset", "io", "memory", "pids"}
+
+// minKernel is the oldest kernel supporting overlayfs in user namespaces
+var minKernel = semver.MustParse("5.11.0")
+
+// Check is the result of checking a single prerequisite
+type Check struct {
+	Name string
+	// Err is nil if the prerequisite is met
+	Err error
+	// Fix are the shell commands, to be run as root, that meet the prerequisite
+	Fix []string
+	// Advice tells how to meet the prerequisite when it can not be done automatically
+	Advice string
+}
+
+// Host is the host whose prerequisites are checked
+type Host struct {
+	// Root is the root of the filesystem to read, "/" except in tests
+	Root string
+	User *user.User
+	// LookPath finds a binary, exec.LookPath except in tests
+	LookPath func(string) (string, error)
+}
+
+// NewHost returns the host running minikube, as seen by the current user
+func NewHost() (*Host, error) {
+	u, err := user.Current()
+	if err != nil {
+		return nil, err
+	}
+	return &Host{Root: "/", User: u, LookPath: exec.LookPath}, nil
+}
+
+// Checks checks every prerequisite of the rootless drivers
+func (h *Host) Checks() []Check {
+	return []Check{
+		h.checkSubIDs("subuid", "/etc/subuid"),
+		h.checkSubIDs("subgid", "/etc/subgid"),
+		h.checkUserNamespaces(),
+		h.checkCgroupV2(),
+		h.CheckDelegation(),
+		h.checkSlirp(),
+		h.checkKernel(),
+	}
+}
+
+func (h *Host) path(p string) string {
+	return filepath.Join(h.Root, p)
+}
+
+// checkSubIDs checks that the user has enough subordinate ids in file, which is either /etc/subuid or /etc/subgid
+func (h *Host) checkSubIDs(name string, file string) Check {
+	c := Check{Name: name}
+	ranges, err := readSubIDs(h.path(file))
+	if err != nil && !os.IsNotExist(err) {
+		c.Err = err
+		return c
+	}
+	next := 100000
+	for _, r := range ranges {
+		if (r.owner == h.User.Username || r.owner == h.User.Uid) && r.count >= subIDCount {
+			return c
+		}
+		if r.start+r.count > next {
+			next = r.start + r.count
+		}
+	}
+	c.Err = fmt.Errorf("%s has no range of %d ids for %s", file, subIDCount, h.User.Username)
+	flag := "--add-subuids"
+	if name == "subgid" {
+		flag = "--add-subgids"
+	}
+	c.Fix = []string{fmt.Sprintf("usermod %s %d-%d %s", flag, next, next+subIDCount-1, h.User.Username)}
+	return c
+}
+
+type subIDRange struct {
+	owner string
+	start int
+	count int
+}
+
+// readSubIDs parses a /etc/subuid or /etc/subgid file, made of "owner:start:count" lines
+func readSubIDs(p string) ([]subIDRange, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var ranges []subIDRange
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Split(strings.TrimSpace(s.Text()), ":")
+		if len(fields) != 3 {
+			continue
+		}
+		start, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+		ranges = append(ranges, subIDRange{owner: fields[0], start: start, count: count})
+	}
+	return ranges, s.Err()
+}
+
+// checkUserNamespaces checks that unprivileged users may create user namespaces, which some distributions disable
+func (h *Host) checkUserNamespaces() Check {
+	c := Check{Name: "user namespaces"}
+	b, err := os.ReadFile(h.path("/proc/sys/kernel/unprivileged_userns_clone"))
+	if err != nil {
+		// only present on kernels patched to disable them
+		return c
+	}
+	if strings.TrimSpace(string(b)) != "1" {
+		c.Err = fmt.Errorf("unprivileged user namespaces are disabled")
+		c.Fix = []string{
+			"echo kernel.unprivileged_userns_clone=1 > /etc/sysctl.d/99-rootless.conf",
+			"sysctl --system",
+		}
+	}
+	return c
+}
+
+// checkCgroupV2 checks that the unified cgroup hierarchy is mounted, as cgroup v1 can not be delegated safely
+func (h *Host) checkCgroupV2() Check {
+	c := Check{Name: "cgroup v2"}
+	if _, err := os.Stat(h.path("/sys/fs/cgroup/cgroup.controllers")); err != nil {
+		c.Err = fmt.Errorf("cgroup v2 is not enabled")
+		c.Advice = "Boot the kernel with systemd.unified_cgroup_hierarchy=1, see https://rootlesscontaine.rs/getting-started/common/cgroup2/"
+	}
+	return c
+}
+
+// CheckDelegation checks that the user systemd instance is delegated the cgroup controllers kubelet needs
+func (h *Host) CheckDelegation() Check {
+	c := Check{Name: "cgroup delegation"}
+	p := h.path(fmt.Sprintf("/sys/fs/cgroup/user.slice/user-%[1]s.slice/user@%[1]s.service/cgroup.controllers", h.User.Uid))
+	b, err := os.ReadFile(p)
+	if err != nil {
+		c.Err = fmt.Errorf("no cgroup is delegated to %s: %v", h.User.Username, err)
+	} else {
+		have := map[string]bool{}
+		for _, ctrl := range strings.Fields(string(b)) {
+			have[ctrl] = true
+		}
+		var missing []string
+		for _, ctrl := range delegatedControllers {
+			if !have[ctrl] {
+				missing = append(missing, ctrl)
+			}
+		}
+		if len(missing) == 0 {
+			return c
+		}
+		c.Err = fmt.Errorf("the %s cgroup controllers are not delegated to %s", strings.Join(missing, ", "), h.User.Username)
+	}
+	c.Fix = []string{
+		"mkdir -p /etc/systemd/system/user@.service.d",
+		fmt.Sprintf("printf '[Service]\\nDelegate=%s\\n' > /etc/systemd/system/user@.service.d/delegate.conf", strings.Join(delegatedControllers, " ")),
+		"systemctl daemon-reload",
+	}
+	c.Advice = "Log out and back in for the delegation to take effect"
+	return c
+}
+
+// checkSlirp checks for a user mode network stack, which rootless containers use instead of a bridge
+func (h *Host) checkSlirp() Check {
+	c := Check{Name: "user mode networking"}
+	for _, bin := range []string{"slirp4netns", "pasta"} {
+		if _, err := h.LookPath(bin); err == nil {
+			return c
+		}
+	}
+	c.Err = fmt.Errorf("neither slirp4netns nor pasta was found")
+	c.Advice = "Install slirp4netns with the package manager of your distribution"
+	return c
+}
+
+// checkKernel checks that the kernel supports overlayfs in user namespaces
+func (h *Host) checkKernel() Check {
+	c := Check{Name: "kernel"}
+	b, err := os.ReadFile(h.path("/proc/sys/kernel/osrelease"))
+	if err != nil {
+		c.Err = err
+		return c
+	}
+	release := strings.TrimSpace(string(b))
+	v, err := semver.ParseTolerant(strings.SplitN(release, "-", 2)[0])
+	if err != nil {
+		c.Err = fmt.Errorf("unable to parse kernel release %q: %v", release, err)
+		return c
+	}
+	if v.LT(minKernel) {
+		c.Err = fmt.Errorf("kernel %s is older than %s", release, minKernel)
+		c.Advice = "Upgrade to kernel 5.11 or later (5.13 or later with SELinux), see https://rootlesscontaine.rs/how-it-works/overlayfs/"
+	}
+	return c
+}
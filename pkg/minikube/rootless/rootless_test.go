@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rootless
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for p, content := range files {
+		p = filepath.Join(root, p)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+}
+
+func TestChecks(t *testing.T) {
+	u := &user.User{Username: "alice", Uid: "1000"}
+	found := func(string) (string, error) { return "/usr/bin/slirp4netns", nil }
+	missing := func(bin string) (string, error) { return "", fmt.Errorf("%s not found", bin) }
+
+	t.Run("ready", func(t *testing.T) {
+		root := t.TempDir()
+		writeFiles(t, root, map[string]string{
+			"/etc/subuid":                       "bob:100000:65536\nalice:165536:65536\n",
+			"/etc/subgid":                       "1000:100000:65536\n",
+			"/proc/sys/kernel/osrelease":        "6.5.0-27-generic\n",
+			"/sys/fs/cgroup/cgroup.controllers": "cpuset cpu io memory hugetlb pids rdma misc\n",
+			"/sys/fs/cgroup/user.slice/user-1000.slice/user@1000.service/cgroup.controllers": "cpuset cpu io memory pids\n",
+		})
+		h := &Host{Root: root, User: u, LookPath: found}
+		for _, c := range h.Checks() {
+			if c.Err != nil {
+				t.Errorf("%s: unexpected error: %v", c.Name, c.Err)
+			}
+		}
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		root := t.TempDir()
+		writeFiles(t, root, map[string]string{
+			"/etc/subuid":                                "bob:100000:65536\nalice:200000:1000\n",
+			"/proc/sys/kernel/osrelease":                 "5.4.0-150-generic\n",
+			"/proc/sys/kernel/unprivileged_userns_clone": "0\n",
+			"/sys/fs/cgroup/cgroup.controllers":          "cpuset cpu io memory pids\n",
+			"/sys/fs/cgroup/user.slice/user-1000.slice/user@1000.service/cgroup.controllers": "memory pids\n",
+		})
+		h := &Host{Root: root, User: u, LookPath: missing}
+		failed := map[string]Check{}
+		for _, c := range h.Checks() {
+			if c.Err != nil {
+				failed[c.Name] = c
+			}
+		}
+		for _, name := range []string{"subuid", "subgid", "user namespaces", "cgroup delegation", "user mode networking", "kernel"} {
+			if _, ok := failed[name]; !ok {
+				t.Errorf("%s: expected a failure", name)
+			}
+		}
+		if _, ok := failed["cgroup v2"]; ok {
+			t.Errorf("cgroup v2: unexpected failure: %v", failed["cgroup v2"].Err)
+		}
+		// the new range must start after every existing one
+		want := "usermod --add-subuids 201000-266535 alice"
+		if fix := failed["subuid"].Fix; len(fix) != 1 || fix[0] != want {
+			t.Errorf("subuid fix = %v, want %q", fix, want)
+		}
+		if fix := failed["subgid"].Fix; len(fix) != 1 || fix[0] != "usermod --add-subgids 100000-165535 alice" {
+			t.Errorf("subgid fix = %v", fix)
+		}
+	})
+}
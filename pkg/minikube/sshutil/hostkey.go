@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshutil
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"k8s.io/klog/v2"
+
+	"k8s.io/minikube/pkg/minikube/localpath"
+)
+
+// hostKeyFile is the file of the machine directory that holds the host key of its guest,
+// recorded the first time minikube connects to it after it created or booted the guest
+const hostKeyFile = "known_host_key"
+
+// ErrHostKeyMismatch is returned when a guest presents another host key than the one recorded for its machine
+var ErrHostKeyMismatch = errors.New("host key mismatch")
+
+// hostKeyMu serializes the recording of the host keys, as the runners of a guest may dial it concurrently
+var hostKeyMu sync.Mutex
+
+// HostKeyPath returns the path of the recorded host key of a machine
+func HostKeyPath(machineName string) string {
+	return filepath.Join(localpath.MachinePath(machineName), hostKeyFile)
+}
+
+// TrustedHostKey returns the recorded host key of a machine, or nil if none was recorded yet
+func TrustedHostKey(machineName string) (ssh.PublicKey, error) {
+	p := HostKeyPath(machineName)
+	b, err := os.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	key, _, _, _, err := ssh.ParseAuthorizedKey(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse %s", p)
+	}
+	return key, nil
+}
+
+// ResetHostKey forgets the recorded host key of a machine, so that the key its guest presents next is trusted
+func ResetHostKey(machineName string) error {
+	hostKeyMu.Lock()
+	defer hostKeyMu.Unlock()
+	if err := os.Remove(HostKeyPath(machineName)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// hostKeyCallback trusts the host key that the guest of a machine presents first, and then verifies that it presents the same one on every connection
+func hostKeyCallback(machineName string) ssh.HostKeyCallback {
+	return func(hostname string, _ net.Addr, key ssh.PublicKey) error {
+		hostKeyMu.Lock()
+		defer hostKeyMu.Unlock()
+
+		known, err := TrustedHostKey(machineName)
+		if err != nil {
+			return errors.Wrap(err, "trusted host key")
+		}
+		if known != nil {
+			if !bytes.Equal(known.Marshal(), key.Marshal()) {
+				return fmt.Errorf("%w: %s presented %s %s, but %s was recorded when minikube last booted it. If its guest was legitimately recreated, run 'minikube node trust --reset' on its node",
+					ErrHostKeyMismatch, machineName, key.Type(), ssh.FingerprintSHA256(key), ssh.FingerprintSHA256(known))
+			}
+			return nil
+		}
+
+		p := HostKeyPath(machineName)
+		klog.Infof("recording the host key of %s (%s): %s %s", machineName, hostname, key.Type(), ssh.FingerprintSHA256(key))
+		if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			return errors.Wrapf(err, "mkdir %s", filepath.Dir(p))
+		}
+		return os.WriteFile(p, ssh.MarshalAuthorizedKey(key), 0o600)
+	}
+}
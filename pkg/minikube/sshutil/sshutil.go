@@ -18,12 +18,15 @@ package sshutil
 
 import (
 	"bufio"
+	"fmt"
 	"net"
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/docker/machine/libmachine/drivers"
 	machinessh "github.com/docker/machine/libmachine/ssh"
 	"github.com/pkg/errors"
@@ -35,6 +38,20 @@ import (
 	"k8s.io/minikube/pkg/util/retry"
 )
 
+// sharedClient is the SSH connection to a guest that its command runners share
+type sharedClient struct {
+	mu sync.Mutex
+	c  *ssh.Client
+}
+
+// keepAliveInterval is how often a shared connection is checked, and how long the guest has to answer
+const keepAliveInterval = 15 * time.Second
+
+var (
+	sharedMu      sync.Mutex
+	sharedClients = map[string]*sharedClient{}
+)
+
 // NewSSHClient returns an SSH client object for running commands.
 func NewSSHClient(d drivers.Driver) (*ssh.Client, error) {
 	h, err := newSSHHost(d)
@@ -42,6 +59,100 @@ func NewSSHClient(d drivers.Driver) (*ssh.Client, error) {
 		return nil, errors.Wrap(err, "Error creating new ssh host from driver")
 
 	}
+	return dial(h)
+}
+
+// SharedSSHClient returns the SSH client of the guest of d, which is dialed once and shared by every caller,
+// each one opening its own sessions over the same connection. It is dialed again once the connection is closed.
+func SharedSSHClient(d drivers.Driver) (*ssh.Client, error) {
+	h, err := newSSHHost(d)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error creating new ssh host from driver")
+	}
+	key := fmt.Sprintf("%s@%s:%d %s", h.Username, h.IP, h.Port, h.SSHKeyPath)
+
+	sharedMu.Lock()
+	sc, ok := sharedClients[key]
+	if !ok {
+		sc = &sharedClient{}
+		sharedClients[key] = sc
+	}
+	sharedMu.Unlock()
+
+	// dial each guest once, without holding up the callers of the other guests
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.c != nil {
+		return sc.c, nil
+	}
+	c, err := dial(h)
+	if err != nil {
+		return nil, err
+	}
+	sc.c = c
+	closed := make(chan struct{})
+	go func() {
+		err := c.Wait()
+		close(closed)
+		klog.Infof("shared ssh connection to %s closed: %v", key, err)
+		sc.mu.Lock()
+		defer sc.mu.Unlock()
+		if sc.c == c {
+			sc.c = nil
+		}
+	}()
+	go keepAlive(c, key, closed)
+	return c, nil
+}
+
+// DropSharedClient closes c, a client returned by SharedSSHClient that failed, so that the next caller dials the guest again
+func DropSharedClient(c *ssh.Client) {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+	for key, sc := range sharedClients {
+		sc.mu.Lock()
+		if sc.c == c {
+			klog.Infof("dropping shared ssh connection to %s", key)
+			sc.c = nil
+		}
+		sc.mu.Unlock()
+	}
+	c.Close()
+}
+
+// keepAlive pings the guest over the shared connection c until it is closed, and closes it once the guest stops
+// answering, eg: after it was restarted, instead of leaving the next callers with a connection that hangs
+func keepAlive(c *ssh.Client, key string, closed <-chan struct{}) {
+	t := time.NewTicker(keepAliveInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case <-t.C:
+		}
+
+		replied := make(chan error, 1)
+		go func() {
+			_, _, err := c.SendRequest("keepalive@openssh.com", true, nil)
+			replied <- err
+		}()
+		select {
+		case err := <-replied:
+			if err == nil {
+				continue
+			}
+			klog.Warningf("shared ssh connection to %s failed its keepalive: %v", key, err)
+		case <-time.After(keepAliveInterval):
+			klog.Warningf("shared ssh connection to %s did not answer its keepalive within %s", key, keepAliveInterval)
+		}
+		c.Close()
+		return
+	}
+}
+
+// dial returns a new SSH client of the guest h
+func dial(h *sshHost) (*ssh.Client, error) {
 	defaultKeyPath := filepath.Join(homedir.HomeDir(), ".ssh", "id_rsa")
 	auth := &machinessh.Auth{}
 	if h.SSHKeyPath != "" {
@@ -56,10 +167,14 @@ func NewSSHClient(d drivers.Driver) (*ssh.Client, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "Error creating new native config from ssh using: %s, %s", h.Username, auth)
 	}
+	config.HostKeyCallback = hostKeyCallback(h.MachineName)
 
 	var client *ssh.Client
 	getSSH := func() (err error) {
 		client, err = ssh.Dial("tcp", net.JoinHostPort(h.IP, strconv.Itoa(h.Port)), &config)
+		if errors.Is(err, ErrHostKeyMismatch) {
+			return backoff.Permanent(err)
+		}
 		if err != nil {
 			klog.Warningf("dial failure (will retry): %v", err)
 		}
@@ -74,10 +189,11 @@ func NewSSHClient(d drivers.Driver) (*ssh.Client, error) {
 }
 
 type sshHost struct {
-	IP         string
-	Port       int
-	SSHKeyPath string
-	Username   string
+	IP          string
+	Port        int
+	SSHKeyPath  string
+	Username    string
+	MachineName string
 }
 
 func newSSHHost(d drivers.Driver) (*sshHost, error) {
@@ -91,10 +207,11 @@ func newSSHHost(d drivers.Driver) (*sshHost, error) {
 		return nil, errors.Wrap(err, "Error getting ssh port for driver")
 	}
 	return &sshHost{
-		IP:         ip,
-		Port:       port,
-		SSHKeyPath: d.GetSSHKeyPath(),
-		Username:   d.GetSSHUsername(),
+		IP:          ip,
+		Port:        port,
+		SSHKeyPath:  d.GetSSHKeyPath(),
+		Username:    d.GetSSHUsername(),
+		MachineName: d.GetMachineName(),
 	}, nil
 }
 
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+)
+
+const (
+	altScreen  = "\x1b[?1049h\x1b[?25l"
+	mainScreen = "\x1b[?25h\x1b[?1049l"
+	clear      = "\x1b[H\x1b[2J"
+)
+
+// escapes are the keys sent as escape sequences by terminals
+var escapes = map[string]Key{
+	"\x1b[A": KeyUp,
+	"\x1bOA": KeyUp,
+	"\x1b[B": KeyDown,
+	"\x1bOB": KeyDown,
+	"\x1b[Z": KeyBackTab,
+}
+
+// parseKeys splits what was read from the terminal into keys
+func parseKeys(b []byte) []Key {
+	var keys []Key
+	for i := 0; i < len(b); {
+		if b[i] == 0x1b && i+2 < len(b) {
+			if k, ok := escapes[string(b[i:i+3])]; ok {
+				keys = append(keys, k)
+				i += 3
+				continue
+			}
+		}
+		switch b[i] {
+		case '\t':
+			keys = append(keys, KeyTab)
+		case '\r', '\n':
+			keys = append(keys, KeyEnter)
+		case 0x03:
+			keys = append(keys, KeyCtrlC)
+		case 0x1b:
+			// a lone escape, or an unknown sequence
+		default:
+			if b[i] >= 0x20 && b[i] < 0x7f {
+				keys = append(keys, Key(b[i:i+1]))
+			}
+		}
+		i++
+	}
+	return keys
+}
+
+// Run runs the UI in the terminal in until it is quit, refreshing every interval
+func Run(m *Model, in *os.File, out io.Writer, interval time.Duration) error {
+	fd := int(in.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return errors.Wrap(err, "raw terminal")
+	}
+	defer term.Restore(fd, state) // nolint: errcheck
+	fmt.Fprint(out, altScreen)
+	defer fmt.Fprint(out, mainScreen)
+
+	keys := make(chan Key)
+	go func() {
+		b := make([]byte, 64)
+		for {
+			n, err := in.Read(b)
+			if err != nil {
+				close(keys)
+				return
+			}
+			for _, k := range parseKeys(b[:n]) {
+				keys <- k
+			}
+		}
+	}()
+
+	draw := func() {
+		width, height, err := term.GetSize(fd)
+		if err != nil {
+			width, height = 80, 24
+		}
+		fmt.Fprint(out, clear+m.Render(width, height))
+	}
+
+	m.Refresh()
+	draw()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case k, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			a, quit := m.Key(k)
+			if quit {
+				return nil
+			}
+			if a != nil {
+				m.Working(a)
+				draw()
+				m.Done(a, a.Run())
+				m.Refresh()
+			}
+		case <-ticker.C:
+			m.Refresh()
+		}
+		draw()
+	}
+}
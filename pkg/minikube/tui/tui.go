@@ -0,0 +1,428 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tui is the interactive terminal UI of minikube ui, which shows the clusters, nodes, addons,
+// services and recent events, and runs the common actions on them, through libminikube.
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"k8s.io/minikube/pkg/libminikube"
+)
+
+// Backend is what the UI shows and acts on, implemented by *libminikube.Client
+type Backend interface {
+	Clusters() ([]string, error)
+	Status(cluster string) ([]libminikube.NodeStatus, error)
+	Addons(cluster string) (map[string]bool, error)
+	Services(cluster string) ([]libminikube.Service, error)
+	Events(cluster string) ([]libminikube.Event, error)
+	StartNode(cluster, name string) error
+	StopNode(cluster, name string) error
+	PauseNode(cluster, name string) error
+	UnpauseNode(cluster, name string) error
+	SetAddon(cluster, name string, enable bool) error
+}
+
+// Pane is a list of the UI, one at a time
+type Pane int
+
+const (
+	// ClustersPane lists the clusters, to select one
+	ClustersPane Pane = iota
+	// NodesPane lists the nodes of the selected cluster, with their health and OS
+	NodesPane
+	// AddonsPane lists the addons of the selected cluster
+	AddonsPane
+	// ServicesPane lists the services of the selected cluster
+	ServicesPane
+
+	paneCount = 4
+)
+
+var paneNames = []string{"Clusters", "Nodes", "Addons", "Services"}
+
+// paneHelp are the keys of the actions of each pane
+var paneHelp = []string{
+	"enter: select",
+	"s: start  x: stop  p: pause  u: unpause",
+	"enter: enable/disable",
+	"enter: open in browser",
+}
+
+// maxEvents is how many of the recent events are shown
+const maxEvents = 5
+
+// Key is a key pressed by the user: one of the constants, or a printable character, eg: "s"
+type Key string
+
+// the keys that are not printable characters
+const (
+	KeyUp      Key = "up"
+	KeyDown    Key = "down"
+	KeyTab     Key = "tab"
+	KeyBackTab Key = "backtab"
+	KeyEnter   Key = "enter"
+	KeyCtrlC   Key = "ctrl+c"
+)
+
+// Action is an action that the user asked for, which may take a while
+type Action struct {
+	// Doing is shown while the action runs, eg: "Stopping node dev-m02 ..."
+	Doing string
+	Run   func() error
+}
+
+// Model is the state of the UI
+type Model struct {
+	backend Backend
+	open    func(url string) error
+
+	pane   Pane
+	cursor [paneCount]int
+
+	cluster  string
+	clusters []string
+	nodes    []libminikube.NodeStatus
+	addons   []string
+	enabled  map[string]bool
+	services []libminikube.Service
+	events   []libminikube.Event
+
+	// message is the outcome of the last action
+	message string
+	// errs are the errors of the last refresh
+	errs []string
+}
+
+// New returns the model of a UI on b, which opens the URLs of the services with open
+func New(b Backend, open func(url string) error) *Model {
+	return &Model{backend: b, open: open}
+}
+
+// Select selects a cluster, whose nodes, addons and services are shown. It takes effect on Refresh.
+func (m *Model) Select(cluster string) {
+	m.cluster = cluster
+	m.pane = NodesPane
+	m.cursor[NodesPane], m.cursor[AddonsPane], m.cursor[ServicesPane] = 0, 0, 0
+}
+
+// Refresh loads the clusters, and the nodes, addons, services and events of the selected cluster
+func (m *Model) Refresh() {
+	m.errs = nil
+	fail := func(what string, err error) {
+		m.errs = append(m.errs, fmt.Sprintf("%s: %v", what, err))
+	}
+
+	clusters, err := m.backend.Clusters()
+	if err != nil {
+		fail("clusters", err)
+	}
+	m.clusters = clusters
+	found := false
+	for i, c := range clusters {
+		if c == m.cluster {
+			found = true
+			m.cursor[ClustersPane] = i
+		}
+	}
+	if !found {
+		m.cluster = ""
+		if len(clusters) > 0 {
+			m.cluster = clusters[0]
+		}
+	}
+
+	m.nodes, m.addons, m.enabled, m.services, m.events = nil, nil, nil, nil, nil
+	if m.cluster != "" {
+		if m.nodes, err = m.backend.Status(m.cluster); err != nil {
+			fail("status", err)
+		}
+		if m.enabled, err = m.backend.Addons(m.cluster); err != nil {
+			fail("addons", err)
+		}
+		for name := range m.enabled {
+			m.addons = append(m.addons, name)
+		}
+		sort.Strings(m.addons)
+		// the services are only listed while the API server is running
+		if m.running() {
+			if m.services, err = m.backend.Services(m.cluster); err != nil {
+				fail("services", err)
+			}
+		}
+		if m.events, err = m.backend.Events(m.cluster); err != nil {
+			fail("events", err)
+		}
+		if len(m.events) > maxEvents {
+			m.events = m.events[len(m.events)-maxEvents:]
+		}
+	}
+	for p := range m.cursor {
+		m.cursor[p] = max(0, min(m.cursor[p], m.rowCount(Pane(p))-1))
+	}
+}
+
+// running returns whether a control-plane node of the selected cluster runs its API server
+func (m *Model) running() bool {
+	for _, n := range m.nodes {
+		if n.ControlPlane && n.APIServer == "Running" {
+			return true
+		}
+	}
+	return false
+}
+
+// rowCount returns the number of rows of a pane
+func (m *Model) rowCount(p Pane) int {
+	switch p {
+	case ClustersPane:
+		return len(m.clusters)
+	case NodesPane:
+		return len(m.nodes)
+	case AddonsPane:
+		return len(m.addons)
+	}
+	return len(m.services)
+}
+
+// Key handles a key, and returns the action it asks for, if any, and whether the UI is quit
+func (m *Model) Key(k Key) (*Action, bool) {
+	switch k {
+	case "q", KeyCtrlC:
+		return nil, true
+	case KeyTab:
+		m.pane = (m.pane + 1) % Pane(paneCount)
+	case KeyBackTab:
+		m.pane = (m.pane + Pane(paneCount) - 1) % Pane(paneCount)
+	case "1", "2", "3", "4":
+		m.pane = Pane(k[0] - '1')
+	case KeyUp, "k":
+		m.cursor[m.pane] = max(0, m.cursor[m.pane]-1)
+	case KeyDown, "j":
+		m.cursor[m.pane] = max(0, min(m.cursor[m.pane]+1, m.rowCount(m.pane)-1))
+	case "r":
+		return &Action{Doing: "Refreshing ...", Run: func() error { return nil }}, false
+	default:
+		return m.paneKey(k), false
+	}
+	return nil, false
+}
+
+// paneKey returns the action of a key of the current pane, if any
+func (m *Model) paneKey(k Key) *Action {
+	i := m.cursor[m.pane]
+	if i >= m.rowCount(m.pane) {
+		return nil
+	}
+	cluster := m.cluster
+	switch m.pane {
+	case ClustersPane:
+		if k == KeyEnter {
+			m.Select(m.clusters[i])
+			return &Action{Doing: fmt.Sprintf("Loading %s ...", m.cluster), Run: func() error { return nil }}
+		}
+	case NodesPane:
+		name := m.nodes[i].Name
+		actions := map[Key]struct {
+			doing string
+			run   func(cluster, name string) error
+		}{
+			"s": {"Starting", m.backend.StartNode},
+			"x": {"Stopping", m.backend.StopNode},
+			"p": {"Pausing", m.backend.PauseNode},
+			"u": {"Unpausing", m.backend.UnpauseNode},
+		}
+		if a, ok := actions[k]; ok {
+			return &Action{Doing: fmt.Sprintf("%s node %s ...", a.doing, name), Run: func() error { return a.run(cluster, name) }}
+		}
+	case AddonsPane:
+		if k == KeyEnter || k == " " {
+			name := m.addons[i]
+			enable := !m.enabled[name]
+			doing := "Enabling"
+			if !enable {
+				doing = "Disabling"
+			}
+			return &Action{Doing: fmt.Sprintf("%s addon %s ...", doing, name), Run: func() error { return m.backend.SetAddon(cluster, name, enable) }}
+		}
+	case ServicesPane:
+		if k == KeyEnter || k == "o" {
+			s := m.services[i]
+			if len(s.URLs) == 0 {
+				m.message = fmt.Sprintf("Service %s/%s has no node port", s.Namespace, s.Name)
+				return nil
+			}
+			return &Action{Doing: fmt.Sprintf("Opening %s ...", s.URLs[0]), Run: func() error { return m.open(s.URLs[0]) }}
+		}
+	}
+	return nil
+}
+
+// Done records the outcome of an action
+func (m *Model) Done(a *Action, err error) {
+	if err != nil {
+		m.message = fmt.Sprintf("%s failed: %v", strings.TrimSuffix(a.Doing, " ..."), err)
+		return
+	}
+	m.message = strings.TrimSuffix(a.Doing, " ...") + ": done"
+}
+
+// Working shows that an action is running
+func (m *Model) Working(a *Action) {
+	m.message = a.Doing
+}
+
+// rows returns the header and the rows of a pane
+func (m *Model) rows(p Pane) [][]string {
+	switch p {
+	case ClustersPane:
+		rows := [][]string{{"NAME", ""}}
+		for _, c := range m.clusters {
+			selected := ""
+			if c == m.cluster {
+				selected = "(selected)"
+			}
+			rows = append(rows, []string{c, selected})
+		}
+		return rows
+	case NodesPane:
+		rows := [][]string{{"NAME", "ROLE", "HOST", "KUBELET", "APISERVER", "OS"}}
+		for _, n := range m.nodes {
+			role := "worker"
+			if n.ControlPlane {
+				role = "control-plane"
+			}
+			rows = append(rows, []string{n.Name, role, n.Host, n.Kubelet, n.APIServer, n.OS})
+		}
+		return rows
+	case AddonsPane:
+		rows := [][]string{{"NAME", "STATUS"}}
+		for _, a := range m.addons {
+			status := "disabled"
+			if m.enabled[a] {
+				status = "enabled"
+			}
+			rows = append(rows, []string{a, status})
+		}
+		return rows
+	}
+	rows := [][]string{{"NAMESPACE", "NAME", "URL"}}
+	for _, s := range m.services {
+		rows = append(rows, []string{s.Namespace, s.Name, strings.Join(s.URLs, " ")})
+	}
+	return rows
+}
+
+// table aligns the columns of rows
+func table(rows [][]string) []string {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	for _, r := range rows {
+		fmt.Fprintln(w, strings.Join(r, "\t"))
+	}
+	w.Flush()
+	return strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+}
+
+// Render returns the screen of the UI, of width columns and height lines
+func (m *Model) Render(width, height int) string {
+	title := "minikube ui"
+	if m.cluster != "" {
+		title += " - " + m.cluster
+	} else if len(m.errs) == 0 {
+		title += " - no clusters, run 'minikube start' to create one"
+	}
+	var tabs []string
+	for p, name := range paneNames {
+		if Pane(p) == m.pane {
+			tabs = append(tabs, fmt.Sprintf("[%d %s]", p+1, name))
+		} else {
+			tabs = append(tabs, fmt.Sprintf(" %d %s ", p+1, name))
+		}
+	}
+	top := []string{title, strings.Join(tabs, " "), ""}
+
+	bottom := []string{"", "Recent events:"}
+	if len(m.events) == 0 {
+		bottom = append(bottom, "  none")
+	}
+	for _, e := range m.events {
+		bottom = append(bottom, "  "+eventLine(e))
+	}
+	bottom = append(bottom, "")
+	for _, e := range m.errs {
+		bottom = append(bottom, "! "+e)
+	}
+	if m.message != "" {
+		bottom = append(bottom, m.message)
+	}
+	bottom = append(bottom, paneHelp[m.pane]+"  tab: next pane  r: refresh  q: quit")
+
+	lines := table(m.rows(m.pane))
+	header, rows := "  "+lines[0], lines[1:]
+	if len(m.rows(m.pane)) == 1 {
+		rows = nil
+	}
+	// the rows that fit, scrolled to the cursor
+	fit := max(1, height-len(top)-len(bottom)-1)
+	cursor := m.cursor[m.pane]
+	start := max(0, cursor-fit+1)
+	end := min(len(rows), start+fit)
+	middle := []string{header}
+	if len(rows) == 0 {
+		middle = append(middle, "  none")
+	}
+	for i := start; i < end; i++ {
+		prefix := "  "
+		if i == cursor {
+			prefix = "> "
+		}
+		middle = append(middle, prefix+rows[i])
+	}
+
+	var all []string
+	for _, l := range append(append(top, middle...), bottom...) {
+		all = append(all, truncate(l, width))
+	}
+	return strings.Join(all, "\r\n")
+}
+
+// eventLine returns a line of an event, eg: "[3/19] Preparing Kubernetes v1.30.1 on Docker 26.1.1 ..."
+func eventLine(e libminikube.Event) string {
+	switch {
+	case e.Type == libminikube.StepEvent && e.TotalSteps > 0:
+		return fmt.Sprintf("[%d/%d] %s", e.CurrentStep, e.TotalSteps, e.Message)
+	case e.Type == libminikube.ErrorEvent || e.Type == libminikube.WarningEvent:
+		return fmt.Sprintf("%s: %s", e.Type, e.Message)
+	}
+	return e.Message
+}
+
+// truncate cuts s to width characters
+func truncate(s string, width int) string {
+	s = strings.TrimRight(strings.ReplaceAll(s, "\n", " "), " ")
+	r := []rune(s)
+	if width > 0 && len(r) > width {
+		return string(r[:width])
+	}
+	return s
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/minikube/pkg/libminikube"
+)
+
+// fakeBackend records the actions of the UI
+type fakeBackend struct {
+	calls []string
+}
+
+func (f *fakeBackend) Clusters() ([]string, error) {
+	return []string{"dev", "minikube"}, nil
+}
+
+func (f *fakeBackend) Status(cluster string) ([]libminikube.NodeStatus, error) {
+	return []libminikube.NodeStatus{
+		{Name: cluster, ControlPlane: true, Host: "Running", Kubelet: "Running", APIServer: "Running", OS: "Buildroot 2023.02.9"},
+		{Name: cluster + "-m02", Host: "Stopped", Kubelet: "Stopped", APIServer: "Irrelevant"},
+	}, nil
+}
+
+func (f *fakeBackend) Addons(string) (map[string]bool, error) {
+	return map[string]bool{"ingress": false, "dashboard": true}, nil
+}
+
+func (f *fakeBackend) Services(string) ([]libminikube.Service, error) {
+	return []libminikube.Service{{Namespace: "default", Name: "web", URLs: []string{"http://192.168.49.2:30080"}}}, nil
+}
+
+func (f *fakeBackend) Events(string) ([]libminikube.Event, error) {
+	return []libminikube.Event{{Type: libminikube.StepEvent, Message: "Done!", CurrentStep: 19, TotalSteps: 19}}, nil
+}
+
+func (f *fakeBackend) StartNode(cluster, name string) error {
+	f.calls = append(f.calls, "start "+cluster+" "+name)
+	return nil
+}
+
+func (f *fakeBackend) StopNode(cluster, name string) error {
+	f.calls = append(f.calls, "stop "+cluster+" "+name)
+	return nil
+}
+
+func (f *fakeBackend) PauseNode(cluster, name string) error {
+	f.calls = append(f.calls, "pause "+cluster+" "+name)
+	return nil
+}
+
+func (f *fakeBackend) UnpauseNode(cluster, name string) error {
+	f.calls = append(f.calls, "unpause "+cluster+" "+name)
+	return nil
+}
+
+func (f *fakeBackend) SetAddon(cluster, name string, enable bool) error {
+	f.calls = append(f.calls, fmt.Sprintf("addon %s %s %v", cluster, name, enable))
+	return nil
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		description string
+		keys        []Key
+		want        string
+	}{
+		{"stop the selected node", []Key{"j", "x"}, "stop minikube minikube-m02"},
+		{"pause the control plane", []Key{"p"}, "pause minikube minikube"},
+		{"select another cluster", []Key{"1", "k", KeyEnter, "s"}, "start dev dev"},
+		{"enable an addon", []Key{KeyTab, KeyDown, KeyEnter}, "addon minikube ingress true"},
+		{"disable an addon", []Key{"3", KeyEnter}, "addon minikube dashboard false"},
+		{"open a service", []Key{KeyBackTab, KeyBackTab, KeyEnter}, "open http://192.168.49.2:30080"},
+		{"cursor stays in the pane", []Key{KeyDown, KeyDown, KeyDown, "u"}, "unpause minikube minikube-m02"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			b := &fakeBackend{}
+			m := New(b, func(url string) error {
+				b.calls = append(b.calls, "open "+url)
+				return nil
+			})
+			m.Select("minikube")
+			m.Refresh()
+			for _, k := range tc.keys {
+				a, quit := m.Key(k)
+				if quit {
+					t.Fatalf("Key(%q) quit", k)
+				}
+				if a != nil {
+					m.Done(a, a.Run())
+					m.Refresh()
+				}
+			}
+			if got := strings.Join(b.calls, ", "); got != tc.want {
+				t.Errorf("calls = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestQuit(t *testing.T) {
+	m := New(&fakeBackend{}, nil)
+	for _, k := range []Key{"q", KeyCtrlC} {
+		if _, quit := m.Key(k); !quit {
+			t.Errorf("Key(%q) did not quit", k)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	m := New(&fakeBackend{}, nil)
+	m.Select("minikube")
+	m.Refresh()
+	a, _ := m.Key("x")
+	m.Done(a, fmt.Errorf("boom"))
+
+	screen := m.Render(100, 24)
+	for _, want := range []string{
+		"minikube ui - minikube",
+		"[2 Nodes]",
+		"> minikube      control-plane  Running",
+		"Buildroot 2023.02.9",
+		"[19/19] Done!",
+		"Stopping node minikube failed: boom",
+	} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("Render() does not contain %q:\n%s", want, screen)
+		}
+	}
+	for _, l := range strings.Split(m.Render(20, 24), "\r\n") {
+		if len([]rune(l)) > 20 {
+			t.Errorf("Render(20) line %q is wider than 20", l)
+		}
+	}
+	if lines := strings.Count(m.Render(100, 10), "\r\n") + 1; lines > 12 {
+		t.Errorf("Render(height 10) = %d lines", lines)
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	got := parseKeys([]byte("j\x1b[A\x1b[B\t\x1b[Z\rq\x03\x1b"))
+	want := []Key{"j", KeyUp, KeyDown, KeyTab, KeyBackTab, KeyEnter, "q", KeyCtrlC}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseKeys() = %q, want %q", got, want)
+	}
+}
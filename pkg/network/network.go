@@ -225,6 +225,17 @@ func FreeSubnet(startSubnet string, step, tries int) (*Parameters, error) {
 	return nil, fmt.Errorf("no free private network subnets found with given parameters (start: %q, step: %d, tries: %d)", startSubnet, step, tries)
 }
 
+// IPv6Subnet returns the unique local IPv6 subnet and its gateway that pair with the IPv4 subnet ip in dual-stack networks,
+// eg: fd00:c0a8:3100::/64 and fd00:c0a8:3100::1 for 192.168.49.0, so that each IPv4 subnet has its own IPv6 one
+func IPv6Subnet(ip string) (cidr string, gateway string) {
+	ip4 := net.ParseIP(ip).To4()
+	if ip4 == nil {
+		return "", ""
+	}
+	prefix := fmt.Sprintf("fd00:%02x%02x:%02x%02x::", ip4[0], ip4[1], ip4[2], ip4[3])
+	return prefix + "/64", prefix + "1"
+}
+
 // ParseAddr will try to parse an ip or a cidr address
 func ParseAddr(addr string) (net.IP, *net.IPNet, error) {
 	ip, network, err := net.ParseCIDR(addr)
@@ -242,7 +253,7 @@ func ParseAddr(addr string) (net.IP, *net.IPNet, error) {
 var reserveSubnet = func(subnet string) (mutex.Releaser, error) {
 	spec := lock.PathMutexSpec(subnet)
 	spec.Timeout = 1 * time.Millisecond // practically: just check, don't wait
-	reservation, err := mutex.Acquire(spec)
+	reservation, err := lock.Acquire(spec)
 	if err != nil {
 		return nil, err
 	}
@@ -145,3 +145,21 @@ func TestParseAddr(t *testing.T) {
 		}
 	})
 }
+
+func TestIPv6Subnet(t *testing.T) {
+	tests := []struct {
+		ip      string
+		cidr    string
+		gateway string
+	}{
+		{"192.168.49.0", "fd00:c0a8:3100::/64", "fd00:c0a8:3100::1"},
+		{"10.0.2.0", "fd00:0a00:0200::/64", "fd00:0a00:0200::1"},
+		{"fd00::", "", ""},
+	}
+	for _, tc := range tests {
+		cidr, gateway := IPv6Subnet(tc.ip)
+		if cidr != tc.cidr || gateway != tc.gateway {
+			t.Errorf("IPv6Subnet(%q) = %q, %q, want %q, %q", tc.ip, cidr, gateway, tc.cidr, tc.gateway)
+		}
+	}
+}
@@ -52,6 +52,11 @@ func (p *BuildrootProvisioner) String() string {
 	return "buildroot"
 }
 
+// SSHCommand runs a command on the guest over its shared SSH connection
+func (p *BuildrootProvisioner) SSHCommand(args string) (string, error) {
+	return sshCommand(p.Driver, args)
+}
+
 // CompatibleWithHost checks if provisioner is compatible with host
 func (p *BuildrootProvisioner) CompatibleWithHost() bool {
 	return p.OsReleaseInfo.ID == "buildroot"
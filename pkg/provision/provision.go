@@ -267,6 +267,16 @@ CRIO_MINIKUBE_OPTIONS='{{ range .EngineOptions.InsecureRegistry }}--insecure-reg
 	return nil
 }
 
+// sshCommand runs a shell command on the guest over its shared SSH connection, and returns its output,
+// where drivers.RunSSHCommandFromDriver dials a new connection for each command
+func sshCommand(d drivers.Driver, cmd string) (string, error) {
+	rr, err := command.NewSSHRunner(d).RunCmd(exec.Command("/bin/bash", "-c", cmd))
+	if err != nil {
+		return "", err
+	}
+	return rr.Stdout.String() + rr.Stderr.String(), nil
+}
+
 func rootFileSystemType(p provision.SSHCommander) (string, error) {
 	fs, err := p.SSHCommand("df --output=fstype / | tail -n 1")
 	if err != nil {
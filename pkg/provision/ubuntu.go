@@ -53,6 +53,11 @@ func (p *UbuntuProvisioner) String() string {
 	return "ubuntu"
 }
 
+// SSHCommand runs a command on the guest over its shared SSH connection
+func (p *UbuntuProvisioner) SSHCommand(args string) (string, error) {
+	return sshCommand(p.Driver, args)
+}
+
 // CompatibleWithHost checks if provisioner is compatible with host
 func (p *UbuntuProvisioner) CompatibleWithHost() bool {
 	return p.OsReleaseInfo.ID == "ubuntu"
@@ -34,8 +34,8 @@ import (
 	"k8s.io/minikube/pkg/util/lock"
 )
 
-// GenerateCACert generates a CA certificate and RSA key for a common name
-func GenerateCACert(certPath, keyPath string, name string) error {
+// GenerateCACert generates a CA certificate and RSA key for a common name, the key is created with file mode keyPerm
+func GenerateCACert(certPath, keyPath string, name string, keyPerm os.FileMode) error {
 	priv, err := rsa.GenerateKey(rand.Reader, 2048)
 	if err != nil {
 		return errors.Wrap(err, "Error generating rsa key")
@@ -55,16 +55,18 @@ func GenerateCACert(certPath, keyPath string, name string) error {
 		IsCA:                  true,
 	}
 
-	return writeCertsAndKeys(&template, certPath, priv, keyPath, &template, priv)
+	return writeCertsAndKeys(&template, certPath, priv, keyPath, &template, priv, keyPerm)
 }
 
 // You may also specify additional subject alt names (either ip or dns names) for the certificate
-// The certificate will be created with file mode 0644. The key will be created with file mode 0600.
+// The key will be created with file mode keyPerm, eg: 0600. The certificate will be created with the same file mode,
+// plus read access for everyone: 0644 for a keyPerm of 0600.
 // If the certificate or key files already exist, they will be overwritten.
-// Any parent directories of the certPath or keyPath will be created as needed with file mode 0755.
+// Any parent directories of the certPath or keyPath will be created as needed with the file mode of the certificate,
+// plus the matching execute bits: 0755 for a keyPerm of 0600.
 
 // GenerateSignedCert generates a signed certificate and key
-func GenerateSignedCert(certPath, keyPath, cn string, ips []net.IP, alternateDNS []string, signerCertPath, signerKeyPath string, expiration time.Duration) error {
+func GenerateSignedCert(certPath, keyPath, cn string, ips []net.IP, alternateDNS []string, signerCertPath, signerKeyPath string, expiration time.Duration, keyPerm os.FileMode) error {
 	klog.Infof("Generating cert %s with IP's: %s", certPath, ips)
 	signerCertBytes, err := os.ReadFile(signerCertPath)
 	if err != nil {
@@ -113,7 +115,7 @@ func GenerateSignedCert(certPath, keyPath, cn string, ips []net.IP, alternateDNS
 		return errors.Wrap(err, "Error loading or generating private key: keyPath")
 	}
 
-	return writeCertsAndKeys(&template, certPath, priv, keyPath, signerCert, signerKey)
+	return writeCertsAndKeys(&template, certPath, priv, keyPath, signerCert, signerKey, keyPerm)
 }
 
 func loadOrGeneratePrivateKey(keyPath string) (*rsa.PrivateKey, error) {
@@ -134,7 +136,7 @@ func loadOrGeneratePrivateKey(keyPath string) (*rsa.PrivateKey, error) {
 	return priv, nil
 }
 
-func writeCertsAndKeys(template *x509.Certificate, certPath string, signeeKey *rsa.PrivateKey, keyPath string, parent *x509.Certificate, signingKey *rsa.PrivateKey) error {
+func writeCertsAndKeys(template *x509.Certificate, certPath string, signeeKey *rsa.PrivateKey, keyPath string, parent *x509.Certificate, signingKey *rsa.PrivateKey, keyPerm os.FileMode) error {
 	derBytes, err := x509.CreateCertificate(rand.Reader, template, parent, &signeeKey.PublicKey, signingKey)
 	if err != nil {
 		return errors.Wrap(err, "Error creating certificate")
@@ -150,19 +152,21 @@ func writeCertsAndKeys(template *x509.Certificate, certPath string, signeeKey *r
 		return errors.Wrap(err, "Error encoding key")
 	}
 
-	if err := os.MkdirAll(filepath.Dir(certPath), os.FileMode(0755)); err != nil {
+	certPerm := keyPerm | 0o044
+	dirPerm := certPerm | (certPerm&0o444)>>2
+	if err := os.MkdirAll(filepath.Dir(certPath), dirPerm); err != nil {
 		return errors.Wrap(err, "Error creating certificate directory")
 	}
 	klog.Infof("Writing cert to %s ...", certPath)
-	if err := lock.WriteFile(certPath, certBuffer.Bytes(), os.FileMode(0644)); err != nil {
+	if err := lock.WriteFile(certPath, certBuffer.Bytes(), certPerm); err != nil {
 		return errors.Wrap(err, "Error writing certificate to cert path")
 	}
 
-	if err := os.MkdirAll(filepath.Dir(keyPath), os.FileMode(0755)); err != nil {
+	if err := os.MkdirAll(filepath.Dir(keyPath), dirPerm); err != nil {
 		return errors.Wrap(err, "Error creating key directory")
 	}
 	klog.Infof("Writing key to %s ...", keyPath)
-	if err := lock.WriteFile(keyPath, keyBuffer.Bytes(), os.FileMode(0600)); err != nil {
+	if err := lock.WriteFile(keyPath, keyBuffer.Bytes(), keyPerm); err != nil {
 		return errors.Wrap(err, "Error writing key file")
 	}
 
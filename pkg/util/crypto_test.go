@@ -32,7 +32,7 @@ func TestGenerateCACert(t *testing.T) {
 
 	certPath := filepath.Join(tmpDir, "cert")
 	keyPath := filepath.Join(tmpDir, "key")
-	if err := GenerateCACert(certPath, keyPath, constants.APIServerName); err != nil {
+	if err := GenerateCACert(certPath, keyPath, constants.APIServerName, 0600); err != nil {
 		t.Fatalf("GenerateCACert() error = %v", err)
 	}
 
@@ -58,7 +58,7 @@ func TestGenerateSignedCert(t *testing.T) {
 	validSignerCertPath := filepath.Join(signerTmpDir, "cert")
 	validSignerKeyPath := filepath.Join(signerTmpDir, "key")
 
-	if err := GenerateCACert(validSignerCertPath, validSignerKeyPath, constants.APIServerName); err != nil {
+	if err := GenerateCACert(validSignerCertPath, validSignerKeyPath, constants.APIServerName, 0600); err != nil {
 		t.Fatalf("Error generating signer cert")
 	}
 
@@ -110,7 +110,7 @@ func TestGenerateSignedCert(t *testing.T) {
 		t.Run(test.description, func(t *testing.T) {
 			err := GenerateSignedCert(
 				certPath, keyPath, "minikube", ips, alternateDNS, test.signerCertPath,
-				test.signerKeyPath, constants.DefaultCertExpiration,
+				test.signerKeyPath, constants.DefaultCertExpiration, 0600,
 			)
 			if err != nil && !test.err {
 				t.Errorf("GenerateSignedCert() error = %v", err)
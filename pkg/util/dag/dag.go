@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dag runs tasks concurrently, each one as soon as the tasks it depends on have succeeded.
+package dag
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+type task struct {
+	name string
+	fn   func() error
+	deps []string
+}
+
+// Graph is a set of tasks and their dependencies. The zero value is an empty graph.
+type Graph struct {
+	tasks []*task
+}
+
+// Add adds a task named name, that runs fn once every task of deps has succeeded.
+// Dependencies may be added after the tasks that depend on them.
+func (g *Graph) Add(name string, fn func() error, deps ...string) {
+	g.tasks = append(g.tasks, &task{name: name, fn: fn, deps: deps})
+}
+
+// validate checks that task names are unique, that every dependency exists, and that there is no cycle
+func (g *Graph) validate() error {
+	deps := map[string][]string{}
+	for _, t := range g.tasks {
+		if _, ok := deps[t.name]; ok {
+			return fmt.Errorf("duplicate task %q", t.name)
+		}
+		deps[t.name] = t.deps
+	}
+	for _, t := range g.tasks {
+		for _, d := range t.deps {
+			if _, ok := deps[d]; !ok {
+				return fmt.Errorf("task %q depends on unknown task %q", t.name, d)
+			}
+		}
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var visit func(string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("dependency cycle through task %q", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, d := range deps[name] {
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, t := range g.tasks {
+		if err := visit(t.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run runs the tasks and waits for them. Once a task fails, the tasks that have not started yet are skipped,
+// and the error of the first failed task is returned as is. A task that panics fails, and its panic is raised
+// again in the caller of Run once the other tasks have finished, for the caller to recover from it.
+func (g *Graph) Run() error {
+	if err := g.validate(); err != nil {
+		return err
+	}
+
+	// done is closed when a task has finished, whether it succeeded or not
+	done := map[string]chan struct{}{}
+	for _, t := range g.tasks {
+		done[t.name] = make(chan struct{})
+	}
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		panicked interface{}
+		wg       sync.WaitGroup
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	for _, t := range g.tasks {
+		wg.Add(1)
+		go func(t *task) {
+			defer wg.Done()
+			defer close(done[t.name])
+
+			for _, d := range t.deps {
+				<-done[d]
+			}
+			if failed() {
+				klog.Infof("skipping %s: a task it depends on failed", t.name)
+				return
+			}
+
+			start := time.Now()
+			err := func() (err error) {
+				defer func() {
+					if r := recover(); r != nil {
+						mu.Lock()
+						if panicked == nil {
+							panicked = r
+						}
+						mu.Unlock()
+						err = fmt.Errorf("%s panicked: %v", t.name, r)
+					}
+				}()
+				return t.fn()
+			}()
+			klog.Infof("duration metric: took %s for %s", time.Since(start), t.name)
+			if err != nil {
+				klog.Warningf("%s failed: %v", t.name, err)
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(t)
+	}
+	wg.Wait()
+	if panicked != nil {
+		panic(panicked)
+	}
+	return firstErr
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dag
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	record := func(name string, d time.Duration) func() error {
+		return func() error {
+			time.Sleep(d)
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	var g Graph
+	// added before its dependencies
+	g.Add("machine", record("machine", 0), "profile", "base image")
+	g.Add("preload", record("preload", 50*time.Millisecond))
+	g.Add("base image", record("base image", 20*time.Millisecond))
+	g.Add("profile", record("profile", 0))
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+
+	pos := map[string]int{}
+	for i, name := range order {
+		pos[name] = i
+	}
+	if len(pos) != 4 {
+		t.Fatalf("ran %v, want every task once", order)
+	}
+	if pos["machine"] < pos["profile"] || pos["machine"] < pos["base image"] {
+		t.Errorf("machine ran before its dependencies: %v", order)
+	}
+	// independent tasks run concurrently, so the slowest one finishes last
+	if pos["preload"] != 3 {
+		t.Errorf("preload did not run concurrently with the other tasks: %v", order)
+	}
+}
+
+func TestRunFailure(t *testing.T) {
+	want := errors.New("no space left")
+	ran := false
+
+	var g Graph
+	g.Add("download", func() error { return want })
+	g.Add("extract", func() error { ran = true; return nil }, "download")
+	if err := g.Run(); err != want {
+		t.Errorf("Run() error = %v, want %v", err, want)
+	}
+	if ran {
+		t.Errorf("a task ran after the task it depends on failed")
+	}
+}
+
+func TestRunInvalid(t *testing.T) {
+	noop := func() error { return nil }
+	tests := []struct {
+		name  string
+		tasks map[string][]string
+		want  string
+	}{
+		{"unknown", map[string][]string{"a": {"b"}}, "unknown task"},
+		{"cycle", map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"a"}}, "cycle"},
+		{"self", map[string][]string{"a": {"a"}}, "cycle"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var g Graph
+			for name, deps := range tc.tasks {
+				g.Add(name, noop, deps...)
+			}
+			err := g.Run()
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Run() error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+
+	var g Graph
+	g.Add("a", noop)
+	g.Add("a", noop)
+	if err := g.Run(); err == nil || !strings.Contains(err.Error(), "duplicate") {
+		t.Errorf("Run() error = %v, want duplicate", err)
+	}
+}
+
+func TestRunPanic(t *testing.T) {
+	var g Graph
+	var ran bool
+	g.Add("a", func() error { panic("exit 80") })
+	g.Add("b", func() error { ran = true; return nil }, "a")
+	g.Add("c", func() error { return nil })
+
+	defer func() {
+		r := recover()
+		if r != "exit 80" {
+			t.Errorf("Run() panicked with %v, want the panic of the task", r)
+		}
+		if ran {
+			t.Errorf("a task that depends on the task that panicked ran")
+		}
+	}()
+	_ = g.Run()
+	t.Errorf("Run() returned, want the panic of the task in its caller")
+}
@@ -0,0 +1,87 @@
+//go:build !windows
+
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/juju/mutex/v2"
+	"github.com/pkg/errors"
+)
+
+// fileReleaser releases a lock taken with flock
+type fileReleaser struct {
+	f *os.File
+}
+
+// Release implements mutex.Releaser
+func (r *fileReleaser) Release() {
+	if r.f == nil {
+		return
+	}
+	// closing the file releases the flock
+	r.f.Close()
+	r.f = nil
+}
+
+// acquireFile takes an exclusive flock on the file named after spec in dir, polling it like juju/mutex does
+func acquireFile(dir string, perm os.FileMode, spec mutex.Spec) (mutex.Releaser, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, errors.Wrap(err, "creating lock directory")
+	}
+	path := filepath.Join(dir, spec.Name)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY|syscall.O_CLOEXEC, perm)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening lock file %s", path)
+	}
+	// the umask may have taken bits away from perm, only the owner of the file can give them back
+	if fi, err := f.Stat(); err == nil && fi.Mode().Perm() != perm {
+		_ = f.Chmod(perm)
+	}
+
+	var timeout <-chan time.Time
+	if spec.Timeout > 0 {
+		timeout = spec.Clock.After(spec.Timeout)
+	}
+	for {
+		err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			return &fileReleaser{f: f}, nil
+		}
+		if err != syscall.EWOULDBLOCK {
+			f.Close()
+			return nil, errors.Wrapf(err, "locking %s", path)
+		}
+		select {
+		case <-timeout:
+			f.Close()
+			return nil, mutex.ErrTimeout
+		case <-spec.Cancel:
+			f.Close()
+			return nil, mutex.ErrCancelled
+		case <-spec.Clock.After(spec.Delay):
+		}
+	}
+}
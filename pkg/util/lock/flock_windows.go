@@ -0,0 +1,30 @@
+//go:build windows
+
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"os"
+
+	"github.com/juju/mutex/v2"
+)
+
+// acquireFile falls back to juju/mutex, which uses named mutexes instead of lock files on Windows
+func acquireFile(_ string, _ os.FileMode, spec mutex.Spec) (mutex.Releaser, error) {
+	return mutex.Acquire(spec)
+}
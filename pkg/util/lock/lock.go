@@ -29,11 +29,34 @@ import (
 	"k8s.io/klog/v2"
 )
 
+var (
+	// dir holds the lock files when it is set, instead of the system temp directory
+	dir string
+	// perm is the permissions of the lock files in dir
+	perm os.FileMode
+)
+
+// SetDir makes Acquire keep its lock files in d, created with permissions p, so that every user who may write to d
+// can also take its locks. The lock files of juju/mutex live in the system temp directory, and only their owner may
+// open them. An empty d restores juju/mutex.
+func SetDir(d string, p os.FileMode) {
+	dir = d
+	perm = p
+}
+
+// Acquire acquires the mutex of spec, like mutex.Acquire does, in the directory set by SetDir if any
+func Acquire(spec mutex.Spec) (mutex.Releaser, error) {
+	if dir == "" {
+		return mutex.Acquire(spec)
+	}
+	return acquireFile(dir, perm, spec)
+}
+
 // WriteFile decorates os.WriteFile with a file lock and retry
 func WriteFile(filename string, data []byte, perm os.FileMode) error {
 	spec := PathMutexSpec(filename)
 	klog.Infof("WriteFile acquiring %s: %+v", filename, spec)
-	releaser, err := mutex.Acquire(spec)
+	releaser, err := Acquire(spec)
 	if err != nil {
 		return errors.Wrapf(err, "failed to acquire lock for %s: %+v", filename, spec)
 	}
@@ -48,7 +71,7 @@ func WriteFile(filename string, data []byte, perm os.FileMode) error {
 func AppendToFile(filename string, data []byte, perm os.FileMode) error {
 	spec := PathMutexSpec(filename)
 	klog.Infof("WriteFile acquiring %s: %+v", filename, spec)
-	releaser, err := mutex.Acquire(spec)
+	releaser, err := Acquire(spec)
 	if err != nil {
 		return errors.Wrapf(err, "failed to acquire lock for %s: %+v", filename, spec)
 	}
@@ -17,7 +17,11 @@ limitations under the License.
 package lock
 
 import (
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/juju/mutex/v2"
 )
@@ -81,3 +85,39 @@ func TestUserMutexSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestAcquireInDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the locks of Windows are not files")
+	}
+	dir := filepath.Join(t.TempDir(), "locks")
+	SetDir(dir, 0o660)
+	defer SetDir("", 0)
+
+	spec := PathMutexSpec("/foo/bar")
+	r, err := Acquire(spec)
+	if err != nil {
+		t.Fatalf("Acquire(%+v) failed: %v", spec, err)
+	}
+	fi, err := os.Stat(filepath.Join(dir, spec.Name))
+	if err != nil {
+		t.Fatalf("lock file: %v", err)
+	}
+	if fi.Mode().Perm() != 0o660 {
+		t.Errorf("lock file mode = %o, want 660", fi.Mode().Perm())
+	}
+
+	held := spec
+	held.Delay = 10 * time.Millisecond
+	held.Timeout = 50 * time.Millisecond
+	if _, err := Acquire(held); err != mutex.ErrTimeout {
+		t.Errorf("Acquire of a held lock = %v, want %v", err, mutex.ErrTimeout)
+	}
+
+	r.Release()
+	r, err = Acquire(held)
+	if err != nil {
+		t.Fatalf("Acquire after Release failed: %v", err)
+	}
+	r.Release()
+}
@@ -0,0 +1,56 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/minikube/pkg/kapi"
+)
+
+// TestKubeconfigModeSeparate makes sure the clients of minikube find the context of a profile with --kubeconfig-mode=separate,
+// which is only written to the kubeconfig of the profile
+func TestKubeconfigModeSeparate(t *testing.T) {
+	MaybeParallel(t)
+
+	profile := UniqueProfileName("kubeconfig-separate")
+	ctx, cancel := context.WithTimeout(context.Background(), Minutes(30))
+	defer CleanupWithLogs(t, profile, cancel)
+
+	args := append([]string{"start", "-p", profile, "--memory=2048", "--kubeconfig-mode=separate", "--wait=all"}, StartArgs()...)
+	rr, err := Run(t, exec.CommandContext(ctx, Target(), args...))
+	if err != nil {
+		t.Fatalf("failed to start minikube with args: %q : %v", rr.Command(), err)
+	}
+
+	client, err := kapi.Client(profile)
+	if err != nil {
+		t.Fatalf("failed to get the client of %s: %v", profile, err)
+	}
+	nodes, err := client.CoreV1().Nodes().List(ctx, meta.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list the nodes of %s: %v", profile, err)
+	}
+	if len(nodes.Items) != 1 {
+		t.Errorf("expected 1 node in %s, got %d", profile, len(nodes.Items))
+	}
+}